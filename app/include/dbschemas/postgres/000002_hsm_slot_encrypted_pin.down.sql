@@ -0,0 +1 @@
+ALTER TABLE cfg_hardware_security_module_slot ALTER COLUMN pin TYPE VARCHAR(256);
@@ -0,0 +1,25 @@
+CREATE TABLE cfg_signing_limit (
+    id VARCHAR(64) NOT NULL,
+    application_id VARCHAR(64) NOT NULL,
+    internal_resource_id VARCHAR(64) NOT NULL,
+    scope VARCHAR(16) NOT NULL,
+    subject VARCHAR(256) NOT NULL,
+    window_seconds BIGINT NOT NULL,
+    max_signatures BIGINT NULL,
+    max_value VARCHAR(80) NOT NULL,
+    description VARCHAR(256) NULL,
+    creation_date BIGINT NULL,
+    last_update BIGINT NULL,
+    resource_version VARCHAR(256) NOT NULL,
+    PRIMARY KEY (application_id, id)
+);
+CREATE UNIQUE INDEX idx_cfg_signing_limit_internal_resource_id ON cfg_signing_limit(internal_resource_id);
+CREATE TABLE signing_limit_usage (
+    application_id VARCHAR(64) NOT NULL,
+    limit_id VARCHAR(64) NOT NULL,
+    subject VARCHAR(256) NOT NULL,
+    buckets TEXT NOT NULL,
+    last_update BIGINT NULL,
+    resource_version VARCHAR(256) NOT NULL,
+    PRIMARY KEY (application_id, limit_id, subject)
+);
@@ -0,0 +1,18 @@
+CREATE TABLE cfg_rbac_permission (
+    id VARCHAR(64) NOT NULL,
+    actions TEXT NOT NULL,
+    description VARCHAR(256) NULL,
+    creation_date BIGINT NULL,
+    last_update BIGINT NULL,
+    resource_version VARCHAR(256) NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE TABLE cfg_rbac_role (
+    id VARCHAR(64) NOT NULL,
+    permissions TEXT NOT NULL,
+    description VARCHAR(256) NULL,
+    creation_date BIGINT NULL,
+    last_update BIGINT NULL,
+    resource_version VARCHAR(256) NOT NULL,
+    PRIMARY KEY (id)
+);
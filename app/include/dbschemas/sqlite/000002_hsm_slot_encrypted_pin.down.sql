@@ -0,0 +1,2 @@
+-- SQLite does not enforce the length of VARCHAR columns, so encrypted pins already fit in cfg_hardware_security_module_slot.pin.
+-- This step keeps the schema versions aligned with the PostgreSQL migrations.
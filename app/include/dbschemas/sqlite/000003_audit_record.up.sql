@@ -0,0 +1,18 @@
+CREATE TABLE audit_record (
+    sequence_number BIGINT NOT NULL,
+    creation_date BIGINT NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    user_id VARCHAR(64) NOT NULL,
+    application_id VARCHAR(64) NOT NULL,
+    address VARCHAR(64) NOT NULL,
+    chain_id VARCHAR(256) NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    outcome VARCHAR(16) NOT NULL,
+    error TEXT NOT NULL,
+    previous_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    PRIMARY KEY (sequence_number)
+);
+CREATE INDEX idx_audit_record_user_id ON audit_record(user_id);
+CREATE INDEX idx_audit_record_application_id ON audit_record(application_id);
+CREATE INDEX idx_audit_record_address ON audit_record(address);
@@ -0,0 +1 @@
+DROP TABLE cfg_signing_policy;
@@ -0,0 +1,13 @@
+CREATE TABLE cfg_signing_policy (
+    id VARCHAR(64) NOT NULL,
+    application_id VARCHAR(64) NOT NULL,
+    internal_resource_id VARCHAR(64) NOT NULL,
+    address VARCHAR(64) NOT NULL,
+    rules TEXT NOT NULL,
+    description VARCHAR(256) NULL,
+    creation_date BIGINT NULL,
+    last_update BIGINT NULL,
+    resource_version VARCHAR(256) NOT NULL,
+    PRIMARY KEY (application_id, id)
+);
+CREATE UNIQUE INDEX idx_cfg_signing_policy_internal_resource_id ON cfg_signing_policy(internal_resource_id);
@@ -0,0 +1,2 @@
+DROP TABLE signing_limit_usage;
+DROP TABLE cfg_signing_limit;
@@ -0,0 +1,8 @@
+CREATE TABLE account_nonce (
+    chain_id VARCHAR(80) NOT NULL,
+    address VARCHAR(64) NOT NULL,
+    next_nonce BIGINT NOT NULL,
+    creation_date BIGINT NULL,
+    last_update BIGINT NULL,
+    PRIMARY KEY (chain_id, address)
+);
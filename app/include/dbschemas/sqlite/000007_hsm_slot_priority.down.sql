@@ -0,0 +1,3 @@
+DROP INDEX idx_cfg_hardware_security_module_slot_application_priority;
+ALTER TABLE cfg_hardware_security_module_slot DROP COLUMN priority;
+CREATE UNIQUE INDEX idx_cfg_hardware_security_module_slot_application_id ON cfg_hardware_security_module_slot(application_id);
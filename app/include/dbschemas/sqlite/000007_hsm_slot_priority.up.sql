@@ -0,0 +1,3 @@
+DROP INDEX idx_cfg_hardware_security_module_slot_application_id;
+ALTER TABLE cfg_hardware_security_module_slot ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+CREATE UNIQUE INDEX idx_cfg_hardware_security_module_slot_application_priority ON cfg_hardware_security_module_slot(application_id, priority);
@@ -0,0 +1,2 @@
+DROP TABLE cfg_rbac_role;
+DROP TABLE cfg_rbac_permission;
@@ -0,0 +1 @@
+ALTER TABLE audit_record DROP COLUMN signed_hash;
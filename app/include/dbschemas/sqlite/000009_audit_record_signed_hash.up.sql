@@ -0,0 +1 @@
+ALTER TABLE audit_record ADD COLUMN signed_hash VARCHAR(66) NOT NULL DEFAULT '';
@@ -7,12 +7,18 @@ import (
 	"fmt"
 
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	generatedhttpinfra "github.com/hyperledger-labs/signare/app/pkg/infra/generated/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
 	"github.com/hyperledger-labs/signare/app/pkg/utils"
 )
 
@@ -21,6 +27,8 @@ const (
 	maxListApplicationLimit     int = 100
 	defaultAdminUserListLimit   int = 30
 	maxListAdminUserLimit       int = 100
+	defaultAuditRecordListLimit int = 30
+	maxListAuditRecordLimit     int = 100
 )
 
 var _ generatedhttpinfra.AdminAPIAdapter = new(DefaultAdminAPIAdapter)
@@ -170,7 +178,7 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminApplicationsRemove(ctx context.
 func mapApplicationOut(in application.Application) generatedhttpinfra.ApplicationDetail {
 	creationDate := in.CreationDate.String()
 	lastUpdate := in.LastUpdate.String()
-	chainID := in.ChainID.String()
+	chainID := in.ChainID.BigInt().String()
 	return generatedhttpinfra.ApplicationDetail{
 		Meta: &generatedhttpinfra.ResourceMetaDetail{
 			Id:              &in.ID,
@@ -185,6 +193,175 @@ func mapApplicationOut(in application.Application) generatedhttpinfra.Applicatio
 	}
 }
 
+/*******************/
+/*     Audit      */
+/*****************/
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminAuditList(ctx context.Context, request generatedhttpinfra.AdminAuditListRequest) (*generatedhttpinfra.AdminAuditListResponseWrapper, *httpinfra.HTTPError) {
+	var input audit.ListRecordsInput
+
+	var limitInput int
+	if request.Limit != nil {
+		limitInput = int(*request.Limit)
+	}
+	var offsetInput int
+	if request.Offset != nil {
+		offsetInput = int(*request.Offset)
+	}
+	input.PageLimit = utils.MaxValue(utils.DefaultIntValue(limitInput, defaultAuditRecordListLimit), maxListAuditRecordLimit)
+	input.PageOffset = offsetInput
+	input.OrderDirection = request.OrderDirection
+	if len(request.UserId) > 0 {
+		input.UserID = &request.UserId
+	}
+	if len(request.ApplicationId) > 0 {
+		input.ApplicationID = &request.ApplicationId
+	}
+	if len(request.Address) > 0 {
+		input.Address = &request.Address
+	}
+	if len(request.Operation) > 0 {
+		input.Operation = &request.Operation
+	}
+	input.FromSequenceNumber = request.FromSequenceNumber
+
+	outputData, err := adapter.auditUseCase.ListRecords(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	adaptedUseCaseCollection := make([]generatedhttpinfra.AuditRecordDetail, len(outputData.Items))
+	for i, item := range outputData.Items {
+		adaptedUseCaseCollection[i] = mapAuditRecord(item)
+	}
+	offset := int32(outputData.Offset)
+	limit := int32(outputData.Limit)
+	response := generatedhttpinfra.AdminAuditListResponseWrapper{
+		AuditRecordCollection: generatedhttpinfra.AuditRecordCollection{
+			Items:     &adaptedUseCaseCollection,
+			Offset:    &offset,
+			Limit:     &limit,
+			MoreItems: &outputData.MoreItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminAuditVerify(ctx context.Context, _ generatedhttpinfra.AdminAuditVerifyRequest) (*generatedhttpinfra.AdminAuditVerifyResponseWrapper, *httpinfra.HTTPError) {
+	out, err := adapter.auditUseCase.VerifyRecords(ctx, audit.VerifyRecordsInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	response := generatedhttpinfra.AdminAuditVerifyResponseWrapper{
+		AuditVerification: generatedhttpinfra.AuditVerification{
+			Valid:                      &out.Valid,
+			RecordsVerified:            &out.RecordsVerified,
+			LastHash:                   out.LastHash,
+			FirstInvalidSequenceNumber: out.FirstInvalidSequenceNumber,
+			Reason:                     out.Reason,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
+func mapAuditRecord(record audit.Record) generatedhttpinfra.AuditRecordDetail {
+	timestamp := record.Timestamp.String()
+	operation := string(record.Operation)
+	outcome := string(record.Outcome)
+	detail := generatedhttpinfra.AuditRecordDetail{
+		SequenceNumber: &record.SequenceNumber,
+		Timestamp:      &timestamp,
+		Operation:      &operation,
+		UserId:         &record.UserID,
+		Outcome:        &outcome,
+		PreviousHash:   &record.PreviousHash,
+		Hash:           &record.Hash,
+	}
+	if len(record.ApplicationID) > 0 {
+		detail.ApplicationId = &record.ApplicationID
+	}
+	if len(record.Address) > 0 {
+		detail.Address = &record.Address
+	}
+	if len(record.ChainID) > 0 {
+		detail.ChainId = &record.ChainID
+	}
+	if len(record.TxHash) > 0 {
+		detail.TxHash = &record.TxHash
+	}
+	if len(record.SignedHash) > 0 {
+		detail.SignedHash = &record.SignedHash
+	}
+	if len(record.Error) > 0 {
+		detail.Error = &record.Error
+	}
+	return detail
+}
+
+/*******************/
+/*  Authorization */
+/*****************/
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminAuthorizationExplain(ctx context.Context, request generatedhttpinfra.AdminAuthorizationExplainRequest) (*generatedhttpinfra.AdminAuthorizationExplainResponseWrapper, *httpinfra.HTTPError) {
+	input := pdp.ExplainAuthorizationInput{
+		UserID:   *request.AuthorizationQuery.UserId,
+		ActionID: *request.AuthorizationQuery.Action,
+	}
+	if request.AuthorizationQuery.ApplicationId != nil && len(*request.AuthorizationQuery.ApplicationId) > 0 {
+		input.ApplicationID = request.AuthorizationQuery.ApplicationId
+	}
+	if request.AuthorizationQuery.Address != nil {
+		addr, err := address.NewFromHexString(*request.AuthorizationQuery.Address)
+		if err != nil {
+			return nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("address '%s' is not a valid hex address", *request.AuthorizationQuery.Address))
+		}
+		input.Address = &addr
+	}
+
+	out, err := adapter.policyDecisionPoint.ExplainAuthorization(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	grants := make([]generatedhttpinfra.AuthorizationGrant, len(out.Grants))
+	for i, grant := range out.Grants {
+		grants[i] = generatedhttpinfra.AuthorizationGrant{
+			Role:       &grant.Role,
+			Permission: &grant.Permission,
+		}
+	}
+	explanation := generatedhttpinfra.AuthorizationExplanation{
+		Authorized:     &out.Authorized,
+		Roles:          &out.Roles,
+		ActionExists:   &out.ActionExists,
+		Grants:         &grants,
+		AccountEnabled: out.AccountEnabled,
+	}
+	if out.DenialReason != nil {
+		denialReason := string(*out.DenialReason)
+		explanation.DenialReason = &denialReason
+	}
+	if out.UserType != nil {
+		userType := string(*out.UserType)
+		explanation.UserType = &userType
+	}
+
+	response := generatedhttpinfra.AdminAuthorizationExplainResponseWrapper{
+		AuthorizationExplanation: explanation,
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
 /*******************/
 /*    Modules     */
 /*****************/
@@ -209,6 +386,12 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesCreate(ctx context.Conte
 			if request.ModuleCreation.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindSofthsm {
 				input.Configuration.SoftHSMConfiguration = &hsmmodule.SoftHSMConfiguration{}
 			}
+			if request.ModuleCreation.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindCloudkms {
+				input.Configuration.CloudKMSConfiguration = &hsmmodule.CloudKMSConfiguration{}
+			}
+			if request.ModuleCreation.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindPkcs11 && request.ModuleCreation.Spec.Configuration.Pkcs11 != nil {
+				input.Configuration.PKCS11Configuration = mapUseCasePKCS11ConfigurationFrom(*request.ModuleCreation.Spec.Configuration.Pkcs11)
+			}
 		}
 	}
 	out, err := adapter.hsmUseCase.CreateHSMModule(ctx, input)
@@ -285,6 +468,12 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesEdit(ctx context.Context
 			if request.ModuleUpdate.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindSofthsm {
 				input.Configuration.SoftHSMConfiguration = &hsmmodule.SoftHSMConfiguration{}
 			}
+			if request.ModuleUpdate.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindCloudkms {
+				input.Configuration.CloudKMSConfiguration = &hsmmodule.CloudKMSConfiguration{}
+			}
+			if request.ModuleUpdate.Spec.Configuration.HsmKind == generatedhttpinfra.HsmKindPkcs11 && request.ModuleUpdate.Spec.Configuration.Pkcs11 != nil {
+				input.Configuration.PKCS11Configuration = mapUseCasePKCS11ConfigurationFrom(*request.ModuleUpdate.Spec.Configuration.Pkcs11)
+			}
 		}
 	}
 
@@ -307,6 +496,60 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesEdit(ctx context.Context
 	return &response, nil
 }
 
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesHealth(ctx context.Context, _ generatedhttpinfra.AdminModulesHealthRequest) (*generatedhttpinfra.AdminModulesHealthResponseWrapper, *httpinfra.HTTPError) {
+	out, err := adapter.hsmHealthUseCase.GetHSMHealth(ctx, hsmhealth.GetHSMHealthInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	modules := make([]generatedhttpinfra.ModuleHealthDetail, len(out.Modules))
+	for i, module := range out.Modules {
+		modules[i] = mapModuleHealth(module)
+	}
+
+	response := generatedhttpinfra.AdminModulesHealthResponseWrapper{
+		ModulesHealth: generatedhttpinfra.ModulesHealth{
+			Items: &modules,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
+func mapModuleHealth(in hsmhealth.ModuleHealth) generatedhttpinfra.ModuleHealthDetail {
+	kind := string(in.Kind)
+	slots := make([]generatedhttpinfra.SlotHealthDetail, len(in.Slots))
+	for i, slot := range in.Slots {
+		slots[i] = mapSlotHealth(slot)
+	}
+	detail := generatedhttpinfra.ModuleHealthDetail{
+		ModuleId: &in.ModuleID,
+		Kind:     &kind,
+		Slots:    &slots,
+	}
+	if in.LastReset != nil {
+		lastReset := in.LastReset.String()
+		detail.LastReset = &lastReset
+	}
+	return detail
+}
+
+func mapSlotHealth(in hsmhealth.SlotHealth) generatedhttpinfra.SlotHealthDetail {
+	latency := in.Latency.Milliseconds()
+	lastCheck := in.LastCheck.String()
+	return generatedhttpinfra.SlotHealthDetail{
+		SlotId:                &in.SlotID,
+		ApplicationId:         &in.ApplicationID,
+		Slot:                  &in.Slot,
+		Up:                    &in.Up,
+		LatencyInMilliseconds: &latency,
+		LastCheck:             &lastCheck,
+		Reason:                in.Reason,
+	}
+}
+
 func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesList(ctx context.Context, request generatedhttpinfra.AdminModulesListRequest) (*generatedhttpinfra.AdminModulesListResponseWrapper, *httpinfra.HTTPError) {
 	var input hsmmodule.ListHSMModulesInput
 
@@ -379,6 +622,362 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminModulesRemove(ctx context.Conte
 	return &response, nil
 }
 
+/*******************/
+/*     Nonces     */
+/*****************/
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminNoncesDescribe(ctx context.Context, data generatedhttpinfra.AdminNoncesDescribeRequest) (*generatedhttpinfra.AdminNoncesDescribeResponseWrapper, *httpinfra.HTTPError) {
+	nonceID, httpError := mapNonceID(data.ChainId, data.Address)
+	if httpError != nil {
+		return nil, httpError
+	}
+
+	out, err := adapter.nonceUseCase.GetNonce(ctx, nonce.GetNonceInput{
+		NonceID: *nonceID,
+	})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	response := generatedhttpinfra.AdminNoncesDescribeResponseWrapper{
+		NonceDetail: mapNonce(out.Nonce),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminNoncesReset(ctx context.Context, data generatedhttpinfra.AdminNoncesResetRequest) (*generatedhttpinfra.AdminNoncesResetResponseWrapper, *httpinfra.HTTPError) {
+	nonceID, httpError := mapNonceID(data.ChainId, data.Address)
+	if httpError != nil {
+		return nil, httpError
+	}
+	if *data.NonceReset.NextNonce < 0 {
+		return nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage("nextNonce can not be negative")
+	}
+
+	out, err := adapter.nonceUseCase.ResetNonce(ctx, nonce.ResetNonceInput{
+		NonceID:   *nonceID,
+		NextNonce: entities.UInt64(*data.NonceReset.NextNonce),
+	})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	response := generatedhttpinfra.AdminNoncesResetResponseWrapper{
+		NonceDetail: mapNonce(out.Nonce),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}
+	return &response, nil
+}
+
+func mapNonceID(chainIDIn string, addressIn string) (*nonce.NonceID, *httpinfra.HTTPError) {
+	chainID, err := entities.NewInt256FromString(chainIDIn)
+	if err != nil {
+		return nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("chainId '%s' is not a valid chain identifier", chainIDIn))
+	}
+	addr, err := address.NewFromHexString(addressIn)
+	if err != nil {
+		return nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("address '%s' is not a valid hex address", addressIn))
+	}
+	return &nonce.NonceID{
+		ChainID: *chainID,
+		Address: addr,
+	}, nil
+}
+
+func mapNonce(in nonce.Nonce) generatedhttpinfra.NonceDetail {
+	chainID := in.ChainID.BigInt().String()
+	addr := in.Address.String()
+	nextNonce := int64(in.NextNonce)
+	creationDate := in.CreationDate.String()
+	lastUpdate := in.LastUpdate.String()
+	return generatedhttpinfra.NonceDetail{
+		ChainId:      &chainID,
+		Address:      &addr,
+		NextNonce:    &nextNonce,
+		CreationDate: &creationDate,
+		LastUpdate:   &lastUpdate,
+	}
+}
+
+/*******************/
+/*  Permissions   */
+/*****************/
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminPermissionsCreate(ctx context.Context, request generatedhttpinfra.AdminPermissionsCreateRequest) (*generatedhttpinfra.AdminPermissionsCreateResponseWrapper, *httpinfra.HTTPError) {
+	input := role.CreatePermissionInput{
+		Description: request.PermissionCreation.Spec.Description,
+		Actions:     *request.PermissionCreation.Spec.Actions,
+	}
+	if request.PermissionCreation.Meta.Id != nil {
+		input.ID = *request.PermissionCreation.Meta.Id
+	}
+	out, err := adapter.roleUseCase.CreatePermission(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminPermissionsCreateResponseWrapper{
+		PermissionDetail: mapPermission(out.Permission),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeCreated,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminPermissionsDescribe(ctx context.Context, request generatedhttpinfra.AdminPermissionsDescribeRequest) (*generatedhttpinfra.AdminPermissionsDescribeResponseWrapper, *httpinfra.HTTPError) {
+	input := role.GetPermissionInput{
+		StandardID: entities.StandardID{
+			ID: request.PermissionId,
+		},
+	}
+	out, err := adapter.roleUseCase.GetPermission(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminPermissionsDescribeResponseWrapper{
+		PermissionDetail: mapPermission(out.Permission),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminPermissionsEdit(ctx context.Context, request generatedhttpinfra.AdminPermissionsEditRequest) (*generatedhttpinfra.AdminPermissionsEditResponseWrapper, *httpinfra.HTTPError) {
+	input := role.EditPermissionInput{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: entities.StandardID{
+					ID: request.PermissionId,
+				},
+			},
+			ResourceVersion: *request.PermissionUpdate.Meta.ResourceVersion,
+		},
+		Description: request.PermissionUpdate.Spec.Description,
+		Actions:     *request.PermissionUpdate.Spec.Actions,
+	}
+	out, err := adapter.roleUseCase.EditPermission(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminPermissionsEditResponseWrapper{
+		PermissionDetail: mapPermission(out.Permission),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminPermissionsList(ctx context.Context, _ generatedhttpinfra.AdminPermissionsListRequest) (*generatedhttpinfra.AdminPermissionsListResponseWrapper, *httpinfra.HTTPError) {
+	out, err := adapter.roleUseCase.ListPermissions(ctx, role.ListPermissionsInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	items := make([]generatedhttpinfra.PermissionDetail, len(out.Permissions))
+	for i, permission := range out.Permissions {
+		items[i] = mapPermission(permission)
+	}
+	page := entities.NewUnlimitedQueryStandardCollectionPage(len(items))
+	limit := int32(page.Limit)
+	offset := int32(page.Offset)
+	return &generatedhttpinfra.AdminPermissionsListResponseWrapper{
+		PermissionCollection: generatedhttpinfra.PermissionCollection{
+			Items:     &items,
+			Limit:     &limit,
+			Offset:    &offset,
+			MoreItems: &page.MoreItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminPermissionsRemove(ctx context.Context, request generatedhttpinfra.AdminPermissionsRemoveRequest) (*generatedhttpinfra.AdminPermissionsRemoveResponseWrapper, *httpinfra.HTTPError) {
+	input := role.RemovePermissionInput{
+		StandardID: entities.StandardID{
+			ID: request.PermissionId,
+		},
+	}
+	out, err := adapter.roleUseCase.RemovePermission(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminPermissionsRemoveResponseWrapper{
+		PermissionDetail: mapPermission(out.Permission),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func mapPermission(in role.Permission) generatedhttpinfra.PermissionDetail {
+	var description string
+	if in.Description != nil {
+		description = *in.Description
+	}
+	creationDate := in.CreationDate.String()
+	lastUpdate := in.LastUpdate.String()
+	return generatedhttpinfra.PermissionDetail{
+		Meta: &generatedhttpinfra.ResourceMetaDetail{
+			Id:              &in.ID,
+			ResourceVersion: &in.ResourceVersion,
+			CreationDate:    &creationDate,
+			LastUpdate:      &lastUpdate,
+		},
+		Spec: &generatedhttpinfra.PermissionDetailSpec{
+			Actions:     &in.Actions,
+			Description: &description,
+			BuiltIn:     &in.BuiltIn,
+		},
+	}
+}
+
+/*******************/
+/*     Roles      */
+/*****************/
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminRolesCreate(ctx context.Context, request generatedhttpinfra.AdminRolesCreateRequest) (*generatedhttpinfra.AdminRolesCreateResponseWrapper, *httpinfra.HTTPError) {
+	input := role.CreateRoleInput{
+		Description: request.RoleCreation.Spec.Description,
+		Permissions: *request.RoleCreation.Spec.Permissions,
+	}
+	if request.RoleCreation.Meta.Id != nil {
+		input.ID = *request.RoleCreation.Meta.Id
+	}
+	out, err := adapter.roleUseCase.CreateRole(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminRolesCreateResponseWrapper{
+		RoleDetail: mapRole(out.Role),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeCreated,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminRolesDescribe(ctx context.Context, request generatedhttpinfra.AdminRolesDescribeRequest) (*generatedhttpinfra.AdminRolesDescribeResponseWrapper, *httpinfra.HTTPError) {
+	input := role.GetRoleInput{
+		StandardID: entities.StandardID{
+			ID: request.RoleId,
+		},
+	}
+	out, err := adapter.roleUseCase.GetRole(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminRolesDescribeResponseWrapper{
+		RoleDetail: mapRole(out.Role),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminRolesEdit(ctx context.Context, request generatedhttpinfra.AdminRolesEditRequest) (*generatedhttpinfra.AdminRolesEditResponseWrapper, *httpinfra.HTTPError) {
+	input := role.EditRoleInput{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: entities.StandardID{
+					ID: request.RoleId,
+				},
+			},
+			ResourceVersion: *request.RoleUpdate.Meta.ResourceVersion,
+		},
+		Description: request.RoleUpdate.Spec.Description,
+		Permissions: *request.RoleUpdate.Spec.Permissions,
+	}
+	out, err := adapter.roleUseCase.EditRole(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminRolesEditResponseWrapper{
+		RoleDetail: mapRole(out.Role),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminRolesList(ctx context.Context, _ generatedhttpinfra.AdminRolesListRequest) (*generatedhttpinfra.AdminRolesListResponseWrapper, *httpinfra.HTTPError) {
+	out, err := adapter.roleUseCase.ListRoles(ctx, role.ListRolesInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	items := make([]generatedhttpinfra.RoleDetail, len(out.Roles))
+	for i, r := range out.Roles {
+		items[i] = mapRole(r)
+	}
+	page := entities.NewUnlimitedQueryStandardCollectionPage(len(items))
+	limit := int32(page.Limit)
+	offset := int32(page.Offset)
+	return &generatedhttpinfra.AdminRolesListResponseWrapper{
+		RoleCollection: generatedhttpinfra.RoleCollection{
+			Items:     &items,
+			Limit:     &limit,
+			Offset:    &offset,
+			MoreItems: &page.MoreItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultAdminAPIAdapter) AdaptAdminRolesRemove(ctx context.Context, request generatedhttpinfra.AdminRolesRemoveRequest) (*generatedhttpinfra.AdminRolesRemoveResponseWrapper, *httpinfra.HTTPError) {
+	input := role.RemoveRoleInput{
+		StandardID: entities.StandardID{
+			ID: request.RoleId,
+		},
+	}
+	out, err := adapter.roleUseCase.RemoveRole(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.AdminRolesRemoveResponseWrapper{
+		RoleDetail: mapRole(out.Role),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func mapRole(in role.Role) generatedhttpinfra.RoleDetail {
+	var description string
+	if in.Description != nil {
+		description = *in.Description
+	}
+	creationDate := in.CreationDate.String()
+	lastUpdate := in.LastUpdate.String()
+	return generatedhttpinfra.RoleDetail{
+		Meta: &generatedhttpinfra.ResourceMetaDetail{
+			Id:              &in.ID,
+			ResourceVersion: &in.ResourceVersion,
+			CreationDate:    &creationDate,
+			LastUpdate:      &lastUpdate,
+		},
+		Spec: &generatedhttpinfra.RoleDetailSpec{
+			Permissions: &in.Permissions,
+			Description: &description,
+			BuiltIn:     &in.BuiltIn,
+		},
+	}
+}
+
 /*******************/
 /*     Slots      */
 /*****************/
@@ -400,6 +999,10 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminSlotsCreate(ctx context.Context
 	if data.SlotCreation.Spec != nil && data.SlotCreation.Spec.Pin != nil {
 		input.Pin = *data.SlotCreation.Spec.Pin
 	}
+	if data.SlotCreation.Spec != nil && data.SlotCreation.Spec.Priority != nil {
+		priority := int(*data.SlotCreation.Spec.Priority)
+		input.Priority = &priority
+	}
 
 	out, err := adapter.hsmSlotUseCase.CreateHSMSlot(ctx, input)
 	if err != nil {
@@ -746,6 +1349,7 @@ func (adapter *DefaultAdminAPIAdapter) AdaptAdminUsersRemove(ctx context.Context
 func mapSlot(slot hsmslot.HSMSlot) generatedhttpinfra.SlotDetail {
 	creationDate := slot.CreationDate.String()
 	lastUpdate := slot.LastUpdate.String()
+	priority := int64(slot.Priority)
 
 	return generatedhttpinfra.SlotDetail{
 		Meta: &generatedhttpinfra.ResourceMetaDetail{
@@ -758,6 +1362,7 @@ func mapSlot(slot hsmslot.HSMSlot) generatedhttpinfra.SlotDetail {
 			HardwareSecurityModuleId: &slot.HSMModuleID,
 			ApplicationId:            &slot.ApplicationID,
 			Slot:                     &slot.Slot,
+			Priority:                 &priority,
 		},
 	}
 }
@@ -775,6 +1380,30 @@ func mapModule(module hsmmodule.HSMModule) (*generatedhttpinfra.ModuleDetail, er
 		return nil, err
 	}
 	kind := string(*infraHSMKind)
+	configuration := generatedhttpinfra.ModuleSpecConfiguration{
+		HsmKind: *infraHSMKind,
+	}
+	switch *infraHSMKind {
+	case generatedhttpinfra.HsmKindSofthsm:
+		configuration.SoftHsm = &generatedhttpinfra.SoftHsm{
+			HsmKind: &kind,
+		}
+	case generatedhttpinfra.HsmKindCloudkms:
+		configuration.CloudKms = &generatedhttpinfra.CloudKms{
+			HsmKind: &kind,
+		}
+	case generatedhttpinfra.HsmKindPkcs11:
+		configuration.Pkcs11 = &generatedhttpinfra.Pkcs11{
+			HsmKind: &kind,
+		}
+		if module.Configuration.PKCS11Configuration != nil {
+			configuration.Pkcs11.Library = &module.Configuration.PKCS11Configuration.Library
+			configuration.Pkcs11.TokenLabel = module.Configuration.PKCS11Configuration.TokenLabel
+			configuration.Pkcs11.Quirks = &generatedhttpinfra.Pkcs11Quirks{
+				OmitKeyId: &module.Configuration.PKCS11Configuration.Quirks.OmitKeyID,
+			}
+		}
+	}
 	return &generatedhttpinfra.ModuleDetail{
 		Meta: &generatedhttpinfra.ResourceMetaDetail{
 			Id:              &module.ID,
@@ -783,13 +1412,8 @@ func mapModule(module hsmmodule.HSMModule) (*generatedhttpinfra.ModuleDetail, er
 			LastUpdate:      &lastUpdate,
 		},
 		Spec: &generatedhttpinfra.ModuleSpec{
-			Configuration: &generatedhttpinfra.ModuleSpecConfiguration{
-				HsmKind: *infraHSMKind,
-				SoftHsm: &generatedhttpinfra.SoftHsm{
-					HsmKind: &kind,
-				},
-			},
-			Description: module.Description,
+			Configuration: &configuration,
+			Description:   module.Description,
 		},
 	}, nil
 }
@@ -799,6 +1423,14 @@ func mapModuleCreationSpecConfigurationTypeFrom(configurationType hsmmodule.Modu
 		t := generatedhttpinfra.HsmKindSofthsm
 		return &t, nil
 	}
+	if configurationType == hsmmodule.CloudKMSModuleKind {
+		t := generatedhttpinfra.HsmKindCloudkms
+		return &t, nil
+	}
+	if configurationType == hsmmodule.PKCS11ModuleKind {
+		t := generatedhttpinfra.HsmKindPkcs11
+		return &t, nil
+	}
 	return nil, httpinfra.NewHTTPError(httpinfra.StatusInternal).SetMessage(fmt.Sprintf("cannot map invalid module kind [%s]", configurationType))
 }
 
@@ -807,23 +1439,53 @@ func mapUseCaseHSMKindFrom(configurationKind generatedhttpinfra.ModuleSpecConfig
 		t := hsmmodule.SoftHSMModuleKind
 		return &t, nil
 	}
+	if configurationKind == generatedhttpinfra.HsmKindCloudkms {
+		t := hsmmodule.CloudKMSModuleKind
+		return &t, nil
+	}
+	if configurationKind == generatedhttpinfra.HsmKindPkcs11 {
+		t := hsmmodule.PKCS11ModuleKind
+		return &t, nil
+	}
 	return nil, httpinfra.NewHTTPError(httpinfra.StatusInternal).SetMessage(fmt.Sprintf("can't map '%s' to usecase HSM type", configurationKind))
 }
 
+func mapUseCasePKCS11ConfigurationFrom(configuration generatedhttpinfra.Pkcs11) *hsmmodule.PKCS11Configuration {
+	var result hsmmodule.PKCS11Configuration
+	if configuration.Library != nil {
+		result.Library = *configuration.Library
+	}
+	result.TokenLabel = configuration.TokenLabel
+	if configuration.Quirks != nil && configuration.Quirks.OmitKeyId != nil {
+		result.Quirks.OmitKeyID = *configuration.Quirks.OmitKeyId
+	}
+	return &result
+}
+
 // DefaultAdminAPIAdapter implements AdminAPIAdapter.
 type DefaultAdminAPIAdapter struct {
-	applicationUseCase application.ApplicationUseCase
-	adminUseCase       admin.AdminUseCase
-	hsmUseCase         hsmmodule.HSMModuleUseCase
-	hsmSlotUseCase     hsmslot.HSMSlotUseCase
+	applicationUseCase  application.ApplicationUseCase
+	adminUseCase        admin.AdminUseCase
+	hsmUseCase          hsmmodule.HSMModuleUseCase
+	hsmSlotUseCase      hsmslot.HSMSlotUseCase
+	auditUseCase        audit.AuditUseCase
+	nonceUseCase        nonce.NonceUseCase
+	hsmHealthUseCase    hsmhealth.HSMHealthUseCase
+	roleUseCase         role.RoleUseCase
+	policyDecisionPoint pdp.PolicyDecisionPointUseCase
 }
 
 // DefaultAdminAPIAdapterOptions options to create a new DefaultAdminAPIAdapter.
 type DefaultAdminAPIAdapterOptions struct {
-	ApplicationUseCase application.ApplicationUseCase
-	AdminUseCase       admin.AdminUseCase
-	HSMUseCase         hsmmodule.HSMModuleUseCase
-	HSMSlotUseCase     hsmslot.HSMSlotUseCase
+	ApplicationUseCase  application.ApplicationUseCase
+	AdminUseCase        admin.AdminUseCase
+	HSMUseCase          hsmmodule.HSMModuleUseCase
+	HSMSlotUseCase      hsmslot.HSMSlotUseCase
+	AuditUseCase        audit.AuditUseCase
+	NonceUseCase        nonce.NonceUseCase
+	HSMHealthUseCase    hsmhealth.HSMHealthUseCase
+	RoleUseCase         role.RoleUseCase
+	PolicyDecisionPoint pdp.PolicyDecisionPointUseCase
 }
 
 // ProvideDefaultAdminAPIAdapter creates a new DefaultAdminAPIAdapter instance.
@@ -840,11 +1502,31 @@ func ProvideDefaultAdminAPIAdapter(options DefaultAdminAPIAdapterOptions) (*Defa
 	if options.HSMUseCase == nil {
 		return nil, errors.New("mandatory 'HSMUseCase' was not provided")
 	}
+	if options.AuditUseCase == nil {
+		return nil, errors.New("mandatory 'AuditUseCase' was not provided")
+	}
+	if options.NonceUseCase == nil {
+		return nil, errors.New("mandatory 'NonceUseCase' was not provided")
+	}
+	if options.HSMHealthUseCase == nil {
+		return nil, errors.New("mandatory 'HSMHealthUseCase' was not provided")
+	}
+	if options.RoleUseCase == nil {
+		return nil, errors.New("mandatory 'RoleUseCase' was not provided")
+	}
+	if options.PolicyDecisionPoint == nil {
+		return nil, errors.New("mandatory 'PolicyDecisionPoint' was not provided")
+	}
 
 	return &DefaultAdminAPIAdapter{
-		applicationUseCase: options.ApplicationUseCase,
-		adminUseCase:       options.AdminUseCase,
-		hsmUseCase:         options.HSMUseCase,
-		hsmSlotUseCase:     options.HSMSlotUseCase,
+		applicationUseCase:  options.ApplicationUseCase,
+		adminUseCase:        options.AdminUseCase,
+		hsmUseCase:          options.HSMUseCase,
+		hsmSlotUseCase:      options.HSMSlotUseCase,
+		auditUseCase:        options.AuditUseCase,
+		nonceUseCase:        options.NonceUseCase,
+		hsmHealthUseCase:    options.HSMHealthUseCase,
+		roleUseCase:         options.RoleUseCase,
+		policyDecisionPoint: options.PolicyDecisionPoint,
 	}, nil
 }
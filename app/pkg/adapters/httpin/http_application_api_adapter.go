@@ -10,6 +10,8 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	generatedhttpinfra "github.com/hyperledger-labs/signare/app/pkg/infra/generated/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
 	"github.com/hyperledger-labs/signare/app/pkg/utils"
 )
@@ -70,6 +72,328 @@ func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationAccountsRemove(ctx
 	}, nil
 }
 
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsCreate(ctx context.Context, data generatedhttpinfra.ApplicationLimitsCreateRequest) (*generatedhttpinfra.ApplicationLimitsCreateResponseWrapper, *httpinfra.HTTPError) {
+	spec := data.SigningLimitCreation.Spec
+	input := signinglimit.CreateSigningLimitInput{
+		ApplicationID: data.ApplicationId,
+		Scope:         signinglimit.Scope(*spec.Scope),
+		Subject:       spec.Subject,
+		WindowSeconds: *spec.WindowSeconds,
+		MaxSignatures: spec.MaxSignatures,
+		Description:   spec.Description,
+	}
+	if data.SigningLimitCreation.Meta != nil && data.SigningLimitCreation.Meta.Id != nil {
+		input.ID = data.SigningLimitCreation.Meta.Id
+	}
+	maxValue, httpError := mapSigningLimitMaxValueIn(spec.MaxValue)
+	if httpError != nil {
+		return nil, httpError
+	}
+	input.MaxValue = maxValue
+
+	out, err := adapter.signingLimitUseCase.CreateSigningLimit(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationLimitsCreateResponseWrapper{
+		SigningLimitDetail: mapSigningLimit(out.SigningLimit),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeCreated,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsDescribe(ctx context.Context, data generatedhttpinfra.ApplicationLimitsDescribeRequest) (*generatedhttpinfra.ApplicationLimitsDescribeResponseWrapper, *httpinfra.HTTPError) {
+	input := signinglimit.GetSigningLimitInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.LimitId,
+			ApplicationID: data.ApplicationId,
+		},
+	}
+	out, err := adapter.signingLimitUseCase.GetSigningLimit(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationLimitsDescribeResponseWrapper{
+		SigningLimitDetail: mapSigningLimit(out.SigningLimit),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsEdit(ctx context.Context, data generatedhttpinfra.ApplicationLimitsEditRequest) (*generatedhttpinfra.ApplicationLimitsEditResponseWrapper, *httpinfra.HTTPError) {
+	spec := data.SigningLimitUpdate.Spec
+	input := signinglimit.EditSigningLimitInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.LimitId,
+			ApplicationID: data.ApplicationId,
+		},
+		ResourceVersion: *data.SigningLimitUpdate.Meta.ResourceVersion,
+		WindowSeconds:   *spec.WindowSeconds,
+		MaxSignatures:   spec.MaxSignatures,
+		Description:     spec.Description,
+	}
+	maxValue, httpError := mapSigningLimitMaxValueIn(spec.MaxValue)
+	if httpError != nil {
+		return nil, httpError
+	}
+	input.MaxValue = maxValue
+
+	out, err := adapter.signingLimitUseCase.EditSigningLimit(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationLimitsEditResponseWrapper{
+		SigningLimitDetail: mapSigningLimit(out.SigningLimit),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsList(ctx context.Context, data generatedhttpinfra.ApplicationLimitsListRequest) (*generatedhttpinfra.ApplicationLimitsListResponseWrapper, *httpinfra.HTTPError) {
+	input := signinglimit.ListSigningLimitsInput{
+		ApplicationID: data.ApplicationId,
+	}
+	var limitInput int
+	if data.Limit != nil {
+		limitInput = int(*data.Limit)
+	}
+	var offsetInput int
+	if data.Offset != nil {
+		offsetInput = int(*data.Offset)
+	}
+	pageLimit := utils.MaxValue(utils.DefaultIntValue(limitInput, defaultApplicationListLimit), maxListApplicationLimit)
+	input.PageLimit = pageLimit
+	input.PageOffset = offsetInput
+	input.OrderBy = data.OrderBy
+	input.OrderDirection = data.OrderDirection
+
+	out, err := adapter.signingLimitUseCase.ListSigningLimits(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	adaptedItems := make([]generatedhttpinfra.SigningLimitDetail, len(out.Items))
+	for i, item := range out.Items {
+		adaptedItems[i] = mapSigningLimit(item)
+	}
+
+	offset := int32(out.Offset)
+	limit := int32(out.Limit)
+	return &generatedhttpinfra.ApplicationLimitsListResponseWrapper{
+		SigningLimitCollection: generatedhttpinfra.SigningLimitCollection{
+			Limit:     &limit,
+			Offset:    &offset,
+			MoreItems: &out.MoreItems,
+			Items:     &adaptedItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsRemove(ctx context.Context, data generatedhttpinfra.ApplicationLimitsRemoveRequest) (*generatedhttpinfra.ApplicationLimitsRemoveResponseWrapper, *httpinfra.HTTPError) {
+	input := signinglimit.DeleteSigningLimitInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.LimitId,
+			ApplicationID: data.ApplicationId,
+		},
+	}
+
+	out, err := adapter.signingLimitUseCase.DeleteSigningLimit(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationLimitsRemoveResponseWrapper{
+		SigningLimitDetail: mapSigningLimit(out.SigningLimit),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationLimitsUsage(ctx context.Context, data generatedhttpinfra.ApplicationLimitsUsageRequest) (*generatedhttpinfra.ApplicationLimitsUsageResponseWrapper, *httpinfra.HTTPError) {
+	input := signinglimit.GetSigningLimitUsageInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.LimitId,
+			ApplicationID: data.ApplicationId,
+		},
+	}
+	out, err := adapter.signingLimitUseCase.GetSigningLimitUsage(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	adaptedItems := make([]generatedhttpinfra.SigningLimitSubjectUsage, len(out.Items))
+	for i := range out.Items {
+		value := out.Items[i].Value.BigInt().String()
+		adaptedItems[i] = generatedhttpinfra.SigningLimitSubjectUsage{
+			Subject:    &out.Items[i].Subject,
+			Signatures: &out.Items[i].Signatures,
+			Value:      &value,
+		}
+	}
+
+	return &generatedhttpinfra.ApplicationLimitsUsageResponseWrapper{
+		SigningLimitUsage: generatedhttpinfra.SigningLimitUsage{
+			LimitId:       &out.SigningLimit.ID,
+			WindowSeconds: &out.SigningLimit.WindowSeconds,
+			Items:         &adaptedItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationPoliciesCreate(ctx context.Context, data generatedhttpinfra.ApplicationPoliciesCreateRequest) (*generatedhttpinfra.ApplicationPoliciesCreateResponseWrapper, *httpinfra.HTTPError) {
+	input := signingpolicy.CreateSigningPolicyInput{
+		ApplicationID: data.ApplicationId,
+	}
+	if data.SigningPolicyCreation.Meta != nil && data.SigningPolicyCreation.Meta.Id != nil {
+		input.ID = data.SigningPolicyCreation.Meta.Id
+	}
+	addr, rules, httpError := mapSigningPolicySpecIn(data.SigningPolicyCreation.Spec.Address, *data.SigningPolicyCreation.Spec.Rules)
+	if httpError != nil {
+		return nil, httpError
+	}
+	input.Address = addr
+	input.Rules = *rules
+	input.Description = data.SigningPolicyCreation.Spec.Description
+
+	out, err := adapter.signingPolicyUseCase.CreateSigningPolicy(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationPoliciesCreateResponseWrapper{
+		SigningPolicyDetail: mapSigningPolicy(out.SigningPolicy),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeCreated,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationPoliciesDescribe(ctx context.Context, data generatedhttpinfra.ApplicationPoliciesDescribeRequest) (*generatedhttpinfra.ApplicationPoliciesDescribeResponseWrapper, *httpinfra.HTTPError) {
+	input := signingpolicy.GetSigningPolicyInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.PolicyId,
+			ApplicationID: data.ApplicationId,
+		},
+	}
+	out, err := adapter.signingPolicyUseCase.GetSigningPolicy(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationPoliciesDescribeResponseWrapper{
+		SigningPolicyDetail: mapSigningPolicy(out.SigningPolicy),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationPoliciesEdit(ctx context.Context, data generatedhttpinfra.ApplicationPoliciesEditRequest) (*generatedhttpinfra.ApplicationPoliciesEditResponseWrapper, *httpinfra.HTTPError) {
+	input := signingpolicy.EditSigningPolicyInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.PolicyId,
+			ApplicationID: data.ApplicationId,
+		},
+		ResourceVersion: *data.SigningPolicyUpdate.Meta.ResourceVersion,
+	}
+	addr, rules, httpError := mapSigningPolicySpecIn(data.SigningPolicyUpdate.Spec.Address, *data.SigningPolicyUpdate.Spec.Rules)
+	if httpError != nil {
+		return nil, httpError
+	}
+	input.Address = addr
+	input.Rules = *rules
+	input.Description = data.SigningPolicyUpdate.Spec.Description
+
+	out, err := adapter.signingPolicyUseCase.EditSigningPolicy(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationPoliciesEditResponseWrapper{
+		SigningPolicyDetail: mapSigningPolicy(out.SigningPolicy),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationPoliciesList(ctx context.Context, data generatedhttpinfra.ApplicationPoliciesListRequest) (*generatedhttpinfra.ApplicationPoliciesListResponseWrapper, *httpinfra.HTTPError) {
+	input := signingpolicy.ListSigningPoliciesInput{
+		ApplicationID: data.ApplicationId,
+	}
+	var limitInput int
+	if data.Limit != nil {
+		limitInput = int(*data.Limit)
+	}
+	var offsetInput int
+	if data.Offset != nil {
+		offsetInput = int(*data.Offset)
+	}
+	pageLimit := utils.MaxValue(utils.DefaultIntValue(limitInput, defaultApplicationListLimit), maxListApplicationLimit)
+	input.PageLimit = pageLimit
+	input.PageOffset = offsetInput
+	input.OrderBy = data.OrderBy
+	input.OrderDirection = data.OrderDirection
+
+	out, err := adapter.signingPolicyUseCase.ListSigningPolicies(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	adaptedItems := make([]generatedhttpinfra.SigningPolicyDetail, len(out.Items))
+	for i, item := range out.Items {
+		adaptedItems[i] = mapSigningPolicy(item)
+	}
+
+	offset := int32(out.Offset)
+	limit := int32(out.Limit)
+	return &generatedhttpinfra.ApplicationPoliciesListResponseWrapper{
+		SigningPolicyCollection: generatedhttpinfra.SigningPolicyCollection{
+			Limit:     &limit,
+			Offset:    &offset,
+			MoreItems: &out.MoreItems,
+			Items:     &adaptedItems,
+		},
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
+func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationPoliciesRemove(ctx context.Context, data generatedhttpinfra.ApplicationPoliciesRemoveRequest) (*generatedhttpinfra.ApplicationPoliciesRemoveResponseWrapper, *httpinfra.HTTPError) {
+	input := signingpolicy.DeleteSigningPolicyInput{
+		ApplicationStandardID: entities.ApplicationStandardID{
+			ID:            data.PolicyId,
+			ApplicationID: data.ApplicationId,
+		},
+	}
+
+	out, err := adapter.signingPolicyUseCase.DeleteSigningPolicy(ctx, input)
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+
+	return &generatedhttpinfra.ApplicationPoliciesRemoveResponseWrapper{
+		SigningPolicyDetail: mapSigningPolicy(out.SigningPolicy),
+		ResponseInfo: httpinfra.ResponseInfo{
+			ResponseType: httpinfra.ResponseTypeOk,
+		},
+	}, nil
+}
+
 func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationUsersCreate(ctx context.Context, data generatedhttpinfra.ApplicationUsersCreateRequest) (*generatedhttpinfra.ApplicationUsersCreateResponseWrapper, *httpinfra.HTTPError) {
 	input := user.CreateUserInput{
 		ApplicationID: data.ApplicationId,
@@ -210,12 +534,16 @@ func (adapter *DefaultApplicationAPIAdapter) AdaptApplicationUsersRemove(ctx con
 
 // DefaultApplicationAPIAdapter implements ApplicationAPIAdapter.
 type DefaultApplicationAPIAdapter struct {
-	userUseCase user.UserUseCase
+	userUseCase          user.UserUseCase
+	signingPolicyUseCase signingpolicy.SigningPolicyUseCase
+	signingLimitUseCase  signinglimit.SigningLimitUseCase
 }
 
 // DefaultApplicationAPIAdapterOptions options to create a new DefaultApplicationAPIAdapter.
 type DefaultApplicationAPIAdapterOptions struct {
-	UserUseCase user.UserUseCase
+	UserUseCase          user.UserUseCase
+	SigningPolicyUseCase signingpolicy.SigningPolicyUseCase
+	SigningLimitUseCase  signinglimit.SigningLimitUseCase
 }
 
 // ProvideDefaultApplicationAPIAdapter creates a new DefaultApplicationAPIAdapter instance.
@@ -223,9 +551,17 @@ func ProvideDefaultApplicationAPIAdapter(options DefaultApplicationAPIAdapterOpt
 	if options.UserUseCase == nil {
 		return nil, errors.New("mandatory 'UserUseCase' was not provided")
 	}
+	if options.SigningPolicyUseCase == nil {
+		return nil, errors.New("mandatory 'SigningPolicyUseCase' was not provided")
+	}
+	if options.SigningLimitUseCase == nil {
+		return nil, errors.New("mandatory 'SigningLimitUseCase' was not provided")
+	}
 
 	return &DefaultApplicationAPIAdapter{
-		userUseCase: options.UserUseCase,
+		userUseCase:          options.UserUseCase,
+		signingPolicyUseCase: options.SigningPolicyUseCase,
+		signingLimitUseCase:  options.SigningLimitUseCase,
 	}, nil
 }
 
@@ -251,3 +587,173 @@ func mapUser(user user.User) generatedhttpinfra.UserDetail {
 		},
 	}
 }
+
+func mapSigningPolicySpecIn(addressIn *string, rulesIn generatedhttpinfra.SigningPolicyRules) (*address.Address, *signingpolicy.Rules, *httpinfra.HTTPError) {
+	var policyAddress *address.Address
+	if addressIn != nil {
+		a, err := address.NewFromHexString(*addressIn)
+		if err != nil {
+			return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("address '%s' is not a valid hex address", *addressIn))
+		}
+		policyAddress = &a
+	}
+
+	rules := signingpolicy.Rules{}
+	if rulesIn.AllowedRecipients != nil {
+		rules.AllowedRecipients = make([]address.Address, len(*rulesIn.AllowedRecipients))
+		for i, recipient := range *rulesIn.AllowedRecipients {
+			a, err := address.NewFromHexString(recipient)
+			if err != nil {
+				return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("address '%s' is not a valid hex address", recipient))
+			}
+			rules.AllowedRecipients[i] = a
+		}
+	}
+	if rulesIn.MaxValue != nil {
+		maxValue, err := entities.NewInt256FromString(*rulesIn.MaxValue)
+		if err != nil {
+			return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("maxValue '%s' is not a valid number", *rulesIn.MaxValue))
+		}
+		rules.MaxValue = maxValue
+	}
+	if rulesIn.MaxGas != nil {
+		maxGas, err := entities.NewUInt64FromString(*rulesIn.MaxGas)
+		if err != nil {
+			return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("maxGas '%s' is not a valid number", *rulesIn.MaxGas))
+		}
+		rules.MaxGas = &maxGas
+	}
+	if rulesIn.MaxGasPrice != nil {
+		maxGasPrice, err := entities.NewInt256FromString(*rulesIn.MaxGasPrice)
+		if err != nil {
+			return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("maxGasPrice '%s' is not a valid number", *rulesIn.MaxGasPrice))
+		}
+		rules.MaxGasPrice = maxGasPrice
+	}
+	if rulesIn.AllowedMethodSelectors != nil {
+		rules.AllowedMethodSelectors = make([]signingpolicy.MethodSelector, len(*rulesIn.AllowedMethodSelectors))
+		for i, selector := range *rulesIn.AllowedMethodSelectors {
+			rules.AllowedMethodSelectors[i] = signingpolicy.MethodSelector(selector)
+		}
+	}
+	if rulesIn.DenyContractCreation != nil {
+		rules.DenyContractCreation = *rulesIn.DenyContractCreation
+	}
+	if rulesIn.AllowMessages != nil {
+		rules.AllowMessages = *rulesIn.AllowMessages
+	}
+	if rulesIn.AllowTypedData != nil {
+		rules.AllowTypedData = *rulesIn.AllowTypedData
+	}
+	if rulesIn.AllowedVerifyingContracts != nil {
+		rules.AllowedVerifyingContracts = make([]address.Address, len(*rulesIn.AllowedVerifyingContracts))
+		for i, verifyingContract := range *rulesIn.AllowedVerifyingContracts {
+			a, err := address.NewFromHexString(verifyingContract)
+			if err != nil {
+				return nil, nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("address '%s' is not a valid hex address", verifyingContract))
+			}
+			rules.AllowedVerifyingContracts[i] = a
+		}
+	}
+	return policyAddress, &rules, nil
+}
+
+func mapSigningPolicy(signingPolicy signingpolicy.SigningPolicy) generatedhttpinfra.SigningPolicyDetail {
+	creationDate := signingPolicy.CreationDate.String()
+	lastUpdate := signingPolicy.LastUpdate.String()
+
+	rules := generatedhttpinfra.SigningPolicyRules{
+		DenyContractCreation: &signingPolicy.Rules.DenyContractCreation,
+		AllowMessages:        &signingPolicy.Rules.AllowMessages,
+		AllowTypedData:       &signingPolicy.Rules.AllowTypedData,
+	}
+	if len(signingPolicy.Rules.AllowedRecipients) > 0 {
+		recipients := make([]string, len(signingPolicy.Rules.AllowedRecipients))
+		for i, recipient := range signingPolicy.Rules.AllowedRecipients {
+			recipients[i] = recipient.String()
+		}
+		rules.AllowedRecipients = &recipients
+	}
+	if signingPolicy.Rules.MaxValue != nil {
+		maxValue := signingPolicy.Rules.MaxValue.BigInt().String()
+		rules.MaxValue = &maxValue
+	}
+	if signingPolicy.Rules.MaxGas != nil {
+		maxGas := signingPolicy.Rules.MaxGas.String()
+		rules.MaxGas = &maxGas
+	}
+	if signingPolicy.Rules.MaxGasPrice != nil {
+		maxGasPrice := signingPolicy.Rules.MaxGasPrice.BigInt().String()
+		rules.MaxGasPrice = &maxGasPrice
+	}
+	if len(signingPolicy.Rules.AllowedMethodSelectors) > 0 {
+		selectors := make([]string, len(signingPolicy.Rules.AllowedMethodSelectors))
+		for i, selector := range signingPolicy.Rules.AllowedMethodSelectors {
+			selectors[i] = string(selector)
+		}
+		rules.AllowedMethodSelectors = &selectors
+	}
+	if len(signingPolicy.Rules.AllowedVerifyingContracts) > 0 {
+		verifyingContracts := make([]string, len(signingPolicy.Rules.AllowedVerifyingContracts))
+		for i, verifyingContract := range signingPolicy.Rules.AllowedVerifyingContracts {
+			verifyingContracts[i] = verifyingContract.String()
+		}
+		rules.AllowedVerifyingContracts = &verifyingContracts
+	}
+
+	detail := generatedhttpinfra.SigningPolicyDetail{
+		Meta: &generatedhttpinfra.ResourceMetaDetail{
+			Id:              &signingPolicy.ID,
+			ResourceVersion: &signingPolicy.ResourceVersion,
+			CreationDate:    &creationDate,
+			LastUpdate:      &lastUpdate,
+		},
+		Spec: &generatedhttpinfra.SigningPolicyDetailSpec{
+			Rules:       &rules,
+			Description: signingPolicy.Description,
+		},
+	}
+	if signingPolicy.Address != nil {
+		policyAddress := signingPolicy.Address.String()
+		detail.Spec.Address = &policyAddress
+	}
+	return detail
+}
+
+func mapSigningLimitMaxValueIn(maxValueIn *string) (*entities.Int256, *httpinfra.HTTPError) {
+	if maxValueIn == nil {
+		return nil, nil
+	}
+	maxValue, err := entities.NewInt256FromString(*maxValueIn)
+	if err != nil {
+		return nil, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(fmt.Sprintf("maxValue '%s' is not a valid number", *maxValueIn))
+	}
+	return maxValue, nil
+}
+
+func mapSigningLimit(signingLimit signinglimit.SigningLimit) generatedhttpinfra.SigningLimitDetail {
+	creationDate := signingLimit.CreationDate.String()
+	lastUpdate := signingLimit.LastUpdate.String()
+	scope := string(signingLimit.Scope)
+
+	detail := generatedhttpinfra.SigningLimitDetail{
+		Meta: &generatedhttpinfra.ResourceMetaDetail{
+			Id:              &signingLimit.ID,
+			ResourceVersion: &signingLimit.ResourceVersion,
+			CreationDate:    &creationDate,
+			LastUpdate:      &lastUpdate,
+		},
+		Spec: &generatedhttpinfra.SigningLimitDetailSpec{
+			Scope:         &scope,
+			Subject:       signingLimit.Subject,
+			WindowSeconds: &signingLimit.WindowSeconds,
+			MaxSignatures: signingLimit.MaxSignatures,
+			Description:   signingLimit.Description,
+		},
+	}
+	if signingLimit.MaxValue != nil {
+		maxValue := signingLimit.MaxValue.BigInt().String()
+		detail.Spec.MaxValue = &maxValue
+	}
+	return detail
+}
@@ -0,0 +1,65 @@
+package httpin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/healthhttpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
+)
+
+var _ healthhttpinfra.HealthAPIAdapter = new(DefaultHealthAPIAdapter)
+
+func (adapter *DefaultHealthAPIAdapter) AdaptLiveness(ctx context.Context) (*healthhttpinfra.HealthResponse, *httpinfra.HTTPError) {
+	out, err := adapter.healthUseCase.CheckLiveness(ctx, health.CheckLivenessInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+	response := mapHealth(out.Health)
+	return &response, nil
+}
+
+func (adapter *DefaultHealthAPIAdapter) AdaptReadiness(ctx context.Context) (*healthhttpinfra.HealthResponse, *httpinfra.HTTPError) {
+	out, err := adapter.healthUseCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+	if err != nil {
+		return nil, httpinfra.NewHTTPErrorFromUseCaseError(ctx, err)
+	}
+	response := mapHealth(out.Health)
+	return &response, nil
+}
+
+func mapHealth(in health.Health) healthhttpinfra.HealthResponse {
+	checks := make([]healthhttpinfra.HealthCheckResponse, len(in.Checks))
+	for i, check := range in.Checks {
+		checks[i] = healthhttpinfra.HealthCheckResponse{
+			Name:   check.Name,
+			Status: string(check.Status),
+			Detail: check.Detail,
+		}
+	}
+	return healthhttpinfra.HealthResponse{
+		Status: string(in.Status),
+		Checks: checks,
+	}
+}
+
+// DefaultHealthAPIAdapter implements HealthAPIAdapter.
+type DefaultHealthAPIAdapter struct {
+	healthUseCase health.HealthUseCase
+}
+
+// DefaultHealthAPIAdapterOptions options to create a new DefaultHealthAPIAdapter.
+type DefaultHealthAPIAdapterOptions struct {
+	HealthUseCase health.HealthUseCase
+}
+
+// ProvideDefaultHealthAPIAdapter creates a new DefaultHealthAPIAdapter instance.
+func ProvideDefaultHealthAPIAdapter(options DefaultHealthAPIAdapterOptions) (*DefaultHealthAPIAdapter, error) {
+	if options.HealthUseCase == nil {
+		return nil, errors.New("mandatory 'HealthUseCase' was not provided")
+	}
+	return &DefaultHealthAPIAdapter{
+		healthUseCase: options.HealthUseCase,
+	}, nil
+}
@@ -23,7 +23,7 @@ func (adapter DefaultAccountUserPolicyDecisionPointAdapter) AuthorizeAccountUser
 
 	_, authorizeUserAccountErr := adapter.policyDecisionPoint.AuthorizeUserAccount(ctx, authorizeUserAccountInput)
 	if authorizeUserAccountErr != nil {
-		return nil, authorizeUserAccountErr
+		return nil, adaptDeniedError(authorizeUserAccountErr)
 	}
 
 	return &pep.AuthorizeAccountUserOutput{}, nil
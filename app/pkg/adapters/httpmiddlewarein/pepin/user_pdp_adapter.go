@@ -21,12 +21,24 @@ func (adapter DefaultUserPolicyDecisionPointAdapter) AuthorizeUser(ctx context.C
 
 	_, authorizeUserAccountErr := adapter.policyDecisionPoint.AuthorizeUser(ctx, authorizeUserAccountInput)
 	if authorizeUserAccountErr != nil {
-		return nil, authorizeUserAccountErr
+		return nil, adaptDeniedError(authorizeUserAccountErr)
 	}
 
 	return &pep.AuthorizeUserOutput{}, nil
 }
 
+// adaptDeniedError adapts the errors of the Policy Decision Point that carry the reason why the authorization was denied
+func adaptDeniedError(err error) error {
+	reason, ok := pdp.DenialReasonFromError(err)
+	if !ok {
+		return err
+	}
+	return &pep.DeniedError{
+		Reason: string(reason),
+		Err:    err,
+	}
+}
+
 // DefaultUserPolicyDecisionPointAdapterOptions are the set of fields to create an DefaultUserPolicyDecisionPointAdapter
 type DefaultUserPolicyDecisionPointAdapterOptions struct {
 	// DefaultPolicyDecisionPointUseCase is the business logic to perform user authorization for different actions
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
@@ -18,20 +19,21 @@ import (
 var _ rpcinfra.JSONRPCAPIAdapter = new(DefaultAPIAdapter)
 
 func (adapter *DefaultAPIAdapter) AdaptGenerateAccount(ctx context.Context, data rpcinfra.GenerateAccountRequestParams) (*string, *rpcerrors.RPCError) {
-	input := hsmconnection.ByApplicationInput{
+	input := hsmconnection.ForKeyGenerationInput{
 		ApplicationID: data.ApplicationID,
 	}
-	hsmConnection, err := adapter.hsmConnectionResolver.ByApplication(ctx, input)
+	hsmConnection, err := adapter.hsmConnectionResolver.ForKeyGeneration(ctx, input)
 	if err != nil {
 		return nil, adaptError(err)
 	}
 
 	generateAddressInput := hsmconnector.GenerateAddressInput{
 		SlotConnectionData: hsmconnector.SlotConnectionData{
-			Pin:        hsmConnection.Pin,
-			Slot:       hsmConnection.Slot,
-			ModuleKind: hsmconnector.ModuleKind(hsmConnection.ModuleKind),
-			ChainID:    hsmConnection.ChainID,
+			Pin:                 hsmConnection.Pin,
+			Slot:                hsmConnection.Slot,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
 		},
 	}
 	out, err := adapter.hsmConnector.GenerateAddress(ctx, generateAddressInput)
@@ -70,10 +72,11 @@ func (adapter *DefaultAPIAdapter) AdaptListAccounts(ctx context.Context, data rp
 
 	listAddressesInput := hsmconnector.ListAddressesInput{
 		SlotConnectionData: hsmconnector.SlotConnectionData{
-			Pin:        hsmConnection.Pin,
-			Slot:       hsmConnection.Slot,
-			ModuleKind: hsmconnector.ModuleKind(hsmConnection.ModuleKind),
-			ChainID:    hsmConnection.ChainID,
+			Pin:                 hsmConnection.Pin,
+			Slot:                hsmConnection.Slot,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
 		},
 	}
 	out, err := adapter.hsmConnector.ListAddresses(ctx, listAddressesInput)
@@ -88,8 +91,14 @@ func (adapter *DefaultAPIAdapter) AdaptListAccounts(ctx context.Context, data rp
 }
 
 func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra.SignTXRequestParams) (*string, *rpcerrors.RPCError) {
+	from, err := address.NewFromHexString(data.From)
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [from]: %w", err))
+	}
+
 	byApplicationInput := hsmconnection.ByApplicationInput{
 		ApplicationID: data.ApplicationID,
+		Address:       &from,
 	}
 	hsmConnection, err := adapter.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
 	if err != nil {
@@ -98,11 +107,13 @@ func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra
 
 	signTxInput := hsmconnector.SignTxInput{
 		SlotConnectionData: hsmconnector.SlotConnectionData{
-			Pin:        hsmConnection.Pin,
-			Slot:       hsmConnection.Slot,
-			ModuleKind: hsmconnector.ModuleKind(hsmConnection.ModuleKind),
-			ChainID:    hsmConnection.ChainID,
+			Pin:                 hsmConnection.Pin,
+			Slot:                hsmConnection.Slot,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
 		},
+		ApplicationID: data.ApplicationID,
 	}
 	if len(data.Data) == 0 {
 		emptyBytes := entities.NewHexBytes([]byte{})
@@ -115,16 +126,6 @@ func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra
 		signTxInput.Data = inputData
 	}
 
-	nonce, err := entities.NewHexUInt64FromString(data.Nonce)
-	if err != nil {
-		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [nonce]: %w", err))
-	}
-	signTxInput.Nonce = nonce
-
-	from, err := address.NewFromHexString(data.From)
-	if err != nil {
-		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [from]: %w", err))
-	}
 	signTxInput.From = from
 
 	if data.To != nil {
@@ -135,6 +136,14 @@ func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra
 		signTxInput.To = &to
 	}
 
+	if data.Nonce != nil {
+		nonce, errNonce := entities.NewHexUInt64FromString(*data.Nonce)
+		if errNonce != nil {
+			return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [nonce]: %w", errNonce))
+		}
+		signTxInput.Nonce = &nonce
+	}
+
 	if data.Gas != nil {
 		gas, errGas := entities.NewHexUInt64FromString(*data.Gas)
 		if errGas != nil {
@@ -151,6 +160,42 @@ func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra
 		signTxInput.GasPrice = gasPrice
 	}
 
+	if data.MaxFeePerGas != nil {
+		maxFeePerGas, errMaxFeePerGas := entities.NewHexInt256FromString(*data.MaxFeePerGas)
+		if errMaxFeePerGas != nil {
+			return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [maxFeePerGas]: %w", errMaxFeePerGas))
+		}
+		signTxInput.MaxFeePerGas = maxFeePerGas
+	}
+
+	if data.MaxPriorityFeePerGas != nil {
+		maxPriorityFeePerGas, errMaxPriorityFeePerGas := entities.NewHexInt256FromString(*data.MaxPriorityFeePerGas)
+		if errMaxPriorityFeePerGas != nil {
+			return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [maxPriorityFeePerGas]: %w", errMaxPriorityFeePerGas))
+		}
+		signTxInput.MaxPriorityFeePerGas = maxPriorityFeePerGas
+	}
+
+	if data.Type != nil {
+		txType, errType := entities.NewHexUInt64FromString(*data.Type)
+		if errType != nil {
+			return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [type]: %w", errType))
+		}
+		if txType.Uint64() > math.MaxUint8 {
+			return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [type]: '%s' is out of range", *data.Type))
+		}
+		signTxType := hsmconnector.TransactionType(txType.Uint64())
+		signTxInput.Type = &signTxType
+	}
+
+	if data.AccessList != nil {
+		accessList, errAccessList := accessListFrom(data.AccessList)
+		if errAccessList != nil {
+			return nil, rpcerrors.NewInvalidParamsFromErr(errAccessList)
+		}
+		signTxInput.AccessList = accessList
+	}
+
 	if data.Value != nil && len(*data.Value) > 0 {
 		value, errValue := entities.NewHexInt256FromString(*data.Value)
 		if errValue != nil {
@@ -167,6 +212,113 @@ func (adapter *DefaultAPIAdapter) AdaptSignTx(ctx context.Context, data rpcinfra
 	return &response, nil
 }
 
+func (adapter *DefaultAPIAdapter) AdaptSignMessage(ctx context.Context, data rpcinfra.SignMessageRequestParams) (*string, *rpcerrors.RPCError) {
+	from, err := address.NewFromHexString(data.Address)
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [address]: %w", err))
+	}
+
+	message, err := entities.NewHexBytesFromString(data.Data)
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [data]: %w", err))
+	}
+
+	byApplicationInput := hsmconnection.ByApplicationInput{
+		ApplicationID: data.ApplicationID,
+		Address:       &from,
+	}
+	hsmConnection, err := adapter.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
+	if err != nil {
+		return nil, adaptError(err)
+	}
+
+	signMessageInput := hsmconnector.SignMessageInput{
+		SlotConnectionData: hsmconnector.SlotConnectionData{
+			Pin:                 hsmConnection.Pin,
+			Slot:                hsmConnection.Slot,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
+		},
+		ApplicationID: data.ApplicationID,
+		From:          from,
+		Message:       message,
+	}
+	out, err := adapter.hsmConnector.SignMessage(ctx, signMessageInput)
+	if err != nil {
+		return nil, adaptError(err)
+	}
+	response := out.Signature.Encode()
+	return &response, nil
+}
+
+func (adapter *DefaultAPIAdapter) AdaptSignTypedData(ctx context.Context, data rpcinfra.SignTypedDataRequestParams) (*string, *rpcerrors.RPCError) {
+	from, err := address.NewFromHexString(data.Address)
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [address]: %w", err))
+	}
+
+	typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(data.TypedData))
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(fmt.Errorf("invalid [typedData]: %w", err))
+	}
+
+	byApplicationInput := hsmconnection.ByApplicationInput{
+		ApplicationID: data.ApplicationID,
+		Address:       &from,
+	}
+	hsmConnection, err := adapter.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
+	if err != nil {
+		return nil, adaptError(err)
+	}
+
+	signTypedDataInput := hsmconnector.SignTypedDataInput{
+		SlotConnectionData: hsmconnector.SlotConnectionData{
+			Pin:                 hsmConnection.Pin,
+			Slot:                hsmConnection.Slot,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
+		},
+		ApplicationID: data.ApplicationID,
+		From:          from,
+		TypedData:     *typedData,
+	}
+	out, err := adapter.hsmConnector.SignTypedData(ctx, signTypedDataInput)
+	if err != nil {
+		return nil, adaptError(err)
+	}
+	response := out.Signature.Encode()
+	return &response, nil
+}
+
+// accessListFrom maps the access list request parameters to the use case access list, validating addresses and storage keys.
+func accessListFrom(params []rpcinfra.AccessListEntryParams) (hsmconnector.AccessList, error) {
+	accessList := make(hsmconnector.AccessList, len(params))
+	for i, entry := range params {
+		addr, err := address.NewFromHexString(entry.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid [accessList][%d].address: %w", i, err)
+		}
+		storageKeys := make([]entities.HexBytes32, len(entry.StorageKeys))
+		for j, key := range entry.StorageKeys {
+			keyBytes, errKey := entities.NewHexBytesFromString(key)
+			if errKey != nil {
+				return nil, fmt.Errorf("invalid [accessList][%d].storageKeys[%d]: %w", i, j, errKey)
+			}
+			if len(keyBytes.Bytes()) != 32 {
+				return nil, fmt.Errorf("invalid [accessList][%d].storageKeys[%d]: storage keys must be 32 bytes long", i, j)
+			}
+			storageKeys[j].FromBytes(keyBytes.Bytes())
+		}
+		accessList[i] = hsmconnector.AccessTuple{
+			Address:     addr,
+			StorageKeys: storageKeys,
+		}
+	}
+	return accessList, nil
+}
+
 // DefaultAPIAdapter implements JSONRPCAPIAdapter.
 type DefaultAPIAdapter struct {
 	accountUseCase        user.AccountUseCase
@@ -16,5 +16,11 @@ func adaptError(err error) *rpcerrors.RPCError {
 	if errors.IsPreconditionFailed(err) {
 		return rpcerrors.NewPreconditionFailedFromErr(err)
 	}
+	if errors.IsTooManyReq(err) {
+		return rpcerrors.NewLimitExceededFromErr(err)
+	}
+	if errors.IsTimeout(err) {
+		return rpcerrors.NewTimeoutFromErr(err)
+	}
 	return rpcerrors.NewInternalFromErr(err)
 }
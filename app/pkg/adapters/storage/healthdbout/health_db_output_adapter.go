@@ -0,0 +1,116 @@
+// Package healthdbout defines the output database adapter to check the state of the database.
+package healthdbout
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/dbmigrator"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
+)
+
+// schemaVersionTTL is the time the version of the database schema is cached. Reading it locks the migrations and
+// initializes the migrations table, so it is not read on every readiness probe.
+const schemaVersionTTL = 30 * time.Second
+
+var _ health.HealthStorage = new(Repository)
+
+// Ping checks that the database is reachable. It returns an internal error if it is not
+func (repository *Repository) Ping(ctx context.Context) error {
+	err := repository.connection.GetDB().PingContext(ctx)
+	if err != nil {
+		return errors.InternalFromErr(err)
+	}
+	return nil
+}
+
+// GetSchemaVersion returns the version of the database schema and the version of the latest migration files. The
+// version of the database schema is read at most once every schemaVersionTTL, unless the schema is upgraded.
+func (repository *Repository) GetSchemaVersion(ctx context.Context) (*health.SchemaVersion, error) {
+	repository.versionMutex.Lock()
+	defer repository.versionMutex.Unlock()
+
+	if repository.version == nil || time.Since(repository.versionReadAt) >= schemaVersionTTL {
+		version, err := repository.migrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+		if err != nil {
+			return nil, errors.InternalFromErr(err)
+		}
+		repository.version = &health.SchemaVersion{
+			Current:  version.Version,
+			Dirty:    version.Dirty,
+			Expected: repository.expectedVersion,
+		}
+		repository.versionReadAt = time.Now()
+	}
+	version := *repository.version
+	return &version, nil
+}
+
+// UpgradeSchema upgrades the database schema to the version of the latest migration files
+func (repository *Repository) UpgradeSchema(ctx context.Context) error {
+	err := repository.migrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{
+		FS: repository.migrationsFS,
+	})
+	// the version is read again even if the upgrade failed, since some of its steps may have been executed
+	repository.versionMutex.Lock()
+	repository.version = nil
+	repository.versionMutex.Unlock()
+	if err != nil {
+		return errors.InternalFromErr(err)
+	}
+	return nil
+}
+
+// Repository checks the state of the database
+type Repository struct {
+	connection      sql.Connection
+	migrator        *dbmigrator.DbMigrator
+	migrationsFS    fs.ReadDirFS
+	expectedVersion int
+	// versionMutex guards version and versionReadAt
+	versionMutex sync.Mutex
+	// version is the version of the database schema last read, if any
+	version *health.SchemaVersion
+	// versionReadAt is the time version was read
+	versionReadAt time.Time
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	// Connection to the database
+	Connection sql.Connection
+	// MigrationsFS contains the migration files of the database schema
+	MigrationsFS fs.ReadDirFS
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	if options.Connection == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'Connection' not provided")
+	}
+	if options.MigrationsFS == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'MigrationsFS' not provided")
+	}
+	migrator, err := dbmigrator.NewDbMigrator(dbmigrator.DbMigratorOptions{
+		Connection: options.Connection,
+	})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	expectedVersion, err := migrator.LatestVersionFromFiles(dbmigrator.LatestVersionFromFilesInput{
+		FS: options.MigrationsFS,
+	})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	return &Repository{
+		connection:      options.Connection,
+		migrator:        migrator,
+		migrationsFS:    options.MigrationsFS,
+		expectedVersion: expectedVersion,
+	}, nil
+}
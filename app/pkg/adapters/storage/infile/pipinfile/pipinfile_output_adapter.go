@@ -41,8 +41,38 @@ func (d DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter) ListActions(_
 	}, nil
 }
 
+// ActionExists checks if the Action is defined in the RBAC
+func (d DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter) ActionExists(_ context.Context, input pdp.ActionExistsInput) (*pdp.ActionExistsOutput, error) {
+	return &pdp.ActionExistsOutput{
+		Exists: d.actions.Contains(input.ActionID),
+	}, nil
+}
+
+// ExplainAction checks if the Action exists and lists the permissions of the given roles that grant it. Roles that
+// don't exist are ignored
+func (d DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter) ExplainAction(_ context.Context, input pdp.ExplainActionInput) (*pdp.ExplainActionOutput, error) {
+	grants := make([]pdp.Grant, 0)
+	for _, role := range input.Roles {
+		for _, permission := range d.rolePermissionMap[role] {
+			if d.permissionActionMap[permission].Contains(input.ActionID) {
+				grants = append(grants, pdp.Grant{
+					Role:       role,
+					Permission: permission,
+				})
+			}
+		}
+	}
+
+	return &pdp.ExplainActionOutput{
+		ActionExists: d.actions.Contains(input.ActionID),
+		Grants:       grants,
+	}, nil
+}
+
 func loadRolesAndActions(fileSystem fs.FS, basePath string) (*loadRolesResult, error) {
 	roleActionMap := make(map[string]pdp.Actions)
+	rolePermissionMap := make(map[string][]string)
+	permissionActionMap := make(map[string]pdp.Actions)
 
 	// Read manual actions
 	var actionsManual ActionCollection
@@ -96,6 +126,7 @@ func loadRolesAndActions(fileSystem fs.FS, basePath string) (*loadRolesResult, e
 		permissions = make(map[string]ActionCollection)
 		for _, permission := range permissionsCollection.Permissions {
 			permissions[permission.ID] = ActionCollection{Actions: permission.Actions}
+			permissionActionMap[permission.ID] = *pdp.NewActions(permission.Actions)
 		}
 	}
 
@@ -125,15 +156,22 @@ func loadRolesAndActions(fileSystem fs.FS, basePath string) (*loadRolesResult, e
 			permissionGrantedActions = mergeActions(permissionGrantedActions, actionsForPermission)
 		}
 		roleActionMap[role.ID] = *pdp.NewActions(permissionGrantedActions.Actions)
+		rolePermissionMap[role.ID] = role.Permissions
 	}
 
 	return &loadRolesResult{
-		roleActionMap: roleActionMap,
+		roleActionMap:       roleActionMap,
+		rolePermissionMap:   rolePermissionMap,
+		permissionActionMap: permissionActionMap,
+		actions:             *pdp.NewActions(actions.Actions),
 	}, nil
 }
 
 type loadRolesResult struct {
-	roleActionMap map[string]pdp.Actions
+	roleActionMap       map[string]pdp.Actions
+	rolePermissionMap   map[string][]string
+	permissionActionMap map[string]pdp.Actions
+	actions             pdp.Actions
 }
 
 // validatePermissions checks that actions pointed by permissions exist and returns an error if any of them doesn't exist
@@ -160,7 +198,10 @@ type DefaultRBACActionsPolicyInformationPointYAMLOutputAdapterOptions struct {
 
 // DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter is a port to adapt requests related to the Actions
 type DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter struct {
-	roleActionMap map[string]pdp.Actions
+	roleActionMap       map[string]pdp.Actions
+	rolePermissionMap   map[string][]string
+	permissionActionMap map[string]pdp.Actions
+	actions             pdp.Actions
 }
 
 // ProvideDefaultRBACActionsPolicyInformationPointYAMLOutputAdapter provides an instance of an DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter
@@ -175,6 +216,9 @@ func ProvideDefaultRBACActionsPolicyInformationPointYAMLOutputAdapter(options De
 	}
 
 	return &DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter{
-		roleActionMap: loadResult.roleActionMap,
+		roleActionMap:       loadResult.roleActionMap,
+		rolePermissionMap:   loadResult.rolePermissionMap,
+		permissionActionMap: loadResult.permissionActionMap,
+		actions:             loadResult.actions,
 	}, nil
 }
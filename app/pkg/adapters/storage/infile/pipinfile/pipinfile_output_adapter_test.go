@@ -69,3 +69,57 @@ func TestDefaultRBACPolicyInformationPointYAMLOutputAdapter_ListActions_Success(
 	require.Nil(t, failure)
 	require.True(t, userActions.Actions.Equal(*expectedTestUserActions))
 }
+
+func TestDefaultRBACPolicyInformationPointYAMLOutputAdapter_ActionExists_Success(t *testing.T) {
+	ctx := context.TODO()
+
+	adapter, err := NewDefaultRBACPolicyInformationPointYAMLOutputAdapterForTest()
+	require.NoError(t, err)
+	require.NotNil(t, adapter)
+
+	output, failure := adapter.ActionExists(ctx, pdp.ActionExistsInput{ActionID: defaultActionFour})
+	require.Nil(t, failure)
+	require.True(t, output.Exists)
+
+	output, failure = adapter.ActionExists(ctx, pdp.ActionExistsInput{ActionID: "unknown.action"})
+	require.Nil(t, failure)
+	require.False(t, output.Exists)
+}
+
+func TestDefaultRBACPolicyInformationPointYAMLOutputAdapter_ExplainAction_Success(t *testing.T) {
+	ctx := context.TODO()
+
+	adapter, err := NewDefaultRBACPolicyInformationPointYAMLOutputAdapterForTest()
+	require.NoError(t, err)
+	require.NotNil(t, adapter)
+
+	explainActionInput := pdp.ExplainActionInput{
+		Roles:    []string{defaultRoleTestAdmin, defaultRoleTestUser},
+		ActionID: defaultActionFour,
+	}
+	output, failure := adapter.ExplainAction(ctx, explainActionInput)
+	require.Nil(t, failure)
+	require.True(t, output.ActionExists)
+	require.Equal(t, []pdp.Grant{
+		{Role: defaultRoleTestAdmin, Permission: "allow-manual-actions"},
+		{Role: defaultRoleTestUser, Permission: "allow-manual-actions"},
+	}, output.Grants)
+
+	explainActionInput = pdp.ExplainActionInput{
+		Roles:    []string{defaultRoleTestUser},
+		ActionID: defaultActionOne,
+	}
+	output, failure = adapter.ExplainAction(ctx, explainActionInput)
+	require.Nil(t, failure)
+	require.True(t, output.ActionExists)
+	require.Empty(t, output.Grants)
+
+	explainActionInput = pdp.ExplainActionInput{
+		Roles:    []string{defaultRoleTestAdmin},
+		ActionID: "unknown.action",
+	}
+	output, failure = adapter.ExplainAction(ctx, explainActionInput)
+	require.Nil(t, failure)
+	require.False(t, output.ActionExists)
+	require.Empty(t, output.Grants)
+}
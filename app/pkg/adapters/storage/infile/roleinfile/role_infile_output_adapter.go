@@ -1,4 +1,4 @@
-// Package roleinfile defines the implementation of the output adapter to read roles from YAML files.
+// Package roleinfile defines the implementation of the output adapter to read the built-in roles, permissions and actions from YAML files.
 package roleinfile
 
 import (
@@ -8,38 +8,88 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
 )
 
-const defaultRolesFileName = "roles.yaml"
+const (
+	defaultRolesFileName            = "roles.yaml"
+	defaultPermissionsFileName      = "permissions.yaml"
+	defaultActionsGeneratedFileName = "actions-generated.yaml"
+	defaultActionsManualFileName    = "actions-manual.yaml"
+)
 
-var _ role.RoleStorage = new(DefaultRoleStorageInFile)
+var _ role.BuiltInStorage = new(DefaultRoleStorageInFile)
 
-// ListRoles fetches a collection of Role based on the ListRolesInput
-func (d DefaultRoleStorageInFile) ListRoles(_ context.Context, _ role.ListRolesInput) (*role.ListRolesOutput, error) {
+// ListBuiltInRoles fetches the collection of Role defined in the roles file
+func (d DefaultRoleStorageInFile) ListBuiltInRoles(_ context.Context, _ role.ListBuiltInRolesInput) (*role.ListBuiltInRolesOutput, error) {
 	roles := make([]role.Role, 0)
 	for _, r := range d.rolesInfo.Roles {
+		description := r.Description
 		newRole := role.Role{
-			ID: r.ID,
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: entities.StandardID{
+						ID: r.ID,
+					},
+				},
+			},
+			Description: &description,
+			Permissions: r.Permissions,
+			BuiltIn:     true,
 		}
 		roles = append(roles, newRole)
 	}
 
-	return &role.ListRolesOutput{
+	return &role.ListBuiltInRolesOutput{
 		Roles: roles,
 	}, nil
 }
 
+// ListBuiltInPermissions fetches the collection of Permission defined in the permissions file
+func (d DefaultRoleStorageInFile) ListBuiltInPermissions(_ context.Context, _ role.ListBuiltInPermissionsInput) (*role.ListBuiltInPermissionsOutput, error) {
+	permissions := make([]role.Permission, 0)
+	for _, p := range d.permissionsInfo.Permissions {
+		description := p.Description
+		newPermission := role.Permission{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: entities.StandardID{
+						ID: p.ID,
+					},
+				},
+			},
+			Description: &description,
+			Actions:     p.Actions,
+			BuiltIn:     true,
+		}
+		permissions = append(permissions, newPermission)
+	}
+
+	return &role.ListBuiltInPermissionsOutput{
+		Permissions: permissions,
+	}, nil
+}
+
+// ListActions fetches the manual and the generated actions
+func (d DefaultRoleStorageInFile) ListActions(_ context.Context, _ role.ListActionsInput) (*role.ListActionsOutput, error) {
+	return &role.ListActionsOutput{
+		Actions: append(make([]string, 0), d.actionsInfo.Actions...),
+	}, nil
+}
+
 // DefaultRoleStorageInFileOptions are the set of fields to create an DefaultRoleStorageInFile
 type DefaultRoleStorageInFileOptions struct {
 	FileSystem fs.FS
 	BasePath   string
 }
 
-// DefaultRoleStorageInFile is a port to adapt requests related to the Roles
+// DefaultRoleStorageInFile is a port to adapt requests related to the built-in Roles
 type DefaultRoleStorageInFile struct {
-	rolesInfo RolesInfo
+	rolesInfo       RolesInfo
+	permissionsInfo PermissionsInfo
+	actionsInfo     ActionsInfo
 }
 
 // ProvideDefaultRoleStorageInFile provides an instance of an DefaultRoleStorageInFile
@@ -47,31 +97,45 @@ func ProvideDefaultRoleStorageInFile(options DefaultRoleStorageInFileOptions) (*
 	if options.FileSystem == nil {
 		return nil, errors.Internal().WithMessage("mandatory 'FileSystem' not provided")
 	}
-	// As roles, permissions and actions are static, we can read them at start up time
-	rolesInfo, err := loadRoles(options.FileSystem, options.BasePath)
+	// As built-in roles, permissions and actions are static, we can read them at start up time
+	var rolesInfo RolesInfo
+	err := loadFile(options.FileSystem, path.Join(options.BasePath, defaultRolesFileName), &rolesInfo)
+	if err != nil {
+		return nil, err
+	}
+	var permissionsInfo PermissionsInfo
+	err = loadFile(options.FileSystem, path.Join(options.BasePath, defaultPermissionsFileName), &permissionsInfo)
+	if err != nil {
+		return nil, err
+	}
+	var actionsManual ActionsInfo
+	err = loadFile(options.FileSystem, path.Join(options.BasePath, defaultActionsManualFileName), &actionsManual)
+	if err != nil {
+		return nil, err
+	}
+	var actionsGenerated ActionsInfo
+	err = loadFile(options.FileSystem, path.Join(options.BasePath, defaultActionsGeneratedFileName), &actionsGenerated)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DefaultRoleStorageInFile{
-		rolesInfo: *rolesInfo,
+		rolesInfo:       rolesInfo,
+		permissionsInfo: permissionsInfo,
+		actionsInfo: ActionsInfo{
+			Actions: append(actionsManual.Actions, actionsGenerated.Actions...),
+		},
 	}, nil
 }
 
-func loadRoles(fileSystem fs.FS, basePath string) (*RolesInfo, error) {
-	// Read roles
-	var roles RolesInfo
-	{
-		rolesFilePath := path.Join(basePath, defaultRolesFileName)
-		rolesBytes, err := fs.ReadFile(fileSystem, rolesFilePath)
-		if err != nil {
-			return nil, errors.Internal().WithMessage("could not read roles file from %s", rolesFilePath)
-		}
-		err = yaml.Unmarshal(rolesBytes, &roles)
-		if err != nil {
-			return nil, errors.Internal().WithMessage("roles from file %s could not be unmarshalled", rolesFilePath)
-		}
+func loadFile(fileSystem fs.FS, filePath string, out any) error {
+	fileBytes, err := fs.ReadFile(fileSystem, filePath)
+	if err != nil {
+		return errors.Internal().WithMessage("could not read file from %s", filePath)
 	}
-
-	return &roles, nil
+	err = yaml.Unmarshal(fileBytes, out)
+	if err != nil {
+		return errors.Internal().WithMessage("file %s could not be unmarshalled", filePath)
+	}
+	return nil
 }
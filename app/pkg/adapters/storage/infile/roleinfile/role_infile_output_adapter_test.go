@@ -34,15 +34,15 @@ func NewDefaultRoleStorageInFileYAMLOutputAdapterForTest() (*roleinfile.DefaultR
 	return adapter, nil
 }
 
-func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListActions_Success(t *testing.T) {
+func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListBuiltInRoles_Success(t *testing.T) {
 	ctx := context.TODO()
 
 	adapter, err := NewDefaultRoleStorageInFileYAMLOutputAdapterForTest()
 	require.NoError(t, err)
 	require.NotNil(t, adapter)
 
-	listRolesInput := role.ListRolesInput{}
-	listRolesOutput, listRolesErr := adapter.ListRoles(ctx, listRolesInput)
+	listRolesInput := role.ListBuiltInRolesInput{}
+	listRolesOutput, listRolesErr := adapter.ListBuiltInRoles(ctx, listRolesInput)
 	require.NoError(t, listRolesErr)
 	require.NotNil(t, listRolesOutput)
 
@@ -54,6 +54,7 @@ func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListActions_Success(t *testin
 	for _, role := range listRolesOutput.Roles {
 		_, ok := expectedRoles[role.ID]
 		require.True(t, ok)
+		require.True(t, role.BuiltIn)
 
 		expectedRoles[role.ID] = true
 	}
@@ -62,4 +63,39 @@ func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListActions_Success(t *testin
 		found := expectedRoles[role.ID]
 		require.True(t, found)
 	}
+	require.Equal(t, []string{"allow-manual-actions"}, listRolesOutput.Roles[1].Permissions)
+}
+
+func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListBuiltInPermissions_Success(t *testing.T) {
+	ctx := context.TODO()
+
+	adapter, err := NewDefaultRoleStorageInFileYAMLOutputAdapterForTest()
+	require.NoError(t, err)
+	require.NotNil(t, adapter)
+
+	listPermissionsOutput, listPermissionsErr := adapter.ListBuiltInPermissions(ctx, role.ListBuiltInPermissionsInput{})
+	require.NoError(t, listPermissionsErr)
+	require.Len(t, listPermissionsOutput.Permissions, 2)
+	require.Equal(t, "allow-manual-actions", listPermissionsOutput.Permissions[1].ID)
+	require.Equal(t, "Grants access to manual actions", *listPermissionsOutput.Permissions[1].Description)
+	require.Equal(t, []string{"manual.action.four", "manual.action.five"}, listPermissionsOutput.Permissions[1].Actions)
+	require.True(t, listPermissionsOutput.Permissions[1].BuiltIn)
+}
+
+func TestDefaultRoleStorageInFileYAMLOutputAdapter_ListActions_Success(t *testing.T) {
+	ctx := context.TODO()
+
+	adapter, err := NewDefaultRoleStorageInFileYAMLOutputAdapterForTest()
+	require.NoError(t, err)
+	require.NotNil(t, adapter)
+
+	listActionsOutput, listActionsErr := adapter.ListActions(ctx, role.ListActionsInput{})
+	require.NoError(t, listActionsErr)
+	require.ElementsMatch(t, []string{
+		"generated.action.one",
+		"generated.action.two",
+		"generated.action.three",
+		"manual.action.four",
+		"manual.action.five",
+	}, listActionsOutput.Actions)
 }
@@ -17,3 +17,25 @@ type RolesInfo struct {
 	// Roles is the array of roles desribed in the file
 	Roles []Role `yaml:"roles"`
 }
+
+// Permission contains a set of actions
+type Permission struct {
+	// ID is the name of the Permission
+	ID string `yaml:"id"`
+	// Description describes the permission with a phrase
+	Description string `yaml:"description"`
+	// Actions are the array of actions granted by the Permission
+	Actions []string `yaml:"actions"`
+}
+
+// PermissionsInfo is the data type that defines the permissions in the file
+type PermissionsInfo struct {
+	// Permissions is the array of permissions described in the file
+	Permissions []Permission `yaml:"permissions"`
+}
+
+// ActionsInfo is the data type that defines the actions in the files
+type ActionsInfo struct {
+	// Actions is the array of actions described in the file
+	Actions []string `yaml:"actions"`
+}
@@ -0,0 +1,159 @@
+// Package auditdbout defines the output database adapters for the audit Record resource.
+package auditdbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/auditdb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+)
+
+var _ audit.AuditStorage = new(Repository)
+
+// Add a Record to storage.
+func (repository *Repository) Add(ctx context.Context, data audit.Record) (*audit.Record, error) {
+	db, err := mapToDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	err = repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return &data, nil
+}
+
+// Last retrieves the Record with the highest sequence number from storage.
+func (repository *Repository) Last(ctx context.Context) (*audit.Record, error) {
+	storageData, err := repository.infra.Last(ctx)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("the audit log is empty")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining the last 'audit record'")
+	}
+
+	return mapFromDB(storageData[0]), nil
+}
+
+// All retrieves all Records from the storage.
+func (repository *Repository) All(ctx context.Context, filters audit.RecordFilters) (*audit.RecordCollection, error) {
+	f, ok := filters.(*auditRecordDBFilter)
+	if !ok {
+		return nil, errors.Internal().WithMessage("invalid query filters provided")
+	}
+
+	if f.Pagination != nil {
+		f.Pagination.Limit++
+	}
+	storageData, err := repository.infra.List(ctx, *f.AuditRecordDBFilter)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	collection := audit.RecordCollection{}
+	if f.Pagination != nil {
+		collection.Offset = f.Pagination.Offset
+		collection.Limit = f.Pagination.Limit - 1
+		if len(storageData) == f.Pagination.Limit {
+			collection.MoreItems = true
+			storageData = storageData[:len(storageData)-1]
+		}
+		f.Pagination.Limit--
+	} else {
+		collection.StandardCollectionPage = entities.NewUnlimitedQueryStandardCollectionPage(len(storageData))
+	}
+
+	collection.Items = mapSliceFromDB(storageData)
+
+	return &collection, nil
+}
+
+// Filter creates a new filter for the provided audit records.
+func (repository *Repository) Filter() audit.RecordFilters {
+	storageFilter := auditRecordDBFilter{
+		AuditRecordDBFilter: &auditdb.AuditRecordDBFilter{},
+	}
+	return &storageFilter
+}
+
+// Repository implementation of audit.AuditStorage
+type Repository struct {
+	infra *auditdb.AuditRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *auditdb.AuditRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
+
+var _ audit.RecordFilters = (*auditRecordDBFilter)(nil)
+
+// OrderBySequenceNumber orders resources in storage by sequence number
+func (filter *auditRecordDBFilter) OrderBySequenceNumber(orderDirection persistence.OrderDirection) audit.RecordFilters {
+	filter.AuditRecordDBFilter = filter.AuditRecordDBFilter.Sort("sequence_number", orderDirection)
+	return filter
+}
+
+// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter
+func (filter *auditRecordDBFilter) Paged(limit int, offset int) audit.RecordFilters {
+	filter.AuditRecordDBFilter = filter.AuditRecordDBFilter.Paged(limit, offset)
+	return filter
+}
+
+// FilterByUserID filters resources by user
+func (filter *auditRecordDBFilter) FilterByUserID(userID string) audit.RecordFilters {
+	filter.UserID = userID
+	filter.AppendFilter(postgres.NewEqualFilter("user_id"))
+	return filter
+}
+
+// FilterByApplicationID filters resources by application
+func (filter *auditRecordDBFilter) FilterByApplicationID(applicationID string) audit.RecordFilters {
+	filter.ApplicationID = applicationID
+	filter.AppendFilter(postgres.NewEqualFilter("application_id"))
+	return filter
+}
+
+// FilterByAddress filters resources by address
+func (filter *auditRecordDBFilter) FilterByAddress(address string) audit.RecordFilters {
+	filter.Address = address
+	filter.AppendFilter(postgres.NewEqualFilter("address"))
+	return filter
+}
+
+// FilterByOperation filters resources by operation
+func (filter *auditRecordDBFilter) FilterByOperation(operation string) audit.RecordFilters {
+	filter.Operation = operation
+	filter.AppendFilter(postgres.NewEqualFilter("operation"))
+	return filter
+}
+
+// FilterFromSequenceNumber filters resources with a sequence number greater or equal than the given one
+func (filter *auditRecordDBFilter) FilterFromSequenceNumber(sequenceNumber int64) audit.RecordFilters {
+	filter.SequenceNumber = sequenceNumber
+	filter.AppendFilter(postgres.NewGreaterOrEqualFilter("sequence_number"))
+	return filter
+}
+
+type auditRecordDBFilter struct {
+	*auditdb.AuditRecordDBFilter
+}
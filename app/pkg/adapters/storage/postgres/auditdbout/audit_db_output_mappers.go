@@ -0,0 +1,71 @@
+package auditdbout
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/auditdb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+)
+
+func mapToDB(record audit.Record) (*auditdb.AuditRecordDB, error) {
+	if record.SequenceNumber <= 0 {
+		return nil, errors.Internal().WithMessage("'SequenceNumber' must be positive")
+	}
+	if len(record.Hash) == 0 {
+		return nil, errors.Internal().WithMessage("'Hash' cannot be empty")
+	}
+
+	return &auditdb.AuditRecordDB{
+		SequenceNumber: record.SequenceNumber,
+		CreationDate:   record.Timestamp.ToInt64(),
+		Operation:      string(record.Operation),
+		UserID:         record.UserID,
+		ApplicationID:  record.ApplicationID,
+		Address:        record.Address,
+		ChainID:        record.ChainID,
+		TxHash:         record.TxHash,
+		SignedHash:     record.SignedHash,
+		Outcome:        string(record.Outcome),
+		Error:          record.Error,
+		PreviousHash:   record.PreviousHash,
+		Hash:           record.Hash,
+	}, nil
+}
+
+func mapFromDB(db auditdb.AuditRecordDB) *audit.Record {
+	return &audit.Record{
+		SequenceNumber: db.SequenceNumber,
+		Timestamp:      time.TimestampFromInt64(db.CreationDate),
+		Operation:      audit.Operation(db.Operation),
+		UserID:         db.UserID,
+		ApplicationID:  db.ApplicationID,
+		Address:        db.Address,
+		ChainID:        db.ChainID,
+		TxHash:         db.TxHash,
+		SignedHash:     db.SignedHash,
+		Outcome:        audit.Outcome(db.Outcome),
+		Error:          db.Error,
+		PreviousHash:   db.PreviousHash,
+		Hash:           db.Hash,
+	}
+}
+
+func mapSliceFromDB(dbSlice []auditdb.AuditRecordDB) []audit.Record {
+	recordSlice := make([]audit.Record, len(dbSlice))
+	for index := range dbSlice {
+		recordSlice[index] = *mapFromDB(dbSlice[index])
+	}
+
+	return recordSlice
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
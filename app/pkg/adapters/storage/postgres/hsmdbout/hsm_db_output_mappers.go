@@ -1,6 +1,8 @@
 package hsmdbout
 
 import (
+	"encoding/json"
+
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
@@ -20,7 +22,10 @@ func mapToCreateDB(module hsmmodule.HSMModule) (*hsmmoduledb.HardwareSecurityMod
 	if mapErr != nil {
 		return nil, mapErr
 	}
-	configuration := mapConfigurationDBFrom(module)
+	configuration, mapErr := mapConfigurationDBFrom(module)
+	if mapErr != nil {
+		return nil, mapErr
+	}
 	db := hsmmoduledb.HardwareSecurityModuleCreateDB{
 		HardwareSecurityModuleDB: hsmmoduledb.HardwareSecurityModuleDB{
 			StandardID:         module.StandardID,
@@ -48,7 +53,10 @@ func mapToUpdateDB(module hsmmodule.HSMModule) (*hsmmoduledb.HardwareSecurityMod
 	if mapErr != nil {
 		return nil, mapErr
 	}
-	configuration := mapConfigurationDBFrom(module)
+	configuration, mapErr := mapConfigurationDBFrom(module)
+	if mapErr != nil {
+		return nil, mapErr
+	}
 
 	db := hsmmoduledb.HardwareSecurityModuleUpdateDB{
 		HardwareSecurityModuleDB: hsmmoduledb.HardwareSecurityModuleDB{
@@ -76,7 +84,10 @@ func mapFromDB(db hsmmoduledb.HardwareSecurityModuleDB) (*hsmmodule.HSMModule, e
 	if len(db.InternalResourceID) == 0 {
 		return nil, errors.Internal().WithMessage("'InternalResourceID' cannot be empty")
 	}
-	configuration := mapUseCaseConfiguration(db)
+	configuration, mapErr := mapUseCaseConfiguration(db)
+	if mapErr != nil {
+		return nil, mapErr
+	}
 	useCaseHSMType, mapErr := mapUseCaseModuleKindFrom(db.Kind)
 	if mapErr != nil {
 		return nil, mapErr
@@ -114,23 +125,58 @@ func mapSliceFromDB(dbSlice []hsmmoduledb.HardwareSecurityModuleDB) ([]hsmmodule
 	return hsmModuleSlice, nil
 }
 
-func mapUseCaseConfiguration(module hsmmoduledb.HardwareSecurityModuleDB) *hsmmodule.HSMModuleConfiguration {
+func mapUseCaseConfiguration(module hsmmoduledb.HardwareSecurityModuleDB) (*hsmmodule.HSMModuleConfiguration, error) {
 	var configuration hsmmodule.HSMModuleConfiguration
 	if module.Kind == string(hsmmodule.SoftHSMModuleKind) {
 		configuration.SoftHSMConfiguration = &hsmmodule.SoftHSMConfiguration{}
 	}
+	if module.Kind == string(hsmmodule.CloudKMSModuleKind) {
+		configuration.CloudKMSConfiguration = &hsmmodule.CloudKMSConfiguration{}
+	}
+	if module.Kind == hsmmoduledb.PKCS11ModuleKind {
+		var pkcs11Configuration hsmmoduledb.PKCS11ConfigurationDB
+		err := json.Unmarshal([]byte(module.Configuration), &pkcs11Configuration)
+		if err != nil {
+			return nil, errors.InternalFromErr(err).WithMessage("couldn't decode the configuration of the HSM module '%s'", module.ID)
+		}
+		configuration.PKCS11Configuration = &hsmmodule.PKCS11Configuration{
+			Library:    pkcs11Configuration.Library,
+			TokenLabel: pkcs11Configuration.TokenLabel,
+			Quirks: hsmmodule.PKCS11Quirks{
+				OmitKeyID: pkcs11Configuration.OmitKeyID,
+			},
+		}
+	}
 
-	return &configuration
+	return &configuration, nil
 }
 
-func mapConfigurationDBFrom(module hsmmodule.HSMModule) *string {
+func mapConfigurationDBFrom(module hsmmodule.HSMModule) (*string, error) {
 	var configuration string
 	if module.Kind == hsmmodule.SoftHSMModuleKind {
 		// SoftHSM configuration is static and not persisted
 		configuration = ""
 	}
+	if module.Kind == hsmmodule.CloudKMSModuleKind {
+		// Cloud KMS configuration is static and not persisted
+		configuration = ""
+	}
+	if module.Kind == hsmmodule.PKCS11ModuleKind {
+		if module.Configuration.PKCS11Configuration == nil {
+			return nil, errors.InvalidArgument().WithMessage("the configuration of the PKCS11 module '%s' is required", module.ID)
+		}
+		encoded, err := json.Marshal(hsmmoduledb.PKCS11ConfigurationDB{
+			Library:    module.Configuration.PKCS11Configuration.Library,
+			TokenLabel: module.Configuration.PKCS11Configuration.TokenLabel,
+			OmitKeyID:  module.Configuration.PKCS11Configuration.Quirks.OmitKeyID,
+		})
+		if err != nil {
+			return nil, errors.InternalFromErr(err).WithMessage("couldn't encode the configuration of the HSM module '%s'", module.ID)
+		}
+		configuration = string(encoded)
+	}
 
-	return &configuration
+	return &configuration, nil
 }
 
 func mapUseCaseModuleKindFrom(kind string) (*hsmmodule.ModuleKind, error) {
@@ -138,6 +184,14 @@ func mapUseCaseModuleKindFrom(kind string) (*hsmmodule.ModuleKind, error) {
 		k := hsmmodule.SoftHSMModuleKind
 		return &k, nil
 	}
+	if kind == hsmmoduledb.CloudKMSModuleKind {
+		k := hsmmodule.CloudKMSModuleKind
+		return &k, nil
+	}
+	if kind == hsmmoduledb.PKCS11ModuleKind {
+		k := hsmmodule.PKCS11ModuleKind
+		return &k, nil
+	}
 	return nil, errors.Internal().WithMessage("couldn't map '%s' to usecase HSM kind", kind)
 }
 
@@ -146,6 +200,14 @@ func mapDBModuleKindFrom(moduleKind hsmmodule.ModuleKind) (*string, error) {
 		kind := hsmmoduledb.SoftHSMModuleKind
 		return &kind, nil
 	}
+	if moduleKind == hsmmodule.CloudKMSModuleKind {
+		kind := hsmmoduledb.CloudKMSModuleKind
+		return &kind, nil
+	}
+	if moduleKind == hsmmodule.PKCS11ModuleKind {
+		kind := hsmmoduledb.PKCS11ModuleKind
+		return &kind, nil
+	}
 	return nil, errors.InvalidArgument().WithMessage("couldn't map '%s' to database HSM type", moduleKind)
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
@@ -20,13 +21,17 @@ func (r *Repository) Add(ctx context.Context, data hsmslot.HSMSlot) (*hsmslot.HS
 	if err != nil {
 		return nil, errors.InternalFromErr(err)
 	}
+	db.Pin, err = r.sealPin(ctx, db.ID, db.Pin)
+	if err != nil {
+		return nil, err
+	}
 
 	storageData, err := r.infra.Add(ctx, *db)
 	if err != nil {
 		return nil, mapPersistenceErrorToSignerError(err)
 	}
 
-	return mapFromDB(*storageData)
+	return r.fromDB(ctx, *storageData)
 }
 
 // Get an HSMSlot from storage.
@@ -44,9 +49,10 @@ func (r *Repository) Get(ctx context.Context, id entities.StandardID) (*hsmslot.
 		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'hsm_slot'")
 	}
 
-	return mapFromDB(storageData[0])
+	return r.fromDB(ctx, storageData[0])
 }
 
+// GetByApplication gets the HSMSlot with the highest priority of an Application from storage.
 func (r *Repository) GetByApplication(ctx context.Context, applicationID entities.StandardID) (*hsmslot.HSMSlot, error) {
 	storageData, err := r.infra.GetByApplicationID(ctx, applicationID)
 	if err != nil {
@@ -61,7 +67,7 @@ func (r *Repository) GetByApplication(ctx context.Context, applicationID entitie
 		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'hsm_slot'")
 	}
 
-	return mapFromDB(storageData[0])
+	return r.fromDB(ctx, storageData[0])
 }
 
 // EditPin edits an HSMSlot's Pin in storage.
@@ -70,6 +76,10 @@ func (r *Repository) EditPin(ctx context.Context, data hsmslot.HSMSlot) (*hsmslo
 	if err != nil {
 		return nil, errors.InternalFromErr(err)
 	}
+	db.Pin, err = r.sealPin(ctx, db.ID, db.Pin)
+	if err != nil {
+		return nil, err
+	}
 
 	result, err := r.infra.EditPin(ctx, *db)
 	if err != nil {
@@ -139,9 +149,9 @@ func (r *Repository) All(ctx context.Context, filters hsmslot.HSMSlotFilters) (*
 	} else {
 		collection.StandardCollectionPage = entities.NewUnlimitedQueryStandardCollectionPage(len(storageData))
 	}
-	collection.Items, err = mapSliceFromDB(storageData)
+	collection.Items, err = r.sliceFromDB(ctx, storageData)
 	if err != nil {
-		return nil, errors.InternalFromErr(err)
+		return nil, err
 	}
 	return &collection, nil
 }
@@ -158,18 +168,22 @@ func (r *Repository) Filter() hsmslot.HSMSlotFilters {
 
 // Repository implementation of hsmslot.HSMSlotStorage
 type Repository struct {
-	infra *hsmslotdb.HSMSlotRepositoryInfra
+	infra        *hsmslotdb.HSMSlotRepositoryInfra
+	pinEncrypter *envelope.Encrypter
 }
 
 // RepositoryOptions configures a Repository
 type RepositoryOptions struct {
 	Infra *hsmslotdb.HSMSlotRepositoryInfra
+	// PinEncrypter encrypts the pins before storing them. Pins are stored in plain text if it is not provided.
+	PinEncrypter *envelope.Encrypter
 }
 
 // NewRepository creates a Repository with the given options
 func NewRepository(options RepositoryOptions) (*Repository, error) {
 	return &Repository{
-		infra: options.Infra,
+		infra:        options.Infra,
+		pinEncrypter: options.PinEncrypter,
 	}, nil
 }
 
@@ -201,6 +215,12 @@ func (filter *hsmSlotDBFilter) OrderByLastUpdateDate(orderDirection persistence.
 	return filter
 }
 
+// OrderByPriority orders resources in storage by their priority in the Application.
+func (filter *hsmSlotDBFilter) OrderByPriority(orderDirection persistence.OrderDirection) hsmslot.HSMSlotFilters {
+	filter.HSMSlotDBFilter = filter.HSMSlotDBFilter.Sort("priority", orderDirection)
+	return filter
+}
+
 // Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
 func (filter *hsmSlotDBFilter) Paged(limit int, offset int) hsmslot.HSMSlotFilters {
 	filter.HSMSlotDBFilter = filter.HSMSlotDBFilter.Paged(limit, offset)
@@ -36,6 +36,7 @@ func mapToCreateDB(slot hsmslot.HSMSlot) (*hsmslotdb.HSMSlotCreateDB, error) {
 			HSMModuleID:        slot.HSMModuleID,
 			Slot:               slot.Slot,
 			Pin:                slot.Pin,
+			Priority:           slot.Priority,
 			CreationDate:       slot.CreationDate.ToInt64(),
 			LastUpdate:         slot.LastUpdate.ToInt64(),
 		},
@@ -76,22 +77,11 @@ func mapFromDB(db hsmslotdb.HSMSlotDB) (*hsmslot.HSMSlot, error) {
 		HSMModuleID:        db.HSMModuleID,
 		Slot:               db.Slot,
 		Pin:                db.Pin,
+		Priority:           db.Priority,
 		InternalResourceID: entities.InternalResourceID(db.InternalResourceID),
 	}, nil
 }
 
-func mapSliceFromDB(dbSlice []hsmslotdb.HSMSlotDB) ([]hsmslot.HSMSlot, error) {
-	userSlice := make([]hsmslot.HSMSlot, len(dbSlice))
-	for index := range dbSlice {
-		item, err := mapFromDB(dbSlice[index])
-		if err != nil {
-			return nil, err
-		}
-		userSlice[index] = *item
-	}
-	return userSlice, nil
-}
-
 func mapPersistenceErrorToSignerError(err error) error {
 	if persistence.IsAlreadyExists(err) {
 		return errors.AlreadyExistsFromErr(err)
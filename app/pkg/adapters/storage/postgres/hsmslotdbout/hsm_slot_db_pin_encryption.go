@@ -0,0 +1,116 @@
+package hsmslotdbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+)
+
+// ReEncryptPinsOutput defines the output of re-encrypting the stored pins.
+type ReEncryptPinsOutput struct {
+	// ReEncrypted number of pins encrypted with the current master key.
+	ReEncrypted int
+	// Skipped number of pins that were modified while they were being re-encrypted.
+	Skipped int
+}
+
+// ReEncryptPins encrypts with the current master key all the pins stored in plain text or encrypted with a previous
+// master key. Pins modified concurrently are skipped, so that the process can be run again until none is skipped.
+func (r *Repository) ReEncryptPins(ctx context.Context) (*ReEncryptPinsOutput, error) {
+	if r.pinEncrypter == nil {
+		return nil, errors.Internal().WithMessage("pin encryption is not configured")
+	}
+	storageData, err := r.infra.List(ctx, hsmslotdb.HSMSlotDBFilter{})
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	output := ReEncryptPinsOutput{}
+	for _, db := range storageData {
+		if r.pinEncrypter.IsEncryptedWithMasterKey(db.Pin) {
+			continue
+		}
+		pin, openErr := r.openPin(ctx, db.ID, db.Pin)
+		if openErr != nil {
+			return nil, openErr
+		}
+		storedPin, sealErr := r.sealPin(ctx, db.ID, pin)
+		if sealErr != nil {
+			return nil, sealErr
+		}
+		result, updateErr := r.infra.UpdateStoredPin(ctx, hsmslotdb.HSMSlotUpdateStoredPinDB{
+			StandardID:  db.StandardID,
+			Pin:         storedPin,
+			PreviousPin: db.Pin,
+		})
+		if updateErr != nil {
+			return nil, mapPersistenceErrorToSignerError(updateErr)
+		}
+		rowsAffected, rowsAffectedErr := result.Result.RowsAffected()
+		if rowsAffectedErr != nil {
+			return nil, errors.InternalFromErr(rowsAffectedErr)
+		}
+		if rowsAffected == 0 {
+			output.Skipped++
+			continue
+		}
+		output.ReEncrypted++
+	}
+	return &output, nil
+}
+
+// sealPin returns the value to store for the pin of the given slot. The pin is bound to the slot, so that the stored
+// value cannot be copied to another slot.
+func (r *Repository) sealPin(ctx context.Context, slotID string, pin string) (string, error) {
+	if r.pinEncrypter == nil {
+		return pin, nil
+	}
+	storedPin, err := r.pinEncrypter.Encrypt(ctx, []byte(pin), []byte(slotID))
+	if err != nil {
+		return "", errors.InternalFromErr(err).WithMessage("error encrypting the pin of 'hsm_slot' [%s]", slotID)
+	}
+	return storedPin, nil
+}
+
+// openPin returns the pin of the given slot from its stored value. Pins stored before encryption was enabled are
+// returned as they are.
+func (r *Repository) openPin(ctx context.Context, slotID string, storedPin string) (string, error) {
+	if !envelope.IsEncrypted(storedPin) {
+		return storedPin, nil
+	}
+	if r.pinEncrypter == nil {
+		return "", errors.Internal().WithMessage("the pin of 'hsm_slot' [%s] is encrypted but pin encryption is not configured", slotID)
+	}
+	pin, err := r.pinEncrypter.Decrypt(ctx, storedPin, []byte(slotID))
+	if err != nil {
+		return "", errors.InternalFromErr(err).WithMessage("error decrypting the pin of 'hsm_slot' [%s]", slotID)
+	}
+	return string(pin), nil
+}
+
+func (r *Repository) fromDB(ctx context.Context, db hsmslotdb.HSMSlotDB) (*hsmslot.HSMSlot, error) {
+	slot, err := mapFromDB(db)
+	if err != nil {
+		return nil, err
+	}
+	slot.Pin, err = r.openPin(ctx, db.ID, db.Pin)
+	if err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+func (r *Repository) sliceFromDB(ctx context.Context, dbSlice []hsmslotdb.HSMSlotDB) ([]hsmslot.HSMSlot, error) {
+	slotSlice := make([]hsmslot.HSMSlot, len(dbSlice))
+	for index := range dbSlice {
+		item, err := r.fromDB(ctx, dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		slotSlice[index] = *item
+	}
+	return slotSlice, nil
+}
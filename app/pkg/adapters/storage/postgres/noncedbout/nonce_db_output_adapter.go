@@ -0,0 +1,84 @@
+// Package noncedbout defines the output database adapters for the Nonce resource.
+package noncedbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/noncedb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+)
+
+var _ nonce.NonceStorage = new(Repository)
+
+// Increment the next nonce of a Nonce in storage, adding it if it isn't in storage.
+func (repository *Repository) Increment(ctx context.Context, data nonce.Nonce) error {
+	db := mapToDB(data)
+	db.NextNonce++
+
+	err := repository.infra.Increment(ctx, db)
+	if err != nil {
+		return mapPersistenceErrorToSignerError(err)
+	}
+	return nil
+}
+
+// Get a Nonce from storage.
+func (repository *Repository) Get(ctx context.Context, id nonce.NonceID) (*nonce.Nonce, error) {
+	storageData, err := repository.infra.Get(ctx, mapIDToDB(id))
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'nonce' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'nonce'")
+	}
+
+	storedNonce, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedNonce, nil
+}
+
+// Set the next nonce of a Nonce in storage, adding it if it isn't in storage.
+func (repository *Repository) Set(ctx context.Context, data nonce.Nonce) (*nonce.Nonce, error) {
+	err := repository.infra.Set(ctx, mapToDB(data))
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return repository.Get(ctx, data.NonceID)
+}
+
+// Advance the next nonce of a Nonce in storage to the given one if it is greater, adding the Nonce if it isn't in storage.
+func (repository *Repository) Advance(ctx context.Context, data nonce.Nonce) (*nonce.Nonce, error) {
+	err := repository.infra.Advance(ctx, mapToDB(data))
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return repository.Get(ctx, data.NonceID)
+}
+
+// Repository implementation of nonce.NonceStorage
+type Repository struct {
+	infra *noncedb.NonceRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *noncedb.NonceRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package noncedbout
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/noncedb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+)
+
+func mapIDToDB(id nonce.NonceID) noncedb.NonceDB {
+	return noncedb.NonceDB{
+		ChainID: id.ChainID.BigInt().String(),
+		Address: id.Address.String(),
+	}
+}
+
+func mapToDB(data nonce.Nonce) noncedb.NonceDB {
+	db := mapIDToDB(data.NonceID)
+	db.NextNonce = int64(data.NextNonce.Uint64())
+	db.CreationDate = data.CreationDate.ToInt64()
+	db.LastUpdate = data.LastUpdate.ToInt64()
+	return db
+}
+
+func mapFromDB(db noncedb.NonceDB) (*nonce.Nonce, error) {
+	chainID, err := entities.NewInt256FromString(db.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := address.NewFromHexString(db.Address)
+	if err != nil {
+		return nil, err
+	}
+	if db.NextNonce < 0 {
+		return nil, errors.Internal().WithMessage("'NextNonce' cannot be negative")
+	}
+
+	return &nonce.Nonce{
+		NonceID: nonce.NonceID{
+			ChainID: *chainID,
+			Address: addr,
+		},
+		NextNonce: entities.NewUInt64(uint64(db.NextNonce)),
+		Timestamps: entities.Timestamps{
+			CreationDate: time.TimestampFromInt64(db.CreationDate),
+			LastUpdate:   time.TimestampFromInt64(db.LastUpdate),
+		},
+	}, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
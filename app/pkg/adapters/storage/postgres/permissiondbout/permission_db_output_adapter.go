@@ -0,0 +1,136 @@
+// Package permissiondbout defines the output database adapters for the custom Permission resource.
+package permissiondbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/permissiondb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+)
+
+var _ role.PermissionStorage = new(Repository)
+
+// Add a Permission to storage.
+func (repository *Repository) Add(ctx context.Context, data role.Permission) (*role.Permission, error) {
+	db, err := mapToCreateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	storageData, err := repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	addedPermission, err := mapFromDB(*storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return addedPermission, nil
+}
+
+// Get a Permission from storage.
+func (repository *Repository) Get(ctx context.Context, id entities.StandardID) (*role.Permission, error) {
+	storageData, err := repository.infra.Get(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'permission' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'permission'")
+	}
+
+	storedPermission, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedPermission, nil
+}
+
+// Edit a Permission from in storage.
+func (repository *Repository) Edit(ctx context.Context, data role.Permission) (*role.Permission, error) {
+	db, err := mapToUpdateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	result, err := repository.infra.Edit(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	rowsAffected, errRowsAffected := result.Result.RowsAffected()
+	if errRowsAffected != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'permission' does not match the one stored")
+	}
+
+	if rowsAffected > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'permission'")
+	}
+
+	storageData, err := repository.Get(ctx, data.StandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storageData, nil
+}
+
+// Remove a Permission from the storage.
+func (repository *Repository) Remove(ctx context.Context, id entities.StandardID) (*role.Permission, error) {
+	storageData, err := repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repository.infra.Remove(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return storageData, nil
+}
+
+// All retrieves all the Permissions from the storage.
+func (repository *Repository) All(ctx context.Context) ([]role.Permission, error) {
+	storageData, err := repository.infra.List(ctx)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	items, err := mapSliceFromDB(storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return items, nil
+}
+
+// Repository implementation of role.PermissionStorage
+type Repository struct {
+	infra *permissiondb.PermissionRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *permissiondb.PermissionRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
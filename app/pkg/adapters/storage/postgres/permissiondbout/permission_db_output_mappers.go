@@ -0,0 +1,125 @@
+package permissiondbout
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/permissiondb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+)
+
+func mapToCreateDB(permission role.Permission) (*permissiondb.PermissionCreateDB, error) {
+	if len(permission.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(permission.Actions) == 0 {
+		return nil, errors.Internal().WithMessage("'Actions' cannot be empty")
+	}
+	actions, err := json.Marshal(permission.Actions)
+	if err != nil {
+		return nil, err
+	}
+	var description string
+	if permission.Description != nil {
+		description = *permission.Description
+	}
+
+	return &permissiondb.PermissionCreateDB{
+		PermissionDB: permissiondb.PermissionDB{
+			StandardID:   permission.StandardID,
+			Actions:      string(actions),
+			Description:  description,
+			CreationDate: permission.CreationDate.ToInt64(),
+			LastUpdate:   permission.LastUpdate.ToInt64(),
+		},
+	}, nil
+}
+
+func mapToUpdateDB(permission role.Permission) (*permissiondb.PermissionUpdateDB, error) {
+	if len(permission.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(permission.Actions) == 0 {
+		return nil, errors.Internal().WithMessage("'Actions' cannot be empty")
+	}
+	actions, err := json.Marshal(permission.Actions)
+	if err != nil {
+		return nil, err
+	}
+	var description string
+	if permission.Description != nil {
+		description = *permission.Description
+	}
+
+	return &permissiondb.PermissionUpdateDB{
+		PermissionDB: permissiondb.PermissionDB{
+			StandardID:      permission.StandardID,
+			Actions:         string(actions),
+			Description:     description,
+			CreationDate:    permission.CreationDate.ToInt64(),
+			LastUpdate:      permission.LastUpdate.ToInt64(),
+			ResourceVersion: permission.ResourceVersion,
+		},
+	}, nil
+}
+
+func mapFromDB(db permissiondb.PermissionDB) (*role.Permission, error) {
+	if len(db.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(db.Actions) == 0 {
+		return nil, errors.Internal().WithMessage("'Actions' cannot be empty")
+	}
+
+	var actions []string
+	err := json.Unmarshal([]byte(db.Actions), &actions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role.Permission{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: entities.StandardID{
+					ID: db.ID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: time.TimestampFromInt64(db.CreationDate),
+					LastUpdate:   time.TimestampFromInt64(db.LastUpdate),
+				},
+			},
+			ResourceVersion: db.ResourceVersion,
+		},
+		Description: &db.Description,
+		Actions:     actions,
+	}, nil
+}
+
+func mapSliceFromDB(dbSlice []permissiondb.PermissionDB) ([]role.Permission, error) {
+	permissionSlice := make([]role.Permission, len(dbSlice))
+	for index := range dbSlice {
+		item, err := mapFromDB(dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		permissionSlice[index] = *item
+	}
+
+	return permissionSlice, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
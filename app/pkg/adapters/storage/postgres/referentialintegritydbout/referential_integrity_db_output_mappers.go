@@ -118,6 +118,14 @@ func mapTypeToDB(resourceKind referentialintegrity.ResourceKind) (*string, error
 		k := referentialintegritydb.KindHSMSlot
 		return &k, nil
 	}
+	if resourceKind == referentialintegrity.KindSigningLimit {
+		k := referentialintegritydb.KindSigningLimit
+		return &k, nil
+	}
+	if resourceKind == referentialintegrity.KindSigningPolicy {
+		k := referentialintegritydb.KindSigningPolicy
+		return &k, nil
+	}
 	if resourceKind == referentialintegrity.KindUser {
 		k := referentialintegritydb.KindUser
 		return &k, nil
@@ -142,6 +150,14 @@ func mapTypeFromDB(resourceKind string) (*referentialintegrity.ResourceKind, err
 		k := referentialintegrity.KindHSMSlot
 		return &k, nil
 	}
+	if resourceKind == referentialintegritydb.KindSigningLimit {
+		k := referentialintegrity.KindSigningLimit
+		return &k, nil
+	}
+	if resourceKind == referentialintegritydb.KindSigningPolicy {
+		k := referentialintegrity.KindSigningPolicy
+		return &k, nil
+	}
 	if resourceKind == referentialintegritydb.KindUser {
 		k := referentialintegrity.KindUser
 		return &k, nil
@@ -0,0 +1,145 @@
+// Package roledbout defines the output database adapters for the custom Role resource.
+package roledbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/roledb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+)
+
+var _ role.RoleStorage = new(Repository)
+
+// Add a Role to storage.
+func (repository *Repository) Add(ctx context.Context, data role.Role) (*role.Role, error) {
+	db, err := mapToCreateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	storageData, err := repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	addedRole, err := mapFromDB(*storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return addedRole, nil
+}
+
+// Get a Role from storage.
+func (repository *Repository) Get(ctx context.Context, id entities.StandardID) (*role.Role, error) {
+	storageData, err := repository.infra.Get(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'role' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'role'")
+	}
+
+	storedRole, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedRole, nil
+}
+
+// Edit a Role from in storage.
+func (repository *Repository) Edit(ctx context.Context, data role.Role) (*role.Role, error) {
+	db, err := mapToUpdateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	result, err := repository.infra.Edit(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	rowsAffected, errRowsAffected := result.Result.RowsAffected()
+	if errRowsAffected != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'role' does not match the one stored")
+	}
+
+	if rowsAffected > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'role'")
+	}
+
+	storageData, err := repository.Get(ctx, data.StandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storageData, nil
+}
+
+// Remove a Role from the storage.
+func (repository *Repository) Remove(ctx context.Context, id entities.StandardID) (*role.Role, error) {
+	storageData, err := repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repository.infra.Remove(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return storageData, nil
+}
+
+// All retrieves all the Roles from the storage.
+func (repository *Repository) All(ctx context.Context) ([]role.Role, error) {
+	storageData, err := repository.infra.List(ctx)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	items, err := mapSliceFromDB(storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return items, nil
+}
+
+// Assigned checks whether the Role is assigned to any user.
+func (repository *Repository) Assigned(ctx context.Context, id entities.StandardID) (bool, error) {
+	assigned, err := repository.infra.Assigned(ctx, id)
+	if err != nil {
+		return false, mapPersistenceErrorToSignerError(err)
+	}
+	return assigned, nil
+}
+
+// Repository implementation of role.RoleStorage
+type Repository struct {
+	infra *roledb.RoleRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *roledb.RoleRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package roledbout
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/roledb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+)
+
+func mapToCreateDB(role role.Role) (*roledb.RoleCreateDB, error) {
+	if len(role.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(role.Permissions) == 0 {
+		return nil, errors.Internal().WithMessage("'Permissions' cannot be empty")
+	}
+	permissions, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	var description string
+	if role.Description != nil {
+		description = *role.Description
+	}
+
+	return &roledb.RoleCreateDB{
+		RoleDB: roledb.RoleDB{
+			StandardID:   role.StandardID,
+			Permissions:  string(permissions),
+			Description:  description,
+			CreationDate: role.CreationDate.ToInt64(),
+			LastUpdate:   role.LastUpdate.ToInt64(),
+		},
+	}, nil
+}
+
+func mapToUpdateDB(role role.Role) (*roledb.RoleUpdateDB, error) {
+	if len(role.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(role.Permissions) == 0 {
+		return nil, errors.Internal().WithMessage("'Permissions' cannot be empty")
+	}
+	permissions, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	var description string
+	if role.Description != nil {
+		description = *role.Description
+	}
+
+	return &roledb.RoleUpdateDB{
+		RoleDB: roledb.RoleDB{
+			StandardID:      role.StandardID,
+			Permissions:     string(permissions),
+			Description:     description,
+			CreationDate:    role.CreationDate.ToInt64(),
+			LastUpdate:      role.LastUpdate.ToInt64(),
+			ResourceVersion: role.ResourceVersion,
+		},
+	}, nil
+}
+
+func mapFromDB(db roledb.RoleDB) (*role.Role, error) {
+	if len(db.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(db.Permissions) == 0 {
+		return nil, errors.Internal().WithMessage("'Permissions' cannot be empty")
+	}
+
+	var permissions []string
+	err := json.Unmarshal([]byte(db.Permissions), &permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role.Role{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: entities.StandardID{
+					ID: db.ID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: time.TimestampFromInt64(db.CreationDate),
+					LastUpdate:   time.TimestampFromInt64(db.LastUpdate),
+				},
+			},
+			ResourceVersion: db.ResourceVersion,
+		},
+		Description: &db.Description,
+		Permissions: permissions,
+	}, nil
+}
+
+func mapSliceFromDB(dbSlice []roledb.RoleDB) ([]role.Role, error) {
+	roleSlice := make([]role.Role, len(dbSlice))
+	for index := range dbSlice {
+		item, err := mapFromDB(dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		roleSlice[index] = *item
+	}
+
+	return roleSlice, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
@@ -0,0 +1,197 @@
+// Package signinglimitdbout defines the output database adapters for the SigningLimit resource.
+package signinglimitdbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitdb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+)
+
+var _ signinglimit.SigningLimitStorage = new(Repository)
+
+// Add a SigningLimit to storage.
+func (repository *Repository) Add(ctx context.Context, data signinglimit.SigningLimit) (*signinglimit.SigningLimit, error) {
+	db, err := mapToCreateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	storageData, err := repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	addedSigningLimit, err := mapFromDB(*storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return addedSigningLimit, nil
+}
+
+// Get a SigningLimit from storage.
+func (repository *Repository) Get(ctx context.Context, id entities.ApplicationStandardID) (*signinglimit.SigningLimit, error) {
+	storageData, err := repository.infra.Get(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing limit' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing limit'")
+	}
+
+	storedSigningLimit, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedSigningLimit, nil
+}
+
+// Edit a SigningLimit in storage.
+func (repository *Repository) Edit(ctx context.Context, data signinglimit.SigningLimit) (*signinglimit.SigningLimit, error) {
+	db, err := mapToUpdateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	result, err := repository.infra.Edit(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	rowsAffected, errRowsAffected := result.Result.RowsAffected()
+	if errRowsAffected != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing limit' does not match the one stored")
+	}
+
+	if rowsAffected > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing limit'")
+	}
+
+	storageData, err := repository.Get(ctx, data.ApplicationStandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storageData, nil
+}
+
+// Remove a SigningLimit from the storage.
+func (repository *Repository) Remove(ctx context.Context, id entities.ApplicationStandardID) (*signinglimit.SigningLimit, error) {
+	storageData, err := repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repository.infra.Remove(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return storageData, nil
+}
+
+// All retrieves all SigningLimit resources from the storage.
+func (repository *Repository) All(ctx context.Context, filters signinglimit.SigningLimitFilters) (*signinglimit.SigningLimitCollection, error) {
+	f, ok := filters.(*signingLimitDBFilter)
+	if !ok {
+		return nil, errors.Internal().WithMessage("invalid query filters provided")
+	}
+
+	if f.Pagination != nil {
+		f.Pagination.Limit++
+	}
+	storageData, err := repository.infra.List(ctx, *f.SigningLimitDBFilter)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	collection := signinglimit.SigningLimitCollection{}
+	if f.Pagination != nil {
+		collection.Offset = f.Pagination.Offset
+		collection.Limit = f.Pagination.Limit - 1
+		if len(storageData) == f.Pagination.Limit {
+			collection.MoreItems = true
+			storageData = storageData[:len(storageData)-1]
+		}
+		f.Pagination.Limit--
+	} else {
+		collection.StandardCollectionPage = entities.NewUnlimitedQueryStandardCollectionPage(len(storageData))
+	}
+
+	items, err := mapSliceFromDB(storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	collection.Items = items
+
+	return &collection, nil
+}
+
+// Filter creates a new filter for the provided application.
+func (repository *Repository) Filter(applicationID string) signinglimit.SigningLimitFilters {
+	storageFilter := signingLimitDBFilter{
+		SigningLimitDBFilter: &signinglimitdb.SigningLimitDBFilter{
+			SigningLimitDB: signinglimitdb.SigningLimitDB{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ApplicationID: applicationID,
+				},
+			},
+		},
+	}
+	return &storageFilter
+}
+
+// Repository implementation of signinglimit.SigningLimitStorage
+type Repository struct {
+	infra *signinglimitdb.SigningLimitRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *signinglimitdb.SigningLimitRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
+
+var _ signinglimit.SigningLimitFilters = (*signingLimitDBFilter)(nil)
+
+// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
+func (filter *signingLimitDBFilter) Paged(limit int, offset int) signinglimit.SigningLimitFilters {
+	filter.SigningLimitDBFilter = filter.SigningLimitDBFilter.Paged(limit, offset)
+	return filter
+}
+
+// OrderByCreationDate orders resources in storage by creation date.
+func (filter *signingLimitDBFilter) OrderByCreationDate(orderDirection persistence.OrderDirection) signinglimit.SigningLimitFilters {
+	filter.SigningLimitDBFilter = filter.SigningLimitDBFilter.Sort("creation_date", orderDirection)
+	return filter
+}
+
+// OrderByLastUpdateDate orders resources in storage by last update date.
+func (filter *signingLimitDBFilter) OrderByLastUpdateDate(orderDirection persistence.OrderDirection) signinglimit.SigningLimitFilters {
+	filter.SigningLimitDBFilter = filter.SigningLimitDBFilter.Sort("last_update", orderDirection)
+	return filter
+}
+
+type signingLimitDBFilter struct {
+	*signinglimitdb.SigningLimitDBFilter
+}
@@ -0,0 +1,126 @@
+package signinglimitdbout
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitdb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+)
+
+func mapToCreateDB(signingLimit signinglimit.SigningLimit) (*signinglimitdb.SigningLimitCreateDB, error) {
+	if len(signingLimit.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(signingLimit.InternalResourceID) == 0 {
+		return nil, errors.Internal().WithMessage("'InternalResourceID' cannot be empty")
+	}
+	if len(signingLimit.ApplicationID) == 0 {
+		return nil, errors.Internal().WithMessage("'ApplicationID' cannot be empty")
+	}
+	return &signinglimitdb.SigningLimitCreateDB{
+		SigningLimitDB: mapToDB(signingLimit),
+	}, nil
+}
+
+func mapToUpdateDB(signingLimit signinglimit.SigningLimit) (*signinglimitdb.SigningLimitUpdateDB, error) {
+	if len(signingLimit.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(signingLimit.ApplicationID) == 0 {
+		return nil, errors.Internal().WithMessage("'ApplicationID' cannot be empty")
+	}
+	db := mapToDB(signingLimit)
+	db.ResourceVersion = signingLimit.ResourceVersion
+	return &signinglimitdb.SigningLimitUpdateDB{
+		SigningLimitDB: db,
+	}, nil
+}
+
+func mapToDB(signingLimit signinglimit.SigningLimit) signinglimitdb.SigningLimitDB {
+	db := signinglimitdb.SigningLimitDB{
+		ApplicationStandardID: signingLimit.ApplicationStandardID,
+		InternalResourceID:    signingLimit.InternalResourceID.String(),
+		Scope:                 string(signingLimit.Scope),
+		WindowSeconds:         signingLimit.WindowSeconds,
+		MaxSignatures:         signingLimit.MaxSignatures,
+		CreationDate:          signingLimit.CreationDate.ToInt64(),
+		LastUpdate:            signingLimit.LastUpdate.ToInt64(),
+	}
+	if signingLimit.Subject != nil {
+		db.Subject = *signingLimit.Subject
+	}
+	if signingLimit.MaxValue != nil {
+		db.MaxValue = signingLimit.MaxValue.BigInt().String()
+	}
+	if signingLimit.Description != nil {
+		db.Description = *signingLimit.Description
+	}
+	return db
+}
+
+func mapFromDB(db signinglimitdb.SigningLimitDB) (*signinglimit.SigningLimit, error) {
+	if len(db.InternalResourceID) == 0 {
+		return nil, errors.Internal().WithMessage("'InternalResourceID' cannot be empty")
+	}
+
+	signingLimit := signinglimit.SigningLimit{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ID:            db.ID,
+					ApplicationID: db.ApplicationID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: time.TimestampFromInt64(db.CreationDate),
+					LastUpdate:   time.TimestampFromInt64(db.LastUpdate),
+				},
+			},
+			ResourceVersion: db.ResourceVersion,
+		},
+		Scope:              signinglimit.Scope(db.Scope),
+		WindowSeconds:      db.WindowSeconds,
+		MaxSignatures:      db.MaxSignatures,
+		Description:        &db.Description,
+		InternalResourceID: entities.InternalResourceID(db.InternalResourceID),
+	}
+	if len(db.Subject) > 0 {
+		subject := db.Subject
+		signingLimit.Subject = &subject
+	}
+	if len(db.MaxValue) > 0 {
+		maxValue, err := entities.NewInt256FromString(db.MaxValue)
+		if err != nil {
+			return nil, err
+		}
+		signingLimit.MaxValue = maxValue
+	}
+	return &signingLimit, nil
+}
+
+func mapSliceFromDB(dbSlice []signinglimitdb.SigningLimitDB) ([]signinglimit.SigningLimit, error) {
+	signingLimitSlice := make([]signinglimit.SigningLimit, len(dbSlice))
+	for index := range dbSlice {
+		item, err := mapFromDB(dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		signingLimitSlice[index] = *item
+	}
+
+	return signingLimitSlice, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
@@ -0,0 +1,126 @@
+// Package signinglimitusagedbout defines the output database adapters for the SigningLimitUsage resource.
+package signinglimitusagedbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitusagedb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+)
+
+var _ signinglimit.SigningLimitUsageStorage = new(Repository)
+
+// Add a SigningLimitUsage to storage.
+func (repository *Repository) Add(ctx context.Context, data signinglimit.SigningLimitUsage) (*signinglimit.SigningLimitUsage, error) {
+	db, err := mapToDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	storageData, err := repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	addedUsage, err := mapFromDB(*storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return addedUsage, nil
+}
+
+// Get a SigningLimitUsage from storage.
+func (repository *Repository) Get(ctx context.Context, id signinglimit.SigningLimitUsageID) (*signinglimit.SigningLimitUsage, error) {
+	storageData, err := repository.infra.Get(ctx, mapIDToDB(id))
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing limit usage' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing limit usage'")
+	}
+
+	storedUsage, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedUsage, nil
+}
+
+// Edit a SigningLimitUsage in storage.
+func (repository *Repository) Edit(ctx context.Context, data signinglimit.SigningLimitUsage) (*signinglimit.SigningLimitUsage, error) {
+	db, err := mapToDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	result, err := repository.infra.Edit(ctx, signinglimitusagedb.SigningLimitUsageUpdateDB{
+		SigningLimitUsageDB: *db,
+	})
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	rowsAffected, errRowsAffected := result.Result.RowsAffected()
+	if errRowsAffected != nil {
+		return nil, errors.InternalFromErr(errRowsAffected)
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing limit usage' does not match the one stored")
+	}
+
+	if rowsAffected > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing limit usage'")
+	}
+
+	return repository.Get(ctx, data.SigningLimitUsageID)
+}
+
+// RemoveAll SigningLimitUsage of a SigningLimit from the storage.
+func (repository *Repository) RemoveAll(ctx context.Context, limitID entities.ApplicationStandardID) error {
+	_, err := repository.infra.RemoveByLimit(ctx, limitID.ApplicationID, limitID.ID)
+	if err != nil {
+		return mapPersistenceErrorToSignerError(err)
+	}
+	return nil
+}
+
+// All retrieves all SigningLimitUsage of a SigningLimit from the storage.
+func (repository *Repository) All(ctx context.Context, limitID entities.ApplicationStandardID) ([]signinglimit.SigningLimitUsage, error) {
+	storageData, err := repository.infra.ListByLimit(ctx, limitID.ApplicationID, limitID.ID)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	items, err := mapSliceFromDB(storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	return items, nil
+}
+
+// Repository implementation of signinglimit.SigningLimitUsageStorage
+type Repository struct {
+	infra *signinglimitusagedb.SigningLimitUsageRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *signinglimitusagedb.SigningLimitUsageRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
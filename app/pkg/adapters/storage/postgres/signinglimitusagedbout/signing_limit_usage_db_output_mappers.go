@@ -0,0 +1,117 @@
+package signinglimitusagedbout
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitusagedb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+)
+
+// bucketDB is the JSON representation of a bucket of usage in the database
+type bucketDB struct {
+	Start      int64  `json:"start"`
+	Signatures int64  `json:"signatures"`
+	Value      string `json:"value"`
+}
+
+func mapIDToDB(id signinglimit.SigningLimitUsageID) signinglimitusagedb.SigningLimitUsageDB {
+	return signinglimitusagedb.SigningLimitUsageDB{
+		ApplicationID: id.LimitID.ApplicationID,
+		LimitID:       id.LimitID.ID,
+		Subject:       id.Subject,
+	}
+}
+
+func mapToDB(usage signinglimit.SigningLimitUsage) (*signinglimitusagedb.SigningLimitUsageDB, error) {
+	if len(usage.LimitID.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'LimitID' cannot be empty")
+	}
+	if len(usage.LimitID.ApplicationID) == 0 {
+		return nil, errors.Internal().WithMessage("'ApplicationID' cannot be empty")
+	}
+	if len(usage.Subject) == 0 {
+		return nil, errors.Internal().WithMessage("'Subject' cannot be empty")
+	}
+
+	buckets := make([]bucketDB, len(usage.Buckets))
+	for i, bucket := range usage.Buckets {
+		buckets[i] = bucketDB{
+			Start:      bucket.Start.ToInt64(),
+			Signatures: bucket.Signatures,
+			Value:      bucket.Value.BigInt().String(),
+		}
+	}
+	encoded, err := json.Marshal(buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	db := mapIDToDB(usage.SigningLimitUsageID)
+	db.Buckets = string(encoded)
+	db.LastUpdate = usage.LastUpdate.ToInt64()
+	db.ResourceVersion = usage.ResourceVersion
+	return &db, nil
+}
+
+func mapFromDB(db signinglimitusagedb.SigningLimitUsageDB) (*signinglimit.SigningLimitUsage, error) {
+	var buckets []bucketDB
+	err := json.Unmarshal([]byte(db.Buckets), &buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := signinglimit.SigningLimitUsage{
+		SigningLimitUsageID: signinglimit.SigningLimitUsageID{
+			LimitID: entities.ApplicationStandardID{
+				ID:            db.LimitID,
+				ApplicationID: db.ApplicationID,
+			},
+			Subject: db.Subject,
+		},
+		Buckets:         make([]signinglimit.UsageBucket, len(buckets)),
+		ResourceVersion: db.ResourceVersion,
+		LastUpdate:      time.TimestampFromInt64(db.LastUpdate),
+	}
+	for i, bucket := range buckets {
+		value, valueErr := entities.NewInt256FromString(bucket.Value)
+		if valueErr != nil {
+			return nil, valueErr
+		}
+		usage.Buckets[i] = signinglimit.UsageBucket{
+			Start:      time.TimestampFromInt64(bucket.Start),
+			Signatures: bucket.Signatures,
+			Value:      *value,
+		}
+	}
+	return &usage, nil
+}
+
+func mapSliceFromDB(dbSlice []signinglimitusagedb.SigningLimitUsageDB) ([]signinglimit.SigningLimitUsage, error) {
+	usageSlice := make([]signinglimit.SigningLimitUsage, len(dbSlice))
+	for index := range dbSlice {
+		item, err := mapFromDB(dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		usageSlice[index] = *item
+	}
+
+	return usageSlice, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
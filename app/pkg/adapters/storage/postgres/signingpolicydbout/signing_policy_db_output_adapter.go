@@ -0,0 +1,197 @@
+// Package signingpolicydbout defines the output database adapters for the SigningPolicy resource.
+package signingpolicydbout
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signingpolicydb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
+)
+
+var _ signingpolicy.SigningPolicyStorage = new(Repository)
+
+// Add a SigningPolicy to storage.
+func (repository *Repository) Add(ctx context.Context, data signingpolicy.SigningPolicy) (*signingpolicy.SigningPolicy, error) {
+	db, err := mapToCreateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	storageData, err := repository.infra.Add(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	addedSigningPolicy, err := mapFromDB(*storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return addedSigningPolicy, nil
+}
+
+// Get a SigningPolicy from storage.
+func (repository *Repository) Get(ctx context.Context, id entities.ApplicationStandardID) (*signingpolicy.SigningPolicy, error) {
+	storageData, err := repository.infra.Get(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	if len(storageData) == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing policy' does not exist")
+	}
+
+	if len(storageData) > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing policy'")
+	}
+
+	storedSigningPolicy, err := mapFromDB(storageData[0])
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storedSigningPolicy, nil
+}
+
+// Edit a SigningPolicy in storage.
+func (repository *Repository) Edit(ctx context.Context, data signingpolicy.SigningPolicy) (*signingpolicy.SigningPolicy, error) {
+	db, err := mapToUpdateDB(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	result, err := repository.infra.Edit(ctx, *db)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	rowsAffected, errRowsAffected := result.Result.RowsAffected()
+	if errRowsAffected != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.NotFound().WithMessage("resource 'signing policy' does not match the one stored")
+	}
+
+	if rowsAffected > 1 {
+		return nil, errors.Internal().WithMessage("unexpected number of results when obtaining 'signing policy'")
+	}
+
+	storageData, err := repository.Get(ctx, data.ApplicationStandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return storageData, nil
+}
+
+// Remove a SigningPolicy from the storage.
+func (repository *Repository) Remove(ctx context.Context, id entities.ApplicationStandardID) (*signingpolicy.SigningPolicy, error) {
+	storageData, err := repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repository.infra.Remove(ctx, id)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	return storageData, nil
+}
+
+// All retrieves all SigningPolicy resources from the storage.
+func (repository *Repository) All(ctx context.Context, filters signingpolicy.SigningPolicyFilters) (*signingpolicy.SigningPolicyCollection, error) {
+	f, ok := filters.(*signingPolicyDBFilter)
+	if !ok {
+		return nil, errors.Internal().WithMessage("invalid query filters provided")
+	}
+
+	if f.Pagination != nil {
+		f.Pagination.Limit++
+	}
+	storageData, err := repository.infra.List(ctx, *f.SigningPolicyDBFilter)
+	if err != nil {
+		return nil, mapPersistenceErrorToSignerError(err)
+	}
+
+	collection := signingpolicy.SigningPolicyCollection{}
+	if f.Pagination != nil {
+		collection.Offset = f.Pagination.Offset
+		collection.Limit = f.Pagination.Limit - 1
+		if len(storageData) == f.Pagination.Limit {
+			collection.MoreItems = true
+			storageData = storageData[:len(storageData)-1]
+		}
+		f.Pagination.Limit--
+	} else {
+		collection.StandardCollectionPage = entities.NewUnlimitedQueryStandardCollectionPage(len(storageData))
+	}
+
+	items, err := mapSliceFromDB(storageData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	collection.Items = items
+
+	return &collection, nil
+}
+
+// Filter creates a new filter for the provided application.
+func (repository *Repository) Filter(applicationID string) signingpolicy.SigningPolicyFilters {
+	storageFilter := signingPolicyDBFilter{
+		SigningPolicyDBFilter: &signingpolicydb.SigningPolicyDBFilter{
+			SigningPolicyDB: signingpolicydb.SigningPolicyDB{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ApplicationID: applicationID,
+				},
+			},
+		},
+	}
+	return &storageFilter
+}
+
+// Repository implementation of signingpolicy.SigningPolicyStorage
+type Repository struct {
+	infra *signingpolicydb.SigningPolicyRepositoryInfra
+}
+
+// RepositoryOptions configures a Repository
+type RepositoryOptions struct {
+	Infra *signingpolicydb.SigningPolicyRepositoryInfra
+}
+
+// NewRepository creates a Repository with the given options
+func NewRepository(options RepositoryOptions) (*Repository, error) {
+	return &Repository{
+		infra: options.Infra,
+	}, nil
+}
+
+var _ signingpolicy.SigningPolicyFilters = (*signingPolicyDBFilter)(nil)
+
+// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
+func (filter *signingPolicyDBFilter) Paged(limit int, offset int) signingpolicy.SigningPolicyFilters {
+	filter.SigningPolicyDBFilter = filter.SigningPolicyDBFilter.Paged(limit, offset)
+	return filter
+}
+
+// OrderByCreationDate orders resources in storage by creation date.
+func (filter *signingPolicyDBFilter) OrderByCreationDate(orderDirection persistence.OrderDirection) signingpolicy.SigningPolicyFilters {
+	filter.SigningPolicyDBFilter = filter.SigningPolicyDBFilter.Sort("creation_date", orderDirection)
+	return filter
+}
+
+// OrderByLastUpdateDate orders resources in storage by last update date.
+func (filter *signingPolicyDBFilter) OrderByLastUpdateDate(orderDirection persistence.OrderDirection) signingpolicy.SigningPolicyFilters {
+	filter.SigningPolicyDBFilter = filter.SigningPolicyDBFilter.Sort("last_update", orderDirection)
+	return filter
+}
+
+type signingPolicyDBFilter struct {
+	*signingpolicydb.SigningPolicyDBFilter
+}
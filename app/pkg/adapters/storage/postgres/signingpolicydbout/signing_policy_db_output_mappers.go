@@ -0,0 +1,230 @@
+package signingpolicydbout
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signingpolicydb"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
+)
+
+// rulesDB is the JSON representation of the rules of a SigningPolicy in the database
+type rulesDB struct {
+	AllowedRecipients         []string `json:"allowedRecipients,omitempty"`
+	MaxValue                  *string  `json:"maxValue,omitempty"`
+	MaxGas                    *uint64  `json:"maxGas,omitempty"`
+	MaxGasPrice               *string  `json:"maxGasPrice,omitempty"`
+	AllowedMethodSelectors    []string `json:"allowedMethodSelectors,omitempty"`
+	DenyContractCreation      bool     `json:"denyContractCreation"`
+	AllowMessages             bool     `json:"allowMessages"`
+	AllowTypedData            bool     `json:"allowTypedData"`
+	AllowedVerifyingContracts []string `json:"allowedVerifyingContracts,omitempty"`
+}
+
+func mapToCreateDB(signingPolicy signingpolicy.SigningPolicy) (*signingpolicydb.SigningPolicyCreateDB, error) {
+	if len(signingPolicy.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(signingPolicy.InternalResourceID) == 0 {
+		return nil, errors.Internal().WithMessage("'InternalResourceID' cannot be empty")
+	}
+	if len(signingPolicy.ApplicationID) == 0 {
+		return nil, errors.Internal().WithMessage("'ApplicationID' cannot be empty")
+	}
+	db, err := mapToDB(signingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &signingpolicydb.SigningPolicyCreateDB{
+		SigningPolicyDB: *db,
+	}, nil
+}
+
+func mapToUpdateDB(signingPolicy signingpolicy.SigningPolicy) (*signingpolicydb.SigningPolicyUpdateDB, error) {
+	if len(signingPolicy.ID) == 0 {
+		return nil, errors.Internal().WithMessage("'ID' cannot be empty")
+	}
+	if len(signingPolicy.ApplicationID) == 0 {
+		return nil, errors.Internal().WithMessage("'ApplicationID' cannot be empty")
+	}
+	db, err := mapToDB(signingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	db.ResourceVersion = signingPolicy.ResourceVersion
+	return &signingpolicydb.SigningPolicyUpdateDB{
+		SigningPolicyDB: *db,
+	}, nil
+}
+
+func mapToDB(signingPolicy signingpolicy.SigningPolicy) (*signingpolicydb.SigningPolicyDB, error) {
+	rules, err := mapRulesToDB(signingPolicy.Rules)
+	if err != nil {
+		return nil, err
+	}
+	db := signingpolicydb.SigningPolicyDB{
+		ApplicationStandardID: signingPolicy.ApplicationStandardID,
+		InternalResourceID:    signingPolicy.InternalResourceID.String(),
+		Rules:                 *rules,
+		CreationDate:          signingPolicy.CreationDate.ToInt64(),
+		LastUpdate:            signingPolicy.LastUpdate.ToInt64(),
+	}
+	if signingPolicy.Address != nil {
+		db.Address = signingPolicy.Address.String()
+	}
+	if signingPolicy.Description != nil {
+		db.Description = *signingPolicy.Description
+	}
+	return &db, nil
+}
+
+func mapRulesToDB(rules signingpolicy.Rules) (*string, error) {
+	db := rulesDB{
+		DenyContractCreation: rules.DenyContractCreation,
+		AllowMessages:        rules.AllowMessages,
+		AllowTypedData:       rules.AllowTypedData,
+	}
+	for _, recipient := range rules.AllowedRecipients {
+		db.AllowedRecipients = append(db.AllowedRecipients, recipient.String())
+	}
+	for _, verifyingContract := range rules.AllowedVerifyingContracts {
+		db.AllowedVerifyingContracts = append(db.AllowedVerifyingContracts, verifyingContract.String())
+	}
+	for _, selector := range rules.AllowedMethodSelectors {
+		db.AllowedMethodSelectors = append(db.AllowedMethodSelectors, string(selector))
+	}
+	if rules.MaxValue != nil {
+		maxValue := rules.MaxValue.BigInt().String()
+		db.MaxValue = &maxValue
+	}
+	if rules.MaxGas != nil {
+		maxGas := rules.MaxGas.Uint64()
+		db.MaxGas = &maxGas
+	}
+	if rules.MaxGasPrice != nil {
+		maxGasPrice := rules.MaxGasPrice.BigInt().String()
+		db.MaxGasPrice = &maxGasPrice
+	}
+	encoded, err := json.Marshal(db)
+	if err != nil {
+		return nil, err
+	}
+	result := string(encoded)
+	return &result, nil
+}
+
+func mapRulesFromDB(rules string) (*signingpolicy.Rules, error) {
+	var db rulesDB
+	err := json.Unmarshal([]byte(rules), &db)
+	if err != nil {
+		return nil, err
+	}
+	result := signingpolicy.Rules{
+		DenyContractCreation: db.DenyContractCreation,
+		AllowMessages:        db.AllowMessages,
+		AllowTypedData:       db.AllowTypedData,
+	}
+	for _, recipient := range db.AllowedRecipients {
+		addr, addrErr := address.NewFromHexString(recipient)
+		if addrErr != nil {
+			return nil, addrErr
+		}
+		result.AllowedRecipients = append(result.AllowedRecipients, addr)
+	}
+	for _, verifyingContract := range db.AllowedVerifyingContracts {
+		addr, addrErr := address.NewFromHexString(verifyingContract)
+		if addrErr != nil {
+			return nil, addrErr
+		}
+		result.AllowedVerifyingContracts = append(result.AllowedVerifyingContracts, addr)
+	}
+	for _, selector := range db.AllowedMethodSelectors {
+		result.AllowedMethodSelectors = append(result.AllowedMethodSelectors, signingpolicy.MethodSelector(selector))
+	}
+	if db.MaxValue != nil {
+		maxValue, maxValueErr := entities.NewInt256FromString(*db.MaxValue)
+		if maxValueErr != nil {
+			return nil, maxValueErr
+		}
+		result.MaxValue = maxValue
+	}
+	if db.MaxGas != nil {
+		maxGas := entities.NewUInt64(*db.MaxGas)
+		result.MaxGas = &maxGas
+	}
+	if db.MaxGasPrice != nil {
+		maxGasPrice, maxGasPriceErr := entities.NewInt256FromString(*db.MaxGasPrice)
+		if maxGasPriceErr != nil {
+			return nil, maxGasPriceErr
+		}
+		result.MaxGasPrice = maxGasPrice
+	}
+	return &result, nil
+}
+
+func mapFromDB(db signingpolicydb.SigningPolicyDB) (*signingpolicy.SigningPolicy, error) {
+	if len(db.InternalResourceID) == 0 {
+		return nil, errors.Internal().WithMessage("'InternalResourceID' cannot be empty")
+	}
+	rules, err := mapRulesFromDB(db.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	signingPolicy := signingpolicy.SigningPolicy{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ID:            db.ID,
+					ApplicationID: db.ApplicationID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: time.TimestampFromInt64(db.CreationDate),
+					LastUpdate:   time.TimestampFromInt64(db.LastUpdate),
+				},
+			},
+			ResourceVersion: db.ResourceVersion,
+		},
+		Rules:              *rules,
+		Description:        &db.Description,
+		InternalResourceID: entities.InternalResourceID(db.InternalResourceID),
+	}
+	if len(db.Address) > 0 {
+		addr, addrErr := address.NewFromHexString(db.Address)
+		if addrErr != nil {
+			return nil, addrErr
+		}
+		signingPolicy.Address = &addr
+	}
+	return &signingPolicy, nil
+}
+
+func mapSliceFromDB(dbSlice []signingpolicydb.SigningPolicyDB) ([]signingpolicy.SigningPolicy, error) {
+	signingPolicySlice := make([]signingpolicy.SigningPolicy, len(dbSlice))
+	for index := range dbSlice {
+		item, err := mapFromDB(dbSlice[index])
+		if err != nil {
+			return nil, err
+		}
+		signingPolicySlice[index] = *item
+	}
+
+	return signingPolicySlice, nil
+}
+
+func mapPersistenceErrorToSignerError(err error) error {
+	if persistence.IsAlreadyExists(err) {
+		return errors.AlreadyExistsFromErr(err)
+	}
+	if persistence.IsNotFound(err) {
+		return errors.NotFoundFromErr(err)
+	}
+	if persistence.IsEntryNotAdded(err) {
+		return errors.InternalFromErr(err)
+	}
+	return errors.InternalFromErr(err)
+}
@@ -0,0 +1,92 @@
+// Package cacheinvalidation defines the adapters that invalidate the caches when the cached resources change.
+package cacheinvalidation
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/pip"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnection"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
+)
+
+var (
+	_ application.CacheInvalidationPort = (*DefaultCacheInvalidationAdapter)(nil)
+	_ hsmslot.CacheInvalidationPort     = (*DefaultCacheInvalidationAdapter)(nil)
+	_ hsmmodule.CacheInvalidationPort   = (*DefaultCacheInvalidationAdapter)(nil)
+	_ user.CacheInvalidationPort        = (*DefaultCacheInvalidationAdapter)(nil)
+	_ admin.CacheInvalidationPort       = (*DefaultCacheInvalidationAdapter)(nil)
+	_ role.CacheInvalidationPort        = (*DefaultCacheInvalidationAdapter)(nil)
+)
+
+// InvalidateApplication removes the cached connections to the slots of the application and the cached information of its users
+func (d DefaultCacheInvalidationAdapter) InvalidateApplication(ctx context.Context, applicationID string) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of application [%s]", applicationID)
+	d.connectionCache.InvalidateApplication(applicationID)
+	d.pipCache.InvalidateApplication(applicationID)
+}
+
+// InvalidateHSMModule removes the cached connections to the slots of all the applications, as any of them may use the module
+func (d DefaultCacheInvalidationAdapter) InvalidateHSMModule(ctx context.Context, hsmModuleID string) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of hsm module [%s]", hsmModuleID)
+	d.connectionCache.InvalidateAll()
+}
+
+// InvalidateUser removes the cached roles and accounts of the user
+func (d DefaultCacheInvalidationAdapter) InvalidateUser(ctx context.Context, applicationID string, userID string) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of user [%s] of application [%s]", userID, applicationID)
+	d.pipCache.InvalidateUser(applicationID, userID)
+}
+
+// InvalidateAddress removes the cached slot that holds the address of the application
+func (d DefaultCacheInvalidationAdapter) InvalidateAddress(ctx context.Context, applicationID string, addr address.Address) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of address [%s] of application [%s]", addr, applicationID)
+	d.connectionCache.InvalidateAddress(applicationID, addr)
+}
+
+// InvalidateAdmin removes the cached roles of the admin
+func (d DefaultCacheInvalidationAdapter) InvalidateAdmin(ctx context.Context, adminID string) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of admin [%s]", adminID)
+	d.pipCache.InvalidateAdmin(adminID)
+}
+
+// InvalidateRoles removes the cached actions of all the roles
+func (d DefaultCacheInvalidationAdapter) InvalidateRoles(ctx context.Context) {
+	logger.LogEntry(ctx).Debugf("invalidating cached data of roles")
+	d.pipCache.InvalidateRoles()
+}
+
+// DefaultCacheInvalidationAdapterOptions are the set of fields to create a DefaultCacheInvalidationAdapter
+type DefaultCacheInvalidationAdapterOptions struct {
+	// ConnectionCache caches the connections to the HSM slots of the applications
+	ConnectionCache *hsmconnection.ConnectionCache
+	// PIPCache caches the information of the policy information point
+	PIPCache *pip.Cache
+}
+
+// DefaultCacheInvalidationAdapter is a port to adapt the changes of the resources to the invalidation of the caches
+type DefaultCacheInvalidationAdapter struct {
+	connectionCache *hsmconnection.ConnectionCache
+	pipCache        *pip.Cache
+}
+
+// ProvideDefaultCacheInvalidationAdapter provides an instance of a DefaultCacheInvalidationAdapter
+func ProvideDefaultCacheInvalidationAdapter(options DefaultCacheInvalidationAdapterOptions) (*DefaultCacheInvalidationAdapter, error) {
+	if options.ConnectionCache == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ConnectionCache' not provided")
+	}
+	if options.PIPCache == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'PIPCache' not provided")
+	}
+	return &DefaultCacheInvalidationAdapter{
+		connectionCache: options.ConnectionCache,
+		pipCache:        options.PIPCache,
+	}, nil
+}
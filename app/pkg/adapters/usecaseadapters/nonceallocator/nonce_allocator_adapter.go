@@ -0,0 +1,67 @@
+// Package nonceallocator defines the adapters to assign nonces to the transactions signed without one.
+package nonceallocator
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+)
+
+var _ hsmconnector.NoncePort = (*DefaultNonceAllocatorAdapter)(nil)
+
+// AllocateNonce allocates the next nonce of the account in the chain of the transaction
+func (d DefaultNonceAllocatorAdapter) AllocateNonce(ctx context.Context, input hsmconnector.AllocateNonceInput) (*hsmconnector.AllocateNonceOutput, error) {
+	allocateNonceOutput, err := d.nonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{
+		NonceID: nonce.NonceID{
+			ChainID: input.ChainID,
+			Address: input.From,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.AllocateNonceOutput{
+		Nonce: entities.HexUInt64{
+			UInt64: allocateNonceOutput.Nonce,
+		},
+	}, nil
+}
+
+// AdvanceNonce advances the next nonce of the account in the chain of the transaction after the nonce of the transaction
+func (d DefaultNonceAllocatorAdapter) AdvanceNonce(ctx context.Context, input hsmconnector.AdvanceNonceInput) (*hsmconnector.AdvanceNonceOutput, error) {
+	_, err := d.nonceUseCase.AdvanceNonce(ctx, nonce.AdvanceNonceInput{
+		NonceID: nonce.NonceID{
+			ChainID: input.ChainID,
+			Address: input.From,
+		},
+		UsedNonce: input.Nonce.UInt64,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.AdvanceNonceOutput{}, nil
+}
+
+// DefaultNonceAllocatorAdapterOptions are the set of fields to create a DefaultNonceAllocatorAdapter
+type DefaultNonceAllocatorAdapterOptions struct {
+	// NonceUseCase defines the management of the Nonce resource
+	NonceUseCase nonce.NonceUseCase
+}
+
+// DefaultNonceAllocatorAdapter is a port to adapt the assignment of nonces to the nonce tracking
+type DefaultNonceAllocatorAdapter struct {
+	nonceUseCase nonce.NonceUseCase
+}
+
+// ProvideDefaultNonceAllocatorAdapter provides an instance of a DefaultNonceAllocatorAdapter
+func ProvideDefaultNonceAllocatorAdapter(options DefaultNonceAllocatorAdapterOptions) (*DefaultNonceAllocatorAdapter, error) {
+	if options.NonceUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'NonceUseCase' not provided")
+	}
+	return &DefaultNonceAllocatorAdapter{
+		nonceUseCase: options.NonceUseCase,
+	}, nil
+}
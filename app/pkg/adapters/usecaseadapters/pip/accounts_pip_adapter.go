@@ -10,13 +10,15 @@ import (
 
 var _ pdp.AccountsPolicyInformationPort = (*DefaultAccountsPIPAdapter)(nil)
 
-// GetAccount returns data of a given Account
+// GetAccount returns data of a given Account. Existing accounts are cached
 func (d DefaultAccountsPIPAdapter) GetAccount(ctx context.Context, input pdp.GetAccountInput) (*pdp.GetAccountOutput, error) {
-	getAccountInput := user.GetAccountInput{
-		AccountID: user.AccountID(input.AccountID),
-	}
-
-	_, getAccountErr := d.accountUseCase.GetAccount(ctx, getAccountInput)
+	_, getAccountErr := d.cache.accounts.GetOrLoad(input.AccountID, func() (struct{}, error) {
+		getAccountInput := user.GetAccountInput{
+			AccountID: user.AccountID(input.AccountID),
+		}
+		_, err := d.accountUseCase.GetAccount(ctx, getAccountInput)
+		return struct{}{}, err
+	})
 	if getAccountErr != nil {
 		return nil, getAccountErr
 	}
@@ -28,16 +30,24 @@ func (d DefaultAccountsPIPAdapter) GetAccount(ctx context.Context, input pdp.Get
 type DefaultAccountsPIPAdapterOptions struct {
 	// AccountUseCase defines the management of the Account resource
 	AccountUseCase user.AccountUseCase
+	// Cache of the accounts. Accounts are not cached if it is not provided
+	Cache *Cache
 }
 
 // DefaultAccountsPIPAdapter is a port to adapt requests related to the Account resource
 type DefaultAccountsPIPAdapter struct {
 	accountUseCase user.AccountUseCase
+	cache          *Cache
 }
 
 // ProvideDefaultAccountsPIPAdapter provides an instance of an DefaultAccountsPIPAdapter
 func ProvideDefaultAccountsPIPAdapter(options DefaultAccountsPIPAdapterOptions) (*DefaultAccountsPIPAdapter, error) {
+	pipCache := options.Cache
+	if pipCache == nil {
+		pipCache = disabledCache()
+	}
 	return &DefaultAccountsPIPAdapter{
 		accountUseCase: options.AccountUseCase,
+		cache:          pipCache,
 	}, nil
 }
@@ -0,0 +1,126 @@
+package pip
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+)
+
+var _ pdp.ActionsPolicyInformationPointPort = (*DefaultActionsPIPAdapter)(nil)
+
+// ListActions list the Actions granted by the given roles, both built-in and custom. The actions of each role are cached
+func (d DefaultActionsPIPAdapter) ListActions(ctx context.Context, input pdp.ListActionsInput) (*pdp.ListActionsOutput, error) {
+	if len(input.Roles) < 1 {
+		return nil, errors.InvalidArgument().WithMessage("no 'Roles' provided")
+	}
+
+	actions := pdp.NewActions([]string{})
+	for _, roleID := range input.Roles {
+		roleActions, listRoleActionsErr := d.cache.roleActions.GetOrLoad(roleID, func() ([]string, error) {
+			listRoleActionsInput := role.ListRoleActionsInput{
+				RoleID: roleID,
+			}
+			listRoleActionsOutput, err := d.roleUseCase.ListRoleActions(ctx, listRoleActionsInput)
+			if err != nil {
+				return nil, err
+			}
+			return listRoleActionsOutput.Actions, nil
+		})
+		if listRoleActionsErr != nil {
+			return nil, listRoleActionsErr
+		}
+		actions.Merge(*pdp.NewActions(roleActions))
+	}
+
+	return &pdp.ListActionsOutput{
+		Actions: *actions,
+	}, nil
+}
+
+// ActionExists checks if the Action is defined in the RBAC. The actions are cached
+func (d DefaultActionsPIPAdapter) ActionExists(ctx context.Context, input pdp.ActionExistsInput) (*pdp.ActionExistsOutput, error) {
+	actions, err := d.cache.actions.GetOrLoad(struct{}{}, func() (pdp.Actions, error) {
+		listActionsOutput, listActionsErr := d.roleUseCase.ListActions(ctx, role.ListActionsInput{})
+		if listActionsErr != nil {
+			return pdp.Actions{}, listActionsErr
+		}
+		return *pdp.NewActions(listActionsOutput.Actions), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pdp.ActionExistsOutput{
+		Exists: actions.Contains(input.ActionID),
+	}, nil
+}
+
+// ExplainAction checks if the Action exists and lists the permissions of the given roles that grant it. Unlike
+// ListActions, it reads the roles and permissions without the cache and ignores the roles that don't exist
+func (d DefaultActionsPIPAdapter) ExplainAction(ctx context.Context, input pdp.ExplainActionInput) (*pdp.ExplainActionOutput, error) {
+	listActionsOutput, err := d.roleUseCase.ListActions(ctx, role.ListActionsInput{})
+	if err != nil {
+		return nil, err
+	}
+	listRolesOutput, err := d.roleUseCase.ListRoles(ctx, role.ListRolesInput{})
+	if err != nil {
+		return nil, err
+	}
+	listPermissionsOutput, err := d.roleUseCase.ListPermissions(ctx, role.ListPermissionsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	permissionActions := make(map[string][]string)
+	for _, permission := range listPermissionsOutput.Permissions {
+		permissionActions[permission.ID] = permission.Actions
+	}
+	grants := make([]pdp.Grant, 0)
+	for _, r := range listRolesOutput.Roles {
+		if !slices.Contains(input.Roles, r.ID) {
+			continue
+		}
+		for _, permissionID := range r.Permissions {
+			if slices.Contains(permissionActions[permissionID], input.ActionID) {
+				grants = append(grants, pdp.Grant{
+					Role:       r.ID,
+					Permission: permissionID,
+				})
+			}
+		}
+	}
+
+	return &pdp.ExplainActionOutput{
+		ActionExists: slices.Contains(listActionsOutput.Actions, input.ActionID),
+		Grants:       grants,
+	}, nil
+}
+
+// DefaultActionsPIPAdapterOptions are the set of fields to create an DefaultActionsPIPAdapter
+type DefaultActionsPIPAdapterOptions struct {
+	// RoleUseCase defines the management of the roles and their permissions
+	RoleUseCase role.RoleUseCase
+	// Cache of the actions of the roles. Actions are not cached if it is not provided
+	Cache *Cache
+}
+
+// DefaultActionsPIPAdapter is a port to adapt requests related to the Actions granted by the roles
+type DefaultActionsPIPAdapter struct {
+	roleUseCase role.RoleUseCase
+	cache       *Cache
+}
+
+// ProvideDefaultActionsPIPAdapter provides an instance of an DefaultActionsPIPAdapter
+func ProvideDefaultActionsPIPAdapter(options DefaultActionsPIPAdapterOptions) (*DefaultActionsPIPAdapter, error) {
+	pipCache := options.Cache
+	if pipCache == nil {
+		pipCache = disabledCache()
+	}
+	return &DefaultActionsPIPAdapter{
+		roleUseCase: options.RoleUseCase,
+		cache:       pipCache,
+	}, nil
+}
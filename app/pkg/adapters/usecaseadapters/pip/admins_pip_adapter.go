@@ -9,18 +9,24 @@ import (
 
 var _ pdp.AdminsPolicyInformationPort = (*DefaultAdminsPIPAdapter)(nil)
 
-// GetAdminRoles returns the list of roles assigned to an admin
+// GetAdminRoles returns the list of roles assigned to an admin. The roles are cached
 func (d DefaultAdminsPIPAdapter) GetAdminRoles(ctx context.Context, input pdp.GetAdminRolesInput) (*pdp.GetAdminRolesOutput, error) {
-	getAdminRolesInput := admin.GetAdminInput{
-		StandardID: input.AdminID,
-	}
-	getAdminOutput, getAdminErr := d.adminUseCase.GetAdmin(ctx, getAdminRolesInput)
+	roles, getAdminErr := d.cache.adminRoles.GetOrLoad(input.AdminID.ID, func() ([]string, error) {
+		getAdminRolesInput := admin.GetAdminInput{
+			StandardID: input.AdminID,
+		}
+		getAdminOutput, err := d.adminUseCase.GetAdmin(ctx, getAdminRolesInput)
+		if err != nil {
+			return nil, err
+		}
+		return getAdminOutput.Roles, nil
+	})
 	if getAdminErr != nil {
 		return nil, getAdminErr
 	}
 
 	return &pdp.GetAdminRolesOutput{
-		Roles: getAdminOutput.Roles,
+		Roles: append([]string(nil), roles...),
 	}, nil
 }
 
@@ -28,16 +34,24 @@ func (d DefaultAdminsPIPAdapter) GetAdminRoles(ctx context.Context, input pdp.Ge
 type DefaultAdminsPIPAdapterOptions struct {
 	// AdminUseCase defines the management of Admin in storage
 	AdminUseCase admin.AdminUseCase
+	// Cache of the roles of the admins. Roles are not cached if it is not provided
+	Cache *Cache
 }
 
 // DefaultAdminsPIPAdapter is a port to adapt requests related to the Admin resource
 type DefaultAdminsPIPAdapter struct {
 	adminUseCase admin.AdminUseCase
+	cache        *Cache
 }
 
 // ProvideDefaultAdminsPIPAdapter provides an instance of an DefaultAdminsPIPAdapter
 func ProvideDefaultAdminsPIPAdapter(options DefaultAdminsPIPAdapterOptions) (*DefaultAdminsPIPAdapter, error) {
+	pipCache := options.Cache
+	if pipCache == nil {
+		pipCache = disabledCache()
+	}
 	return &DefaultAdminsPIPAdapter{
 		adminUseCase: options.AdminUseCase,
+		cache:        pipCache,
 	}, nil
 }
@@ -0,0 +1,92 @@
+package pip
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
+)
+
+const (
+	userRolesCacheName   = "pip_user_roles"
+	adminRolesCacheName  = "pip_admin_roles"
+	accountsCacheName    = "pip_accounts"
+	roleActionsCacheName = "pip_role_actions"
+	actionsCacheName     = "pip_actions"
+)
+
+// Cache caches the information read by the policy information point adapters, so that it is not read from the storage
+// to authorize every request. It must be invalidated when the users, their accounts, the admins or the roles change.
+type Cache struct {
+	// userRoles roles of the users, by application and user
+	userRoles *cache.Cache[userKey, []string]
+	// adminRoles roles of the admins, by admin ID
+	adminRoles *cache.Cache[string, []string]
+	// accounts the accounts known to exist. Accounts that don't exist are not cached
+	accounts *cache.Cache[pdp.AccountID, struct{}]
+	// roleActions actions granted by the roles, by role ID
+	roleActions *cache.Cache[string, []string]
+	// actions all the actions defined in the RBAC, under a single entry
+	actions *cache.Cache[struct{}, pdp.Actions]
+}
+
+// userKey identifies a user of an application.
+type userKey struct {
+	applicationID string
+	userID        string
+}
+
+// InvalidateUser removes the cached roles and accounts of the given user.
+func (c *Cache) InvalidateUser(applicationID string, userID string) {
+	c.userRoles.Delete(userKey{
+		applicationID: applicationID,
+		userID:        userID,
+	})
+	c.accounts.DeleteFunc(func(key pdp.AccountID) bool {
+		return key.ApplicationID == applicationID && key.UserID == userID
+	})
+}
+
+// InvalidateApplication removes the cached roles and accounts of all the users of the given application.
+func (c *Cache) InvalidateApplication(applicationID string) {
+	c.userRoles.DeleteFunc(func(key userKey) bool {
+		return key.applicationID == applicationID
+	})
+	c.accounts.DeleteFunc(func(key pdp.AccountID) bool {
+		return key.ApplicationID == applicationID
+	})
+}
+
+// InvalidateAdmin removes the cached roles of the given admin.
+func (c *Cache) InvalidateAdmin(adminID string) {
+	c.adminRoles.Delete(adminID)
+}
+
+// InvalidateRoles removes the cached actions of all the roles and the cached actions defined in the RBAC.
+func (c *Cache) InvalidateRoles() {
+	c.roleActions.Purge()
+	c.actions.Purge()
+}
+
+// CacheOptions are the set of fields to create a Cache
+type CacheOptions struct {
+	// Configuration of the time to live and the size of the caches
+	Configuration cache.Configuration
+	// Metrics counts the hits and misses of the caches
+	Metrics *cache.Metrics
+}
+
+// ProvideCache provides an instance of a Cache
+func ProvideCache(options CacheOptions) (*Cache, error) {
+	return &Cache{
+		userRoles:   cache.New[userKey, []string](userRolesCacheName, options.Configuration, options.Metrics),
+		adminRoles:  cache.New[string, []string](adminRolesCacheName, options.Configuration, options.Metrics),
+		accounts:    cache.New[pdp.AccountID, struct{}](accountsCacheName, options.Configuration, options.Metrics),
+		roleActions: cache.New[string, []string](roleActionsCacheName, options.Configuration, options.Metrics),
+		actions:     cache.New[struct{}, pdp.Actions](actionsCacheName, options.Configuration, options.Metrics),
+	}, nil
+}
+
+// disabledCache returns a Cache that doesn't cache anything, used when the adapters are not given one.
+func disabledCache() *Cache {
+	c, _ := ProvideCache(CacheOptions{})
+	return c
+}
@@ -0,0 +1,397 @@
+package pip_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/pip"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx           = context.Background()
+	configuration = cache.Configuration{
+		TTL:        time.Minute,
+		MaxEntries: 100,
+	}
+	accountAddress = address.MustNewFromHexString("0x970e8128ab834e8eac17ab8e3812f010678cf791")
+)
+
+func TestDefaultUsersPIPAdapter_GetUserRoles(t *testing.T) {
+	t.Run("success: roles cached until the user is invalidated", func(t *testing.T) {
+		pipCache, users := newCache(t), &userUseCase{roles: []string{"application-admin"}}
+		adapter, err := pip.ProvideDefaultUsersPIPAdapter(pip.DefaultUsersPIPAdapterOptions{
+			UserUseCase: users,
+			Cache:       pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.GetUserRolesInput{UserID: "user", ApplicationID: "app"}
+
+		for i := 0; i < 2; i++ {
+			output, getErr := adapter.GetUserRoles(ctx, input)
+			require.NoError(t, getErr)
+			require.Equal(t, []string{"application-admin"}, output.Roles)
+		}
+		require.Equal(t, 1, users.gets)
+
+		users.roles = []string{"transaction-signer"}
+		pipCache.InvalidateUser("app", "user")
+		output, err := adapter.GetUserRoles(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, []string{"transaction-signer"}, output.Roles)
+		require.Equal(t, 2, users.gets)
+	})
+
+	t.Run("success: roles cached until the application is invalidated", func(t *testing.T) {
+		pipCache, users := newCache(t), &userUseCase{roles: []string{"application-admin"}}
+		adapter, err := pip.ProvideDefaultUsersPIPAdapter(pip.DefaultUsersPIPAdapterOptions{
+			UserUseCase: users,
+			Cache:       pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.GetUserRolesInput{UserID: "user", ApplicationID: "app"}
+
+		_, err = adapter.GetUserRoles(ctx, input)
+		require.NoError(t, err)
+		pipCache.InvalidateApplication("other-app")
+		_, err = adapter.GetUserRoles(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, 1, users.gets)
+
+		pipCache.InvalidateApplication("app")
+		_, err = adapter.GetUserRoles(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, 2, users.gets)
+	})
+
+	t.Run("success: not cached without a cache", func(t *testing.T) {
+		users := &userUseCase{roles: []string{"application-admin"}}
+		adapter, err := pip.ProvideDefaultUsersPIPAdapter(pip.DefaultUsersPIPAdapterOptions{
+			UserUseCase: users,
+		})
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			_, err = adapter.GetUserRoles(ctx, pdp.GetUserRolesInput{UserID: "user", ApplicationID: "app"})
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, users.gets)
+	})
+
+	t.Run("failure: errors not cached", func(t *testing.T) {
+		pipCache, users := newCache(t), &userUseCase{err: errors.NotFound()}
+		adapter, err := pip.ProvideDefaultUsersPIPAdapter(pip.DefaultUsersPIPAdapterOptions{
+			UserUseCase: users,
+			Cache:       pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.GetUserRolesInput{UserID: "user", ApplicationID: "app"}
+
+		_, err = adapter.GetUserRoles(ctx, input)
+		require.True(t, errors.IsNotFound(err))
+		users.err = nil
+		users.roles = []string{"application-admin"}
+		output, err := adapter.GetUserRoles(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, []string{"application-admin"}, output.Roles)
+	})
+}
+
+func TestDefaultAdminsPIPAdapter_GetAdminRoles(t *testing.T) {
+	t.Run("success: roles cached until the admin is invalidated", func(t *testing.T) {
+		pipCache, admins := newCache(t), &adminUseCase{roles: []string{"signer-admin"}}
+		adapter, err := pip.ProvideDefaultAdminsPIPAdapter(pip.DefaultAdminsPIPAdapterOptions{
+			AdminUseCase: admins,
+			Cache:        pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.GetAdminRolesInput{AdminID: entities.StandardID{ID: "admin"}}
+
+		for i := 0; i < 2; i++ {
+			output, getErr := adapter.GetAdminRoles(ctx, input)
+			require.NoError(t, getErr)
+			require.Equal(t, []string{"signer-admin"}, output.Roles)
+		}
+		require.Equal(t, 1, admins.gets)
+
+		pipCache.InvalidateAdmin("admin")
+		_, err = adapter.GetAdminRoles(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, 2, admins.gets)
+	})
+}
+
+func TestDefaultActionsPIPAdapter_ListActions(t *testing.T) {
+	t.Run("success: actions cached until the roles are invalidated", func(t *testing.T) {
+		pipCache, roles := newCache(t), &roleUseCase{actions: map[string][]string{
+			"application-admin":  {"application.users.list"},
+			"transaction-signer": {"rpc.method.sign"},
+		}}
+		adapter, err := pip.ProvideDefaultActionsPIPAdapter(pip.DefaultActionsPIPAdapterOptions{
+			RoleUseCase: roles,
+			Cache:       pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.ListActionsInput{Roles: []string{"application-admin", "transaction-signer"}}
+
+		for i := 0; i < 2; i++ {
+			output, listErr := adapter.ListActions(ctx, input)
+			require.NoError(t, listErr)
+			require.True(t, output.Actions.Contains("application.users.list"))
+			require.True(t, output.Actions.Contains("rpc.method.sign"))
+		}
+		require.Equal(t, 2, roles.lists)
+
+		roles.actions["application-admin"] = []string{"application.users.describe"}
+		pipCache.InvalidateRoles()
+		output, err := adapter.ListActions(ctx, input)
+		require.NoError(t, err)
+		require.True(t, output.Actions.Contains("application.users.describe"))
+		require.False(t, output.Actions.Contains("application.users.list"))
+		require.Equal(t, 4, roles.lists)
+	})
+
+	t.Run("failure: role does not exist", func(t *testing.T) {
+		adapter, err := pip.ProvideDefaultActionsPIPAdapter(pip.DefaultActionsPIPAdapterOptions{
+			RoleUseCase: &roleUseCase{},
+			Cache:       newCache(t),
+		})
+		require.NoError(t, err)
+
+		_, err = adapter.ListActions(ctx, pdp.ListActionsInput{Roles: []string{"unknown"}})
+		require.True(t, errors.IsInvalidArgument(err))
+		_, err = adapter.ListActions(ctx, pdp.ListActionsInput{})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+}
+
+func TestDefaultActionsPIPAdapter_ActionExists(t *testing.T) {
+	t.Run("success: actions cached until the roles are invalidated", func(t *testing.T) {
+		pipCache, roles := newCache(t), &roleUseCase{allActions: []string{"application.users.list"}}
+		adapter, err := pip.ProvideDefaultActionsPIPAdapter(pip.DefaultActionsPIPAdapterOptions{
+			RoleUseCase: roles,
+			Cache:       pipCache,
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			output, existsErr := adapter.ActionExists(ctx, pdp.ActionExistsInput{ActionID: "application.users.list"})
+			require.NoError(t, existsErr)
+			require.True(t, output.Exists)
+			output, existsErr = adapter.ActionExists(ctx, pdp.ActionExistsInput{ActionID: "rpc.method.sign"})
+			require.NoError(t, existsErr)
+			require.False(t, output.Exists)
+		}
+		require.Equal(t, 1, roles.actionLists)
+
+		roles.allActions = append(roles.allActions, "rpc.method.sign")
+		pipCache.InvalidateRoles()
+		output, err := adapter.ActionExists(ctx, pdp.ActionExistsInput{ActionID: "rpc.method.sign"})
+		require.NoError(t, err)
+		require.True(t, output.Exists)
+		require.Equal(t, 2, roles.actionLists)
+	})
+}
+
+func TestDefaultActionsPIPAdapter_ExplainAction(t *testing.T) {
+	roles := &roleUseCase{
+		allActions: []string{"application.users.list", "rpc.method.sign"},
+		roles: []role.Role{
+			newRole("application-admin", "allow-application-admin-actions"),
+			newRole("transaction-signer", "allow-sign", "allow-application-admin-actions"),
+		},
+		permissions: []role.Permission{
+			newPermission("allow-application-admin-actions", "application.users.list"),
+			newPermission("allow-sign", "rpc.method.sign"),
+		},
+	}
+	adapter, err := pip.ProvideDefaultActionsPIPAdapter(pip.DefaultActionsPIPAdapterOptions{
+		RoleUseCase: roles,
+	})
+	require.NoError(t, err)
+
+	t.Run("success: action granted by the roles", func(t *testing.T) {
+		output, explainErr := adapter.ExplainAction(ctx, pdp.ExplainActionInput{
+			Roles:    []string{"transaction-signer", "unknown"},
+			ActionID: "application.users.list",
+		})
+		require.NoError(t, explainErr)
+		require.True(t, output.ActionExists)
+		require.Equal(t, []pdp.Grant{{Role: "transaction-signer", Permission: "allow-application-admin-actions"}}, output.Grants)
+	})
+
+	t.Run("success: action not granted by the roles", func(t *testing.T) {
+		output, explainErr := adapter.ExplainAction(ctx, pdp.ExplainActionInput{
+			Roles:    []string{"application-admin"},
+			ActionID: "rpc.method.sign",
+		})
+		require.NoError(t, explainErr)
+		require.True(t, output.ActionExists)
+		require.Empty(t, output.Grants)
+	})
+
+	t.Run("success: action does not exist", func(t *testing.T) {
+		output, explainErr := adapter.ExplainAction(ctx, pdp.ExplainActionInput{
+			Roles:    []string{"application-admin"},
+			ActionID: "application.unknown.list",
+		})
+		require.NoError(t, explainErr)
+		require.False(t, output.ActionExists)
+		require.Empty(t, output.Grants)
+	})
+}
+
+func TestDefaultAccountsPIPAdapter_GetAccount(t *testing.T) {
+	t.Run("success: account cached until its user is invalidated", func(t *testing.T) {
+		pipCache, accounts := newCache(t), &accountUseCase{}
+		adapter, err := pip.ProvideDefaultAccountsPIPAdapter(pip.DefaultAccountsPIPAdapterOptions{
+			AccountUseCase: accounts,
+			Cache:          pipCache,
+		})
+		require.NoError(t, err)
+		input := pdp.GetAccountInput{
+			AccountID: pdp.AccountID{Address: accountAddress, UserID: "user", ApplicationID: "app"},
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err = adapter.GetAccount(ctx, input)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, accounts.gets)
+
+		pipCache.InvalidateUser("app", "other-user")
+		_, err = adapter.GetAccount(ctx, input)
+		require.NoError(t, err)
+		require.Equal(t, 1, accounts.gets)
+
+		accounts.err = errors.NotFound()
+		pipCache.InvalidateUser("app", "user")
+		_, err = adapter.GetAccount(ctx, input)
+		require.True(t, errors.IsNotFound(err))
+		require.Equal(t, 2, accounts.gets)
+	})
+}
+
+func newCache(t *testing.T) *pip.Cache {
+	pipCache, err := pip.ProvideCache(pip.CacheOptions{
+		Configuration: configuration,
+	})
+	require.NoError(t, err)
+	return pipCache
+}
+
+type userUseCase struct {
+	user.UserUseCase
+	roles []string
+	err   error
+	gets  int
+}
+
+func (u *userUseCase) GetUser(_ context.Context, input user.GetUserInput) (*user.GetUserOutput, error) {
+	u.gets++
+	if u.err != nil {
+		return nil, u.err
+	}
+	output := user.GetUserOutput{}
+	output.ID = input.ID
+	output.ApplicationID = input.ApplicationID
+	output.Roles = u.roles
+	return &output, nil
+}
+
+type adminUseCase struct {
+	admin.AdminUseCase
+	roles []string
+	gets  int
+}
+
+func (u *adminUseCase) GetAdmin(_ context.Context, input admin.GetAdminInput) (*admin.GetAdminOutput, error) {
+	u.gets++
+	output := admin.GetAdminOutput{}
+	output.ID = input.ID
+	output.Roles = u.roles
+	return &output, nil
+}
+
+type roleUseCase struct {
+	role.RoleUseCase
+	actions     map[string][]string
+	lists       int
+	allActions  []string
+	actionLists int
+	roles       []role.Role
+	permissions []role.Permission
+}
+
+func (u *roleUseCase) ListActions(_ context.Context, _ role.ListActionsInput) (*role.ListActionsOutput, error) {
+	u.actionLists++
+	return &role.ListActionsOutput{
+		Actions: u.allActions,
+	}, nil
+}
+
+func (u *roleUseCase) ListRoles(_ context.Context, _ role.ListRolesInput) (*role.ListRolesOutput, error) {
+	return &role.ListRolesOutput{
+		Roles: u.roles,
+	}, nil
+}
+
+func (u *roleUseCase) ListPermissions(_ context.Context, _ role.ListPermissionsInput) (*role.ListPermissionsOutput, error) {
+	return &role.ListPermissionsOutput{
+		Permissions: u.permissions,
+	}, nil
+}
+
+func newRole(id string, permissions ...string) role.Role {
+	r := role.Role{
+		Permissions: permissions,
+	}
+	r.ID = id
+	return r
+}
+
+func newPermission(id string, actions ...string) role.Permission {
+	permission := role.Permission{
+		Actions: actions,
+	}
+	permission.ID = id
+	return permission
+}
+
+func (u *roleUseCase) ListRoleActions(_ context.Context, input role.ListRoleActionsInput) (*role.ListRoleActionsOutput, error) {
+	u.lists++
+	actions, ok := u.actions[input.RoleID]
+	if !ok {
+		return nil, errors.InvalidArgument().WithMessage("role %s does not exist", input.RoleID)
+	}
+	return &role.ListRoleActionsOutput{
+		Actions: actions,
+	}, nil
+}
+
+type accountUseCase struct {
+	user.AccountUseCase
+	err  error
+	gets int
+}
+
+func (u *accountUseCase) GetAccount(_ context.Context, input user.GetAccountInput) (*user.GetAccountOutput, error) {
+	u.gets++
+	if u.err != nil {
+		return nil, u.err
+	}
+	return &user.GetAccountOutput{
+		Account: user.Account{
+			AccountID: input.AccountID,
+		},
+	}, nil
+}
@@ -10,22 +10,31 @@ import (
 
 var _ pdp.UsersPolicyInformationPort = (*DefaultUsersPIPAdapter)(nil)
 
-// GetUserRoles returns the list of roles assigned to a user
+// GetUserRoles returns the list of roles assigned to a user. The roles are cached
 func (d DefaultUsersPIPAdapter) GetUserRoles(ctx context.Context, input pdp.GetUserRolesInput) (*pdp.GetUserRolesOutput, error) {
-	getUserInput := user.GetUserInput{
-		ApplicationStandardID: entities.ApplicationStandardID{
-			ID:            input.UserID,
-			ApplicationID: input.ApplicationID,
-		},
+	key := userKey{
+		applicationID: input.ApplicationID,
+		userID:        input.UserID,
 	}
-
-	getUserOutput, getUserErr := d.userUseCase.GetUser(ctx, getUserInput)
+	roles, getUserErr := d.cache.userRoles.GetOrLoad(key, func() ([]string, error) {
+		getUserInput := user.GetUserInput{
+			ApplicationStandardID: entities.ApplicationStandardID{
+				ID:            input.UserID,
+				ApplicationID: input.ApplicationID,
+			},
+		}
+		getUserOutput, err := d.userUseCase.GetUser(ctx, getUserInput)
+		if err != nil {
+			return nil, err
+		}
+		return getUserOutput.Roles, nil
+	})
 	if getUserErr != nil {
 		return nil, getUserErr
 	}
 
 	return &pdp.GetUserRolesOutput{
-		Roles: getUserOutput.Roles,
+		Roles: append([]string(nil), roles...),
 	}, nil
 }
 
@@ -33,16 +42,24 @@ func (d DefaultUsersPIPAdapter) GetUserRoles(ctx context.Context, input pdp.GetU
 type DefaultUsersPIPAdapterOptions struct {
 	// UserUseCase defines the management of the User resource
 	UserUseCase user.UserUseCase
+	// Cache of the roles of the users. Roles are not cached if it is not provided
+	Cache *Cache
 }
 
 // DefaultUsersPIPAdapter is a port to adapt requests related to the User resource
 type DefaultUsersPIPAdapter struct {
 	userUseCase user.UserUseCase
+	cache       *Cache
 }
 
 // ProvideDefaultUsersPIPAdapter provides an instance of an DefaultUsersPIPAdapter
 func ProvideDefaultUsersPIPAdapter(options DefaultUsersPIPAdapterOptions) (*DefaultUsersPIPAdapter, error) {
+	pipCache := options.Cache
+	if pipCache == nil {
+		pipCache = disabledCache()
+	}
 	return &DefaultUsersPIPAdapter{
 		userUseCase: options.UserUseCase,
+		cache:       pipCache,
 	}, nil
 }
@@ -0,0 +1,39 @@
+package requester
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+)
+
+var _ audit.IdentityPort = (*DefaultAuditIdentityAdapter)(nil)
+
+// GetRequester returns the user and the application set in the context by the authentication of the request
+func (d DefaultAuditIdentityAdapter) GetRequester(ctx context.Context, _ audit.GetRequesterInput) (*audit.GetRequesterOutput, error) {
+	user, err := requestcontext.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &audit.GetRequesterOutput{
+		UserID: *user,
+	}
+	// admin operations are not performed in an application
+	application, err := requestcontext.ApplicationFromContext(ctx)
+	if err == nil {
+		output.ApplicationID = *application
+	}
+	return output, nil
+}
+
+// DefaultAuditIdentityAdapterOptions are the set of fields to create a DefaultAuditIdentityAdapter
+type DefaultAuditIdentityAdapterOptions struct{}
+
+// DefaultAuditIdentityAdapter is a port to adapt the identity of the requests to the audit log
+type DefaultAuditIdentityAdapter struct{}
+
+// ProvideDefaultAuditIdentityAdapter provides an instance of a DefaultAuditIdentityAdapter
+func ProvideDefaultAuditIdentityAdapter(_ DefaultAuditIdentityAdapterOptions) (*DefaultAuditIdentityAdapter, error) {
+	return &DefaultAuditIdentityAdapter{}, nil
+}
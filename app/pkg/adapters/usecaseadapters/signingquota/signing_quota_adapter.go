@@ -0,0 +1,55 @@
+// Package signingquota defines the adapters to count the signatures against the signing limits.
+package signingquota
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+)
+
+var _ hsmconnector.SigningQuotaPort = (*DefaultSigningQuotaAdapter)(nil)
+
+// ConsumeQuota counts the signature against the signing limits of the application, for the account that signs and for
+// the user set in the context by the authentication of the request
+func (d DefaultSigningQuotaAdapter) ConsumeQuota(ctx context.Context, input hsmconnector.ConsumeQuotaInput) (*hsmconnector.ConsumeQuotaOutput, error) {
+	consumeQuotaInput := signinglimit.ConsumeQuotaInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+		Value:         input.Value,
+	}
+	// user scoped limits don't apply to requests without an authenticated user
+	user, err := requestcontext.UserFromContext(ctx)
+	if err == nil {
+		consumeQuotaInput.UserID = user
+	}
+
+	_, err = d.signingLimitUseCase.ConsumeQuota(ctx, consumeQuotaInput)
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.ConsumeQuotaOutput{}, nil
+}
+
+// DefaultSigningQuotaAdapterOptions are the set of fields to create a DefaultSigningQuotaAdapter
+type DefaultSigningQuotaAdapterOptions struct {
+	// SigningLimitUseCase defines the management of the SigningLimit resource
+	SigningLimitUseCase signinglimit.SigningLimitUseCase
+}
+
+// DefaultSigningQuotaAdapter is a port to adapt the counting of the signatures to the signing limits
+type DefaultSigningQuotaAdapter struct {
+	signingLimitUseCase signinglimit.SigningLimitUseCase
+}
+
+// ProvideDefaultSigningQuotaAdapter provides an instance of a DefaultSigningQuotaAdapter
+func ProvideDefaultSigningQuotaAdapter(options DefaultSigningQuotaAdapterOptions) (*DefaultSigningQuotaAdapter, error) {
+	if options.SigningLimitUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'SigningLimitUseCase' not provided")
+	}
+	return &DefaultSigningQuotaAdapter{
+		signingLimitUseCase: options.SigningLimitUseCase,
+	}, nil
+}
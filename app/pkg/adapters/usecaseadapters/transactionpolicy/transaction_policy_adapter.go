@@ -0,0 +1,87 @@
+// Package transactionpolicy defines the adapters to evaluate the signing policies of the transactions, messages and typed data.
+package transactionpolicy
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
+)
+
+var _ hsmconnector.TransactionPolicyPort = (*DefaultTransactionPolicyAdapter)(nil)
+
+// EvaluateTransaction evaluates the transaction against the signing policies of the application
+func (d DefaultTransactionPolicyAdapter) EvaluateTransaction(ctx context.Context, input hsmconnector.EvaluateTransactionInput) (*hsmconnector.EvaluateTransactionOutput, error) {
+	transaction := input.Transaction
+	evaluateInput := signingpolicy.EvaluateTransactionInput{
+		ApplicationID: input.ApplicationID,
+		From:          transaction.From,
+		To:            transaction.To,
+		Gas:           transaction.Gas.UInt64,
+		Data:          transaction.Data.Bytes(),
+	}
+	if transaction.Value != nil {
+		evaluateInput.Value = &transaction.Value.Int256
+	}
+	if transaction.Type == hsmconnector.DynamicFeeTxType {
+		if transaction.MaxFeePerGas != nil {
+			evaluateInput.MaxFeePerGas = &transaction.MaxFeePerGas.Int256
+		}
+	} else {
+		gasPrice := transaction.GasPrice.Int256
+		evaluateInput.GasPrice = &gasPrice
+	}
+
+	_, err := d.signingPolicyUseCase.EvaluateTransaction(ctx, evaluateInput)
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.EvaluateTransactionOutput{}, nil
+}
+
+// EvaluateMessage evaluates the message against the signing policies of the application
+func (d DefaultTransactionPolicyAdapter) EvaluateMessage(ctx context.Context, input hsmconnector.EvaluateMessageInput) (*hsmconnector.EvaluateMessageOutput, error) {
+	_, err := d.signingPolicyUseCase.EvaluateMessage(ctx, signingpolicy.EvaluateMessageInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.EvaluateMessageOutput{}, nil
+}
+
+// EvaluateTypedData evaluates the typed data against the signing policies of the application
+func (d DefaultTransactionPolicyAdapter) EvaluateTypedData(ctx context.Context, input hsmconnector.EvaluateTypedDataInput) (*hsmconnector.EvaluateTypedDataOutput, error) {
+	_, err := d.signingPolicyUseCase.EvaluateTypedData(ctx, signingpolicy.EvaluateTypedDataInput{
+		ApplicationID:     input.ApplicationID,
+		From:              input.From,
+		VerifyingContract: input.VerifyingContract,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hsmconnector.EvaluateTypedDataOutput{}, nil
+}
+
+// DefaultTransactionPolicyAdapterOptions are the set of fields to create a DefaultTransactionPolicyAdapter
+type DefaultTransactionPolicyAdapterOptions struct {
+	// SigningPolicyUseCase defines the management of the SigningPolicy resource
+	SigningPolicyUseCase signingpolicy.SigningPolicyUseCase
+}
+
+// DefaultTransactionPolicyAdapter is a port to adapt the evaluation of the transactions, messages and typed data to the signing policies
+type DefaultTransactionPolicyAdapter struct {
+	signingPolicyUseCase signingpolicy.SigningPolicyUseCase
+}
+
+// ProvideDefaultTransactionPolicyAdapter provides an instance of a DefaultTransactionPolicyAdapter
+func ProvideDefaultTransactionPolicyAdapter(options DefaultTransactionPolicyAdapterOptions) (*DefaultTransactionPolicyAdapter, error) {
+	if options.SigningPolicyUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'SigningPolicyUseCase' not provided")
+	}
+	return &DefaultTransactionPolicyAdapter{
+		signingPolicyUseCase: options.SigningPolicyUseCase,
+	}, nil
+}
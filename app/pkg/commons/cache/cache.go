@@ -0,0 +1,232 @@
+// Package cache defines a bounded in-memory cache whose entries expire after a time to live.
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
+)
+
+const (
+	// DefaultTTL time the entries are kept if it is not configured.
+	DefaultTTL = 10 * time.Second
+	// DefaultMaxEntries maximum number of entries of a cache if it is not configured.
+	DefaultMaxEntries = 10000
+
+	lookupHit  = "hit"
+	lookupMiss = "miss"
+)
+
+// Configuration configures the time to live and the size of the caches.
+type Configuration struct {
+	// TTL time the entries are kept. Caching is disabled if it is zero or negative.
+	TTL time.Duration
+	// MaxEntries maximum number of entries kept. The least recently used entries are evicted first when it is reached.
+	// Caching is disabled if it is zero or negative.
+	MaxEntries int
+}
+
+// Metrics counts the hits and misses of the caches.
+type Metrics struct {
+	// lookups counts the lookups labelled by cache and result
+	lookups metricrecorder.CounterVector
+}
+
+// MetricsOptions are the options needed to create a Metrics
+type MetricsOptions struct {
+	// MetricRecorder defines the functionality of a metric recorder
+	MetricRecorder metricrecorder.MetricRecorder
+}
+
+// ProvideMetrics creates a new Metrics with the provided options. The same Metrics is meant to be shared by all the
+// caches, which are told apart by their name.
+func ProvideMetrics(options MetricsOptions) (*Metrics, error) {
+	if options.MetricRecorder == nil {
+		return nil, errors.New("mandatory 'MetricRecorder' not provided")
+	}
+	lookups, err := options.MetricRecorder.NewCounterVector("cache_lookup_count", []string{"cache", "result"}, "total number of lookups in the caches by result, hit or miss")
+	if err != nil {
+		return nil, err
+	}
+	return &Metrics{
+		lookups: lookups,
+	}, nil
+}
+
+func (m *Metrics) record(cacheName string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := lookupMiss
+	if hit {
+		result = lookupHit
+	}
+	m.lookups.Inc(map[string]string{
+		"cache":  cacheName,
+		"result": result,
+	})
+}
+
+// Cache keeps the most recently used values up to a maximum number of entries, each of them for a time to live. It is
+// safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mutex         sync.Mutex
+	name          string
+	configuration Configuration
+	metrics       *Metrics
+	entries       map[K]*list.Element
+	// recency holds the entries, the most recently used first
+	recency *list.List
+	// version is incremented by every invalidation, so that values loaded before it are not stored
+	version uint64
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// New creates a new Cache. The name identifies the cache in the metrics, which are not recorded if metrics is nil.
+func New[K comparable, V any](name string, configuration Configuration, metrics *Metrics) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:          name,
+		configuration: configuration,
+		metrics:       metrics,
+		entries:       make(map[K]*list.Element),
+		recency:       list.New(),
+	}
+}
+
+// Get returns the value cached for the given key, if it is cached and not expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	value, ok := c.get(key)
+	if c.enabled() {
+		c.metrics.record(c.name, ok)
+	}
+	return value, ok
+}
+
+// GetOrLoad returns the value cached for the given key or, if it is not cached, loads it and caches it. Errors
+// returned by load are not cached. If the cache is invalidated while the value is loaded, the value is returned but
+// not cached, as it may be already outdated.
+func (c *Cache[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	c.mutex.Lock()
+	value, ok := c.get(key)
+	version := c.version
+	if c.enabled() {
+		c.metrics.record(c.name, ok)
+	}
+	c.mutex.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if version == c.version {
+		c.set(key, value)
+	}
+	return value, nil
+}
+
+// Set caches the value for the given key.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.set(key, value)
+}
+
+// Delete removes the value cached for the given key.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.version++
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+// DeleteFunc removes the values cached for the keys that match.
+func (c *Cache[K, V]) DeleteFunc(match func(key K) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.version++
+	for key, element := range c.entries {
+		if match(key) {
+			c.remove(element)
+		}
+	}
+}
+
+// Purge removes all the cached values.
+func (c *Cache[K, V]) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.version++
+	c.entries = make(map[K]*list.Element)
+	c.recency.Init()
+}
+
+// Len returns the number of cached values, including the expired ones that have not been evicted yet.
+func (c *Cache[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.entries)
+}
+
+func (c *Cache[K, V]) enabled() bool {
+	return c.configuration.TTL > 0 && c.configuration.MaxEntries > 0
+}
+
+func (c *Cache[K, V]) get(key K) (V, bool) {
+	var zero V
+	element, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	cached := element.Value.(*entry[K, V])
+	if time.Now().After(cached.expiresAt) {
+		c.remove(element)
+		return zero, false
+	}
+	c.recency.MoveToFront(element)
+	return cached.value, true
+}
+
+func (c *Cache[K, V]) set(key K, value V) {
+	if !c.enabled() {
+		return
+	}
+	expiresAt := time.Now().Add(c.configuration.TTL)
+	if element, ok := c.entries[key]; ok {
+		cached := element.Value.(*entry[K, V])
+		cached.value = value
+		cached.expiresAt = expiresAt
+		c.recency.MoveToFront(element)
+		return
+	}
+	for len(c.entries) >= c.configuration.MaxEntries {
+		c.remove(c.recency.Back())
+	}
+	c.entries[key] = c.recency.PushFront(&entry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+}
+
+func (c *Cache[K, V]) remove(element *list.Element) {
+	c.recency.Remove(element)
+	delete(c.entries, element.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,187 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
+
+	"github.com/stretchr/testify/require"
+)
+
+var configuration = cache.Configuration{
+	TTL:        time.Minute,
+	MaxEntries: 2,
+}
+
+func TestProvideMetrics(t *testing.T) {
+	t.Run("failure: nil metric recorder", func(t *testing.T) {
+		metrics, err := cache.ProvideMetrics(cache.MetricsOptions{})
+		require.Error(t, err)
+		require.Nil(t, metrics)
+	})
+}
+
+func TestCache_Get(t *testing.T) {
+	t.Run("success: cached value", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		c.Set("a", 1)
+		value, ok := c.Get("a")
+		require.True(t, ok)
+		require.Equal(t, 1, value)
+	})
+
+	t.Run("success: expired value", func(t *testing.T) {
+		c := cache.New[string, int]("test", cache.Configuration{TTL: 10 * time.Millisecond, MaxEntries: 2}, nil)
+		c.Set("a", 1)
+		time.Sleep(20 * time.Millisecond)
+		_, ok := c.Get("a")
+		require.False(t, ok)
+		require.Equal(t, 0, c.Len())
+	})
+
+	t.Run("success: least recently used value evicted", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		_, ok := c.Get("a")
+		require.True(t, ok)
+		c.Set("c", 3)
+
+		_, ok = c.Get("b")
+		require.False(t, ok)
+		_, ok = c.Get("a")
+		require.True(t, ok)
+		_, ok = c.Get("c")
+		require.True(t, ok)
+		require.Equal(t, 2, c.Len())
+	})
+
+	t.Run("success: disabled cache", func(t *testing.T) {
+		c := cache.New[string, int]("test", cache.Configuration{MaxEntries: 2}, nil)
+		c.Set("a", 1)
+		_, ok := c.Get("a")
+		require.False(t, ok)
+	})
+
+	t.Run("success: hits and misses recorded", func(t *testing.T) {
+		recorder := &metricRecorder{}
+		metrics, err := cache.ProvideMetrics(cache.MetricsOptions{MetricRecorder: recorder})
+		require.NoError(t, err)
+		c := cache.New[string, int]("test", configuration, metrics)
+		_, _ = c.Get("a")
+		c.Set("a", 1)
+		_, _ = c.Get("a")
+		_, _ = c.Get("a")
+
+		require.Equal(t, 1, recorder.counter.count("test", "miss"))
+		require.Equal(t, 2, recorder.counter.count("test", "hit"))
+	})
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	t.Run("success: loaded once", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		loads := 0
+		load := func() (int, error) {
+			loads++
+			return 1, nil
+		}
+		for i := 0; i < 3; i++ {
+			value, err := c.GetOrLoad("a", load)
+			require.NoError(t, err)
+			require.Equal(t, 1, value)
+		}
+		require.Equal(t, 1, loads)
+	})
+
+	t.Run("success: errors not cached", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		_, err := c.GetOrLoad("a", func() (int, error) {
+			return 0, errors.New("load failed")
+		})
+		require.Error(t, err)
+		value, err := c.GetOrLoad("a", func() (int, error) {
+			return 1, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, value)
+	})
+
+	t.Run("success: value loaded during an invalidation not cached", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		value, err := c.GetOrLoad("a", func() (int, error) {
+			c.Delete("a")
+			return 1, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, value)
+		_, ok := c.Get("a")
+		require.False(t, ok)
+	})
+}
+
+func TestCache_Delete(t *testing.T) {
+	t.Run("success: single key", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Delete("a")
+		_, ok := c.Get("a")
+		require.False(t, ok)
+		_, ok = c.Get("b")
+		require.True(t, ok)
+	})
+
+	t.Run("success: matching keys", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.DeleteFunc(func(key string) bool {
+			return key == "b"
+		})
+		_, ok := c.Get("a")
+		require.True(t, ok)
+		_, ok = c.Get("b")
+		require.False(t, ok)
+	})
+
+	t.Run("success: purge", func(t *testing.T) {
+		c := cache.New[string, int]("test", configuration, nil)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Purge()
+		require.Equal(t, 0, c.Len())
+	})
+}
+
+type metricRecorder struct {
+	metricrecorder.MetricRecorder
+	counter *counterVector
+}
+
+func (r *metricRecorder) NewCounterVector(_ string, _ []string, _ string) (metricrecorder.CounterVector, error) {
+	r.counter = &counterVector{counts: make(map[[2]string]int)}
+	return r.counter, nil
+}
+
+type counterVector struct {
+	metricrecorder.CounterVector
+	mutex  sync.Mutex
+	counts map[[2]string]int
+}
+
+func (c *counterVector) Inc(labelsValues map[string]string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.counts[[2]string{labelsValues["cache"], labelsValues["result"]}]++
+}
+
+func (c *counterVector) count(cacheName, result string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.counts[[2]string{cacheName, result}]
+}
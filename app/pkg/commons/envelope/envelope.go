@@ -0,0 +1,192 @@
+// Package envelope implements envelope encryption of small secrets.
+// Every value is encrypted with a random data key that is in turn wrapped by a master key, so rotating the master key
+// only requires to re-wrap the data keys and the master key never leaves its storage when it is kept in an HSM.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	prefix        = "enc"
+	formatVersion = "v1"
+	separator     = ":"
+	dataKeyLength = 32
+	// encryptedValueParts is the number of parts of an encrypted value: prefix, format version, master key ID, wrapped data key and ciphertext.
+	encryptedValueParts = 5
+)
+
+var (
+	// ErrUnknownMasterKey is returned when a value was encrypted with a master key that is not configured.
+	ErrUnknownMasterKey = errors.New("the value was encrypted with an unknown master key")
+	// ErrMalformedValue is returned when an encrypted value does not have the expected format.
+	ErrMalformedValue = errors.New("malformed encrypted value")
+)
+
+// Encrypter encrypts values with the current master key and decrypts values encrypted with the current or any of the previous master keys.
+type Encrypter struct {
+	masterKey  MasterKey
+	masterKeys map[string]MasterKey
+}
+
+// EncrypterOptions configures an Encrypter.
+type EncrypterOptions struct {
+	// MasterKey encrypts the new values.
+	MasterKey MasterKey
+	// PreviousMasterKeys decrypt the values that have not been re-encrypted with MasterKey yet.
+	PreviousMasterKeys []MasterKey
+}
+
+// ProvideEncrypter creates an Encrypter with the given options.
+func ProvideEncrypter(options EncrypterOptions) (*Encrypter, error) {
+	if options.MasterKey == nil {
+		return nil, errors.New("mandatory 'MasterKey' not provided")
+	}
+	masterKeys := make(map[string]MasterKey, len(options.PreviousMasterKeys)+1)
+	for _, masterKey := range append([]MasterKey{options.MasterKey}, options.PreviousMasterKeys...) {
+		id := masterKey.ID()
+		if len(id) == 0 || strings.Contains(id, separator) {
+			return nil, fmt.Errorf("invalid master key ID '%s'", id)
+		}
+		if _, ok := masterKeys[id]; ok {
+			return nil, fmt.Errorf("master key '%s' configured more than once", id)
+		}
+		masterKeys[id] = masterKey
+	}
+	return &Encrypter{
+		masterKey:  options.MasterKey,
+		masterKeys: masterKeys,
+	}, nil
+}
+
+// Encrypt encrypts the plaintext with a new data key wrapped by the current master key. The associated data is
+// authenticated but not stored, so the same associated data must be provided to decrypt the value.
+func (e *Encrypter) Encrypt(ctx context.Context, plaintext []byte, associatedData []byte) (string, error) {
+	dataKey := make([]byte, dataKeyLength)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("error generating data key: %w", err)
+	}
+	wrappedDataKey, err := e.masterKey.Wrap(ctx, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping data key with master key '%s': %w", e.masterKey.ID(), err)
+	}
+	ciphertext, err := seal(dataKey, plaintext, associatedData)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join([]string{
+		prefix,
+		formatVersion,
+		e.masterKey.ID(),
+		base64.RawStdEncoding.EncodeToString(wrappedDataKey),
+		base64.RawStdEncoding.EncodeToString(ciphertext),
+	}, separator), nil
+}
+
+// Decrypt decrypts a value returned by Encrypt.
+func (e *Encrypter) Decrypt(ctx context.Context, value string, associatedData []byte) ([]byte, error) {
+	parsed, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+	masterKey, ok := e.masterKeys[parsed.masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownMasterKey, parsed.masterKeyID)
+	}
+	dataKey, err := masterKey.Unwrap(ctx, parsed.wrappedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key with master key '%s': %w", parsed.masterKeyID, err)
+	}
+	return open(dataKey, parsed.ciphertext, associatedData)
+}
+
+// MasterKeyID returns the ID of the master key used to encrypt new values.
+func (e *Encrypter) MasterKeyID() string {
+	return e.masterKey.ID()
+}
+
+// IsEncryptedWithMasterKey returns true if the value was encrypted with the current master key.
+func (e *Encrypter) IsEncryptedWithMasterKey(value string) bool {
+	parsed, err := parse(value)
+	return err == nil && parsed.masterKeyID == e.masterKey.ID()
+}
+
+// IsEncrypted returns true if the value has the format of a value returned by Encrypt.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix+separator)
+}
+
+type encryptedValue struct {
+	masterKeyID    string
+	wrappedDataKey []byte
+	ciphertext     []byte
+}
+
+func parse(value string) (*encryptedValue, error) {
+	parts := strings.Split(value, separator)
+	if len(parts) != encryptedValueParts || parts[0] != prefix {
+		return nil, ErrMalformedValue
+	}
+	if parts[1] != formatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version '%s'", ErrMalformedValue, parts[1])
+	}
+	wrappedDataKey, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedValue, err)
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedValue, err)
+	}
+	return &encryptedValue{
+		masterKeyID:    parts[2],
+		wrappedDataKey: wrappedDataKey,
+		ciphertext:     ciphertext,
+	}, nil
+}
+
+// seal encrypts the plaintext with AES-GCM, returning the nonce followed by the ciphertext.
+func seal(key, plaintext, associatedData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// open decrypts a value returned by seal.
+func open(key, sealed, associatedData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformedValue
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting value: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
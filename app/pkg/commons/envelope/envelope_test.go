@@ -0,0 +1,187 @@
+package envelope_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
+
+	"github.com/stretchr/testify/require"
+)
+
+const hexMasterKey = "6d6d2f9f0c1c3a2f8d7f4e0b9a1c5e3d2b4a6f8e0d1c3b5a79688f7e6d5c4b3a"
+
+var associatedData = []byte("slot-id")
+
+func TestProvideFileMasterKey(t *testing.T) {
+	t.Run("success: hexadecimal key", func(t *testing.T) {
+		masterKey, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: writeKeyFile(t, hexMasterKey+"\n"),
+		})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(masterKey.ID(), "file-"))
+	})
+	t.Run("success: the same key has the same ID whatever its encoding", func(t *testing.T) {
+		rawKey, err := hex.DecodeString(hexMasterKey)
+		require.NoError(t, err)
+		hexKey, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: writeKeyFile(t, hexMasterKey),
+		})
+		require.NoError(t, err)
+		base64Key, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: writeKeyFile(t, base64.StdEncoding.EncodeToString(rawKey)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, hexKey.ID(), base64Key.ID())
+	})
+	t.Run("failure: key too short", func(t *testing.T) {
+		_, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: writeKeyFile(t, hexMasterKey[:32]),
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: key not encoded", func(t *testing.T) {
+		_, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: writeKeyFile(t, "not a key"),
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: file does not exist", func(t *testing.T) {
+		_, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+			Path: filepath.Join(t.TempDir(), "missing.key"),
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestProvideMasterKey(t *testing.T) {
+	t.Run("success: file", func(t *testing.T) {
+		masterKey, err := envelope.ProvideMasterKey(envelope.MasterKeyOptions{
+			File: &envelope.FileMasterKeyOptions{Path: writeKeyFile(t, hexMasterKey)},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, masterKey)
+	})
+	t.Run("failure: no source", func(t *testing.T) {
+		_, err := envelope.ProvideMasterKey(envelope.MasterKeyOptions{})
+		require.Error(t, err)
+	})
+	t.Run("failure: more than one source", func(t *testing.T) {
+		_, err := envelope.ProvideMasterKey(envelope.MasterKeyOptions{
+			File:   &envelope.FileMasterKeyOptions{Path: writeKeyFile(t, hexMasterKey)},
+			PKCS11: &envelope.PKCS11MasterKeyOptions{},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestProvideEncrypter(t *testing.T) {
+	masterKey := newFileMasterKey(t, hexMasterKey)
+
+	t.Run("failure: missing master key", func(t *testing.T) {
+		_, err := envelope.ProvideEncrypter(envelope.EncrypterOptions{})
+		require.Error(t, err)
+	})
+	t.Run("failure: master key configured twice", func(t *testing.T) {
+		_, err := envelope.ProvideEncrypter(envelope.EncrypterOptions{
+			MasterKey:          masterKey,
+			PreviousMasterKeys: []envelope.MasterKey{masterKey},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestEncrypter(t *testing.T) {
+	ctx := context.Background()
+	previousMasterKey := newFileMasterKey(t, strings.Repeat("ab", 32))
+	previousEncrypter, err := envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey: previousMasterKey,
+	})
+	require.NoError(t, err)
+	encrypter, err := envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey:          newFileMasterKey(t, hexMasterKey),
+		PreviousMasterKeys: []envelope.MasterKey{previousMasterKey},
+	})
+	require.NoError(t, err)
+
+	t.Run("success: encrypt and decrypt", func(t *testing.T) {
+		encrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+		require.True(t, envelope.IsEncrypted(encrypted))
+		require.True(t, encrypter.IsEncryptedWithMasterKey(encrypted))
+		require.NotContains(t, encrypted, "userpin")
+
+		decrypted, decryptErr := encrypter.Decrypt(ctx, encrypted, associatedData)
+		require.NoError(t, decryptErr)
+		require.Equal(t, []byte("userpin"), decrypted)
+	})
+	t.Run("success: every encryption uses a new data key", func(t *testing.T) {
+		encrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+		otherEncrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+		require.NotEqual(t, encrypted, otherEncrypted)
+	})
+	t.Run("success: decrypt value encrypted with a previous master key", func(t *testing.T) {
+		encrypted, encryptErr := previousEncrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+		require.False(t, encrypter.IsEncryptedWithMasterKey(encrypted))
+
+		decrypted, decryptErr := encrypter.Decrypt(ctx, encrypted, associatedData)
+		require.NoError(t, decryptErr)
+		require.Equal(t, []byte("userpin"), decrypted)
+	})
+	t.Run("failure: unknown master key", func(t *testing.T) {
+		encrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+
+		_, decryptErr := previousEncrypter.Decrypt(ctx, encrypted, associatedData)
+		require.True(t, errors.Is(decryptErr, envelope.ErrUnknownMasterKey))
+	})
+	t.Run("failure: different associated data", func(t *testing.T) {
+		encrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+
+		_, decryptErr := encrypter.Decrypt(ctx, encrypted, []byte("other-slot-id"))
+		require.Error(t, decryptErr)
+	})
+	t.Run("failure: tampered value", func(t *testing.T) {
+		encrypted, encryptErr := encrypter.Encrypt(ctx, []byte("userpin"), associatedData)
+		require.NoError(t, encryptErr)
+		parts := strings.Split(encrypted, ":")
+		ciphertext, decodeErr := base64.RawStdEncoding.DecodeString(parts[4])
+		require.NoError(t, decodeErr)
+		ciphertext[len(ciphertext)-1] ^= 0xff
+		parts[4] = base64.RawStdEncoding.EncodeToString(ciphertext)
+
+		_, decryptErr := encrypter.Decrypt(ctx, strings.Join(parts, ":"), associatedData)
+		require.Error(t, decryptErr)
+	})
+	t.Run("failure: malformed value", func(t *testing.T) {
+		_, decryptErr := encrypter.Decrypt(ctx, "userpin", associatedData)
+		require.True(t, errors.Is(decryptErr, envelope.ErrMalformedValue))
+		require.False(t, envelope.IsEncrypted("userpin"))
+		require.False(t, encrypter.IsEncryptedWithMasterKey("userpin"))
+	})
+}
+
+func newFileMasterKey(t *testing.T, key string) *envelope.FileMasterKey {
+	masterKey, err := envelope.ProvideFileMasterKey(envelope.FileMasterKeyOptions{
+		Path: writeKeyFile(t, key),
+	})
+	require.NoError(t, err)
+	return masterKey
+}
+
+func writeKeyFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "master.key")
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+	return path
+}
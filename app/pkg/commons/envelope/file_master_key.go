@@ -0,0 +1,84 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	masterKeyLength = 32
+	// masterKeyIDLength is the number of hexadecimal characters of the key fingerprint used as ID.
+	masterKeyIDLength = 16
+)
+
+var _ MasterKey = new(FileMasterKey)
+
+// FileMasterKey is an AES-256 master key read from a file.
+type FileMasterKey struct {
+	id  string
+	key []byte
+}
+
+// FileMasterKeyOptions configures a FileMasterKey.
+type FileMasterKeyOptions struct {
+	// Path to the file holding the 32 bytes of the key encoded in hexadecimal or base64.
+	Path string
+}
+
+// ProvideFileMasterKey reads the master key from the configured file.
+func ProvideFileMasterKey(options FileMasterKeyOptions) (*FileMasterKey, error) {
+	if len(options.Path) == 0 {
+		return nil, errors.New("mandatory 'Path' not provided")
+	}
+	content, err := os.ReadFile(options.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading master key file: %w", err)
+	}
+	key, err := decodeMasterKey(bytes.TrimSpace(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key file '%s': %w", options.Path, err)
+	}
+	fingerprint := sha256.Sum256(key)
+	return &FileMasterKey{
+		id:  "file-" + hex.EncodeToString(fingerprint[:])[:masterKeyIDLength],
+		key: key,
+	}, nil
+}
+
+// ID returns the ID of the key, derived from its fingerprint.
+func (k *FileMasterKey) ID() string {
+	return k.id
+}
+
+// Wrap encrypts the data key with AES-GCM.
+func (k *FileMasterKey) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(k.key, dataKey, nil)
+}
+
+// Unwrap decrypts a data key wrapped by Wrap.
+func (k *FileMasterKey) Unwrap(_ context.Context, wrappedDataKey []byte) ([]byte, error) {
+	return open(k.key, wrappedDataKey, nil)
+}
+
+func decodeMasterKey(encoded []byte) ([]byte, error) {
+	key := make([]byte, hex.DecodedLen(len(encoded)))
+	_, err := hex.Decode(key, encoded)
+	if err != nil {
+		key = make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+		n, base64Err := base64.StdEncoding.Decode(key, encoded)
+		if base64Err != nil {
+			return nil, errors.New("the key must be encoded in hexadecimal or base64")
+		}
+		key = key[:n]
+	}
+	if len(key) != masterKeyLength {
+		return nil, fmt.Errorf("the key must be %d bytes long", masterKeyLength)
+	}
+	return key, nil
+}
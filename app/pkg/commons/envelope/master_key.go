@@ -0,0 +1,38 @@
+package envelope
+
+import (
+	"context"
+	"errors"
+)
+
+// MasterKey wraps the data keys that encrypt the values.
+type MasterKey interface {
+	// ID identifies the master key. It is stored along with every encrypted value to find the key that decrypts it.
+	ID() string
+	// Wrap encrypts the given data key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a data key wrapped by Wrap.
+	Unwrap(ctx context.Context, wrappedDataKey []byte) ([]byte, error)
+}
+
+// MasterKeyOptions configures a master key. Exactly one of the sources of the key must be provided.
+type MasterKeyOptions struct {
+	// File configures a key read from a file.
+	File *FileMasterKeyOptions
+	// PKCS11 configures a key stored in a PKCS11 token.
+	PKCS11 *PKCS11MasterKeyOptions
+}
+
+// ProvideMasterKey creates the master key from the source configured in the given options.
+func ProvideMasterKey(options MasterKeyOptions) (MasterKey, error) {
+	switch {
+	case options.File != nil && options.PKCS11 != nil:
+		return nil, errors.New("only one source of the master key can be provided")
+	case options.File != nil:
+		return ProvideFileMasterKey(*options.File)
+	case options.PKCS11 != nil:
+		return ProvidePKCS11MasterKey(*options.PKCS11)
+	default:
+		return nil, errors.New("a source of the master key must be provided")
+	}
+}
@@ -0,0 +1,173 @@
+package envelope
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+const (
+	gcmNonceLength = 12
+	gcmTagBits     = 128
+)
+
+var _ MasterKey = new(PKCS11MasterKey)
+
+// PKCS11MasterKey is an AES master key stored in a PKCS11 token. The data keys are wrapped and unwrapped inside the
+// token, so the master key never leaves it.
+type PKCS11MasterKey struct {
+	id       string
+	context  *pkcs11.Ctx
+	slot     uint
+	pin      string
+	keyLabel string
+	mutex    sync.Mutex
+}
+
+// PKCS11MasterKeyOptions configures a PKCS11MasterKey.
+type PKCS11MasterKeyOptions struct {
+	// Library path to the PKCS11 library.
+	Library string
+	// Slot where the token holding the key is.
+	Slot uint
+	// Pin of the slot.
+	Pin string
+	// KeyLabel label of the AES secret key.
+	KeyLabel string
+}
+
+// ProvidePKCS11MasterKey creates a PKCS11MasterKey with the given options. The library is initialized lazily, so
+// that it can be shared with the signature managers that use the same library.
+func ProvidePKCS11MasterKey(options PKCS11MasterKeyOptions) (*PKCS11MasterKey, error) {
+	if len(options.Library) == 0 {
+		return nil, errors.New("mandatory 'Library' not provided")
+	}
+	if len(options.Pin) == 0 {
+		return nil, errors.New("mandatory 'Pin' not provided")
+	}
+	if len(options.KeyLabel) == 0 {
+		return nil, errors.New("mandatory 'KeyLabel' not provided")
+	}
+	_, err := os.Stat(options.Library)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS11 library '%s' not found: %w", options.Library, err)
+	}
+	pkcs11Context := pkcs11.New(options.Library)
+	if pkcs11Context == nil {
+		return nil, fmt.Errorf("error instantiating the PKCS11 interface for library '%s'", options.Library)
+	}
+	fingerprint := sha256.Sum256([]byte(fmt.Sprintf("%d/%s", options.Slot, options.KeyLabel)))
+	return &PKCS11MasterKey{
+		id:       "pkcs11-" + hex.EncodeToString(fingerprint[:])[:masterKeyIDLength],
+		context:  pkcs11Context,
+		slot:     options.Slot,
+		pin:      options.Pin,
+		keyLabel: options.KeyLabel,
+	}, nil
+}
+
+// ID returns the ID of the key, derived from the slot and the label of the key.
+func (k *PKCS11MasterKey) ID() string {
+	return k.id
+}
+
+// Wrap encrypts the data key with CKM_AES_GCM, returning the nonce followed by the ciphertext.
+func (k *PKCS11MasterKey) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	var wrapped []byte
+	err := k.withKey(func(session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		gcmParams := pkcs11.NewGCMParams(make([]byte, gcmNonceLength), nil, gcmTagBits)
+		defer gcmParams.Free()
+		err := k.context.EncryptInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, gcmParams)}, key)
+		if err != nil {
+			return fmt.Errorf("error initializing encryption: %w", err)
+		}
+		ciphertext, err := k.context.Encrypt(session, dataKey)
+		if err != nil {
+			return fmt.Errorf("error encrypting: %w", err)
+		}
+		// some modules ignore the given IV and generate their own, so the one actually used is read back
+		wrapped = append(gcmParams.IV(), ciphertext...)
+		return nil
+	})
+	return wrapped, err
+}
+
+// Unwrap decrypts a data key wrapped by Wrap.
+func (k *PKCS11MasterKey) Unwrap(_ context.Context, wrappedDataKey []byte) ([]byte, error) {
+	if len(wrappedDataKey) <= gcmNonceLength {
+		return nil, ErrMalformedValue
+	}
+	var dataKey []byte
+	err := k.withKey(func(session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		gcmParams := pkcs11.NewGCMParams(wrappedDataKey[:gcmNonceLength], nil, gcmTagBits)
+		defer gcmParams.Free()
+		err := k.context.DecryptInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, gcmParams)}, key)
+		if err != nil {
+			return fmt.Errorf("error initializing decryption: %w", err)
+		}
+		dataKey, err = k.context.Decrypt(session, wrappedDataKey[gcmNonceLength:])
+		if err != nil {
+			return fmt.Errorf("error decrypting: %w", err)
+		}
+		return nil
+	})
+	return dataKey, err
+}
+
+// withKey opens a logged-in session, finds the master key and calls the given function with them.
+func (k *PKCS11MasterKey) withKey(operation func(session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error) error {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	err := k.context.Initialize()
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return fmt.Errorf("error initializing the PKCS11 library: %w", err)
+	}
+	session, err := k.context.OpenSession(k.slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return fmt.Errorf("error opening session in slot '%d': %w", k.slot, err)
+	}
+	defer func() {
+		_ = k.context.CloseSession(session)
+	}()
+	err = k.context.Login(session, pkcs11.CKU_USER, k.pin)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return fmt.Errorf("error logging in slot '%d': %w", k.slot, err)
+	}
+
+	key, err := k.findKey(session)
+	if err != nil {
+		return err
+	}
+	return operation(session, key)
+}
+
+func (k *PKCS11MasterKey) findKey(session pkcs11.SessionHandle) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, k.keyLabel),
+	}
+	err := k.context.FindObjectsInit(session, template)
+	if err != nil {
+		return 0, fmt.Errorf("error finding master key: %w", err)
+	}
+	objects, _, err := k.context.FindObjects(session, 2)
+	finalErr := k.context.FindObjectsFinal(session)
+	if err != nil {
+		return 0, fmt.Errorf("error finding master key: %w", err)
+	}
+	if finalErr != nil {
+		return 0, fmt.Errorf("error finding master key: %w", finalErr)
+	}
+	if len(objects) != 1 {
+		return 0, fmt.Errorf("expected one AES key with label '%s' in slot '%d' but found %d", k.keyLabel, k.slot, len(objects))
+	}
+	return objects[0], nil
+}
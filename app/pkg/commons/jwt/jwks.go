@@ -0,0 +1,356 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultRefreshInterval = 15 * time.Minute
+	// minRefreshInterval limits how often a key set is fetched again when a token is signed with an unknown key or the
+	// last fetch failed.
+	minRefreshInterval = 10 * time.Second
+	maxKeySetSize      = 1 << 20
+)
+
+// ErrKeyNotFound is returned when the key that signed a token is not in the key set.
+var ErrKeyNotFound = errors.New("key not found in the key set")
+
+// JSONWebKey is a public key of a JSON Web Key Set as defined in RFC 7517.
+type JSONWebKey struct {
+	// ID of the key, matched against the 'kid' header of the tokens
+	ID string
+	// Algorithm the key is intended to be used with. Empty if the key does not restrict it
+	Algorithm string
+	// Key is an *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey
+	Key crypto.PublicKey
+}
+
+// KeySet resolves the keys that verify the signature of the tokens.
+type KeySet interface {
+	// Key returns the key with the given ID. If the ID is empty, the key is only returned if the set holds a single key.
+	Key(ctx context.Context, keyID string) (*JSONWebKey, error)
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// RSA parameters
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC and OKP parameters
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// ParseKeySet parses a JSON Web Key Set. Keys not intended for signatures and keys of unsupported types are ignored.
+func ParseKeySet(data []byte) ([]JSONWebKey, error) {
+	var keySet jsonWebKeySet
+	err := json.Unmarshal(data, &keySet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Web Key Set: %w", err)
+	}
+	keys := make([]JSONWebKey, 0, len(keySet.Keys))
+	for _, key := range keySet.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, parseErr := key.publicKey()
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid key '%s': %w", key.KeyID, parseErr)
+		}
+		if publicKey == nil {
+			continue
+		}
+		keys = append(keys, JSONWebKey{
+			ID:        key.KeyID,
+			Algorithm: key.Algorithm,
+			Key:       publicKey,
+		})
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("the point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, nil
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
+
+func findKey(keys []JSONWebKey, keyID string) (*JSONWebKey, error) {
+	if keyID == "" {
+		if len(keys) == 1 {
+			return &keys[0], nil
+		}
+		return nil, fmt.Errorf("%w: the token does not identify its key and the key set holds %d keys", ErrKeyNotFound, len(keys))
+	}
+	for i := range keys {
+		if keys[i].ID == keyID {
+			return &keys[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, keyID)
+}
+
+var _ KeySet = new(StaticKeySet)
+
+// StaticKeySet is a key set that does not change, read from a JSON Web Key Set file.
+type StaticKeySet struct {
+	keys []JSONWebKey
+}
+
+// StaticKeySetOptions configures a StaticKeySet.
+type StaticKeySetOptions struct {
+	// Path to the JSON Web Key Set file
+	Path string
+}
+
+// ProvideStaticKeySet reads the key set from the configured file.
+func ProvideStaticKeySet(options StaticKeySetOptions) (*StaticKeySet, error) {
+	if len(options.Path) == 0 {
+		return nil, errors.New("mandatory 'Path' not provided")
+	}
+	data, err := os.ReadFile(options.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading JSON Web Key Set file: %w", err)
+	}
+	keys, err := ParseKeySet(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("the JSON Web Key Set file '%s' does not hold any supported signature key", options.Path)
+	}
+	return &StaticKeySet{
+		keys: keys,
+	}, nil
+}
+
+// Key returns the key with the given ID.
+func (s *StaticKeySet) Key(_ context.Context, keyID string) (*JSONWebKey, error) {
+	return findKey(s.keys, keyID)
+}
+
+var _ KeySet = new(RemoteKeySet)
+
+// RemoteKeySet is a key set fetched from a URL, usually the 'jwks_uri' of an OpenID provider. The keys are cached and
+// fetched again after the refresh interval or when a token is signed with an unknown key, so that the rotation of the
+// keys of the provider is followed. The key set is fetched once at a time, outside the lock of the cache, and at most
+// once per minimum refresh interval, so that an unavailable provider doesn't stall the verification of the tokens.
+type RemoteKeySet struct {
+	url             string
+	httpClient      *http.Client
+	refreshInterval time.Duration
+	group           singleflight.Group
+
+	mutex     sync.RWMutex
+	keys      []JSONWebKey
+	fetchedAt time.Time
+	// attemptedAt is when the key set was last fetched, whether the fetch succeeded or not
+	attemptedAt time.Time
+	// fetchErr is the error of the last fetch. It is nil if it succeeded
+	fetchErr error
+}
+
+// RemoteKeySetOptions configures a RemoteKeySet.
+type RemoteKeySetOptions struct {
+	// URL of the JSON Web Key Set
+	URL string
+	// HTTPClient to fetch the key set. Defaults to a client with a 10 seconds timeout
+	HTTPClient *http.Client
+	// RefreshInterval is the maximum time the keys are cached. Defaults to 15 minutes
+	RefreshInterval time.Duration
+}
+
+// ProvideRemoteKeySet creates a RemoteKeySet with the given options. The keys are fetched the first time they are needed.
+func ProvideRemoteKeySet(options RemoteKeySetOptions) (*RemoteKeySet, error) {
+	if len(options.URL) == 0 {
+		return nil, errors.New("mandatory 'URL' not provided")
+	}
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	refreshInterval := options.RefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	return &RemoteKeySet{
+		url:             options.URL,
+		httpClient:      httpClient,
+		refreshInterval: refreshInterval,
+	}, nil
+}
+
+// Key returns the key with the given ID, fetching the key set again if it is not cached. Stale keys are served while
+// the key set is fetched again in the background, and cached keys are served while the provider is unavailable.
+func (s *RemoteKeySet) Key(ctx context.Context, keyID string) (*JSONWebKey, error) {
+	s.mutex.RLock()
+	keys := s.keys
+	fetchErr := s.fetchErr
+	fresh := time.Since(s.fetchedAt) < s.refreshInterval
+	backingOff := time.Since(s.attemptedAt) < minRefreshInterval
+	s.mutex.RUnlock()
+
+	if keys != nil {
+		key, err := findKey(keys, keyID)
+		switch {
+		case err == nil && (fresh || (fetchErr != nil && backingOff)):
+			return key, nil
+		case err == nil:
+			go func() {
+				_, _ = s.refresh(context.WithoutCancel(ctx))
+			}()
+			return key, nil
+		case backingOff:
+			return nil, err
+		}
+	} else if backingOff {
+		return nil, fetchErr
+	}
+
+	refreshedKeys, err := s.refresh(ctx)
+	if err != nil {
+		if keys != nil {
+			// keep verifying with the cached keys while the provider is unavailable
+			return findKey(keys, keyID)
+		}
+		return nil, err
+	}
+	return findKey(refreshedKeys, keyID)
+}
+
+// refresh fetches the key set and caches it. Concurrent calls share the same fetch, which is not cancelled if the
+// context of the caller that started it is done.
+func (s *RemoteKeySet) refresh(ctx context.Context) ([]JSONWebKey, error) {
+	result := s.group.DoChan(s.url, func() (any, error) {
+		keys, err := s.fetch(context.WithoutCancel(ctx))
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.attemptedAt = time.Now()
+		s.fetchErr = err
+		if err != nil {
+			return nil, err
+		}
+		if keys == nil {
+			keys = make([]JSONWebKey, 0)
+		}
+		s.keys = keys
+		s.fetchedAt = s.attemptedAt
+		return keys, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case fetched := <-result:
+		if fetched.Err != nil {
+			return nil, fetched.Err
+		}
+		return fetched.Val.([]JSONWebKey), nil
+	}
+}
+
+func (s *RemoteKeySet) fetch(ctx context.Context) ([]JSONWebKey, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating JSON Web Key Set request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching JSON Web Key Set: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching JSON Web Key Set: unexpected status code %d", response.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxKeySetSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading JSON Web Key Set: %w", err)
+	}
+	return ParseKeySet(data)
+}
@@ -0,0 +1,262 @@
+// Package jwt verifies signed JSON Web Tokens (RFC 7519) against the public keys of a JSON Web Key Set (RFC 7517),
+// as issued by OpenID Connect providers.
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	_ "crypto/sha256" // Used to register the hash functions of the signature algorithms
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken is returned when a token is malformed, its signature does not verify or its claims are not valid.
+var ErrInvalidToken = errors.New("invalid token")
+
+// curveBitSizes are the sizes of the curves each ECDSA algorithm signs with.
+var curveBitSizes = map[string]int{
+	"ES256": 256,
+	"ES384": 384,
+	"ES512": 521,
+}
+
+// Claims are the claims of a verified token.
+type Claims map[string]any
+
+// String returns the claim with the given name if it is a string.
+func (c Claims) String(name string) (string, bool) {
+	value, ok := c[name].(string)
+	return value, ok
+}
+
+// Verifier verifies the signature and the registered claims of tokens.
+type Verifier struct {
+	keySet   KeySet
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// VerifierOptions configures a Verifier.
+type VerifierOptions struct {
+	// KeySet holds the keys that verify the signature of the tokens
+	KeySet KeySet
+	// Issuer expected in the 'iss' claim. It is not checked if empty
+	Issuer string
+	// Audience expected in the 'aud' claim. It is not checked if empty
+	Audience string
+	// Leeway tolerated when checking the 'exp' and 'nbf' claims to account for clock skew
+	Leeway time.Duration
+}
+
+// ProvideVerifier creates a Verifier with the given options.
+func ProvideVerifier(options VerifierOptions) (*Verifier, error) {
+	if options.KeySet == nil {
+		return nil, errors.New("mandatory 'KeySet' not provided")
+	}
+	if options.Leeway < 0 {
+		return nil, errors.New("'Leeway' cannot be negative")
+	}
+	return &Verifier{
+		keySet:   options.KeySet,
+		issuer:   options.Issuer,
+		audience: options.Audience,
+		leeway:   options.Leeway,
+		now:      time.Now,
+	}, nil
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Critical  []any  `json:"crit"`
+}
+
+// Verify verifies a token in JWS compact serialization and returns its claims. Tokens without expiration are rejected.
+func (v *Verifier) Verify(ctx context.Context, token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: the token must have three parts", ErrInvalidToken)
+	}
+
+	var tokenHeader header
+	err := decodeSegment(parts[0], &tokenHeader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid header: %v", ErrInvalidToken, err)
+	}
+	if len(tokenHeader.Critical) > 0 {
+		return nil, fmt.Errorf("%w: critical header parameters are not supported", ErrInvalidToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding: %v", ErrInvalidToken, err)
+	}
+
+	key, err := v.keySet.Key(ctx, tokenHeader.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if key.Algorithm != "" && key.Algorithm != tokenHeader.Algorithm {
+		return nil, fmt.Errorf("%w: algorithm '%s' not allowed for key '%s'", ErrInvalidToken, tokenHeader.Algorithm, key.ID)
+	}
+	err = verifySignature(tokenHeader.Algorithm, key.Key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var claims Claims
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid claims: %v", ErrInvalidToken, err)
+	}
+	err = v.validateClaims(claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+func (v *Verifier) validateClaims(claims Claims) error {
+	now := v.now()
+	expiration, ok, err := numericDate(claims, "exp")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("the 'exp' claim is mandatory")
+	}
+	if !now.Before(expiration.Add(v.leeway)) {
+		return errors.New("the token has expired")
+	}
+	notBefore, ok, err := numericDate(claims, "nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(v.leeway).Before(notBefore) {
+		return errors.New("the token is not valid yet")
+	}
+
+	if v.issuer != "" {
+		issuer, _ := claims.String("iss")
+		if issuer != v.issuer {
+			return fmt.Errorf("unexpected issuer '%s'", issuer)
+		}
+	}
+	if v.audience != "" && !hasAudience(claims["aud"], v.audience) {
+		return fmt.Errorf("the token is not intended for audience '%s'", v.audience)
+	}
+	return nil
+}
+
+func numericDate(claims Claims, name string) (time.Time, bool, error) {
+	value, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("the '%s' claim must be a number", name)
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("the '%s' claim must be a number", name)
+	}
+	return time.Unix(0, 0).Add(time.Duration(seconds * float64(time.Second))), true, nil
+}
+
+func hasAudience(value any, audience string) bool {
+	switch aud := value.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		return slices.Contains(aud, any(audience))
+	default:
+		return false
+	}
+}
+
+func decodeSegment(segment string, target any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(target)
+}
+
+func verifySignature(algorithm string, publicKey crypto.PublicKey, signingInput []byte, signature []byte) error {
+	switch algorithm {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+		rsaKey, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm '%s' requires an RSA key", algorithm)
+		}
+		hash := hashFor(algorithm)
+		digest := hashSum(hash, signingInput)
+		if strings.HasPrefix(algorithm, "PS") {
+			return rsa.VerifyPSS(rsaKey, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+	case "ES256", "ES384", "ES512":
+		ecKey, ok := publicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm '%s' requires an EC key", algorithm)
+		}
+		bitSize := ecKey.Curve.Params().BitSize
+		if curveBitSizes[algorithm] != bitSize {
+			return fmt.Errorf("algorithm '%s' does not match the curve of the key", algorithm)
+		}
+		hash := hashFor(algorithm)
+		byteSize := (bitSize + 7) / 8
+		if len(signature) != 2*byteSize {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:byteSize])
+		s := new(big.Int).SetBytes(signature[byteSize:])
+		if !ecdsa.Verify(ecKey, hashSum(hash, signingInput), r, s) {
+			return errors.New("signature verification failed")
+		}
+		return nil
+	case "EdDSA":
+		edKey, ok := publicKey.(ed25519.PublicKey)
+		if !ok {
+			return errors.New("algorithm 'EdDSA' requires an Ed25519 key")
+		}
+		if !ed25519.Verify(edKey, signingInput, signature) {
+			return errors.New("signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm '%s'", algorithm)
+	}
+}
+
+func hashFor(algorithm string) crypto.Hash {
+	switch algorithm[2:] {
+	case "384":
+		return crypto.SHA384
+	case "512":
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+func hashSum(hash crypto.Hash, data []byte) []byte {
+	hasher := hash.New()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,353 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/jwt"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer   = "https://idp.example.com"
+	audience = "signare"
+)
+
+func TestProvideStaticKeySet(t *testing.T) {
+	t.Run("success: unsupported keys are ignored", func(t *testing.T) {
+		rsaKey := newRSAKey(t)
+		path := writeKeySet(t, map[string]any{
+			"keys": []any{
+				rsaJWK("rsa", &rsaKey.PublicKey),
+				map[string]any{"kty": "oct", "kid": "symmetric", "k": "c2VjcmV0"},
+				map[string]any{"kty": "RSA", "kid": "encryption", "use": "enc"},
+			},
+		})
+		keySet, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{Path: path})
+		require.NoError(t, err)
+		key, err := keySet.Key(context.Background(), "")
+		require.NoError(t, err)
+		require.Equal(t, "rsa", key.ID)
+	})
+	t.Run("failure: no supported keys", func(t *testing.T) {
+		path := writeKeySet(t, map[string]any{"keys": []any{}})
+		_, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{Path: path})
+		require.Error(t, err)
+	})
+	t.Run("failure: EC point not on the curve", func(t *testing.T) {
+		path := writeKeySet(t, map[string]any{
+			"keys": []any{
+				map[string]any{"kty": "EC", "crv": "P-256", "x": encodeInt(big.NewInt(1)), "y": encodeInt(big.NewInt(1))},
+			},
+		})
+		_, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{Path: path})
+		require.Error(t, err)
+	})
+	t.Run("failure: missing file", func(t *testing.T) {
+		_, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{Path: filepath.Join(t.TempDir(), "missing.json")})
+		require.Error(t, err)
+	})
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	rsaKey := newRSAKey(t)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPublicKey, edPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherRSAKey := newRSAKey(t)
+
+	keySet, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{
+		Path: writeKeySet(t, map[string]any{
+			"keys": []any{
+				rsaJWK("rsa", &rsaKey.PublicKey),
+				ecJWK("ec", &ecKey.PublicKey),
+				map[string]any{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(edPublicKey)},
+			},
+		}),
+	})
+	require.NoError(t, err)
+	verifier, err := jwt.ProvideVerifier(jwt.VerifierOptions{
+		KeySet:   keySet,
+		Issuer:   issuer,
+		Audience: audience,
+		Leeway:   time.Minute,
+	})
+	require.NoError(t, err)
+
+	t.Run("success: RS256", func(t *testing.T) {
+		token := sign(t, "RS256", "rsa", rsaKey, validClaims())
+		claims, verifyErr := verifier.Verify(ctx, token)
+		require.NoError(t, verifyErr)
+		subject, ok := claims.String("sub")
+		require.True(t, ok)
+		require.Equal(t, "alice", subject)
+	})
+	t.Run("success: PS256", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "PS256", "rsa", rsaKey, validClaims()))
+		require.NoError(t, verifyErr)
+	})
+	t.Run("success: ES256", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "ES256", "ec", ecKey, validClaims()))
+		require.NoError(t, verifyErr)
+	})
+	t.Run("success: EdDSA", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "EdDSA", "ed", edPrivateKey, validClaims()))
+		require.NoError(t, verifyErr)
+	})
+	t.Run("success: audience in a list", func(t *testing.T) {
+		claims := validClaims()
+		claims["aud"] = []string{"other", audience}
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.NoError(t, verifyErr)
+	})
+	t.Run("success: expired within the leeway", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-30 * time.Second).Unix()
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.NoError(t, verifyErr)
+	})
+	t.Run("failure: expired", func(t *testing.T) {
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: missing expiration", func(t *testing.T) {
+		claims := validClaims()
+		delete(claims, "exp")
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: not valid yet", func(t *testing.T) {
+		claims := validClaims()
+		claims["nbf"] = time.Now().Add(time.Hour).Unix()
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: unexpected issuer", func(t *testing.T) {
+		claims := validClaims()
+		claims["iss"] = "https://attacker.example.com"
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: unexpected audience", func(t *testing.T) {
+		claims := validClaims()
+		claims["aud"] = "other"
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", rsaKey, claims))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: signed with a different key", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "rsa", otherRSAKey, validClaims()))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: unknown key", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "unknown", rsaKey, validClaims()))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: key not identified with several keys in the set", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "RS256", "", rsaKey, validClaims()))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: algorithm does not match the key", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, sign(t, "ES256", "rsa", ecKey, validClaims()))
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: unsigned token", func(t *testing.T) {
+		token := encodeSegment(t, map[string]any{"alg": "none", "kid": "rsa"}) + "." + encodeSegment(t, validClaims()) + "."
+		_, verifyErr := verifier.Verify(ctx, token)
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: tampered claims", func(t *testing.T) {
+		token := sign(t, "RS256", "rsa", rsaKey, validClaims())
+		claims := validClaims()
+		claims["sub"] = "mallory"
+		parts := strings.Split(token, ".")
+		_, verifyErr := verifier.Verify(ctx, parts[0]+"."+encodeSegment(t, claims)+"."+parts[2])
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+	t.Run("failure: malformed token", func(t *testing.T) {
+		_, verifyErr := verifier.Verify(ctx, "not-a-token")
+		require.True(t, errors.Is(verifyErr, jwt.ErrInvalidToken))
+	})
+}
+
+func TestRemoteKeySet(t *testing.T) {
+	ctx := context.Background()
+	rsaKey := newRSAKey(t)
+	rotatedKey := newRSAKey(t)
+
+	var requests atomic.Int32
+	keys := []any{rsaJWK("first", &rsaKey.PublicKey)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	defer server.Close()
+
+	keySet, err := jwt.ProvideRemoteKeySet(jwt.RemoteKeySetOptions{URL: server.URL})
+	require.NoError(t, err)
+	verifier, err := jwt.ProvideVerifier(jwt.VerifierOptions{KeySet: keySet})
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, sign(t, "RS256", "first", rsaKey, validClaims()))
+	require.NoError(t, err)
+	_, err = verifier.Verify(ctx, sign(t, "RS256", "first", rsaKey, validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load())
+
+	// a key rotated in the provider right after the last fetch is not fetched until the minimum refresh interval elapses
+	keys = []any{rsaJWK("first", &rsaKey.PublicKey), rsaJWK("second", &rotatedKey.PublicKey)}
+	_, err = verifier.Verify(ctx, sign(t, "RS256", "second", rotatedKey, validClaims()))
+	require.True(t, errors.Is(err, jwt.ErrInvalidToken))
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestRemoteKeySet_ProviderUnavailable(t *testing.T) {
+	ctx := context.Background()
+	rsaKey := newRSAKey(t)
+
+	var requests atomic.Int32
+	var unavailable atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []any{rsaJWK("first", &rsaKey.PublicKey)}})
+	}))
+	defer server.Close()
+
+	keySet, err := jwt.ProvideRemoteKeySet(jwt.RemoteKeySetOptions{URL: server.URL, RefreshInterval: time.Millisecond})
+	require.NoError(t, err)
+	verifier, err := jwt.ProvideVerifier(jwt.VerifierOptions{KeySet: keySet})
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, sign(t, "RS256", "first", rsaKey, validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load())
+
+	// the stale keys are served while they are fetched again in the background
+	unavailable.Store(true)
+	time.Sleep(2 * time.Millisecond)
+	_, err = verifier.Verify(ctx, sign(t, "RS256", "first", rsaKey, validClaims()))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return requests.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	// the failed fetch is not retried until the minimum refresh interval elapses
+	for i := 0; i < 5; i++ {
+		_, err = verifier.Verify(ctx, sign(t, "RS256", "first", rsaKey, validClaims()))
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss": issuer,
+		"aud": audience,
+		"sub": "alice",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func sign(t *testing.T, algorithm string, keyID string, privateKey crypto.Signer, claims map[string]any) string {
+	header := map[string]any{"alg": algorithm, "typ": "JWT"}
+	if keyID != "" {
+		header["kid"] = keyID
+	}
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+
+	var signature []byte
+	var err error
+	switch algorithm {
+	case "RS256":
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, privateKey.(*rsa.PrivateKey), crypto.SHA256, digest[:])
+	case "PS256":
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err = rsa.SignPSS(rand.Reader, privateKey.(*rsa.PrivateKey), crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case "ES256":
+		digest := sha256.Sum256([]byte(signingInput))
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, privateKey.(*ecdsa.PrivateKey), digest[:])
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	case "EdDSA":
+		signature = ed25519.Sign(privateKey.(ed25519.PrivateKey), []byte(signingInput))
+	default:
+		t.Fatalf("unsupported algorithm %s", algorithm)
+	}
+	require.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func rsaJWK(keyID string, key *rsa.PublicKey) map[string]any {
+	return map[string]any{
+		"kty": "RSA",
+		"kid": keyID,
+		"use": "sig",
+		"n":   encodeInt(key.N),
+		"e":   encodeInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func ecJWK(keyID string, key *ecdsa.PublicKey) map[string]any {
+	return map[string]any{
+		"kty": "EC",
+		"kid": keyID,
+		"crv": "P-256",
+		"x":   encodeInt(key.X),
+		"y":   encodeInt(key.Y),
+	}
+}
+
+func encodeInt(value *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(value.Bytes())
+}
+
+func writeKeySet(t *testing.T, keySet map[string]any) string {
+	data, err := json.Marshal(keySet)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	err = os.WriteFile(path, data, 0o600)
+	require.NoError(t, err)
+	return path
+}
@@ -62,6 +62,39 @@ type MigrateFromFilesInput struct {
 	MigrationsTablePrefix *string
 }
 
+// GetVersionInput defines the input data to get the version of the database schema.
+type GetVersionInput struct {
+	MigrationsTablePrefix *string
+}
+
+// LatestVersionFromFilesInput defines the input data to get the latest version of the database schema from files.
+type LatestVersionFromFilesInput struct {
+	FS fs.ReadDirFS
+}
+
+// ForceVersionInput defines the input data to force the version of the database schema.
+type ForceVersionInput struct {
+	FS                    fs.ReadDirFS
+	Version               int
+	MigrationsTablePrefix *string
+}
+
+// MigrationPlan defines the steps that a migration executes to reach the target version from the current version.
+type MigrationPlan struct {
+	CurrentVersion int
+	TargetVersion  int
+	Steps          []PlannedMigrationStep
+}
+
+// PlannedMigrationStep defines a step of a MigrationPlan.
+type PlannedMigrationStep struct {
+	// Version of the database schema once the step is executed
+	Version     int
+	Description string
+	// Query executed by the step
+	Query string
+}
+
 type migrationFileConfig struct {
 	Steps []stepConfig `yaml:"migration_steps"`
 }
@@ -96,53 +129,144 @@ func NewDbMigrator(options DbMigratorOptions) (*DbMigrator, error) {
 // It reads migration steps from files specified in the input MigrateFromFilesInput
 // and executes them sequentially.
 func (d DbMigrator) MigrateFromFiles(ctx context.Context, input MigrateFromFilesInput) error {
+	dbMigration, err := d.migrationFromFiles(input)
+	if err != nil {
+		return err
+	}
+	return d.Migrate(ctx, *dbMigration)
+}
+
+// PlanFromFiles returns the steps that MigrateFromFiles executes with the same input, without executing them.
+func (d DbMigrator) PlanFromFiles(ctx context.Context, input MigrateFromFilesInput) (*MigrationPlan, error) {
+	dbMigration, err := d.migrationFromFiles(input)
+	if err != nil {
+		return nil, err
+	}
+	defer closeMigrationSteps(dbMigration.Steps)
+
+	version, err := d.GetVersion(ctx, GetVersionInput{MigrationsTablePrefix: input.MigrationsTablePrefix})
+	if err != nil {
+		return nil, err
+	}
+	stepsToMigrate, err := planSteps(*version, *dbMigration)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := MigrationPlan{
+		CurrentVersion: version.Version,
+		TargetVersion:  dbMigration.TargetVersion,
+		Steps:          make([]PlannedMigrationStep, 0, len(stepsToMigrate)),
+	}
+	for _, step := range stepsToMigrate {
+		query, readErr := io.ReadAll(step.file)
+		if readErr != nil {
+			return nil, readErr
+		}
+		plan.Steps = append(plan.Steps, PlannedMigrationStep{
+			Version:     step.version,
+			Description: step.description,
+			Query:       string(query),
+		})
+	}
+	return &plan, nil
+}
+
+// LatestVersionFromFiles returns the version of the database schema once all the steps of the migration files are
+// executed.
+func (d DbMigrator) LatestVersionFromFiles(input LatestVersionFromFilesInput) (int, error) {
 	if input.FS == nil {
-		return errors.New("FS cannot be nil")
+		return 0, errors.New("FS cannot be nil")
 	}
+	config, err := d.readMigrationFileConfig(input.FS)
+	if err != nil {
+		return 0, err
+	}
+	return len(config.Steps), nil
+}
 
-	migrationFile := path.Join(dbSchemas, d.connection.GetDialectName(), migrationFileName)
+// GetVersion returns the current version of the database schema. It waits for the migration in progress, if any, to
+// finish.
+func (d DbMigrator) GetVersion(ctx context.Context, input GetVersionInput) (*sql.MigrationVersion, error) {
+	lock.Lock()
+	defer lock.Unlock()
 
-	migrationStepsFile, err := input.FS.Open(migrationFile)
+	migrator := d.connection.GetMigrator()
+	err := migrator.OpenConnection(ctx)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	migrationStepsFileData, err := io.ReadAll(migrationStepsFile)
+	defer func() {
+		closeErr := migrator.CloseConnection(ctx)
+		if closeErr != nil {
+			logger.LogEntry(ctx).Errorf("error closing connection [%s]", closeErr.Error())
+		}
+	}()
+
+	err = migrator.InitMigration(ctx, input.MigrationsTablePrefix)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	version, err := migrator.GetMigrationVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &version.MigrationVersion, nil
+}
 
-	var config migrationFileConfig
-	err = yaml.Unmarshal(migrationStepsFileData, &config)
+// ForceVersion sets the version of the database schema and clears its dirty flag, without executing any migration
+// step. It is meant to recover from a migration step that failed once the database has been repaired manually, so it
+// fails if the database schema is not dirty.
+func (d DbMigrator) ForceVersion(ctx context.Context, input ForceVersionInput) error {
+	if input.FS == nil {
+		return errors.New("FS cannot be nil")
+	}
+	config, err := d.readMigrationFileConfig(input.FS)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	dbMigration := Migration{
-		Steps:         make([]MigrationStep, 0),
-		TargetVersion: len(config.Steps),
+	if input.Version < 0 || input.Version > len(config.Steps) {
+		return fmt.Errorf("version [%d] must be between 0 and the available number of steps [%d]", input.Version, len(config.Steps))
 	}
 
-	if input.TargetVersion != nil {
-		dbMigration.TargetVersion = *input.TargetVersion
+	lock.Lock()
+	defer lock.Unlock()
+
+	migrator := d.connection.GetMigrator()
+	err = migrator.OpenConnection(ctx)
+	if err != nil {
+		return err
 	}
-	for _, currentMigrationStepConfig := range config.Steps {
-		upFileReader, innerErr := input.FS.Open(removeLeadingDashFromFilename(currentMigrationStepConfig.Up))
-		if innerErr != nil {
-			panic(innerErr)
-		}
-		downFileReader, innerErr := input.FS.Open(removeLeadingDashFromFilename(currentMigrationStepConfig.Down))
-		if innerErr != nil {
-			panic(innerErr)
-		}
-		newMigrationStep := MigrationStep{
-			UpFile:             upFileReader,
-			DownFile:           downFileReader,
-			VersionDescription: currentMigrationStepConfig.VersionDescription,
+	defer func() {
+		closeErr := migrator.CloseConnection(ctx)
+		if closeErr != nil {
+			logger.LogEntry(ctx).Errorf("error closing connection [%s]", closeErr.Error())
 		}
-		dbMigration.Steps = append(dbMigration.Steps, newMigrationStep)
+	}()
+
+	err = migrator.InitMigration(ctx, input.MigrationsTablePrefix)
+	if err != nil {
+		return err
+	}
+	version, err := migrator.GetMigrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if !version.Dirty {
+		return fmt.Errorf("migration table is not dirty in version [%d], there is nothing to force", version.Version)
 	}
-	dbMigration.MigrationsTablePrefix = input.MigrationsTablePrefix
 
-	return d.Migrate(ctx, dbMigration)
+	migrationVersion := sql.SetVersionInput{
+		MigrationVersion: sql.MigrationVersion{
+			Version: input.Version,
+			Dirty:   false,
+		},
+	}
+	if input.Version > 0 {
+		migrationVersion.Description = config.Steps[input.Version-1].VersionDescription
+	}
+	logger.LogEntry(ctx).Infof("forcing migration version [%d] from dirty version [%d]", input.Version, version.Version)
+	return migrator.SetMigrationVersion(ctx, migrationVersion)
 }
 
 // Migrate executes the database migration process.
@@ -181,23 +305,14 @@ func (d DbMigrator) Migrate(ctx context.Context, migration Migration) error {
 	if err != nil {
 		return err
 	}
-	if version.Dirty {
-		return fmt.Errorf("migration table is dirty in version [%d]", version.Version)
+	stepsToMigrate, err := planSteps(version.MigrationVersion, migration)
+	if err != nil {
+		return err
 	}
-
-	var stepsToMigrate []migrationStep
 	if migration.TargetVersion > version.Version {
 		logger.LogEntry(ctx).Infof("upgrading to target version [%d] from version [%d]", migration.TargetVersion, version.Version)
-		stepsToMigrate, err = mapMigrationsUp(version.Version, migration.TargetVersion, migration.Steps)
-		if err != nil {
-			return err
-		}
 	} else if migration.TargetVersion < version.Version {
 		logger.LogEntry(ctx).Infof("downgrading to target version [%d] from version [%d]", migration.TargetVersion, version.Version)
-		stepsToMigrate, err = mapMigrationsDown(version.Version, migration.TargetVersion, migration.Steps)
-		if err != nil {
-			return err
-		}
 	} else {
 		logger.LogEntry(ctx).Info("nothing to migrate")
 		return nil
@@ -213,6 +328,93 @@ func (d DbMigrator) Migrate(ctx context.Context, migration Migration) error {
 	return nil
 }
 
+// migrationFromFiles reads the Migration defined by the migration files.
+func (d DbMigrator) migrationFromFiles(input MigrateFromFilesInput) (*Migration, error) {
+	if input.FS == nil {
+		return nil, errors.New("FS cannot be nil")
+	}
+
+	config, err := d.readMigrationFileConfig(input.FS)
+	if err != nil {
+		return nil, err
+	}
+	dbMigration := Migration{
+		Steps:                 make([]MigrationStep, 0),
+		TargetVersion:         len(config.Steps),
+		MigrationsTablePrefix: input.MigrationsTablePrefix,
+	}
+	if input.TargetVersion != nil {
+		dbMigration.TargetVersion = *input.TargetVersion
+	}
+
+	for _, currentMigrationStepConfig := range config.Steps {
+		upFileReader, innerErr := input.FS.Open(removeLeadingDashFromFilename(currentMigrationStepConfig.Up))
+		if innerErr != nil {
+			closeMigrationSteps(dbMigration.Steps)
+			return nil, innerErr
+		}
+		downFileReader, innerErr := input.FS.Open(removeLeadingDashFromFilename(currentMigrationStepConfig.Down))
+		if innerErr != nil {
+			_ = upFileReader.Close()
+			closeMigrationSteps(dbMigration.Steps)
+			return nil, innerErr
+		}
+		newMigrationStep := MigrationStep{
+			UpFile:             upFileReader,
+			DownFile:           downFileReader,
+			VersionDescription: currentMigrationStepConfig.VersionDescription,
+		}
+		dbMigration.Steps = append(dbMigration.Steps, newMigrationStep)
+	}
+	return &dbMigration, nil
+}
+
+// closeMigrationSteps closes the files of the given steps.
+func closeMigrationSteps(steps []MigrationStep) {
+	for _, step := range steps {
+		_ = step.UpFile.Close()
+		_ = step.DownFile.Close()
+	}
+}
+
+// planSteps returns the steps to execute to migrate from the given version to the target version of the migration.
+func planSteps(version sql.MigrationVersion, migration Migration) ([]migrationStep, error) {
+	if version.Dirty {
+		return nil, fmt.Errorf("migration table is dirty in version [%d]", version.Version)
+	}
+	if version.Version > len(migration.Steps) {
+		return nil, fmt.Errorf("current version [%d] is higher than the available number of steps [%d]", version.Version, len(migration.Steps))
+	}
+	if migration.TargetVersion > version.Version {
+		return mapMigrationsUp(version.Version, migration.TargetVersion, migration.Steps)
+	}
+	if migration.TargetVersion < version.Version {
+		return mapMigrationsDown(version.Version, migration.TargetVersion, migration.Steps)
+	}
+	return make([]migrationStep, 0), nil
+}
+
+func (d DbMigrator) readMigrationFileConfig(fileSystem fs.ReadDirFS) (*migrationFileConfig, error) {
+	migrationFile := path.Join(dbSchemas, d.connection.GetDialectName(), migrationFileName)
+
+	migrationStepsFile, err := fileSystem.Open(migrationFile)
+	if err != nil {
+		return nil, err
+	}
+	defer migrationStepsFile.Close()
+	migrationStepsFileData, err := io.ReadAll(migrationStepsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var config migrationFileConfig
+	err = yaml.Unmarshal(migrationStepsFileData, &config)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
 func (d DbMigrator) migrateStep(ctx context.Context, migrator sql.Migrator, step migrationStep) error {
 	migrationVersion := sql.SetVersionInput{
 		MigrationVersion: sql.MigrationVersion{
@@ -0,0 +1,115 @@
+package dbmigrator_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	embedded "github.com/hyperledger-labs/signare/app"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/dbmigrator"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
+	_ "github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/init" // Used to register sql dialects
+
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+func TestDbMigrator(t *testing.T) {
+	connection, err := sql.NewConnectionFw(sql.ConnectionFwOptions{
+		SQLite: &sql.SQLiteInfo{
+			ConnectionString: filepath.Join(t.TempDir(), "signare.db"),
+		},
+	})
+	require.NoError(t, err)
+	migrator, err := dbmigrator.NewDbMigrator(dbmigrator.DbMigratorOptions{Connection: connection})
+	require.NoError(t, err)
+
+	latestVersion, err := migrator.LatestVersionFromFiles(dbmigrator.LatestVersionFromFilesInput{FS: embedded.DatabaseMigrations})
+	require.NoError(t, err)
+	require.Greater(t, latestVersion, 2)
+
+	t.Run("success: plan without migrating", func(t *testing.T) {
+		plan, err := migrator.PlanFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations})
+		require.NoError(t, err)
+		require.Equal(t, 0, plan.CurrentVersion)
+		require.Equal(t, latestVersion, plan.TargetVersion)
+		require.Len(t, plan.Steps, latestVersion)
+		require.Equal(t, 1, plan.Steps[0].Version)
+		require.Contains(t, plan.Steps[0].Query, "CREATE TABLE")
+
+		version, err := migrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, 0, version.Version)
+	})
+
+	t.Run("success: migrate up and down to a target version", func(t *testing.T) {
+		err := migrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations})
+		require.NoError(t, err)
+
+		targetVersion := latestVersion - 2
+		plan, err := migrator.PlanFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations, TargetVersion: &targetVersion})
+		require.NoError(t, err)
+		require.Equal(t, latestVersion, plan.CurrentVersion)
+		require.Len(t, plan.Steps, 2)
+		require.Equal(t, latestVersion-1, plan.Steps[0].Version)
+		require.Equal(t, targetVersion, plan.Steps[1].Version)
+
+		err = migrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations, TargetVersion: &targetVersion})
+		require.NoError(t, err)
+		version, err := migrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, targetVersion, version.Version)
+		require.False(t, version.Dirty)
+	})
+
+	t.Run("failure: target version out of range", func(t *testing.T) {
+		targetVersion := latestVersion + 1
+		_, err := migrator.PlanFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations, TargetVersion: &targetVersion})
+		require.Error(t, err)
+	})
+
+	t.Run("failure: force a version if the schema is not dirty", func(t *testing.T) {
+		err := migrator.ForceVersion(ctx, dbmigrator.ForceVersionInput{FS: embedded.DatabaseMigrations, Version: latestVersion})
+		require.ErrorContains(t, err, "is not dirty")
+	})
+
+	t.Run("success: force a version to clear the dirty flag", func(t *testing.T) {
+		version, err := migrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+		require.NoError(t, err)
+		setDirty(t, connection, version.Version)
+
+		err = migrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations})
+		require.ErrorContains(t, err, "dirty")
+		_, err = migrator.PlanFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations})
+		require.ErrorContains(t, err, "dirty")
+
+		err = migrator.ForceVersion(ctx, dbmigrator.ForceVersionInput{FS: embedded.DatabaseMigrations, Version: latestVersion + 1})
+		require.Error(t, err)
+
+		err = migrator.ForceVersion(ctx, dbmigrator.ForceVersionInput{FS: embedded.DatabaseMigrations, Version: version.Version})
+		require.NoError(t, err)
+		forcedVersion, err := migrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, version.Version, forcedVersion.Version)
+		require.False(t, forcedVersion.Dirty)
+
+		err = migrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations})
+		require.NoError(t, err)
+	})
+}
+
+func setDirty(t *testing.T, connection sql.Connection, version int) {
+	migrator := connection.GetMigrator()
+	require.NoError(t, migrator.OpenConnection(ctx))
+	defer func() {
+		require.NoError(t, migrator.CloseConnection(ctx))
+	}()
+	require.NoError(t, migrator.InitMigration(ctx, nil))
+	require.NoError(t, migrator.SetMigrationVersion(ctx, sql.SetVersionInput{
+		MigrationVersion: sql.MigrationVersion{
+			Version: version,
+			Dirty:   true,
+		},
+	}))
+}
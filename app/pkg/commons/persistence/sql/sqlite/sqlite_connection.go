@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// busyTimeoutInMilliseconds maximum time a connection waits for the locks held by other connections.
+const busyTimeoutInMilliseconds = 5000
+
+// ConnectionString returns the connection string to the SQLite database file in the path, or to a new temporary file if
+// the path is nil. The connections wait for the locks held by other connections and the transactions take the write
+// lock when they begin, so that concurrent requests don't fail because the database is locked.
+func ConnectionString(path *string) (string, error) {
+	if path == nil {
+		temporaryPath, err := temporaryFile()
+		if err != nil {
+			return "", err
+		}
+		path = &temporaryPath
+	}
+	if *path == "" {
+		return "", errors.New("the path to the SQLite database file can't be empty")
+	}
+
+	params := url.Values{}
+	params.Set("_busy_timeout", strconv.Itoa(busyTimeoutInMilliseconds))
+	params.Set("_journal_mode", "WAL")
+	params.Set("_txlock", "immediate")
+	// the path is escaped so that characters such as '?' or '#' are not taken as the start of the parameters
+	escapedPath := (&url.URL{Path: *path}).EscapedPath()
+	return fmt.Sprintf("file:%s?%s", escapedPath, params.Encode()), nil
+}
+
+// temporaryFile creates an empty temporary file and returns its path.
+func temporaryFile() (string, error) {
+	file, err := os.CreateTemp("", strings.ReplaceAll(time.Now().String(), " ", ""))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	return file.Name(), nil
+}
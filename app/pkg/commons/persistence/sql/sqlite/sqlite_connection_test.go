@@ -0,0 +1,67 @@
+package sqlite_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/sqlite"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionString(t *testing.T) {
+	t.Run("success: the database file is created in the path", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "signare?mode=ro#1 %.db")
+		connectionString, err := sqlite.ConnectionString(&path)
+		require.NoError(t, err)
+
+		db, err := sqlx.Connect("sqlite3", connectionString)
+		require.NoError(t, err)
+		defer db.Close()
+		_, err = db.Exec("CREATE TABLE test (id TEXT)")
+		require.NoError(t, err)
+
+		_, err = os.Stat(path)
+		require.NoError(t, err)
+
+		var journalMode string
+		err = db.Get(&journalMode, "PRAGMA journal_mode")
+		require.NoError(t, err)
+		require.Equal(t, "wal", journalMode)
+	})
+
+	t.Run("success: temporary file if the path is not provided", func(t *testing.T) {
+		connectionString, err := sqlite.ConnectionString(nil)
+		require.NoError(t, err)
+
+		db, err := sqlx.Connect("sqlite3", connectionString)
+		require.NoError(t, err)
+		defer db.Close()
+
+		var file string
+		err = db.Get(&file, "SELECT file FROM pragma_database_list WHERE name = 'main'")
+		require.NoError(t, err)
+		defer os.Remove(file)
+		_, err = os.Stat(file)
+		require.NoError(t, err)
+
+		var journalMode string
+		err = db.Get(&journalMode, "PRAGMA journal_mode")
+		require.NoError(t, err)
+		require.Equal(t, "wal", journalMode)
+
+		var busyTimeout int
+		err = db.Get(&busyTimeout, "PRAGMA busy_timeout")
+		require.NoError(t, err)
+		require.Positive(t, busyTimeout)
+	})
+
+	t.Run("failure: empty path", func(t *testing.T) {
+		path := ""
+		connectionString, err := sqlite.ConnectionString(&path)
+		require.Error(t, err)
+		require.Empty(t, connectionString)
+	})
+}
@@ -0,0 +1,97 @@
+// Package rbacrules defines the rules that the roles, permissions and actions of the RBAC must follow. They are
+// checked by the RBAC validator tool on the built-in definitions and by the signare whenever the custom roles and
+// permissions change.
+package rbacrules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Role a role and the identifiers of the permissions it grants.
+type Role struct {
+	ID          string
+	Permissions []string
+}
+
+// Permission a permission and the actions it grants.
+type Permission struct {
+	ID      string
+	Actions []string
+}
+
+// Validate checks all the rules: permissions point to existing actions, roles point to existing permissions and every
+// action is granted by at least one role.
+func Validate(roles []Role, permissions []Permission, actions []string) error {
+	err := ValidatePermissions(permissions, actions)
+	if err != nil {
+		return err
+	}
+	err = ValidateRoles(roles, permissions)
+	if err != nil {
+		return err
+	}
+	return CheckOrphanedActions(roles, permissions, actions)
+}
+
+// ValidatePermissions checks that actions pointed by permissions exist and returns an error if any of them doesn't exist
+func ValidatePermissions(permissions []Permission, actions []string) error {
+	actionMap := make(map[string]string)
+	for _, action := range actions {
+		actionMap[action] = ""
+	}
+	for _, permission := range permissions {
+		for _, action := range permission.Actions {
+			if _, actionExists := actionMap[action]; !actionExists {
+				return fmt.Errorf("permission '%s' points to an action '%s' that does not exist", permission.ID, action)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateRoles checks that permissions pointed by roles exist and returns an error if any of them doesn't exist
+func ValidateRoles(roles []Role, permissions []Permission) error {
+	permissionMap := make(map[string]string)
+	for _, permission := range permissions {
+		permissionMap[permission.ID] = ""
+	}
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			if _, permissionExists := permissionMap[permission]; !permissionExists {
+				return fmt.Errorf("role '%s' points to a permission '%s' that does not exist", role.ID, permission)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckOrphanedActions checks that every action is pointed by at least one permission that is also pointed by a role,
+// returning an error with the actions that are not if it fails
+func CheckOrphanedActions(roles []Role, permissions []Permission, actions []string) error {
+	permissionActions := make(map[string][]string)
+	for _, permission := range permissions {
+		permissionActions[permission.ID] = permission.Actions
+	}
+	rolesActions := make(map[string]string)
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			for _, action := range permissionActions[permission] {
+				rolesActions[action] = ""
+			}
+		}
+	}
+
+	orphanActions := make([]string, 0)
+	for _, action := range actions {
+		if _, ok := rolesActions[action]; !ok {
+			orphanActions = append(orphanActions, action)
+		}
+	}
+	if len(orphanActions) > 0 {
+		sort.Strings(orphanActions)
+		return fmt.Errorf("actions '%s' are not assigned to any role", strings.Join(orphanActions, ","))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package rbacrules_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/rbacrules"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	actions     = []string{"application.list", "application.create", "user.list"}
+	permissions = []rbacrules.Permission{
+		{ID: "application.read", Actions: []string{"application.list"}},
+		{ID: "application.write", Actions: []string{"application.create"}},
+		{ID: "user.read", Actions: []string{"user.list"}},
+	}
+	roles = []rbacrules.Role{
+		{ID: "application-admin", Permissions: []string{"application.read", "application.write"}},
+		{ID: "user-auditor", Permissions: []string{"user.read"}},
+	}
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, rbacrules.Validate(roles, permissions, actions))
+	})
+
+	t.Run("failure: permission points to a missing action", func(t *testing.T) {
+		invalidPermissions := append([]rbacrules.Permission{{ID: "admin.read", Actions: []string{"admin.list"}}}, permissions...)
+		err := rbacrules.Validate(roles, invalidPermissions, actions)
+		require.EqualError(t, err, "permission 'admin.read' points to an action 'admin.list' that does not exist")
+	})
+
+	t.Run("failure: role points to a missing permission", func(t *testing.T) {
+		invalidRoles := append([]rbacrules.Role{{ID: "admin-auditor", Permissions: []string{"admin.read"}}}, roles...)
+		err := rbacrules.Validate(invalidRoles, permissions, actions)
+		require.EqualError(t, err, "role 'admin-auditor' points to a permission 'admin.read' that does not exist")
+	})
+
+	t.Run("failure: actions not assigned to any role", func(t *testing.T) {
+		err := rbacrules.Validate(roles[1:], permissions, actions)
+		require.EqualError(t, err, "actions 'application.create,application.list' are not assigned to any role")
+	})
+}
@@ -45,6 +45,9 @@ var (
 // Encode does not support hexstring encoding as it will interpret it as a normal string, thus if
 // you need to encode a hexstring always convert it to []byte
 // Example: The hexstring "0x832728" will encode the utf8 unicode values of "0", "x", "8"..., not the actual bytes representation
+//
+// Nested structures, like the EIP-2930 access lists, are encoded as nested []interface{} lists. For example, an access list
+// is encoded as []interface{}{[]interface{}{addressBytes, [][]byte{storageKey1, storageKey2}}}.
 func Encode(input interface{}) ([]byte, error) {
 	switch item := input.(type) {
 	case string:
@@ -63,6 +66,8 @@ func Encode(input interface{}) ([]byte, error) {
 		return encodeBytes(item)
 	case *[]byte:
 		return encodeBytesPointer(item)
+	case [][]byte:
+		return encodeBytesArray(item)
 	case uint:
 		return encodeUint(item)
 	case *big.Int:
@@ -90,6 +95,23 @@ func encodeBytesPointer(item *[]byte) ([]byte, error) {
 	return encodeBytes(*item)
 }
 
+// encodeBytesArray encodes a list of byte arrays, e.g. the storage keys of an EIP-2930 access list entry.
+func encodeBytesArray(item [][]byte) ([]byte, error) {
+	var encodedItems []byte
+	for _, b := range item {
+		encodedBytes, err := encodeBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		encodedItems = append(encodedItems, encodedBytes...)
+	}
+	encodedLength, err := encodeLength(len(encodedItems), shortListPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return append(encodedLength, encodedItems...), nil
+}
+
 func encodeUint(input interface{}) ([]byte, error) {
 	value := reflect.ValueOf(input)
 	if value.Kind() != reflect.Uint && value.Kind() != reflect.Uint64 {
@@ -152,6 +174,12 @@ func encodeList(list []interface{}) ([]byte, error) {
 				return nil, err
 			}
 			encodedItems = append(encodedItems, encodedBytesPointer...)
+		case [][]byte:
+			encodedBytesArray, err := encodeBytesArray(item)
+			if err != nil {
+				return nil, err
+			}
+			encodedItems = append(encodedItems, encodedBytesArray...)
 		case uint:
 			encodedUint, err := encodeUint(item)
 			if err != nil {
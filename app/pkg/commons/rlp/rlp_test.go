@@ -36,6 +36,25 @@ var decodedTestBytes = "\x01\x02\x03\x04"
 
 var encodedEmptyList = []byte{0xc0}
 
+var testBytesArray = [][]byte{{0x01, 0x02}, {0x03}}
+var encodedTestBytesArray = []byte{0xc4, 0x82, 0x01, 0x02, 0x03}
+
+// accessList is the access list example from EIP-2930 https://eips.ethereum.org/EIPS/eip-2930#parameters
+var accessList interface{} = []interface{}{
+	[]interface{}{
+		hexStringToBytes("0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae"),
+		[][]byte{
+			hexStringToBytes("0x0000000000000000000000000000000000000000000000000000000000000003"),
+			hexStringToBytes("0x0000000000000000000000000000000000000000000000000000000000000007"),
+		},
+	},
+	[]interface{}{
+		hexStringToBytes("0xbb9bc244d798123fde783fcc1c72d3bb8c189413"),
+		[][]byte{},
+	},
+}
+var encodedAccessList = "0xf872f85994de0b295669a9fd93d5f28d9ec85e40f4cb697baef842a00000000000000000000000000000000000000000000000000000000000000003a00000000000000000000000000000000000000000000000000000000000000007d694bb9bc244d798123fde783fcc1c72d3bb8c189413c0"
+
 var nestedList interface{} = []interface{}{
 	"hello world",
 	"1",
@@ -206,6 +225,23 @@ func Test_RLP_Encode_CorrectExecution(t *testing.T) {
 	require.Equal(t, encodedTestBytes, output)
 }
 
+func Test_RLP_Encode_AccessList_CorrectExecution(t *testing.T) {
+	output, err := rlp.Encode(testBytesArray)
+	require.Nil(t, err)
+	require.NotNil(t, output)
+	require.Equal(t, encodedTestBytesArray, output)
+
+	output, err = rlp.Encode([][]byte{})
+	require.Nil(t, err)
+	require.NotNil(t, output)
+	require.Equal(t, encodedEmptyList, output)
+
+	output, err = rlp.Encode(accessList)
+	require.Nil(t, err)
+	require.NotNil(t, output)
+	require.Equal(t, encodedAccessList, bytesToHexString(output))
+}
+
 func Test_RLP_Encode_Error_UnsupportedType(t *testing.T) {
 	output, err := rlp.Encode(errors.New("dummy error"))
 	require.Nil(t, output)
@@ -0,0 +1,111 @@
+// Package tlsconfig creates the TLS configuration of the servers, optionally verifying the certificates of the clients.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ClientAuthMode defines whether the server requests and verifies client certificates.
+type ClientAuthMode string
+
+const (
+	// ClientAuthNone does not request client certificates
+	ClientAuthNone ClientAuthMode = "none"
+	// ClientAuthOptional verifies the client certificates when they are presented, allowing clients without certificate
+	ClientAuthOptional ClientAuthMode = "optional"
+	// ClientAuthRequired requires a valid client certificate to establish the connection
+	ClientAuthRequired ClientAuthMode = "required"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// ServerOptions configures the TLS configuration of a server.
+type ServerOptions struct {
+	// CertFile path to the PEM encoded certificate chain of the server
+	CertFile string
+	// KeyFile path to the PEM encoded private key of the server
+	KeyFile string
+	// MinVersion minimum TLS version accepted, '1.2' or '1.3'. Defaults to '1.2'
+	MinVersion string
+	// ClientCAFile path to the PEM encoded certificates of the authorities that issue the client certificates
+	ClientCAFile string
+	// ClientAuth defines whether client certificates are verified. Defaults to ClientAuthRequired if ClientCAFile is
+	// provided and to ClientAuthNone otherwise
+	ClientAuth ClientAuthMode
+}
+
+// NewServerConfig creates the TLS configuration of a server with the given options.
+func NewServerConfig(options ServerOptions) (*tls.Config, error) {
+	if len(options.CertFile) == 0 {
+		return nil, errors.New("mandatory 'CertFile' not provided")
+	}
+	if len(options.KeyFile) == 0 {
+		return nil, errors.New("mandatory 'KeyFile' not provided")
+	}
+	certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading server certificate: %w", err)
+	}
+
+	minVersion := uint16(tls.VersionTLS12)
+	if len(options.MinVersion) > 0 {
+		version, ok := tlsVersions[options.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS version '%s'", options.MinVersion)
+		}
+		minVersion = version
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   minVersion,
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	clientAuth := options.ClientAuth
+	if len(clientAuth) == 0 {
+		clientAuth = ClientAuthNone
+		if len(options.ClientCAFile) > 0 {
+			clientAuth = ClientAuthRequired
+		}
+	}
+	switch clientAuth {
+	case ClientAuthNone:
+		return config, nil
+	case ClientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequired:
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unsupported client authentication mode '%s'", clientAuth)
+	}
+
+	if len(options.ClientCAFile) == 0 {
+		return nil, errors.New("'ClientCAFile' is mandatory to verify client certificates")
+	}
+	clientCAs, err := loadCertPool(options.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	config.ClientCAs = clientCAs
+	return config, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("the client CA file '%s' does not hold any PEM encoded certificate", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,226 @@
+package tlsconfig_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/tlsconfig"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServerConfig(t *testing.T) {
+	pki := newTestPKI(t)
+
+	t.Run("success: server authentication only", func(t *testing.T) {
+		config, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile: pki.serverCertFile,
+			KeyFile:  pki.serverKeyFile,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tls.NoClientCert, config.ClientAuth)
+		require.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+
+		server := startServer(t, config)
+		response, err := pki.client(nil).Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+	})
+	t.Run("success: client certificates required by default with a client CA", func(t *testing.T) {
+		config, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:     pki.serverCertFile,
+			KeyFile:      pki.serverKeyFile,
+			ClientCAFile: pki.caCertFile,
+			MinVersion:   "1.3",
+		})
+		require.NoError(t, err)
+		require.Equal(t, tls.RequireAndVerifyClientCert, config.ClientAuth)
+		server := startServer(t, config)
+
+		response, err := pki.client(&pki.clientCertificate).Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		_, err = pki.client(nil).Get(server.URL)
+		require.Error(t, err)
+		_, err = pki.client(&pki.untrustedCertificate).Get(server.URL)
+		require.Error(t, err)
+	})
+	t.Run("success: optional client certificates", func(t *testing.T) {
+		config, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:     pki.serverCertFile,
+			KeyFile:      pki.serverKeyFile,
+			ClientCAFile: pki.caCertFile,
+			ClientAuth:   tlsconfig.ClientAuthOptional,
+		})
+		require.NoError(t, err)
+		server := startServer(t, config)
+
+		response, err := pki.client(nil).Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		_, err = pki.client(&pki.untrustedCertificate).Get(server.URL)
+		require.Error(t, err)
+	})
+	t.Run("failure: client CA missing to verify client certificates", func(t *testing.T) {
+		_, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:   pki.serverCertFile,
+			KeyFile:    pki.serverKeyFile,
+			ClientAuth: tlsconfig.ClientAuthRequired,
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: unsupported TLS version", func(t *testing.T) {
+		_, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:   pki.serverCertFile,
+			KeyFile:    pki.serverKeyFile,
+			MinVersion: "1.0",
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: unsupported client authentication mode", func(t *testing.T) {
+		_, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:     pki.serverCertFile,
+			KeyFile:      pki.serverKeyFile,
+			ClientCAFile: pki.caCertFile,
+			ClientAuth:   "sometimes",
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: missing key", func(t *testing.T) {
+		_, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile: pki.serverCertFile,
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: client CA file without certificates", func(t *testing.T) {
+		_, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:     pki.serverCertFile,
+			KeyFile:      pki.serverKeyFile,
+			ClientCAFile: pki.serverKeyFile,
+		})
+		require.Error(t, err)
+	})
+}
+
+func startServer(t *testing.T, config *tls.Config) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = config
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+type testPKI struct {
+	caPool               *x509.CertPool
+	caCertFile           string
+	serverCertFile       string
+	serverKeyFile        string
+	clientCertificate    tls.Certificate
+	untrustedCertificate tls.Certificate
+}
+
+func (p testPKI) client(certificate *tls.Certificate) *http.Client {
+	config := &tls.Config{
+		RootCAs:    p.caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if certificate != nil {
+		// the certificate is always presented, even if it is not issued by the authorities accepted by the server
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certificate, nil
+		}
+	}
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: config},
+		Timeout:   10 * time.Second,
+	}
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	dir := t.TempDir()
+	ca := newCertificate(t, "test-ca", nil, true)
+	untrustedCA := newCertificate(t, "untrusted-ca", nil, true)
+	server := newCertificate(t, "localhost", &ca, false)
+	client := newCertificate(t, "alice", &ca, false)
+	untrustedClient := newCertificate(t, "mallory", &untrustedCA, false)
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(ca.certificate)
+
+	pki := testPKI{
+		caPool:               caPool,
+		caCertFile:           filepath.Join(dir, "ca.pem"),
+		serverCertFile:       filepath.Join(dir, "server.pem"),
+		serverKeyFile:        filepath.Join(dir, "server-key.pem"),
+		clientCertificate:    client.tlsCertificate(),
+		untrustedCertificate: untrustedClient.tlsCertificate(),
+	}
+	writePEM(t, pki.caCertFile, "CERTIFICATE", ca.certificate.Raw)
+	writePEM(t, pki.serverCertFile, "CERTIFICATE", server.certificate.Raw)
+	serverKeyDER, err := x509.MarshalECPrivateKey(server.key)
+	require.NoError(t, err)
+	writePEM(t, pki.serverKeyFile, "EC PRIVATE KEY", serverKeyDER)
+	return pki
+}
+
+type issuedCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func (c issuedCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.certificate.Raw}, PrivateKey: c.key}
+}
+
+func newCertificate(t *testing.T, commonName string, issuer *issuedCertificate, isCA bool) issuedCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		template.DNSNames = []string{"localhost"}
+		template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	parent, parentKey := template, key
+	if issuer != nil {
+		parent, parentKey = issuer.certificate, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return issuedCertificate{certificate: certificate, key: key}
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+}
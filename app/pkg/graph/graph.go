@@ -4,7 +4,10 @@ package graph
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/jwt"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
@@ -13,10 +16,14 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
 
 	"github.com/asaskevich/govalidator"
 
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/certificatecontextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/entrypoint/rpcbatchrequestsupport"
 )
 
 type GraphShared struct {
@@ -65,7 +72,7 @@ func (graph *ApplicationGraph) Build() {
 	checkError(err)
 
 	// Repositories
-	graph.repositoriesGraph, err = InitializeRepositories(graph.librariesGraph.persistenceFramework)
+	graph.repositoriesGraph, err = InitializeRepositories(graph.librariesGraph.persistenceFramework, graph.librariesGraph.persistenceConnection, graph.config)
 	checkError(err)
 
 	// Metrics
@@ -82,21 +89,23 @@ func (graph *ApplicationGraph) Build() {
 
 	// HTTP APIs
 	authHeadersConfiguration := graph.getAuthHeadersConfiguration()
-	graph.httpMiddlewareGraph, err = initializeHTTPMiddleware(graph.infraGraph, graph.useCasesGraph, graph.metricRecorder, authHeadersConfiguration)
+	authentication, err := graph.getAuthenticationConfiguration()
+	checkError(err)
+	graph.httpMiddlewareGraph, err = initializeHTTPMiddleware(graph.infraGraph, graph.useCasesGraph, graph.metricRecorder, authHeadersConfiguration, authentication)
 	checkError(err)
 
 	httpMiddleware := graph.httpMiddlewareGraph.HTTPMiddlewareFactory.Create()
 	err = graph.infraGraph.mainHTTPRouter.RegisterMiddleware(httpMiddleware...)
 	checkError(err)
 
-	graph.rpcMiddlewareGraph, err = initializeRPCMiddleware(graph.infraGraph, graph.useCasesGraph, graph.metricRecorder, authHeadersConfiguration)
+	graph.rpcMiddlewareGraph, err = initializeRPCMiddleware(graph.infraGraph, graph.useCasesGraph, graph.metricRecorder, authHeadersConfiguration, authentication, graph.getRPCBatchConfiguration())
 	checkError(err)
 
 	rpcMiddleware := graph.rpcMiddlewareGraph.RPCMiddlewareFactory.Create()
 	err = graph.infraGraph.rpcRouter.RegisterMiddleware(rpcMiddleware...)
 	checkError(err)
 
-	graph.httpAPIGraph, err = initializeHTTPAPI(graph.useCasesGraph, graph.infraGraph)
+	graph.httpAPIGraph, err = initializeHTTPAPI(graph.useCasesGraph, graph.infraGraph, graph.httpMiddlewareGraph)
 	checkError(err)
 
 	// Metric Routes
@@ -150,6 +159,14 @@ func (graph *ApplicationGraph) MainServer() *httpinfra.DefaultHTTPRouter {
 	return graph.infraGraph.mainHTTPRouter
 }
 
+// HealthServer obtains the router of the liveness and readiness endpoints, which are served without authentication
+func (graph *ApplicationGraph) HealthServer() *httpinfra.HealthHTTPRouter {
+	if graph.infraGraph.healthHTTPRouter == nil {
+		panic(fmt.Errorf("health HTTP server not initialized"))
+	}
+	return graph.infraGraph.healthHTTPRouter
+}
+
 // RPCServer obtains the signare main RPC server
 func (graph *ApplicationGraph) RPCServer() rpcinfra.RPCRouter {
 	if graph.infraGraph.rpcRouter == nil {
@@ -215,3 +232,168 @@ func (graph *ApplicationGraph) getAuthHeadersConfiguration() contextdefinition.A
 	}
 	return authHeadersConfiguration
 }
+
+func (graph *ApplicationGraph) getRPCBatchConfiguration() rpcbatchrequestsupport.BatchConfiguration {
+	var batchConfiguration rpcbatchrequestsupport.BatchConfiguration
+	if graph.config.RPCBatch != nil {
+		if graph.config.RPCBatch.MaxConcurrentRequests != nil {
+			batchConfiguration.MaxConcurrentRequests = *graph.config.RPCBatch.MaxConcurrentRequests
+		}
+		if graph.config.RPCBatch.MaxSize != nil {
+			batchConfiguration.MaxBatchSize = *graph.config.RPCBatch.MaxSize
+		}
+	}
+	return batchConfiguration
+}
+
+// authenticationConfiguration configures how the requests are authenticated when the user and the application are not
+// read from the request headers. Only one of its fields is set.
+type authenticationConfiguration struct {
+	jwt               *jwtAuthenticationConfiguration
+	clientCertificate *clientCertificateAuthenticationConfiguration
+}
+
+// jwtAuthenticationConfiguration configures the authentication of the requests with bearer tokens
+type jwtAuthenticationConfiguration struct {
+	verifier         *jwt.Verifier
+	userClaim        string
+	applicationClaim string
+}
+
+// clientCertificateAuthenticationConfiguration configures the authentication of the requests with client certificates
+type clientCertificateAuthenticationConfiguration struct {
+	userMapping        *certificatecontextdefinition.IdentityMapping
+	applicationMapping *certificatecontextdefinition.IdentityMapping
+}
+
+// getAuthenticationConfiguration returns the configuration of the authentication of the requests, or nil if the user
+// and the application of the requests are read from the headers.
+func (graph *ApplicationGraph) getAuthenticationConfiguration() (*authenticationConfiguration, error) {
+	if graph.config.Authentication == nil {
+		return nil, nil
+	}
+	authenticationConfig := graph.config.Authentication
+	switch {
+	case authenticationConfig.JWT != nil && authenticationConfig.ClientCertificate != nil:
+		return nil, errors.Internal().WithMessage("only one of 'jwt' and 'clientCertificate' authentication can be configured")
+	case authenticationConfig.JWT != nil:
+		jwtAuthentication, err := getJWTAuthenticationConfiguration(*authenticationConfig.JWT)
+		if err != nil {
+			return nil, err
+		}
+		return &authenticationConfiguration{jwt: jwtAuthentication}, nil
+	case authenticationConfig.ClientCertificate != nil:
+		return &authenticationConfiguration{
+			clientCertificate: getClientCertificateAuthenticationConfiguration(*authenticationConfig.ClientCertificate),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func getJWTAuthenticationConfiguration(jwtConfig JWTAuthenticationConfig) (*jwtAuthenticationConfiguration, error) {
+	if len(jwtConfig.ApplicationClaim) == 0 {
+		return nil, errors.Internal().WithMessage("'applicationClaim' must be configured")
+	}
+
+	var keySet jwt.KeySet
+	var err error
+	switch {
+	case len(jwtConfig.JWKSFile) > 0 && len(jwtConfig.JWKSURL) > 0:
+		return nil, errors.Internal().WithMessage("only one of 'jwksFile' and 'jwksURL' can be configured")
+	case len(jwtConfig.JWKSFile) > 0:
+		keySet, err = jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{
+			Path: jwtConfig.JWKSFile,
+		})
+	case len(jwtConfig.JWKSURL) > 0:
+		remoteKeySetOptions := jwt.RemoteKeySetOptions{
+			URL: jwtConfig.JWKSURL,
+		}
+		if jwtConfig.JWKSRefreshIntervalInSeconds != nil {
+			remoteKeySetOptions.RefreshInterval = time.Duration(*jwtConfig.JWKSRefreshIntervalInSeconds) * time.Second
+		}
+		keySet, err = jwt.ProvideRemoteKeySet(remoteKeySetOptions)
+	default:
+		return nil, errors.Internal().WithMessage("one of 'jwksFile' and 'jwksURL' must be configured")
+	}
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("error loading the JSON Web Key Set")
+	}
+
+	verifierOptions := jwt.VerifierOptions{
+		KeySet:   keySet,
+		Issuer:   jwtConfig.Issuer,
+		Audience: jwtConfig.Audience,
+	}
+	if jwtConfig.ClockSkewInSeconds != nil {
+		verifierOptions.Leeway = time.Duration(*jwtConfig.ClockSkewInSeconds) * time.Second
+	}
+	verifier, err := jwt.ProvideVerifier(verifierOptions)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	return &jwtAuthenticationConfiguration{
+		verifier:         verifier,
+		userClaim:        jwtConfig.UserClaim,
+		applicationClaim: jwtConfig.ApplicationClaim,
+	}, nil
+}
+
+func getClientCertificateAuthenticationConfiguration(certificateConfig ClientCertificateAuthenticationConfig) *clientCertificateAuthenticationConfiguration {
+	clientCertificateAuthentication := &clientCertificateAuthenticationConfiguration{}
+	if len(certificateConfig.UserField) > 0 {
+		clientCertificateAuthentication.userMapping = &certificatecontextdefinition.IdentityMapping{
+			Field:  certificatecontextdefinition.CertificateField(certificateConfig.UserField),
+			Prefix: certificateConfig.UserPrefix,
+		}
+	}
+	if len(certificateConfig.ApplicationField) > 0 {
+		clientCertificateAuthentication.applicationMapping = &certificatecontextdefinition.IdentityMapping{
+			Field:  certificatecontextdefinition.CertificateField(certificateConfig.ApplicationField),
+			Prefix: certificateConfig.ApplicationPrefix,
+		}
+	}
+	return clientCertificateAuthentication
+}
+
+func provideCacheConfiguration(config Config) cache.Configuration {
+	cacheConfiguration := cache.Configuration{
+		TTL:        cache.DefaultTTL,
+		MaxEntries: cache.DefaultMaxEntries,
+	}
+	if config.Cache != nil {
+		if config.Cache.TTLInSeconds != nil {
+			cacheConfiguration.TTL = time.Duration(*config.Cache.TTLInSeconds) * time.Second
+		}
+		if config.Cache.MaxEntries != nil {
+			cacheConfiguration.MaxEntries = *config.Cache.MaxEntries
+		}
+	}
+	return cacheConfiguration
+}
+
+func provideOperationTimeouts(config Config) hsmconnector.OperationTimeouts {
+	hsmModules := config.Libraries.HSMModules
+	operationTimeouts := hsmconnector.OperationTimeouts{
+		hsmconnector.SoftHSMModuleKind:  hsmconnector.DefaultOperationTimeout,
+		hsmconnector.CloudKMSModuleKind: hsmconnector.DefaultOperationTimeout,
+		hsmconnector.PKCS11ModuleKind:   hsmconnector.DefaultOperationTimeout,
+	}
+	if hsmModules.SoftHSM != nil && hsmModules.SoftHSM.OperationTimeoutInSeconds != nil {
+		operationTimeouts[hsmconnector.SoftHSMModuleKind] = time.Duration(*hsmModules.SoftHSM.OperationTimeoutInSeconds) * time.Second
+	}
+	if hsmModules.CloudKMS != nil && hsmModules.CloudKMS.OperationTimeoutInSeconds != nil {
+		operationTimeouts[hsmconnector.CloudKMSModuleKind] = time.Duration(*hsmModules.CloudKMS.OperationTimeoutInSeconds) * time.Second
+	}
+	if hsmModules.PKCS11 != nil && hsmModules.PKCS11.OperationTimeoutInSeconds != nil {
+		operationTimeouts[hsmconnector.PKCS11ModuleKind] = time.Duration(*hsmModules.PKCS11.OperationTimeoutInSeconds) * time.Second
+	}
+	return operationTimeouts
+}
+
+func provideHSMHealthMonitorInterval(config Config) hsmhealth.MonitorInterval {
+	if config.HSMHealthMonitor == nil || config.HSMHealthMonitor.IntervalInSeconds == nil {
+		return hsmhealth.MonitorInterval(hsmhealth.DefaultMonitorInterval)
+	}
+	return hsmhealth.MonitorInterval(time.Duration(*config.HSMHealthMonitor.IntervalInSeconds) * time.Second)
+}
@@ -1,5 +1,9 @@
 package graph
 
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
+)
+
 // Config of the application
 type Config struct {
 	// BuildConfig build configuration
@@ -8,6 +12,17 @@ type Config struct {
 	Libraries LibrariesConfig `valid:"required"`
 	// RequestContextConfig configure the headers in a request
 	RequestContextConfig *RequestContextConfig `valid:"optional"`
+	// Authentication configures how the user and the application of a request are authenticated. They are read from the
+	// headers configured in RequestContextConfig if it is not provided.
+	Authentication *AuthenticationConfig `valid:"optional"`
+	// RPCBatch configures the processing of JSON-RPC batch requests
+	RPCBatch *RPCBatchConfig `valid:"optional"`
+	// Cache configures the caching of the applications, HSM slots, users and admins read to process the requests
+	Cache *CacheConfig `valid:"optional"`
+	// HSMHealthMonitor configures the periodic health checks of the HSM modules and slots
+	HSMHealthMonitor *HSMHealthMonitorConfig `valid:"optional"`
+	// SchemaVersionCheck configures what the signare does on startup if the database schema doesn't have the expected version
+	SchemaVersionCheck *SchemaVersionCheckConfig `valid:"optional"`
 }
 
 // BuildConfig defines the information of the current signare build
@@ -27,9 +42,11 @@ type LibrariesConfig struct {
 	// Metrics configuration
 	Metrics *MetricsConfig `valid:"optional"`
 	// PersistenceFw persistence framework configuration
-	PersistenceFw PersistenceFwConfig `valid:"required"`
+	PersistenceFw graphconfig.PersistenceFwConfig `valid:"required"`
 	// HSMModules provides the configuration of the hardware security modules.
 	HSMModules HSMModules `mapstructure:"hsmmodules" valid:"required"`
+	// PinEncryption configures the encryption of the HSM slot pins in the database. Pins are stored in plain text if it is not provided.
+	PinEncryption *graphconfig.PinEncryptionConfig `mapstructure:"pinEncryption" valid:"optional"`
 }
 
 // LoggerConfig configuration of the logger
@@ -58,57 +75,46 @@ type PrometheusConfig struct {
 	Namespace *string `valid:"optional"`
 }
 
-// PersistenceFwConfig persistence framework configuration
-type PersistenceFwConfig struct {
-	// PostgreSQL configuration to connect to a PostgreSQL database
-	PostgreSQL *PostgresSQLConfig `valid:"optional"`
-	// SQLite configuration to connect to a SQLite database. SQLite must be used just for testing purposes and not in a production environment.
-	SQLite *SQLiteConfig `valid:"optional"`
-}
-
 // HSMModules configures the hardware security modules.
 type HSMModules struct {
 	// SoftHSM configuration for SoftHSM.
 	SoftHSM *SoftHSMConfig `mapstructure:"softhsm" valid:"optional"`
+	// CloudKMS configuration for a cloud KMS.
+	CloudKMS *CloudKMSConfig `mapstructure:"cloudkms" valid:"optional"`
+	// PKCS11 configuration for generic PKCS11 modules.
+	PKCS11 *PKCS11Config `mapstructure:"pkcs11" valid:"optional"`
 }
 
 // SoftHSMConfig configures a SoftHSM.
 type SoftHSMConfig struct {
 	Library string `mapstructure:"lib" valid:"required"`
+	// OperationTimeoutInSeconds maximum time an operation can take. Default is 10 seconds, and 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
 }
 
-// PostgresSQLConfig configuration to connect to a PostgreSQL database
-type PostgresSQLConfig struct {
-	// Host of database system
-	Host string `valid:"required"`
-	// Port of database system. Default value is 5432
-	Port *int `valid:"optional"`
-	// Scheme of database system. Default value is "postgres"
-	Scheme *string `valid:"optional"`
-	// Username to use in database system
-	Username string `valid:"required"`
-	// Password to use with username in database system
-	Password string `valid:"required"`
-	// SSLMode to use in database system. Default value is "disable", however, it is advised to enable SSL for security reasons
-	SSLMode string `valid:"optional"`
-	// Database to access to in the database system
-	Database string `valid:"required"`
-	// SQLClient database client configuration
-	SQLClient *PostgresSQLClientConfig `valid:"optional"`
-}
-
-// PostgresSQLClientConfig configuration for the PostgreSQL client
-type PostgresSQLClientConfig struct {
-	// MaxIdleConnections max idle connections for the database/sql handle
-	MaxIdleConnections *int `valid:"optional"`
-	// MaxOpenConnections max open connections for the database/sql handle
-	MaxOpenConnections *int `valid:"optional"`
-	// MaxConnectionLifetime max connection lifetime for the database/sql handle
-	MaxConnectionLifetime *int `valid:"optional"`
-}
-
-// SQLiteConfig configuration for the SQLite client
-type SQLiteConfig struct{}
+// CloudKMSConfig configures a cloud KMS.
+type CloudKMSConfig struct {
+	// Endpoint URL of the KMS REST API
+	Endpoint string `mapstructure:"endpoint" valid:"required"`
+	// Region where the keys are managed
+	Region string `mapstructure:"region" valid:"required"`
+	// AccessKeyID to sign the requests. Requests are not signed if it is not provided
+	AccessKeyID *string `mapstructure:"accessKeyId" valid:"optional"`
+	// SecretAccessKey to sign the requests
+	SecretAccessKey *string `mapstructure:"secretAccessKey" valid:"optional"`
+	// SessionToken to sign the requests with temporary credentials
+	SessionToken *string `mapstructure:"sessionToken" valid:"optional"`
+	// OperationTimeoutInSeconds maximum time an operation can take. Default is 10 seconds, and 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
+}
+
+// PKCS11Config configures the libraries that generic PKCS11 modules are allowed to load.
+type PKCS11Config struct {
+	// Libraries paths to the PKCS11 libraries of the vendors
+	Libraries []string `mapstructure:"libs" valid:"required"`
+	// OperationTimeoutInSeconds maximum time an operation can take. Default is 10 seconds, and 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
+}
 
 // RequestContextConfig configures the keys in the headers of a request
 type RequestContextConfig struct {
@@ -117,3 +123,77 @@ type RequestContextConfig struct {
 	// ApplicationHeaderKey is the header key to define the application of a request
 	ApplicationHeaderKey string `mapstructure:"applicationHeaderKey"`
 }
+
+// AuthenticationConfig configures the authentication of the requests. Only one of its fields can be provided.
+type AuthenticationConfig struct {
+	// JWT configures the authentication with signed bearer tokens
+	JWT *JWTAuthenticationConfig `mapstructure:"jwt" valid:"optional"`
+	// ClientCertificate configures the authentication with the client certificates verified by the TLS listeners
+	ClientCertificate *ClientCertificateAuthenticationConfig `mapstructure:"clientCertificate" valid:"optional"`
+}
+
+// JWTAuthenticationConfig configures the authentication with JSON Web Tokens sent as bearer tokens. Exactly one of
+// JWKSFile and JWKSURL must be provided.
+type JWTAuthenticationConfig struct {
+	// JWKSFile path to a JSON Web Key Set file with the keys that verify the tokens
+	JWKSFile string `mapstructure:"jwksFile" valid:"optional"`
+	// JWKSURL URL of the JSON Web Key Set with the keys that verify the tokens, usually the 'jwks_uri' of the identity provider
+	JWKSURL string `mapstructure:"jwksURL" valid:"optional"`
+	// JWKSRefreshIntervalInSeconds maximum time the keys fetched from JWKSURL are cached. Default is 900 seconds
+	JWKSRefreshIntervalInSeconds *int `mapstructure:"jwksRefreshIntervalInSeconds" valid:"optional"`
+	// Issuer expected in the 'iss' claim of the tokens. It is not checked if empty
+	Issuer string `mapstructure:"issuer" valid:"optional"`
+	// Audience expected in the 'aud' claim of the tokens. It is not checked if empty
+	Audience string `mapstructure:"audience" valid:"optional"`
+	// UserClaim claim that holds the ID of the user. Default is 'sub'
+	UserClaim string `mapstructure:"userClaim" valid:"optional"`
+	// ApplicationClaim claim that holds the ID of the application
+	ApplicationClaim string `mapstructure:"applicationClaim" valid:"required~applicationClaim is mandatory in JWT authentication config"`
+	// ClockSkewInSeconds tolerated when checking the expiration of the tokens. Default is 0
+	ClockSkewInSeconds *int `mapstructure:"clockSkewInSeconds" valid:"optional"`
+}
+
+// ClientCertificateAuthenticationConfig configures the authentication with client certificates. The fields can be
+// 'subject.commonName', 'subject.organization', 'subject.organizationalUnit', 'san.email', 'san.dns' or 'san.uri'.
+type ClientCertificateAuthenticationConfig struct {
+	// UserField field of the certificate that holds the ID of the user. Default is 'subject.commonName'
+	UserField string `mapstructure:"userField" valid:"optional"`
+	// UserPrefix prefix of the value of UserField that is removed from the ID of the user
+	UserPrefix string `mapstructure:"userPrefix" valid:"optional"`
+	// ApplicationField field of the certificate that holds the ID of the application. The application is not read from the certificate if empty
+	ApplicationField string `mapstructure:"applicationField" valid:"optional"`
+	// ApplicationPrefix prefix of the value of ApplicationField that is removed from the ID of the application
+	ApplicationPrefix string `mapstructure:"applicationPrefix" valid:"optional"`
+}
+
+// RPCBatchConfig configures the processing of JSON-RPC batch requests
+type RPCBatchConfig struct {
+	// MaxConcurrentRequests maximum number of requests of a batch processed at the same time. Default is 1, the requests are processed one after another
+	MaxConcurrentRequests *int `mapstructure:"maxConcurrentRequests" valid:"optional"`
+	// MaxSize maximum number of requests in a batch. Batches are not limited if it is not provided
+	MaxSize *int `mapstructure:"maxSize" valid:"optional"`
+}
+
+// CacheConfig configures the caching of the data read from the database to process the requests. The caches of each
+// signare instance are invalidated when the data is changed through that instance, other instances see the changes
+// once the cached entries expire.
+type CacheConfig struct {
+	// TTLInSeconds time the cached entries are kept. Default is 10 seconds, and caching is disabled if it is 0
+	TTLInSeconds *int `mapstructure:"ttlInSeconds" valid:"optional"`
+	// MaxEntries maximum number of entries of each cache. Default is 10000
+	MaxEntries *int `mapstructure:"maxEntries" valid:"optional"`
+}
+
+// HSMHealthMonitorConfig configures the periodic health checks of the HSM modules and slots. The modules whose device is
+// not available are reset by the checks, so that they are reachable again once the device is back.
+type HSMHealthMonitorConfig struct {
+	// IntervalInSeconds time between the checks. Default is 30 seconds, and the checks are disabled if it is 0
+	IntervalInSeconds *int `mapstructure:"intervalInSeconds" valid:"optional"`
+}
+
+// SchemaVersionCheckConfig configures what the signare does on startup if the database schema doesn't have the version
+// expected by its migration files.
+type SchemaVersionCheckConfig struct {
+	// Mode 'refuse' to start, 'warn' and start or 'migrate' the schema and start. Default is 'refuse'
+	Mode *string `mapstructure:"mode" valid:"optional"`
+}
@@ -0,0 +1,81 @@
+// Package graphconfig defines the configuration shared by the application graph and the upgrade graph, so that the
+// upgrade graph doesn't depend on the application graph.
+package graphconfig
+
+// PersistenceFwConfig persistence framework configuration
+type PersistenceFwConfig struct {
+	// PostgreSQL configuration to connect to a PostgreSQL database
+	PostgreSQL *PostgresSQLConfig `valid:"optional"`
+	// SQLite configuration to connect to a SQLite database. It suits small deployments where a single signare accesses the database.
+	SQLite *SQLiteConfig `valid:"optional"`
+}
+
+// PinEncryptionConfig configures the encryption of the HSM slot pins in the database.
+type PinEncryptionConfig struct {
+	// MasterKey encrypts the pins
+	MasterKey MasterKeyConfig `mapstructure:"masterKey" valid:"required"`
+	// PreviousMasterKeys decrypt the pins that have not been re-encrypted with MasterKey yet
+	PreviousMasterKeys []MasterKeyConfig `mapstructure:"previousMasterKeys" valid:"optional"`
+}
+
+// MasterKeyConfig configures a master key. Exactly one of the sources of the key must be provided.
+type MasterKeyConfig struct {
+	// File configures a key read from a file
+	File *FileMasterKeyConfig `mapstructure:"file" valid:"optional"`
+	// PKCS11 configures a key stored in a PKCS11 token
+	PKCS11 *PKCS11MasterKeyConfig `mapstructure:"pkcs11" valid:"optional"`
+}
+
+// FileMasterKeyConfig configures a master key read from a file.
+type FileMasterKeyConfig struct {
+	// Path to the file holding the 32 bytes of the key encoded in hexadecimal or base64
+	Path string `mapstructure:"path" valid:"required"`
+}
+
+// PKCS11MasterKeyConfig configures a master key stored in a PKCS11 token.
+type PKCS11MasterKeyConfig struct {
+	// Library path to the PKCS11 library
+	Library string `mapstructure:"lib" valid:"required"`
+	// Slot where the token holding the key is
+	Slot uint `mapstructure:"slot"`
+	// Pin of the slot
+	Pin string `mapstructure:"pin" valid:"required"`
+	// KeyLabel label of the AES secret key
+	KeyLabel string `mapstructure:"keyLabel" valid:"required"`
+}
+
+// PostgresSQLConfig configuration to connect to a PostgreSQL database
+type PostgresSQLConfig struct {
+	// Host of database system
+	Host string `valid:"required"`
+	// Port of database system. Default value is 5432
+	Port *int `valid:"optional"`
+	// Scheme of database system. Default value is "postgres"
+	Scheme *string `valid:"optional"`
+	// Username to use in database system
+	Username string `valid:"required"`
+	// Password to use with username in database system
+	Password string `valid:"required"`
+	// SSLMode to use in database system. Default value is "disable", however, it is advised to enable SSL for security reasons
+	SSLMode string `valid:"optional"`
+	// Database to access to in the database system
+	Database string `valid:"required"`
+	// SQLClient database client configuration
+	SQLClient *PostgresSQLClientConfig `valid:"optional"`
+}
+
+// PostgresSQLClientConfig configuration for the PostgreSQL client
+type PostgresSQLClientConfig struct {
+	// MaxIdleConnections max idle connections for the database/sql handle
+	MaxIdleConnections *int `valid:"optional"`
+	// MaxOpenConnections max open connections for the database/sql handle
+	MaxOpenConnections *int `valid:"optional"`
+	// MaxConnectionLifetime max connection lifetime for the database/sql handle
+	MaxConnectionLifetime *int `valid:"optional"`
+}
+
+// SQLiteConfig configuration for the SQLite client
+type SQLiteConfig struct {
+	// Path to the database file, which is created if it doesn't exist. A temporary file is used if it is not provided
+	Path *string `valid:"optional"`
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/httpin"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/rpcin"
 	generatedhttpinfra "github.com/hyperledger-labs/signare/app/pkg/infra/generated/httpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/healthhttpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra"
 
@@ -18,6 +19,7 @@ type httpAPIGraph struct {
 	// REST
 	adminAPIRoutesPublished       generatedhttpinfra.AdminAPIRoutesPublished
 	applicationAPIRoutesPublished generatedhttpinfra.ApplicationAPIRoutesPublished
+	healthRoutesPublished         healthhttpinfra.HealthHTTPRoutesPublished
 
 	// JSON-RPC
 	rpcAPIRoutesPublished rpcinfra.JSONRPCAPIRoutesPublished
@@ -62,6 +64,18 @@ var httpAPISet = wire.NewSet(
 	generatedhttpinfra.ProvideApplicationAPIRoutes,
 	wire.Struct(new(generatedhttpinfra.ApplicationAPIPublisherOptions), "*"),
 
+	// Health Router
+	provideHealthRouter,
+
+	// Health API Adapter
+	httpin.ProvideDefaultHealthAPIAdapter,
+	wire.Bind(new(healthhttpinfra.HealthAPIAdapter), new(*httpin.DefaultHealthAPIAdapter)),
+	wire.Struct(new(httpin.DefaultHealthAPIAdapterOptions), "*"),
+
+	// Health Routes
+	healthhttpinfra.ProvideHealthHTTP,
+	wire.Struct(new(healthhttpinfra.HealthHTTPOptions), "*"),
+
 	/*****************/
 	/*   JSON-RPC  */
 	/***************/
@@ -84,28 +98,43 @@ var httpAPISet = wire.NewSet(
 func initializeHTTPAPI(
 	useCases *useCasesGraph,
 	infra *infraGraph,
+	httpMiddleware *httpMiddlewareGraph,
 ) (*httpAPIGraph, error) {
 	wire.Build(httpAPISet,
 		wire.FieldsOf(new(*useCasesGraph),
 			"ApplicationUseCase",
 			"AccountUseCase",
 			"UserUseCase",
+			"SigningPolicyUseCase",
+			"SigningLimitUseCase",
+			"NonceUseCase",
+			"HSMHealthUseCase",
+			"HealthUseCase",
 			"AdminUseCase",
 			"HSMModuleUseCase",
 			"HSMSlotUseCase",
+			"AuditUseCase",
 			"HSMConnector",
 			"HSMConnectionResolver",
+			"RoleUseCase",
 		),
 		wire.FieldsOf(new(*infraGraph),
 			"httpAPIResponseHandler",
 			"rpcRouter",
 		),
+		wire.FieldsOf(new(*httpMiddlewareGraph),
+			"PolicyDecisionPointUseCase",
+		),
 		wire.Bind(new(rpcinfra.RPCRouter), new(*rpcinfra.DefaultRPCRouter)),
 	)
 
 	return &httpAPIGraph{}, nil
 }
 
+func provideHealthRouter(infra *infraGraph) *httpinfra.HealthHTTPRouter {
+	return infra.healthHTTPRouter
+}
+
 func provideMainRouter(infra *infraGraph) *httpinfra.DefaultHTTPRouter {
 	return infra.mainHTTPRouter
 }
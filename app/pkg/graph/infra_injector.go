@@ -14,6 +14,7 @@ type infraGraph struct {
 	defaultRPCInfraResponseHandler *rpcinfra.DefaultRPCInfraResponseHandler
 	mainHTTPRouter                 *httpinfra.DefaultHTTPRouter
 	metricsHTTPRouter              *httpinfra.MetricsHTTPRouter
+	healthHTTPRouter               *httpinfra.HealthHTTPRouter
 	rpcRouter                      *rpcinfra.DefaultRPCRouter
 }
 
@@ -26,6 +27,9 @@ var infraSet = wire.NewSet(
 	// Metrics HTTP HTTPRouter
 	httpinfra.ProvideMetricsHTTPRouter,
 
+	// Health HTTP HTTPRouter
+	httpinfra.ProvideHealthHTTPRouter,
+
 	// HTTP metrics interface
 	httpinfra.ProvideDefaultHTTPMetrics,
 	wire.Bind(new(httpinfra.HTTPMetrics), new(*httpinfra.DefaultHTTPMetrics)),
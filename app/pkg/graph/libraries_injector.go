@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/google/wire"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
@@ -16,7 +15,7 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
-	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/sqlite"
 )
 
 type librariesGraph struct {
@@ -94,12 +93,12 @@ func providePersistenceFwConnection(options persistenceFwConfigOptions) (sql.Con
 		}
 		connectionFwOptions.Postgres = &postgresConfig
 	} else if databaseInfo.SQLite != nil {
-		file, err := os.CreateTemp("", strings.ReplaceAll(time.Now().String(), " ", ""))
+		connectionString, err := sqlite.ConnectionString(databaseInfo.SQLite.Path)
 		if err != nil {
 			return nil, err
 		}
 		connectionFwOptions.SQLite = &sql.SQLiteInfo{
-			ConnectionString: file.Name(),
+			ConnectionString: connectionString,
 		}
 	} else {
 		return nil, errors.New("no valid database configuration")
@@ -4,17 +4,17 @@ package graph
 
 import (
 	"github.com/google/wire"
-	embedded "github.com/hyperledger-labs/signare/app"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/httpmiddlewarein/pepin"
-	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/infile/pipinfile"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/pip"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/certificatecontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/httpcontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/rpccontextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/tokencontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextvalidation"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authorization"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authorization/pep"
@@ -25,26 +25,50 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
 )
 
-func ProvidePolicyInformationPointYAMLOutputAdapter() *pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter {
-	policyDecisionPointOutputAdapterOptions := pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapterOptions{
-		FileSystem: embedded.RBACFiles,
-		BasePath:   "include/rbac",
+// provideHTTPContextDefinition returns the ContextDefinition of the HTTP API, which authenticates the requests as
+// configured or reads the user and the application from the request headers otherwise.
+func provideHTTPContextDefinition(headersContextDefinition *httpcontextdefinition.HTTPContextDefinition, authentication *authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication == nil {
+		return headersContextDefinition, nil
 	}
-	policyInformationPointOutputAdapter, err := pipinfile.ProvideDefaultRBACActionsPolicyInformationPointYAMLOutputAdapter(policyDecisionPointOutputAdapterOptions)
-	checkError(err)
+	return provideAuthenticationContextDefinition(headersContextDefinition, *authentication)
+}
 
-	return policyInformationPointOutputAdapter
+// provideRPCContextDefinition returns the ContextDefinition of the JSON-RPC API, which authenticates the requests as
+// configured or reads the user and the application from the request headers otherwise.
+func provideRPCContextDefinition(headersContextDefinition *rpccontextdefinition.RPCContextDefinition, authentication *authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication == nil {
+		return headersContextDefinition, nil
+	}
+	return provideAuthenticationContextDefinition(headersContextDefinition, *authentication)
+}
+
+func provideAuthenticationContextDefinition(actionDefinition contextdefinition.ContextDefinition, authentication authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication.clientCertificate != nil {
+		return certificatecontextdefinition.ProvideCertificateContextDefinition(certificatecontextdefinition.CertificateContextDefinitionOptions{
+			ActionDefinition:   actionDefinition,
+			UserMapping:        authentication.clientCertificate.userMapping,
+			ApplicationMapping: authentication.clientCertificate.applicationMapping,
+		})
+	}
+	return tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+		ActionDefinition: actionDefinition,
+		Verifier:         authentication.jwt.verifier,
+		UserClaim:        authentication.jwt.userClaim,
+		ApplicationClaim: authentication.jwt.applicationClaim,
+	})
 }
 
 type httpMiddlewareGraph struct {
-	HTTPMiddlewareFactory *middleware.HTTPMiddlewareFactory
+	HTTPMiddlewareFactory      *middleware.HTTPMiddlewareFactory
+	PolicyDecisionPointUseCase pdp.PolicyDecisionPointUseCase
 }
 
 var httpMiddlewareSet = wire.NewSet(
 	wire.Struct(new(httpMiddlewareGraph), "*"),
 
 	httpcontextdefinition.ProvideHTTPContextDefinition,
-	wire.Bind(new(contextdefinition.ContextDefinition), new(*httpcontextdefinition.HTTPContextDefinition)),
+	provideHTTPContextDefinition,
 	wire.Struct(new(httpcontextdefinition.HTTPContextDefinitionOptions), "*"),
 
 	contextvalidation.ProvideRequestContextValidation,
@@ -58,8 +82,9 @@ var httpMiddlewareSet = wire.NewSet(
 	wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)),
 	wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"),
 
-	ProvidePolicyInformationPointYAMLOutputAdapter,
-	wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter)),
+	pip.ProvideDefaultActionsPIPAdapter,
+	wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pip.DefaultActionsPIPAdapter)),
+	wire.Struct(new(pip.DefaultActionsPIPAdapterOptions), "*"),
 
 	pip.ProvideDefaultUsersPIPAdapter,
 	wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)),
@@ -104,6 +129,7 @@ func initializeHTTPMiddleware(
 	useCases *useCasesGraph,
 	metricRecorder metricrecorder.MetricRecorder,
 	configuration contextdefinition.AuthHeadersConfiguration,
+	authentication *authenticationConfiguration,
 ) (*httpMiddlewareGraph, error) {
 	wire.Build(httpMiddlewareSet,
 		wire.FieldsOf(new(*infraGraph),
@@ -114,6 +140,8 @@ func initializeHTTPMiddleware(
 			"UserUseCase",
 			"AccountUseCase",
 			"AdminUseCase",
+			"RoleUseCase",
+			"PIPCache",
 		),
 		wire.Bind(new(httpinfra.HTTPRouter), new(*httpinfra.DefaultHTTPRouter)),
 	)
@@ -133,7 +161,7 @@ var rpcMiddlewareSet = wire.NewSet(
 	wire.Struct(new(contextvalidation.RequestContextValidationOptions), "*"),
 
 	rpccontextdefinition.ProvideRPCContextDefinitionFromHeaders,
-	wire.Bind(new(contextdefinition.ContextDefinition), new(*rpccontextdefinition.RPCContextDefinition)),
+	provideRPCContextDefinition,
 	wire.Struct(new(rpccontextdefinition.RPCContextDefinitionOptions), "*"),
 
 	pip.ProvideDefaultAccountsPIPAdapter,
@@ -144,8 +172,9 @@ var rpcMiddlewareSet = wire.NewSet(
 	wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)),
 	wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"),
 
-	ProvidePolicyInformationPointYAMLOutputAdapter,
-	wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter)),
+	pip.ProvideDefaultActionsPIPAdapter,
+	wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pip.DefaultActionsPIPAdapter)),
+	wire.Struct(new(pip.DefaultActionsPIPAdapterOptions), "*"),
 
 	pip.ProvideDefaultUsersPIPAdapter,
 	wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)),
@@ -193,6 +222,8 @@ func initializeRPCMiddleware(
 	useCases *useCasesGraph,
 	metricRecorder metricrecorder.MetricRecorder,
 	configuration contextdefinition.AuthHeadersConfiguration,
+	authentication *authenticationConfiguration,
+	batchConfiguration rpcbatchrequestsupport.BatchConfiguration,
 ) (*rpcMiddlewareGraph, error) {
 	wire.Build(rpcMiddlewareSet,
 		wire.FieldsOf(new(*infraGraph),
@@ -203,6 +234,8 @@ func initializeRPCMiddleware(
 			"UserUseCase",
 			"AccountUseCase",
 			"AdminUseCase",
+			"RoleUseCase",
+			"PIPCache",
 		),
 		wire.Bind(new(rpcinfra.RPCRouter), new(*rpcinfra.DefaultRPCRouter)),
 		wire.Bind(new(httpinfra.HTTPResponseHandler), new(*rpcinfra.DefaultRPCInfraResponseHandler)),
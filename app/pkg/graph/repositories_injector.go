@@ -3,29 +3,56 @@
 package graph
 
 import (
+	"io/fs"
+
 	"github.com/google/wire"
 
+	embedded "github.com/hyperledger-labs/signare/app"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/healthdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/accountdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/admindbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/applicationdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/auditdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmslotdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/noncedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/permissiondbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/referentialintegritydbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/roledbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitusagedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signingpolicydbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/userdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/transactionaldbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/accountdb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/admindb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/applicationdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/auditdb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmmoduledb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/noncedb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/permissiondb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/referentialintegritydb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/roledb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitusagedb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signingpolicydb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/userdb"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
 )
@@ -39,6 +66,14 @@ type repositoriesGraph struct {
 	hsmSlotStorage              hsmslot.HSMSlotStorage
 	referentialIntegrityStorage referentialintegrity.ReferentialIntegrityStorage
 	transactionalStorage        transactionalmanager.TransactionalStorage
+	auditStorage                audit.AuditStorage
+	signingPolicyStorage        signingpolicy.SigningPolicyStorage
+	signingLimitStorage         signinglimit.SigningLimitStorage
+	signingLimitUsageStorage    signinglimit.SigningLimitUsageStorage
+	nonceStorage                nonce.NonceStorage
+	healthStorage               health.HealthStorage
+	roleStorage                 role.RoleStorage
+	permissionStorage           role.PermissionStorage
 }
 
 var repositoriesSet = wire.NewSet(
@@ -94,6 +129,7 @@ var repositoriesSet = wire.NewSet(
 	wire.Struct(new(hsmslotdb.HSMSlotRepositoryInfraOptions), "*"),
 
 	// HSM Slot Storage
+	providePinEncrypter,
 	hsmslotdbout.NewRepository,
 	wire.Bind(new(hsmslot.HSMSlotStorage), new(*hsmslotdbout.Repository)),
 	wire.Struct(new(hsmslotdbout.RepositoryOptions), "*"),
@@ -107,6 +143,75 @@ var repositoriesSet = wire.NewSet(
 	wire.Bind(new(referentialintegrity.ReferentialIntegrityStorage), new(*referentialintegritydbout.Repository)),
 	wire.Struct(new(referentialintegritydbout.RepositoryOptions), "*"),
 
+	// Audit Database Infra
+	auditdb.ProvideAuditRepositoryInfra,
+	wire.Struct(new(auditdb.AuditRepositoryInfraOptions), "*"),
+
+	// Audit Storage
+	auditdbout.NewRepository,
+	wire.Bind(new(audit.AuditStorage), new(*auditdbout.Repository)),
+	wire.Struct(new(auditdbout.RepositoryOptions), "*"),
+
+	// Signing Policy Database Infra
+	signingpolicydb.ProvideSigningPolicyRepositoryInfra,
+	wire.Struct(new(signingpolicydb.SigningPolicyRepositoryInfraOptions), "*"),
+
+	// Signing Policy Storage
+	signingpolicydbout.NewRepository,
+	wire.Bind(new(signingpolicy.SigningPolicyStorage), new(*signingpolicydbout.Repository)),
+	wire.Struct(new(signingpolicydbout.RepositoryOptions), "*"),
+
+	// Signing Limit Database Infra
+	signinglimitdb.ProvideSigningLimitRepositoryInfra,
+	wire.Struct(new(signinglimitdb.SigningLimitRepositoryInfraOptions), "*"),
+
+	// Signing Limit Storage
+	signinglimitdbout.NewRepository,
+	wire.Bind(new(signinglimit.SigningLimitStorage), new(*signinglimitdbout.Repository)),
+	wire.Struct(new(signinglimitdbout.RepositoryOptions), "*"),
+
+	// Signing Limit Usage Database Infra
+	signinglimitusagedb.ProvideSigningLimitUsageRepositoryInfra,
+	wire.Struct(new(signinglimitusagedb.SigningLimitUsageRepositoryInfraOptions), "*"),
+
+	// Signing Limit Usage Storage
+	signinglimitusagedbout.NewRepository,
+	wire.Bind(new(signinglimit.SigningLimitUsageStorage), new(*signinglimitusagedbout.Repository)),
+	wire.Struct(new(signinglimitusagedbout.RepositoryOptions), "*"),
+
+	// Nonce Database Infra
+	noncedb.ProvideNonceRepositoryInfra,
+	wire.Struct(new(noncedb.NonceRepositoryInfraOptions), "*"),
+
+	// Nonce Storage
+	noncedbout.NewRepository,
+	wire.Bind(new(nonce.NonceStorage), new(*noncedbout.Repository)),
+	wire.Struct(new(noncedbout.RepositoryOptions), "*"),
+
+	// Role Database Infra
+	roledb.ProvideRoleRepositoryInfra,
+	wire.Struct(new(roledb.RoleRepositoryInfraOptions), "*"),
+
+	// Role Storage
+	roledbout.NewRepository,
+	wire.Bind(new(role.RoleStorage), new(*roledbout.Repository)),
+	wire.Struct(new(roledbout.RepositoryOptions), "*"),
+
+	// Permission Database Infra
+	permissiondb.ProvidePermissionRepositoryInfra,
+	wire.Struct(new(permissiondb.PermissionRepositoryInfraOptions), "*"),
+
+	// Permission Storage
+	permissiondbout.NewRepository,
+	wire.Bind(new(role.PermissionStorage), new(*permissiondbout.Repository)),
+	wire.Struct(new(permissiondbout.RepositoryOptions), "*"),
+
+	// Health Storage
+	provideDatabaseMigrations,
+	healthdbout.NewRepository,
+	wire.Bind(new(health.HealthStorage), new(*healthdbout.Repository)),
+	wire.Struct(new(healthdbout.RepositoryOptions), "*"),
+
 	// Transactional Manager Storage
 	transactionaldbout.NewTransactionalRepository,
 	wire.Bind(new(transactionalmanager.TransactionalStorage), new(*transactionaldbout.TransactionalRepository)),
@@ -115,7 +220,53 @@ var repositoriesSet = wire.NewSet(
 
 func InitializeRepositories(
 	persistenceFramework persistence.Storage,
+	connection sql.Connection,
+	config Config,
 ) (*repositoriesGraph, error) {
 	wire.Build(repositoriesSet)
 	return &repositoriesGraph{}, nil
 }
+
+func provideDatabaseMigrations() fs.ReadDirFS {
+	return embedded.DatabaseMigrations
+}
+
+func providePinEncrypter(config Config) (*envelope.Encrypter, error) {
+	pinEncryption := config.Libraries.PinEncryption
+	if pinEncryption == nil {
+		return nil, nil
+	}
+	masterKey, err := envelope.ProvideMasterKey(mapMasterKeyOptions(pinEncryption.MasterKey))
+	if err != nil {
+		return nil, err
+	}
+	previousMasterKeys := make([]envelope.MasterKey, len(pinEncryption.PreviousMasterKeys))
+	for i, previousMasterKey := range pinEncryption.PreviousMasterKeys {
+		previousMasterKeys[i], err = envelope.ProvideMasterKey(mapMasterKeyOptions(previousMasterKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey:          masterKey,
+		PreviousMasterKeys: previousMasterKeys,
+	})
+}
+
+func mapMasterKeyOptions(config graphconfig.MasterKeyConfig) envelope.MasterKeyOptions {
+	options := envelope.MasterKeyOptions{}
+	if config.File != nil {
+		options.File = &envelope.FileMasterKeyOptions{
+			Path: config.File.Path,
+		}
+	}
+	if config.PKCS11 != nil {
+		options.PKCS11 = &envelope.PKCS11MasterKeyOptions{
+			Library:  config.PKCS11.Library,
+			Slot:     config.PKCS11.Slot,
+			Pin:      config.PKCS11.Pin,
+			KeyLabel: config.PKCS11.KeyLabel,
+		}
+	}
+	return options
+}
@@ -4,13 +4,15 @@ package upgrade
 import (
 	"github.com/asaskevich/govalidator"
 
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmslotdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
 )
 
 type UpgradeGraph struct {
 	config Config
 
-	librariesGraph *librariesGraph
+	librariesGraph    *librariesGraph
+	repositoriesGraph *repositoriesGraph
 }
 
 func New(config Config) (*UpgradeGraph, error) {
@@ -31,12 +33,21 @@ func (graph *UpgradeGraph) Build() {
 	// Libraries
 	graph.librariesGraph, err = initializeLibraries(graph.config)
 	checkError(err)
+
+	// Repositories
+	graph.repositoriesGraph, err = initializeRepositories(graph.librariesGraph.persistenceFramework, graph.config)
+	checkError(err)
 }
 
 func (graph *UpgradeGraph) PersistenceFwConnection() sql.Connection {
 	return graph.librariesGraph.persistenceConnection
 }
 
+// HSMSlotStorage returns the storage of the HSM slots, that re-encrypts their pins with the configured master key.
+func (graph *UpgradeGraph) HSMSlotStorage() *hsmslotdbout.Repository {
+	return graph.repositoriesGraph.hsmSlotStorage
+}
+
 func checkError(err error) {
 	if err != nil {
 		panic(err)
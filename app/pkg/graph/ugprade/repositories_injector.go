@@ -0,0 +1,78 @@
+//go:build wireinject
+
+package upgrade
+
+import (
+	"github.com/google/wire"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmslotdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
+)
+
+type repositoriesGraph struct {
+	hsmSlotStorage *hsmslotdbout.Repository
+}
+
+var repositoriesSet = wire.NewSet(
+	wire.Struct(new(repositoriesGraph), "*"),
+
+	// HSM Slot Database Infra
+	hsmslotdb.ProvideHSMSlotRepositoryInfra,
+	wire.Struct(new(hsmslotdb.HSMSlotRepositoryInfraOptions), "*"),
+
+	// HSM Slot Storage
+	providePinEncrypter,
+	hsmslotdbout.NewRepository,
+	wire.Struct(new(hsmslotdbout.RepositoryOptions), "*"),
+)
+
+func initializeRepositories(
+	persistenceFramework persistence.Storage,
+	config Config,
+) (*repositoriesGraph, error) {
+	wire.Build(repositoriesSet)
+	return &repositoriesGraph{}, nil
+}
+
+func providePinEncrypter(config Config) (*envelope.Encrypter, error) {
+	pinEncryption := config.Libraries.PinEncryption
+	if pinEncryption == nil {
+		return nil, nil
+	}
+	masterKey, err := envelope.ProvideMasterKey(mapMasterKeyOptions(pinEncryption.MasterKey))
+	if err != nil {
+		return nil, err
+	}
+	previousMasterKeys := make([]envelope.MasterKey, len(pinEncryption.PreviousMasterKeys))
+	for i, previousMasterKey := range pinEncryption.PreviousMasterKeys {
+		previousMasterKeys[i], err = envelope.ProvideMasterKey(mapMasterKeyOptions(previousMasterKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey:          masterKey,
+		PreviousMasterKeys: previousMasterKeys,
+	})
+}
+
+func mapMasterKeyOptions(config graphconfig.MasterKeyConfig) envelope.MasterKeyOptions {
+	options := envelope.MasterKeyOptions{}
+	if config.File != nil {
+		options.File = &envelope.FileMasterKeyOptions{
+			Path: config.File.Path,
+		}
+	}
+	if config.PKCS11 != nil {
+		options.PKCS11 = &envelope.PKCS11MasterKeyOptions{
+			Library:  config.PKCS11.Library,
+			Slot:     config.PKCS11.Slot,
+			Pin:      config.PKCS11.Pin,
+			KeyLabel: config.PKCS11.KeyLabel,
+		}
+	}
+	return options
+}
@@ -8,14 +8,16 @@ package upgrade
 
 import (
 	"errors"
-	"os"
-	"strings"
 
 	"github.com/google/wire"
 	"github.com/hyperledger-labs/signare/app"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmslotdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
-	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/sqlite"
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
 )
 
 // Injectors from libraries_injector.go:
@@ -43,6 +45,34 @@ func initializeLibraries(config Config) (*librariesGraph, error) {
 	return upgradeLibrariesGraph, nil
 }
 
+// Injectors from repositories_injector.go:
+
+func initializeRepositories(persistenceFramework persistence.Storage, config Config) (*repositoriesGraph, error) {
+	hsmSlotRepositoryInfraOptions := hsmslotdb.HSMSlotRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	hsmSlotRepositoryInfra, err := hsmslotdb.ProvideHSMSlotRepositoryInfra(hsmSlotRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := providePinEncrypter(config)
+	if err != nil {
+		return nil, err
+	}
+	repositoryOptions := hsmslotdbout.RepositoryOptions{
+		Infra:        hsmSlotRepositoryInfra,
+		PinEncrypter: encrypter,
+	}
+	repository, err := hsmslotdbout.NewRepository(repositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	upgradeRepositoriesGraph := &repositoriesGraph{
+		hsmSlotStorage: repository,
+	}
+	return upgradeRepositoriesGraph, nil
+}
+
 // libraries_injector.go:
 
 type librariesGraph struct {
@@ -108,12 +138,12 @@ func providePersistenceFwConnection(options persistenceFwConfigOptions) (sql.Con
 		}
 		connectionFwOptions.Postgres = &postgresConfig
 	} else if databaseInfo.SQLite != nil {
-		file, err := os.CreateTemp("", strings.ReplaceAll(time.Now().String(), " ", ""))
+		connectionString, err := sqlite.ConnectionString(databaseInfo.SQLite.Path)
 		if err != nil {
 			return nil, err
 		}
 		connectionFwOptions.SQLite = &sql.SQLiteInfo{
-			ConnectionString: file.Name(),
+			ConnectionString: connectionString,
 		}
 	} else {
 		return nil, errors.New("no valid database configuration")
@@ -133,3 +163,51 @@ func providePersistenceFwConfig(conn sql.Connection, options persistenceFwConfig
 
 	return fwOptions, nil
 }
+
+// repositories_injector.go:
+
+type repositoriesGraph struct {
+	hsmSlotStorage *hsmslotdbout.Repository
+}
+
+var repositoriesSet = wire.NewSet(wire.Struct(new(repositoriesGraph), "*"), hsmslotdb.ProvideHSMSlotRepositoryInfra, wire.Struct(new(hsmslotdb.HSMSlotRepositoryInfraOptions), "*"), providePinEncrypter, hsmslotdbout.NewRepository, wire.Struct(new(hsmslotdbout.RepositoryOptions), "*"))
+
+func providePinEncrypter(config Config) (*envelope.Encrypter, error) {
+	pinEncryption := config.Libraries.PinEncryption
+	if pinEncryption == nil {
+		return nil, nil
+	}
+	masterKey, err := envelope.ProvideMasterKey(mapMasterKeyOptions(pinEncryption.MasterKey))
+	if err != nil {
+		return nil, err
+	}
+	previousMasterKeys := make([]envelope.MasterKey, len(pinEncryption.PreviousMasterKeys))
+	for i, previousMasterKey := range pinEncryption.PreviousMasterKeys {
+		previousMasterKeys[i], err = envelope.ProvideMasterKey(mapMasterKeyOptions(previousMasterKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey:          masterKey,
+		PreviousMasterKeys: previousMasterKeys,
+	})
+}
+
+func mapMasterKeyOptions(config graphconfig.MasterKeyConfig) envelope.MasterKeyOptions {
+	options := envelope.MasterKeyOptions{}
+	if config.File != nil {
+		options.File = &envelope.FileMasterKeyOptions{
+			Path: config.File.Path,
+		}
+	}
+	if config.PKCS11 != nil {
+		options.PKCS11 = &envelope.PKCS11MasterKeyOptions{
+			Library:  config.PKCS11.Library,
+			Slot:     config.PKCS11.Slot,
+			Pin:      config.PKCS11.Pin,
+			KeyLabel: config.PKCS11.KeyLabel,
+		}
+	}
+	return options
+}
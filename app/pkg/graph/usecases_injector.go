@@ -12,13 +12,26 @@ import (
 
 	embedded "github.com/hyperledger-labs/signare/app"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/infile/roleinfile"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/cacheinvalidation"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/nonceallocator"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/pip"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/requester"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/signingquota"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/transactionpolicy"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnection"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
 )
 
@@ -34,8 +47,17 @@ type useCasesGraph struct {
 	HSMConnectionResolver       hsmconnection.Resolver
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
 	TransactionalManagerUseCase transactionalmanager.TransactionalManagerUseCase
+	AuditUseCase                audit.AuditUseCase
+	SigningPolicyUseCase        signingpolicy.SigningPolicyUseCase
+	SigningLimitUseCase         signinglimit.SigningLimitUseCase
+	NonceUseCase                nonce.NonceUseCase
+	HSMHealthUseCase            hsmhealth.HSMHealthUseCase
+	HSMHealthMonitor            *hsmhealth.Monitor
+	HealthUseCase               health.HealthUseCase
 
 	DigitalSignatureManagerFactory hsmconnector.DigitalSignatureManagerFactory
+
+	PIPCache *pip.Cache
 }
 
 var useCasesSet = wire.NewSet(
@@ -85,19 +107,64 @@ var useCasesSet = wire.NewSet(
 	hsmslot.ProvideDefaultUseCase,
 	wire.Struct(new(hsmslot.DefaultUseCaseOptions), "*"),
 
-	// HMS Connector Use Case
+	// Audit Use Case
+	audit.ProvideDefaultUseCase,
+	wire.Bind(new(audit.AuditUseCase), new(*audit.DefaultUseCase)),
+	wire.Struct(new(audit.DefaultUseCaseOptions), "*"),
+	requester.ProvideDefaultAuditIdentityAdapter,
+	wire.Bind(new(audit.IdentityPort), new(*requester.DefaultAuditIdentityAdapter)),
+	wire.Struct(new(requester.DefaultAuditIdentityAdapterOptions), "*"),
+
+	// Signing Policy Use Case
+	signingpolicy.ProvideDefaultUseCase,
+	wire.Bind(new(signingpolicy.SigningPolicyUseCase), new(*signingpolicy.DefaultUseCase)),
+	wire.Struct(new(signingpolicy.DefaultUseCaseOptions), "*"),
+	transactionpolicy.ProvideDefaultTransactionPolicyAdapter,
+	wire.Bind(new(hsmconnector.TransactionPolicyPort), new(*transactionpolicy.DefaultTransactionPolicyAdapter)),
+	wire.Struct(new(transactionpolicy.DefaultTransactionPolicyAdapterOptions), "*"),
+
+	// Signing Limit Use Case [Transactional]
+	signinglimit.ProvideDefaultUseCaseTransactionalDecorator,
+	wire.Bind(new(signinglimit.SigningLimitUseCase), new(*signinglimit.DefaultUseCaseTransactionalDecorator)),
+	wire.Struct(new(signinglimit.DefaultUseCaseTransactionalDecoratorOptions), "*"),
+	signinglimit.ProvideDefaultUseCase,
+	wire.Struct(new(signinglimit.DefaultUseCaseOptions), "*"),
+	signingquota.ProvideDefaultSigningQuotaAdapter,
+	wire.Bind(new(hsmconnector.SigningQuotaPort), new(*signingquota.DefaultSigningQuotaAdapter)),
+	wire.Struct(new(signingquota.DefaultSigningQuotaAdapterOptions), "*"),
+
+	// Nonce Use Case [Transactional]
+	nonce.ProvideDefaultUseCaseTransactionalDecorator,
+	wire.Bind(new(nonce.NonceUseCase), new(*nonce.DefaultUseCaseTransactionalDecorator)),
+	wire.Struct(new(nonce.DefaultUseCaseTransactionalDecoratorOptions), "*"),
+	nonce.ProvideDefaultUseCase,
+	wire.Struct(new(nonce.DefaultUseCaseOptions), "*"),
+	nonceallocator.ProvideDefaultNonceAllocatorAdapter,
+	wire.Bind(new(hsmconnector.NoncePort), new(*nonceallocator.DefaultNonceAllocatorAdapter)),
+	wire.Struct(new(nonceallocator.DefaultNonceAllocatorAdapterOptions), "*"),
+
+	// HMS Connector Use Case [Audited, Transactional]
+	hsmconnector.ProvideDefaultUseCaseAuditDecorator,
+	wire.Bind(new(hsmconnector.HSMConnector), new(*hsmconnector.DefaultUseCaseAuditDecorator)),
+	wire.Struct(new(hsmconnector.DefaultUseCaseAuditDecoratorOptions), "*"),
+	hsmconnector.ProvideDefaultUseCaseTransactionalDecorator,
+	wire.Struct(new(hsmconnector.DefaultUseCaseTransactionalDecoratorOptions), "*"),
 	hsmconnector.ProvideDefaultHSMConnector,
-	wire.Bind(new(hsmconnector.HSMConnector), new(*hsmconnector.DefaultUseCase)),
 	wire.Struct(new(hsmconnector.DefaultUseCaseOptions), "*"),
 
-	// Role Use Case
+	// Role Use Case [Transactional]
+	role.ProvideDefaultUseCaseTransactionalDecorator,
+	wire.Bind(new(role.RoleUseCase), new(*role.DefaultUseCaseTransactionalDecorator)),
+	wire.Struct(new(role.DefaultUseCaseTransactionalDecoratorOptions), "*"),
 	provideDefaultRoleStorageInFile,
 	role.ProvideDefaultRoleUseCase,
-	wire.Bind(new(role.RoleUseCase), new(*role.DefaultRoleUseCase)),
 	wire.Struct(new(role.DefaultRoleUseCaseOptions), "*"),
 
 	// Digital Signature Manager DigitalSignatureManagerFactory
 	provideSoftHSMConfiguration,
+	provideCloudKMSConfiguration,
+	providePKCS11Libraries,
+	provideOperationTimeouts,
 	hsmconnector.ProvideDefaultDigitalSignatureManagerFactory,
 	wire.Bind(new(hsmconnector.DigitalSignatureManagerFactory), new(*hsmconnector.DefaultDigitalSignatureManagerFactory)),
 	wire.Struct(new(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions), "*"),
@@ -106,6 +173,37 @@ var useCasesSet = wire.NewSet(
 	hsmconnection.ProvideDefaultHSMConnectionResolver,
 	wire.Bind(new(hsmconnection.Resolver), new(*hsmconnection.DefaultHSMConnectionResolver)),
 	wire.Struct(new(hsmconnection.DefaultHSMConnectionResolverOptions), "*"),
+
+	// HSM Health
+	hsmhealth.ProvideDefaultUseCase,
+	wire.Bind(new(hsmhealth.HSMHealthUseCase), new(*hsmhealth.DefaultUseCase)),
+	wire.Struct(new(hsmhealth.DefaultUseCaseOptions), "*"),
+	provideHSMHealthMonitorInterval,
+	hsmhealth.ProvideMonitor,
+	wire.Struct(new(hsmhealth.MonitorOptions), "*"),
+
+	// Health
+	health.ProvideDefaultUseCase,
+	wire.Bind(new(health.HealthUseCase), new(*health.DefaultUseCase)),
+	wire.Struct(new(health.DefaultUseCaseOptions), "*"),
+	provideSchemaVersionCheckMode,
+
+	// Caches
+	provideCacheConfiguration,
+	cache.ProvideMetrics,
+	wire.Struct(new(cache.MetricsOptions), "*"),
+	hsmconnection.ProvideConnectionCache,
+	wire.Struct(new(hsmconnection.ConnectionCacheOptions), "*"),
+	pip.ProvideCache,
+	wire.Struct(new(pip.CacheOptions), "*"),
+	cacheinvalidation.ProvideDefaultCacheInvalidationAdapter,
+	wire.Bind(new(application.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Bind(new(hsmslot.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Bind(new(hsmmodule.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Bind(new(user.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Bind(new(admin.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Bind(new(role.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)),
+	wire.Struct(new(cacheinvalidation.DefaultCacheInvalidationAdapterOptions), "*"),
 )
 
 func initializeUseCases(
@@ -123,6 +221,14 @@ func initializeUseCases(
 			"hsmSlotStorage",
 			"referentialIntegrityStorage",
 			"transactionalStorage",
+			"auditStorage",
+			"signingPolicyStorage",
+			"signingLimitStorage",
+			"signingLimitUsageStorage",
+			"nonceStorage",
+			"healthStorage",
+			"roleStorage",
+			"permissionStorage",
 		),
 	)
 	return &useCasesGraph{}, nil
@@ -136,7 +242,46 @@ func provideSoftHSMConfiguration(config Config) *hsmconnector.PKCS11Library {
 	return nil
 }
 
-func provideDefaultRoleStorageInFile() role.RoleStorage {
+func provideCloudKMSConfiguration(config Config) *hsmconnector.CloudKMSConfiguration {
+	cloudKMS := config.Libraries.HSMModules.CloudKMS
+	if cloudKMS == nil {
+		return nil
+	}
+	cloudKMSConfig := hsmconnector.CloudKMSConfiguration{
+		Endpoint: cloudKMS.Endpoint,
+		Region:   cloudKMS.Region,
+	}
+	if cloudKMS.AccessKeyID != nil {
+		cloudKMSConfig.AccessKeyID = *cloudKMS.AccessKeyID
+	}
+	if cloudKMS.SecretAccessKey != nil {
+		cloudKMSConfig.SecretAccessKey = *cloudKMS.SecretAccessKey
+	}
+	if cloudKMS.SessionToken != nil {
+		cloudKMSConfig.SessionToken = *cloudKMS.SessionToken
+	}
+	return &cloudKMSConfig
+}
+
+func providePKCS11Libraries(config Config) hsmconnector.PKCS11Libraries {
+	if config.Libraries.HSMModules.PKCS11 == nil {
+		return nil
+	}
+	libraries := make(hsmconnector.PKCS11Libraries, len(config.Libraries.HSMModules.PKCS11.Libraries))
+	for i, library := range config.Libraries.HSMModules.PKCS11.Libraries {
+		libraries[i] = hsmconnector.PKCS11Library(library)
+	}
+	return libraries
+}
+
+func provideSchemaVersionCheckMode(config Config) health.SchemaVersionCheckMode {
+	if config.SchemaVersionCheck == nil || config.SchemaVersionCheck.Mode == nil {
+		return health.SchemaVersionCheckRefuse
+	}
+	return health.SchemaVersionCheckMode(*config.SchemaVersionCheck.Mode)
+}
+
+func provideDefaultRoleStorageInFile() role.BuiltInStorage {
 	defaultRoleStorageInFileOptions := roleinfile.DefaultRoleStorageInFileOptions{
 		FileSystem: embedded.RBACFiles,
 		BasePath:   "include/rbac",
@@ -9,8 +9,8 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
-	"strings"
 
 	"github.com/google/wire"
 	"github.com/hyperledger-labs/signare/app"
@@ -18,31 +18,49 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/httpmiddlewarein/pepin"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/metricsout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/rpcin"
-	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/infile/pipinfile"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/healthdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/infile/roleinfile"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/accountdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/admindbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/applicationdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/auditdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/hsmslotdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/noncedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/permissiondbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/referentialintegritydbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/roledbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitusagedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signingpolicydbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/userdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/transactionaldbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/cacheinvalidation"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/nonceallocator"
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/pip"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/requester"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/signingquota"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/transactionpolicy"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/envelope"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql"
-	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/sqlite"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/generated/httpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/healthhttpinfra"
 	httpinfra2 "github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/metricshttpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/certificatecontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/httpcontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/rpccontextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/tokencontextdefinition"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextvalidation"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authorization"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authorization/pep"
@@ -54,36 +72,59 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/accountdb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/admindb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/applicationdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/auditdb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmmoduledb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/hsmslotdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/noncedb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/permissiondb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/referentialintegritydb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/roledb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitdb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signinglimitusagedb"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/signingpolicydb"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/storage/userdb"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/admin"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnection"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/user"
 )
 
 // Injectors from http_api_injector.go:
 
-func initializeHTTPAPI(useCases *useCasesGraph, infra *infraGraph) (*httpAPIGraph, error) {
+func initializeHTTPAPI(useCases *useCasesGraph, infra *infraGraph, httpMiddleware *httpMiddlewareGraph) (*httpAPIGraph, error) {
 	defaultHTTPRouter := provideMainRouter(infra)
 	applicationUseCase := useCases.ApplicationUseCase
 	adminUseCase := useCases.AdminUseCase
 	hsmModuleUseCase := useCases.HSMModuleUseCase
 	hsmSlotUseCase := useCases.HSMSlotUseCase
+	auditUseCase := useCases.AuditUseCase
+	nonceUseCase := useCases.NonceUseCase
+	hsmHealthUseCase := useCases.HSMHealthUseCase
+	roleUseCase := useCases.RoleUseCase
+	policyDecisionPointUseCase := httpMiddleware.PolicyDecisionPointUseCase
 	defaultAdminAPIAdapterOptions := httpin.DefaultAdminAPIAdapterOptions{
-		ApplicationUseCase: applicationUseCase,
-		AdminUseCase:       adminUseCase,
-		HSMUseCase:         hsmModuleUseCase,
-		HSMSlotUseCase:     hsmSlotUseCase,
+		ApplicationUseCase:  applicationUseCase,
+		AdminUseCase:        adminUseCase,
+		HSMUseCase:          hsmModuleUseCase,
+		HSMSlotUseCase:      hsmSlotUseCase,
+		AuditUseCase:        auditUseCase,
+		NonceUseCase:        nonceUseCase,
+		HSMHealthUseCase:    hsmHealthUseCase,
+		RoleUseCase:         roleUseCase,
+		PolicyDecisionPoint: policyDecisionPointUseCase,
 	}
 	defaultAdminAPIAdapter, err := httpin.ProvideDefaultAdminAPIAdapter(defaultAdminAPIAdapterOptions)
 	if err != nil {
@@ -107,8 +148,12 @@ func initializeHTTPAPI(useCases *useCasesGraph, infra *infraGraph) (*httpAPIGrap
 		return nil, err
 	}
 	userUseCase := useCases.UserUseCase
+	signingPolicyUseCase := useCases.SigningPolicyUseCase
+	signingLimitUseCase := useCases.SigningLimitUseCase
 	defaultApplicationAPIAdapterOptions := httpin.DefaultApplicationAPIAdapterOptions{
-		UserUseCase: userUseCase,
+		UserUseCase:          userUseCase,
+		SigningPolicyUseCase: signingPolicyUseCase,
+		SigningLimitUseCase:  signingLimitUseCase,
 	}
 	defaultApplicationAPIAdapter, err := httpin.ProvideDefaultApplicationAPIAdapter(defaultApplicationAPIAdapterOptions)
 	if err != nil {
@@ -130,6 +175,24 @@ func initializeHTTPAPI(useCases *useCasesGraph, infra *infraGraph) (*httpAPIGrap
 	if err != nil {
 		return nil, err
 	}
+	healthHTTPRouter := provideHealthRouter(infra)
+	healthUseCase := useCases.HealthUseCase
+	defaultHealthAPIAdapterOptions := httpin.DefaultHealthAPIAdapterOptions{
+		HealthUseCase: healthUseCase,
+	}
+	defaultHealthAPIAdapter, err := httpin.ProvideDefaultHealthAPIAdapter(defaultHealthAPIAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
+	healthHTTPOptions := healthhttpinfra.HealthHTTPOptions{
+		HTTPInfra:       healthHTTPRouter,
+		Adapter:         defaultHealthAPIAdapter,
+		ResponseHandler: httpResponseHandler,
+	}
+	healthHTTPRoutesPublished, err := healthhttpinfra.ProvideHealthHTTP(healthHTTPOptions)
+	if err != nil {
+		return nil, err
+	}
 	defaultRPCRouter := infra.rpcRouter
 	accountUseCase := useCases.AccountUseCase
 	resolver := useCases.HSMConnectionResolver
@@ -161,6 +224,7 @@ func initializeHTTPAPI(useCases *useCasesGraph, infra *infraGraph) (*httpAPIGrap
 	graphHttpAPIGraph := &httpAPIGraph{
 		adminAPIRoutesPublished:       adminAPIRoutesPublished,
 		applicationAPIRoutesPublished: applicationAPIRoutesPublished,
+		healthRoutesPublished:         healthHTTPRoutesPublished,
 		rpcAPIRoutesPublished:         jsonrpcapiRoutesPublished,
 	}
 	return graphHttpAPIGraph, nil
@@ -192,6 +256,7 @@ func initializeInfra(metricRecorder metricrecorder.MetricRecorder) (*infraGraph,
 	}
 	defaultHTTPRouter := httpinfra2.ProvideHTTPRouter()
 	metricsHTTPRouter := httpinfra2.ProvideMetricsHTTPRouter()
+	healthHTTPRouter := httpinfra2.ProvideHealthHTTPRouter()
 	defaultRPCRouterOptions := rpcinfra.DefaultRPCRouterOptions{
 		DefaultRPCInfraResponseHandler: defaultRPCInfraResponseHandler,
 		HTTPMetrics:                    defaultHTTPMetrics,
@@ -202,6 +267,7 @@ func initializeInfra(metricRecorder metricrecorder.MetricRecorder) (*infraGraph,
 		defaultRPCInfraResponseHandler: defaultRPCInfraResponseHandler,
 		mainHTTPRouter:                 defaultHTTPRouter,
 		metricsHTTPRouter:              metricsHTTPRouter,
+		healthHTTPRouter:               healthHTTPRouter,
 		rpcRouter:                      defaultRPCRouter,
 	}
 	return graphInfraGraph, nil
@@ -267,7 +333,7 @@ func InitializeDummyMetrics() (*dummyMetricsGraph, error) {
 
 // Injectors from middleware_injector.go:
 
-func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metricRecorder metricrecorder.MetricRecorder, configuration contextdefinition.AuthHeadersConfiguration) (*httpMiddlewareGraph, error) {
+func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metricRecorder metricrecorder.MetricRecorder, configuration contextdefinition.AuthHeadersConfiguration, authentication2 *authenticationConfiguration) (*httpMiddlewareGraph, error) {
 	defaultHTTPRouter := infra.mainHTTPRouter
 	httpContextDefinitionOptions := httpcontextdefinition.HTTPContextDefinitionOptions{
 		AuthHeadersConfiguration: configuration,
@@ -277,6 +343,10 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 	if err != nil {
 		return nil, err
 	}
+	contextDefinition, err := provideHTTPContextDefinition(httpContextDefinition, authentication2)
+	if err != nil {
+		return nil, err
+	}
 	httpResponseHandler := infra.httpAPIResponseHandler
 	requestContextValidationOptions := contextvalidation.RequestContextValidationOptions{
 		ResponseHandler: httpResponseHandler,
@@ -286,7 +356,7 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 		return nil, err
 	}
 	authenticationMiddlewareOptions := authentication.AuthenticationMiddlewareOptions{
-		ContextDefinition:        httpContextDefinition,
+		ContextDefinition:        contextDefinition,
 		RequestContextValidation: requestContextValidation,
 	}
 	authenticationMiddleware, err := authentication.ProvideAuthenticationMiddleware(authenticationMiddlewareOptions)
@@ -294,17 +364,28 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 		return nil, err
 	}
 	accountUseCase := useCases.AccountUseCase
+	cache := useCases.PIPCache
 	defaultAccountsPIPAdapterOptions := pip.DefaultAccountsPIPAdapterOptions{
 		AccountUseCase: accountUseCase,
+		Cache:          cache,
 	}
 	defaultAccountsPIPAdapter, err := pip.ProvideDefaultAccountsPIPAdapter(defaultAccountsPIPAdapterOptions)
 	if err != nil {
 		return nil, err
 	}
-	defaultRBACActionsPolicyInformationPointYAMLOutputAdapter := ProvidePolicyInformationPointYAMLOutputAdapter()
+	roleUseCase := useCases.RoleUseCase
+	defaultActionsPIPAdapterOptions := pip.DefaultActionsPIPAdapterOptions{
+		RoleUseCase: roleUseCase,
+		Cache:       cache,
+	}
+	defaultActionsPIPAdapter, err := pip.ProvideDefaultActionsPIPAdapter(defaultActionsPIPAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
 	adminUseCase := useCases.AdminUseCase
 	defaultAdminsPIPAdapterOptions := pip.DefaultAdminsPIPAdapterOptions{
 		AdminUseCase: adminUseCase,
+		Cache:        cache,
 	}
 	defaultAdminsPIPAdapter, err := pip.ProvideDefaultAdminsPIPAdapter(defaultAdminsPIPAdapterOptions)
 	if err != nil {
@@ -313,6 +394,7 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 	userUseCase := useCases.UserUseCase
 	defaultUsersPIPAdapterOptions := pip.DefaultUsersPIPAdapterOptions{
 		UserUseCase: userUseCase,
+		Cache:       cache,
 	}
 	defaultUsersPIPAdapter, err := pip.ProvideDefaultUsersPIPAdapter(defaultUsersPIPAdapterOptions)
 	if err != nil {
@@ -320,7 +402,7 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 	}
 	defaultPolicyDecisionPointUseCaseOptions := pdp.DefaultPolicyDecisionPointUseCaseOptions{
 		AccountsPolicyInformationAdapter:  defaultAccountsPIPAdapter,
-		ActionsPolicyInformationPointPort: defaultRBACActionsPolicyInformationPointYAMLOutputAdapter,
+		ActionsPolicyInformationPointPort: defaultActionsPIPAdapter,
 		AdminsPolicyInformationAdapter:    defaultAdminsPIPAdapter,
 		UsersPolicyInformationAdapter:     defaultUsersPIPAdapter,
 	}
@@ -390,12 +472,13 @@ func initializeHTTPMiddleware(infra *infraGraph, useCases *useCasesGraph, metric
 		return nil, err
 	}
 	graphHttpMiddlewareGraph := &httpMiddlewareGraph{
-		HTTPMiddlewareFactory: httpMiddlewareFactory,
+		HTTPMiddlewareFactory:      httpMiddlewareFactory,
+		PolicyDecisionPointUseCase: defaultPolicyDecisionPointUseCase,
 	}
 	return graphHttpMiddlewareGraph, nil
 }
 
-func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricRecorder metricrecorder.MetricRecorder, configuration contextdefinition.AuthHeadersConfiguration) (*rpcMiddlewareGraph, error) {
+func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricRecorder metricrecorder.MetricRecorder, configuration contextdefinition.AuthHeadersConfiguration, authentication2 *authenticationConfiguration, batchConfiguration rpcbatchrequestsupport.BatchConfiguration) (*rpcMiddlewareGraph, error) {
 	defaultRPCInfraResponseHandler := infra.defaultRPCInfraResponseHandler
 	defaultRPCRouter := infra.rpcRouter
 	rpcContextDefinitionOptions := rpccontextdefinition.RPCContextDefinitionOptions{
@@ -407,6 +490,10 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 	if err != nil {
 		return nil, err
 	}
+	contextDefinition, err := provideRPCContextDefinition(rpcContextDefinition, authentication2)
+	if err != nil {
+		return nil, err
+	}
 	requestContextValidationOptions := contextvalidation.RequestContextValidationOptions{
 		ResponseHandler: defaultRPCInfraResponseHandler,
 	}
@@ -415,7 +502,7 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 		return nil, err
 	}
 	authenticationMiddlewareOptions := authentication.AuthenticationMiddlewareOptions{
-		ContextDefinition:        rpcContextDefinition,
+		ContextDefinition:        contextDefinition,
 		RequestContextValidation: requestContextValidation,
 	}
 	authenticationMiddleware, err := authentication.ProvideAuthenticationMiddleware(authenticationMiddlewareOptions)
@@ -423,17 +510,28 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 		return nil, err
 	}
 	accountUseCase := useCases.AccountUseCase
+	cache := useCases.PIPCache
 	defaultAccountsPIPAdapterOptions := pip.DefaultAccountsPIPAdapterOptions{
 		AccountUseCase: accountUseCase,
+		Cache:          cache,
 	}
 	defaultAccountsPIPAdapter, err := pip.ProvideDefaultAccountsPIPAdapter(defaultAccountsPIPAdapterOptions)
 	if err != nil {
 		return nil, err
 	}
-	defaultRBACActionsPolicyInformationPointYAMLOutputAdapter := ProvidePolicyInformationPointYAMLOutputAdapter()
+	roleUseCase := useCases.RoleUseCase
+	defaultActionsPIPAdapterOptions := pip.DefaultActionsPIPAdapterOptions{
+		RoleUseCase: roleUseCase,
+		Cache:       cache,
+	}
+	defaultActionsPIPAdapter, err := pip.ProvideDefaultActionsPIPAdapter(defaultActionsPIPAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
 	adminUseCase := useCases.AdminUseCase
 	defaultAdminsPIPAdapterOptions := pip.DefaultAdminsPIPAdapterOptions{
 		AdminUseCase: adminUseCase,
+		Cache:        cache,
 	}
 	defaultAdminsPIPAdapter, err := pip.ProvideDefaultAdminsPIPAdapter(defaultAdminsPIPAdapterOptions)
 	if err != nil {
@@ -442,6 +540,7 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 	userUseCase := useCases.UserUseCase
 	defaultUsersPIPAdapterOptions := pip.DefaultUsersPIPAdapterOptions{
 		UserUseCase: userUseCase,
+		Cache:       cache,
 	}
 	defaultUsersPIPAdapter, err := pip.ProvideDefaultUsersPIPAdapter(defaultUsersPIPAdapterOptions)
 	if err != nil {
@@ -449,7 +548,7 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 	}
 	defaultPolicyDecisionPointUseCaseOptions := pdp.DefaultPolicyDecisionPointUseCaseOptions{
 		AccountsPolicyInformationAdapter:  defaultAccountsPIPAdapter,
-		ActionsPolicyInformationPointPort: defaultRBACActionsPolicyInformationPointYAMLOutputAdapter,
+		ActionsPolicyInformationPointPort: defaultActionsPIPAdapter,
 		AdminsPolicyInformationAdapter:    defaultAdminsPIPAdapter,
 		UsersPolicyInformationAdapter:     defaultUsersPIPAdapter,
 	}
@@ -500,6 +599,7 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 	rpcBatchRequestSupportMiddlewareOptions := rpcbatchrequestsupport.RPCBatchRequestSupportMiddlewareOptions{
 		ResponseHandler: defaultRPCInfraResponseHandler,
 		RPCRouter:       defaultRPCRouter,
+		Configuration:   batchConfiguration,
 	}
 	rpcBatchRequestSupportMiddleware, err := rpcbatchrequestsupport.ProvideRPCBatchRequestSupportMiddleware(rpcBatchRequestSupportMiddlewareOptions)
 	if err != nil {
@@ -535,7 +635,7 @@ func initializeRPCMiddleware(infra *infraGraph, useCases *useCasesGraph, metricR
 
 // Injectors from repositories_injector.go:
 
-func InitializeRepositories(persistenceFramework persistence.Storage) (*repositoriesGraph, error) {
+func InitializeRepositories(persistenceFramework persistence.Storage, connection sql.Connection, config Config) (*repositoriesGraph, error) {
 	applicationRepositoryInfraOptions := applicationdb.ApplicationRepositoryInfraOptions{
 		GenericStorage: persistenceFramework,
 	}
@@ -610,8 +710,13 @@ func InitializeRepositories(persistenceFramework persistence.Storage) (*reposito
 	if err != nil {
 		return nil, err
 	}
+	encrypter, err := providePinEncrypter(config)
+	if err != nil {
+		return nil, err
+	}
 	hsmslotdboutRepositoryOptions := hsmslotdbout.RepositoryOptions{
-		Infra: hsmSlotRepositoryInfra,
+		Infra:        hsmSlotRepositoryInfra,
+		PinEncrypter: encrypter,
 	}
 	hsmslotdboutRepository, err := hsmslotdbout.NewRepository(hsmslotdboutRepositoryOptions)
 	if err != nil {
@@ -635,6 +740,110 @@ func InitializeRepositories(persistenceFramework persistence.Storage) (*reposito
 		Storage: persistenceFramework,
 	}
 	transactionalRepository := transactionaldbout.NewTransactionalRepository(transactionalRepositoryOptions)
+	auditRepositoryInfraOptions := auditdb.AuditRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	auditRepositoryInfra := auditdb.ProvideAuditRepositoryInfra(auditRepositoryInfraOptions)
+	auditdboutRepositoryOptions := auditdbout.RepositoryOptions{
+		Infra: auditRepositoryInfra,
+	}
+	auditdboutRepository, err := auditdbout.NewRepository(auditdboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	signingPolicyRepositoryInfraOptions := signingpolicydb.SigningPolicyRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	signingPolicyRepositoryInfra, err := signingpolicydb.ProvideSigningPolicyRepositoryInfra(signingPolicyRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	signingpolicydboutRepositoryOptions := signingpolicydbout.RepositoryOptions{
+		Infra: signingPolicyRepositoryInfra,
+	}
+	signingpolicydboutRepository, err := signingpolicydbout.NewRepository(signingpolicydboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	signingLimitRepositoryInfraOptions := signinglimitdb.SigningLimitRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	signingLimitRepositoryInfra, err := signinglimitdb.ProvideSigningLimitRepositoryInfra(signingLimitRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	signinglimitdboutRepositoryOptions := signinglimitdbout.RepositoryOptions{
+		Infra: signingLimitRepositoryInfra,
+	}
+	signinglimitdboutRepository, err := signinglimitdbout.NewRepository(signinglimitdboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	signingLimitUsageRepositoryInfraOptions := signinglimitusagedb.SigningLimitUsageRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	signingLimitUsageRepositoryInfra, err := signinglimitusagedb.ProvideSigningLimitUsageRepositoryInfra(signingLimitUsageRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	signinglimitusagedboutRepositoryOptions := signinglimitusagedbout.RepositoryOptions{
+		Infra: signingLimitUsageRepositoryInfra,
+	}
+	signinglimitusagedboutRepository, err := signinglimitusagedbout.NewRepository(signinglimitusagedboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	nonceRepositoryInfraOptions := noncedb.NonceRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	nonceRepositoryInfra, err := noncedb.ProvideNonceRepositoryInfra(nonceRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	noncedboutRepositoryOptions := noncedbout.RepositoryOptions{
+		Infra: nonceRepositoryInfra,
+	}
+	noncedboutRepository, err := noncedbout.NewRepository(noncedboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	readDirFS := provideDatabaseMigrations()
+	healthdboutRepositoryOptions := healthdbout.RepositoryOptions{
+		Connection:   connection,
+		MigrationsFS: readDirFS,
+	}
+	healthdboutRepository, err := healthdbout.NewRepository(healthdboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	roleRepositoryInfraOptions := roledb.RoleRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	roleRepositoryInfra, err := roledb.ProvideRoleRepositoryInfra(roleRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	roledboutRepositoryOptions := roledbout.RepositoryOptions{
+		Infra: roleRepositoryInfra,
+	}
+	roledboutRepository, err := roledbout.NewRepository(roledboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
+	permissionRepositoryInfraOptions := permissiondb.PermissionRepositoryInfraOptions{
+		GenericStorage: persistenceFramework,
+	}
+	permissionRepositoryInfra, err := permissiondb.ProvidePermissionRepositoryInfra(permissionRepositoryInfraOptions)
+	if err != nil {
+		return nil, err
+	}
+	permissiondboutRepositoryOptions := permissiondbout.RepositoryOptions{
+		Infra: permissionRepositoryInfra,
+	}
+	permissiondboutRepository, err := permissiondbout.NewRepository(permissiondboutRepositoryOptions)
+	if err != nil {
+		return nil, err
+	}
 	graphRepositoriesGraph := &repositoriesGraph{
 		applicationStorage:          repository,
 		userStorage:                 userdboutRepository,
@@ -644,6 +853,14 @@ func InitializeRepositories(persistenceFramework persistence.Storage) (*reposito
 		hsmSlotStorage:              hsmslotdboutRepository,
 		referentialIntegrityStorage: referentialintegritydboutRepository,
 		transactionalStorage:        transactionalRepository,
+		auditStorage:                auditdboutRepository,
+		signingPolicyStorage:        signingpolicydboutRepository,
+		signingLimitStorage:         signinglimitdboutRepository,
+		signingLimitUsageStorage:    signinglimitusagedboutRepository,
+		nonceStorage:                noncedboutRepository,
+		healthStorage:               healthdboutRepository,
+		roleStorage:                 roledboutRepository,
+		permissionStorage:           permissiondboutRepository,
 	}
 	return graphRepositoriesGraph, nil
 }
@@ -660,9 +877,42 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 	if err != nil {
 		return nil, err
 	}
+	configuration := provideCacheConfiguration(config)
+	metricsOptions := cache.MetricsOptions{
+		MetricRecorder: metricRecorder,
+	}
+	metrics, err := cache.ProvideMetrics(metricsOptions)
+	if err != nil {
+		return nil, err
+	}
+	connectionCacheOptions := hsmconnection.ConnectionCacheOptions{
+		Configuration: configuration,
+		Metrics:       metrics,
+	}
+	connectionCache, err := hsmconnection.ProvideConnectionCache(connectionCacheOptions)
+	if err != nil {
+		return nil, err
+	}
+	cacheOptions := pip.CacheOptions{
+		Configuration: configuration,
+		Metrics:       metrics,
+	}
+	pipCache, err := pip.ProvideCache(cacheOptions)
+	if err != nil {
+		return nil, err
+	}
+	defaultCacheInvalidationAdapterOptions := cacheinvalidation.DefaultCacheInvalidationAdapterOptions{
+		ConnectionCache: connectionCache,
+		PIPCache:        pipCache,
+	}
+	defaultCacheInvalidationAdapter, err := cacheinvalidation.ProvideDefaultCacheInvalidationAdapter(defaultCacheInvalidationAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
 	applicationDefaultUseCaseOptions := application.DefaultUseCaseOptions{
 		Storage:                     applicationStorage,
 		ReferentialIntegrityUseCase: defaultUseCase,
+		CacheInvalidation:           defaultCacheInvalidationAdapter,
 	}
 	applicationDefaultUseCase, err := application.ProvideDefaultUseCase(applicationDefaultUseCaseOptions)
 	if err != nil {
@@ -674,6 +924,7 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 	hsmmoduleDefaultUseCaseOptions := hsmmodule.DefaultUseCaseOptions{
 		HSMModuleStorage:            hsmModuleStorage,
 		ReferentialIntegrityUseCase: defaultUseCase,
+		CacheInvalidation:           defaultCacheInvalidationAdapter,
 	}
 	hsmmoduleDefaultUseCase, err := hsmmodule.ProvideDefaultHSMModuleUseCase(hsmmoduleDefaultUseCaseOptions)
 	if err != nil {
@@ -694,26 +945,133 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 	}
 	hsmSlotStorage := repositories.hsmSlotStorage
 	pkcs11Library := provideSoftHSMConfiguration(config)
+	cloudKMSConfiguration := provideCloudKMSConfiguration(config)
+	pkcs11Libraries := providePKCS11Libraries(config)
+	operationTimeouts := provideOperationTimeouts(config)
 	defaultDigitalSignatureManagerFactoryOptions := hsmconnector.DefaultDigitalSignatureManagerFactoryOptions{
-		SoftHSMLibrary: pkcs11Library,
+		SoftHSMLibrary:    pkcs11Library,
+		CloudKMS:          cloudKMSConfiguration,
+		PKCS11Libraries:   pkcs11Libraries,
+		OperationTimeouts: operationTimeouts,
 	}
 	defaultDigitalSignatureManagerFactory, err := hsmconnector.ProvideDefaultDigitalSignatureManagerFactory(defaultDigitalSignatureManagerFactoryOptions)
 	if err != nil {
 		return nil, err
 	}
+	signingPolicyStorage := repositories.signingPolicyStorage
+	signingpolicyDefaultUseCaseOptions := signingpolicy.DefaultUseCaseOptions{
+		Storage:                     signingPolicyStorage,
+		ApplicationUseCase:          applicationDefaultUseCase,
+		ReferentialIntegrityUseCase: defaultUseCase,
+	}
+	signingpolicyDefaultUseCase, err := signingpolicy.ProvideDefaultUseCase(signingpolicyDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	defaultTransactionPolicyAdapterOptions := transactionpolicy.DefaultTransactionPolicyAdapterOptions{
+		SigningPolicyUseCase: signingpolicyDefaultUseCase,
+	}
+	defaultTransactionPolicyAdapter, err := transactionpolicy.ProvideDefaultTransactionPolicyAdapter(defaultTransactionPolicyAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
+	signingLimitStorage := repositories.signingLimitStorage
+	signingLimitUsageStorage := repositories.signingLimitUsageStorage
+	signinglimitDefaultUseCaseOptions := signinglimit.DefaultUseCaseOptions{
+		Storage:                     signingLimitStorage,
+		UsageStorage:                signingLimitUsageStorage,
+		ApplicationUseCase:          applicationDefaultUseCase,
+		ReferentialIntegrityUseCase: defaultUseCase,
+	}
+	signinglimitDefaultUseCase, err := signinglimit.ProvideDefaultUseCase(signinglimitDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	signinglimitDefaultUseCaseTransactionalDecoratorOptions := signinglimit.DefaultUseCaseTransactionalDecoratorOptions{
+		DefaultUseCase:       signinglimitDefaultUseCase,
+		TransactionalManager: transactionalManager,
+	}
+	signinglimitDefaultUseCaseTransactionalDecorator, err := signinglimit.ProvideDefaultUseCaseTransactionalDecorator(signinglimitDefaultUseCaseTransactionalDecoratorOptions)
+	if err != nil {
+		return nil, err
+	}
+	defaultSigningQuotaAdapterOptions := signingquota.DefaultSigningQuotaAdapterOptions{
+		SigningLimitUseCase: signinglimitDefaultUseCaseTransactionalDecorator,
+	}
+	defaultSigningQuotaAdapter, err := signingquota.ProvideDefaultSigningQuotaAdapter(defaultSigningQuotaAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
+	nonceStorage := repositories.nonceStorage
+	nonceDefaultUseCaseOptions := nonce.DefaultUseCaseOptions{
+		Storage: nonceStorage,
+	}
+	nonceDefaultUseCase, err := nonce.ProvideDefaultUseCase(nonceDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	nonceDefaultUseCaseTransactionalDecoratorOptions := nonce.DefaultUseCaseTransactionalDecoratorOptions{
+		DefaultUseCase:       nonceDefaultUseCase,
+		TransactionalManager: transactionalManager,
+	}
+	nonceDefaultUseCaseTransactionalDecorator, err := nonce.ProvideDefaultUseCaseTransactionalDecorator(nonceDefaultUseCaseTransactionalDecoratorOptions)
+	if err != nil {
+		return nil, err
+	}
+	defaultNonceAllocatorAdapterOptions := nonceallocator.DefaultNonceAllocatorAdapterOptions{
+		NonceUseCase: nonceDefaultUseCaseTransactionalDecorator,
+	}
+	defaultNonceAllocatorAdapter, err := nonceallocator.ProvideDefaultNonceAllocatorAdapter(defaultNonceAllocatorAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
 	hsmconnectorDefaultUseCaseOptions := hsmconnector.DefaultUseCaseOptions{
 		DigitalSignatureManagerFactory: defaultDigitalSignatureManagerFactory,
+		TransactionPolicyPort:          defaultTransactionPolicyAdapter,
+		SigningQuotaPort:               defaultSigningQuotaAdapter,
+		NoncePort:                      defaultNonceAllocatorAdapter,
 	}
 	hsmconnectorDefaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(hsmconnectorDefaultUseCaseOptions)
 	if err != nil {
 		return nil, err
 	}
+	hsmconnectorDefaultUseCaseTransactionalDecoratorOptions := hsmconnector.DefaultUseCaseTransactionalDecoratorOptions{
+		DefaultUseCase:       hsmconnectorDefaultUseCase,
+		TransactionalManager: transactionalManager,
+	}
+	hsmconnectorDefaultUseCaseTransactionalDecorator, err := hsmconnector.ProvideDefaultUseCaseTransactionalDecorator(hsmconnectorDefaultUseCaseTransactionalDecoratorOptions)
+	if err != nil {
+		return nil, err
+	}
+	auditStorage := repositories.auditStorage
+	defaultAuditIdentityAdapterOptions := requester.DefaultAuditIdentityAdapterOptions{}
+	defaultAuditIdentityAdapter, err := requester.ProvideDefaultAuditIdentityAdapter(defaultAuditIdentityAdapterOptions)
+	if err != nil {
+		return nil, err
+	}
+	auditDefaultUseCaseOptions := audit.DefaultUseCaseOptions{
+		AuditStorage: auditStorage,
+		IdentityPort: defaultAuditIdentityAdapter,
+	}
+	auditDefaultUseCase, err := audit.ProvideDefaultUseCase(auditDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	defaultUseCaseAuditDecoratorOptions := hsmconnector.DefaultUseCaseAuditDecoratorOptions{
+		DefaultUseCaseTransactionalDecorator: hsmconnectorDefaultUseCaseTransactionalDecorator,
+		AuditUseCase:                         auditDefaultUseCase,
+	}
+	defaultUseCaseAuditDecorator, err := hsmconnector.ProvideDefaultUseCaseAuditDecorator(defaultUseCaseAuditDecoratorOptions)
+	if err != nil {
+		return nil, err
+	}
 	hsmslotDefaultUseCaseOptions := hsmslot.DefaultUseCaseOptions{
 		HSMSlotStorage:              hsmSlotStorage,
 		ApplicationUseCase:          applicationDefaultUseCase,
 		HSMModuleUseCase:            defaultUseCaseTransactionalDecorator,
-		HSMConnector:                hsmconnectorDefaultUseCase,
+		HSMConnector:                defaultUseCaseAuditDecorator,
 		ReferentialIntegrityUseCase: defaultUseCase,
+		CacheInvalidation:           defaultCacheInvalidationAdapter,
 	}
 	hsmslotDefaultUseCase, err := hsmslot.ProvideDefaultUseCase(hsmslotDefaultUseCaseOptions)
 	if err != nil {
@@ -731,27 +1089,43 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 		ModuleUseCase:      defaultUseCaseTransactionalDecorator,
 		SlotUseCase:        hsmslotDefaultUseCaseTransactionalDecorator,
 		ApplicationUseCase: applicationDefaultUseCase,
+		HSMConnector:       defaultUseCaseAuditDecorator,
+		Cache:              connectionCache,
 	}
 	defaultHSMConnectionResolver, err := hsmconnection.ProvideDefaultHSMConnectionResolver(defaultHSMConnectionResolverOptions)
 	if err != nil {
 		return nil, err
 	}
-	roleStorage := provideDefaultRoleStorageInFile()
+	builtInStorage := provideDefaultRoleStorageInFile()
+	roleStorage := repositories.roleStorage
+	permissionStorage := repositories.permissionStorage
 	defaultRoleUseCaseOptions := role.DefaultRoleUseCaseOptions{
-		RoleStorage: roleStorage,
+		BuiltInStorage:    builtInStorage,
+		RoleStorage:       roleStorage,
+		PermissionStorage: permissionStorage,
+		CacheInvalidation: defaultCacheInvalidationAdapter,
 	}
 	defaultRoleUseCase, err := role.ProvideDefaultRoleUseCase(defaultRoleUseCaseOptions)
 	if err != nil {
 		return nil, err
 	}
+	roleDefaultUseCaseTransactionalDecoratorOptions := role.DefaultUseCaseTransactionalDecoratorOptions{
+		DefaultRoleUseCase:   defaultRoleUseCase,
+		TransactionalManager: transactionalManager,
+	}
+	roleDefaultUseCaseTransactionalDecorator, err := role.ProvideDefaultUseCaseTransactionalDecorator(roleDefaultUseCaseTransactionalDecoratorOptions)
+	if err != nil {
+		return nil, err
+	}
 	defaultUserUseCaseOptions := user.DefaultUserUseCaseOptions{
 		Storage:                     userStorage,
 		AccountStorage:              accountStorage,
 		ApplicationUseCase:          applicationDefaultUseCase,
 		HSMConnectionResolver:       defaultHSMConnectionResolver,
-		HSMConnector:                hsmconnectorDefaultUseCase,
+		HSMConnector:                defaultUseCaseAuditDecorator,
 		ReferentialIntegrityUseCase: defaultUseCase,
-		RoleUseCase:                 defaultRoleUseCase,
+		RoleUseCase:                 roleDefaultUseCaseTransactionalDecorator,
+		CacheInvalidation:           defaultCacheInvalidationAdapter,
 	}
 	defaultUserUseCase, err := user.ProvideDefaultUseCase(defaultUserUseCaseOptions)
 	if err != nil {
@@ -760,13 +1134,44 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 	adminStorage := repositories.adminStorage
 	adminDefaultUseCaseOptions := admin.DefaultUseCaseOptions{
 		AdminStorage:                adminStorage,
-		RoleUseCase:                 defaultRoleUseCase,
+		RoleUseCase:                 roleDefaultUseCaseTransactionalDecorator,
 		ReferentialIntegrityUseCase: defaultUseCase,
+		CacheInvalidation:           defaultCacheInvalidationAdapter,
 	}
 	adminDefaultUseCase, err := admin.ProvideDefaultUseCase(adminDefaultUseCaseOptions)
 	if err != nil {
 		return nil, err
 	}
+	hsmhealthDefaultUseCaseOptions := hsmhealth.DefaultUseCaseOptions{
+		ModuleUseCase:  defaultUseCaseTransactionalDecorator,
+		SlotUseCase:    hsmslotDefaultUseCaseTransactionalDecorator,
+		HSMConnector:   defaultUseCaseAuditDecorator,
+		MetricRecorder: metricRecorder,
+	}
+	hsmhealthDefaultUseCase, err := hsmhealth.ProvideDefaultUseCase(hsmhealthDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
+	monitorInterval := provideHSMHealthMonitorInterval(config)
+	monitorOptions := hsmhealth.MonitorOptions{
+		UseCase:  hsmhealthDefaultUseCase,
+		Interval: monitorInterval,
+	}
+	monitor, err := hsmhealth.ProvideMonitor(monitorOptions)
+	if err != nil {
+		return nil, err
+	}
+	healthStorage := repositories.healthStorage
+	schemaVersionCheckMode := provideSchemaVersionCheckMode(config)
+	healthDefaultUseCaseOptions := health.DefaultUseCaseOptions{
+		HealthStorage:          healthStorage,
+		HSMHealthUseCase:       hsmhealthDefaultUseCase,
+		SchemaVersionCheckMode: schemaVersionCheckMode,
+	}
+	healthDefaultUseCase, err := health.ProvideDefaultUseCase(healthDefaultUseCaseOptions)
+	if err != nil {
+		return nil, err
+	}
 	graphUseCasesGraph := &useCasesGraph{
 		ApplicationUseCase:             applicationDefaultUseCase,
 		UserUseCase:                    defaultUserUseCase,
@@ -774,12 +1179,20 @@ func initializeUseCases(repositories *repositoriesGraph, metricRecorder metricre
 		AdminUseCase:                   adminDefaultUseCase,
 		HSMModuleUseCase:               defaultUseCaseTransactionalDecorator,
 		HSMSlotUseCase:                 hsmslotDefaultUseCaseTransactionalDecorator,
-		HSMConnector:                   hsmconnectorDefaultUseCase,
-		RoleUseCase:                    defaultRoleUseCase,
+		HSMConnector:                   defaultUseCaseAuditDecorator,
+		RoleUseCase:                    roleDefaultUseCaseTransactionalDecorator,
 		HSMConnectionResolver:          defaultHSMConnectionResolver,
 		ReferentialIntegrityUseCase:    defaultUseCase,
 		TransactionalManagerUseCase:    transactionalManager,
+		AuditUseCase:                   auditDefaultUseCase,
+		SigningPolicyUseCase:           signingpolicyDefaultUseCase,
+		SigningLimitUseCase:            signinglimitDefaultUseCaseTransactionalDecorator,
+		NonceUseCase:                   nonceDefaultUseCaseTransactionalDecorator,
+		HSMHealthUseCase:               hsmhealthDefaultUseCase,
+		HSMHealthMonitor:               monitor,
+		HealthUseCase:                  healthDefaultUseCase,
 		DigitalSignatureManagerFactory: defaultDigitalSignatureManagerFactory,
+		PIPCache:                       pipCache,
 	}
 	return graphUseCasesGraph, nil
 }
@@ -790,12 +1203,17 @@ type httpAPIGraph struct {
 	// REST
 	adminAPIRoutesPublished       httpinfra.AdminAPIRoutesPublished
 	applicationAPIRoutesPublished httpinfra.ApplicationAPIRoutesPublished
+	healthRoutesPublished         healthhttpinfra.HealthHTTPRoutesPublished
 
 	// JSON-RPC
 	rpcAPIRoutesPublished rpcinfra.JSONRPCAPIRoutesPublished
 }
 
-var httpAPISet = wire.NewSet(wire.Struct(new(httpAPIGraph), "*"), provideMainRouter, httpin.ProvideDefaultAdminAPIAdapter, wire.Bind(new(httpinfra.AdminAPIAdapter), new(*httpin.DefaultAdminAPIAdapter)), wire.Struct(new(httpin.DefaultAdminAPIAdapterOptions), "*"), httpinfra.NewDefaultAdminAPIHTTPHandler, wire.Bind(new(httpinfra.AdminAPIHTTPHandler), new(*httpinfra.DefaultAdminAPIHTTPHandler)), wire.Struct(new(httpinfra.DefaultAdminAPIHTTPHandlerOptions), "*"), httpinfra.ProvideAdminAPIRoutes, wire.Bind(new(httpinfra2.HTTPRouter), new(*httpinfra2.DefaultHTTPRouter)), wire.Struct(new(httpinfra.AdminAPIPublisherOptions), "*"), httpin.ProvideDefaultApplicationAPIAdapter, wire.Bind(new(httpinfra.ApplicationAPIAdapter), new(*httpin.DefaultApplicationAPIAdapter)), wire.Struct(new(httpin.DefaultApplicationAPIAdapterOptions), "*"), httpinfra.NewDefaultApplicationAPIHTTPHandler, wire.Bind(new(httpinfra.ApplicationAPIHTTPHandler), new(*httpinfra.DefaultApplicationAPIHTTPHandler)), wire.Struct(new(httpinfra.DefaultApplicationAPIHTTPHandlerOptions), "*"), httpinfra.ProvideApplicationAPIRoutes, wire.Struct(new(httpinfra.ApplicationAPIPublisherOptions), "*"), rpcin.NewDefaultAPIAdapter, wire.Bind(new(rpcinfra.JSONRPCAPIAdapter), new(*rpcin.DefaultAPIAdapter)), wire.Struct(new(rpcin.DefaultAPIAdapterOptions), "*"), rpcinfra.NewDefaultJSONRPCAPIHandler, wire.Bind(new(rpcinfra.JSONRPCAPIHandler), new(*rpcinfra.DefaultJSONRPCAPIHandler)), wire.Struct(new(rpcinfra.DefaultJSONRPCAPIHandlerOptions), "*"), rpcinfra.ProvideJSONRPCMethods, wire.Struct(new(rpcinfra.JSONRPCAPIPublisherOptions), "*"))
+var httpAPISet = wire.NewSet(wire.Struct(new(httpAPIGraph), "*"), provideMainRouter, httpin.ProvideDefaultAdminAPIAdapter, wire.Bind(new(httpinfra.AdminAPIAdapter), new(*httpin.DefaultAdminAPIAdapter)), wire.Struct(new(httpin.DefaultAdminAPIAdapterOptions), "*"), httpinfra.NewDefaultAdminAPIHTTPHandler, wire.Bind(new(httpinfra.AdminAPIHTTPHandler), new(*httpinfra.DefaultAdminAPIHTTPHandler)), wire.Struct(new(httpinfra.DefaultAdminAPIHTTPHandlerOptions), "*"), httpinfra.ProvideAdminAPIRoutes, wire.Bind(new(httpinfra2.HTTPRouter), new(*httpinfra2.DefaultHTTPRouter)), wire.Struct(new(httpinfra.AdminAPIPublisherOptions), "*"), httpin.ProvideDefaultApplicationAPIAdapter, wire.Bind(new(httpinfra.ApplicationAPIAdapter), new(*httpin.DefaultApplicationAPIAdapter)), wire.Struct(new(httpin.DefaultApplicationAPIAdapterOptions), "*"), httpinfra.NewDefaultApplicationAPIHTTPHandler, wire.Bind(new(httpinfra.ApplicationAPIHTTPHandler), new(*httpinfra.DefaultApplicationAPIHTTPHandler)), wire.Struct(new(httpinfra.DefaultApplicationAPIHTTPHandlerOptions), "*"), httpinfra.ProvideApplicationAPIRoutes, wire.Struct(new(httpinfra.ApplicationAPIPublisherOptions), "*"), provideHealthRouter, httpin.ProvideDefaultHealthAPIAdapter, wire.Bind(new(healthhttpinfra.HealthAPIAdapter), new(*httpin.DefaultHealthAPIAdapter)), wire.Struct(new(httpin.DefaultHealthAPIAdapterOptions), "*"), healthhttpinfra.ProvideHealthHTTP, wire.Struct(new(healthhttpinfra.HealthHTTPOptions), "*"), rpcin.NewDefaultAPIAdapter, wire.Bind(new(rpcinfra.JSONRPCAPIAdapter), new(*rpcin.DefaultAPIAdapter)), wire.Struct(new(rpcin.DefaultAPIAdapterOptions), "*"), rpcinfra.NewDefaultJSONRPCAPIHandler, wire.Bind(new(rpcinfra.JSONRPCAPIHandler), new(*rpcinfra.DefaultJSONRPCAPIHandler)), wire.Struct(new(rpcinfra.DefaultJSONRPCAPIHandlerOptions), "*"), rpcinfra.ProvideJSONRPCMethods, wire.Struct(new(rpcinfra.JSONRPCAPIPublisherOptions), "*"))
+
+func provideHealthRouter(infra *infraGraph) *httpinfra2.HealthHTTPRouter {
+	return infra.healthHTTPRouter
+}
 
 func provideMainRouter(infra *infraGraph) *httpinfra2.DefaultHTTPRouter {
 	return infra.mainHTTPRouter
@@ -824,10 +1242,11 @@ type infraGraph struct {
 	defaultRPCInfraResponseHandler *rpcinfra.DefaultRPCInfraResponseHandler
 	mainHTTPRouter                 *httpinfra2.DefaultHTTPRouter
 	metricsHTTPRouter              *httpinfra2.MetricsHTTPRouter
+	healthHTTPRouter               *httpinfra2.HealthHTTPRouter
 	rpcRouter                      *rpcinfra.DefaultRPCRouter
 }
 
-var infraSet = wire.NewSet(wire.Struct(new(infraGraph), "*"), httpinfra2.ProvideHTTPRouter, httpinfra2.ProvideMetricsHTTPRouter, httpinfra2.ProvideDefaultHTTPMetrics, wire.Bind(new(httpinfra2.HTTPMetrics), new(*httpinfra2.DefaultHTTPMetrics)), wire.Struct(new(httpinfra2.DefaultHTTPMetricsOptions), "*"), httpinfra2.ProvideDefaultHTTPResponseHandler, wire.Bind(new(httpinfra2.HTTPResponseHandler), new(*httpinfra2.DefaultHTTPResponseHandler)), wire.Struct(new(httpinfra2.DefaultHTTPResponseHandlerOptions), "*"), rpcinfra.ProvideDefaultRPCInfraResponseHandler, wire.Struct(new(rpcinfra.DefaultRPCInfraResponseHandlerOptions), "*"), rpcinfra.ProvideDefaultRPCRouter, wire.Struct(new(rpcinfra.DefaultRPCRouterOptions), "*"))
+var infraSet = wire.NewSet(wire.Struct(new(infraGraph), "*"), httpinfra2.ProvideHTTPRouter, httpinfra2.ProvideMetricsHTTPRouter, httpinfra2.ProvideHealthHTTPRouter, httpinfra2.ProvideDefaultHTTPMetrics, wire.Bind(new(httpinfra2.HTTPMetrics), new(*httpinfra2.DefaultHTTPMetrics)), wire.Struct(new(httpinfra2.DefaultHTTPMetricsOptions), "*"), httpinfra2.ProvideDefaultHTTPResponseHandler, wire.Bind(new(httpinfra2.HTTPResponseHandler), new(*httpinfra2.DefaultHTTPResponseHandler)), wire.Struct(new(httpinfra2.DefaultHTTPResponseHandlerOptions), "*"), rpcinfra.ProvideDefaultRPCInfraResponseHandler, wire.Struct(new(rpcinfra.DefaultRPCInfraResponseHandlerOptions), "*"), rpcinfra.ProvideDefaultRPCRouter, wire.Struct(new(rpcinfra.DefaultRPCRouterOptions), "*"))
 
 // libraries_injector.go:
 
@@ -894,12 +1313,12 @@ func providePersistenceFwConnection(options persistenceFwConfigOptions) (sql.Con
 		}
 		connectionFwOptions.Postgres = &postgresConfig
 	} else if databaseInfo.SQLite != nil {
-		file, err := os.CreateTemp("", strings.ReplaceAll(time.Now().String(), " ", ""))
+		connectionString, err := sqlite.ConnectionString(databaseInfo.SQLite.Path)
 		if err != nil {
 			return nil, err
 		}
 		connectionFwOptions.SQLite = &sql.SQLiteInfo{
-			ConnectionString: file.Name(),
+			ConnectionString: connectionString,
 		}
 	} else {
 		return nil, errors.New("no valid database configuration")
@@ -972,28 +1391,52 @@ var dummyMetricsSet = wire.NewSet(wire.Struct(new(dummyMetricsGraph), "*"), metr
 
 // middleware_injector.go:
 
-func ProvidePolicyInformationPointYAMLOutputAdapter() *pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter {
-	policyDecisionPointOutputAdapterOptions := pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapterOptions{
-		FileSystem: app.RBACFiles,
-		BasePath:   "include/rbac",
+// provideHTTPContextDefinition returns the ContextDefinition of the HTTP API, which authenticates the requests as
+// configured or reads the user and the application from the request headers otherwise.
+func provideHTTPContextDefinition(headersContextDefinition *httpcontextdefinition.HTTPContextDefinition, authentication2 *authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication2 == nil {
+		return headersContextDefinition, nil
 	}
-	policyInformationPointOutputAdapter, err := pipinfile.ProvideDefaultRBACActionsPolicyInformationPointYAMLOutputAdapter(policyDecisionPointOutputAdapterOptions)
-	checkError(err)
+	return provideAuthenticationContextDefinition(headersContextDefinition, *authentication2)
+}
 
-	return policyInformationPointOutputAdapter
+// provideRPCContextDefinition returns the ContextDefinition of the JSON-RPC API, which authenticates the requests as
+// configured or reads the user and the application from the request headers otherwise.
+func provideRPCContextDefinition(headersContextDefinition *rpccontextdefinition.RPCContextDefinition, authentication2 *authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication2 == nil {
+		return headersContextDefinition, nil
+	}
+	return provideAuthenticationContextDefinition(headersContextDefinition, *authentication2)
+}
+
+func provideAuthenticationContextDefinition(actionDefinition contextdefinition.ContextDefinition, authentication2 authenticationConfiguration) (contextdefinition.ContextDefinition, error) {
+	if authentication2.clientCertificate != nil {
+		return certificatecontextdefinition.ProvideCertificateContextDefinition(certificatecontextdefinition.CertificateContextDefinitionOptions{
+			ActionDefinition:   actionDefinition,
+			UserMapping:        authentication2.clientCertificate.userMapping,
+			ApplicationMapping: authentication2.clientCertificate.applicationMapping,
+		})
+	}
+	return tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+		ActionDefinition: actionDefinition,
+		Verifier:         authentication2.jwt.verifier,
+		UserClaim:        authentication2.jwt.userClaim,
+		ApplicationClaim: authentication2.jwt.applicationClaim,
+	})
 }
 
 type httpMiddlewareGraph struct {
-	HTTPMiddlewareFactory *middleware.HTTPMiddlewareFactory
+	HTTPMiddlewareFactory      *middleware.HTTPMiddlewareFactory
+	PolicyDecisionPointUseCase pdp.PolicyDecisionPointUseCase
 }
 
-var httpMiddlewareSet = wire.NewSet(wire.Struct(new(httpMiddlewareGraph), "*"), httpcontextdefinition.ProvideHTTPContextDefinition, wire.Bind(new(contextdefinition.ContextDefinition), new(*httpcontextdefinition.HTTPContextDefinition)), wire.Struct(new(httpcontextdefinition.HTTPContextDefinitionOptions), "*"), contextvalidation.ProvideRequestContextValidation, wire.Struct(new(contextvalidation.RequestContextValidationOptions), "*"), pip.ProvideDefaultAccountsPIPAdapter, wire.Bind(new(pdp.AccountsPolicyInformationPort), new(*pip.DefaultAccountsPIPAdapter)), wire.Struct(new(pip.DefaultAccountsPIPAdapterOptions), "*"), pip.ProvideDefaultAdminsPIPAdapter, wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)), wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"), ProvidePolicyInformationPointYAMLOutputAdapter, wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter)), pip.ProvideDefaultUsersPIPAdapter, wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)), wire.Struct(new(pip.DefaultUsersPIPAdapterOptions), "*"), pdp.ProvideDefaultPolicyDecisionPointUseCase, wire.Bind(new(pdp.PolicyDecisionPointUseCase), new(*pdp.DefaultPolicyDecisionPointUseCase)), wire.Struct(new(pdp.DefaultPolicyDecisionPointUseCaseOptions), "*"), pepin.ProvideUserPolicyDecisionPointAdapter, wire.Bind(new(pep.UserPolicyDecisionPointPort), new(*pepin.DefaultUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultUserPolicyDecisionPointAdapterOptions), "*"), pepin.ProvideDefaultAccountUserPolicyDecisionPointAdapter, wire.Bind(new(pep.AccountUserPolicyDecisionPointPort), new(*pepin.DefaultAccountUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultAccountUserPolicyDecisionPointAdapterOptions), "*"), pep.ProvideHTTPPolicyEnforcementPoint, wire.Struct(new(pep.HTTPPolicyEnforcementPointOptions), "*"), pep.ProvideRPCPolicyEnforcementPoint, wire.Struct(new(pep.RPCPolicyEnforcementPointOptions), "*"), authorization.ProvideAuthorizationMiddleware, wire.Struct(new(authorization.AuthorizationMiddlewareOptions), "*"), authentication.ProvideAuthenticationMiddleware, wire.Struct(new(authentication.AuthenticationMiddlewareOptions), "*"), middleware.ProvideHTTPMiddlewareFactory, wire.Struct(new(middleware.HTTPMiddlewareFactoryOptions), "*"), telemetry.ProvideTelemetryMiddleware, wire.Struct(new(telemetry.TelemetryMiddlewareOptions), "*"), tracer.ProvideHTTPContextTracer, wire.Struct(new(tracer.HTTPContextTracerOptions), "*"))
+var httpMiddlewareSet = wire.NewSet(wire.Struct(new(httpMiddlewareGraph), "*"), httpcontextdefinition.ProvideHTTPContextDefinition, provideHTTPContextDefinition, wire.Struct(new(httpcontextdefinition.HTTPContextDefinitionOptions), "*"), contextvalidation.ProvideRequestContextValidation, wire.Struct(new(contextvalidation.RequestContextValidationOptions), "*"), pip.ProvideDefaultAccountsPIPAdapter, wire.Bind(new(pdp.AccountsPolicyInformationPort), new(*pip.DefaultAccountsPIPAdapter)), wire.Struct(new(pip.DefaultAccountsPIPAdapterOptions), "*"), pip.ProvideDefaultAdminsPIPAdapter, wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)), wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"), pip.ProvideDefaultActionsPIPAdapter, wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pip.DefaultActionsPIPAdapter)), wire.Struct(new(pip.DefaultActionsPIPAdapterOptions), "*"), pip.ProvideDefaultUsersPIPAdapter, wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)), wire.Struct(new(pip.DefaultUsersPIPAdapterOptions), "*"), pdp.ProvideDefaultPolicyDecisionPointUseCase, wire.Bind(new(pdp.PolicyDecisionPointUseCase), new(*pdp.DefaultPolicyDecisionPointUseCase)), wire.Struct(new(pdp.DefaultPolicyDecisionPointUseCaseOptions), "*"), pepin.ProvideUserPolicyDecisionPointAdapter, wire.Bind(new(pep.UserPolicyDecisionPointPort), new(*pepin.DefaultUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultUserPolicyDecisionPointAdapterOptions), "*"), pepin.ProvideDefaultAccountUserPolicyDecisionPointAdapter, wire.Bind(new(pep.AccountUserPolicyDecisionPointPort), new(*pepin.DefaultAccountUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultAccountUserPolicyDecisionPointAdapterOptions), "*"), pep.ProvideHTTPPolicyEnforcementPoint, wire.Struct(new(pep.HTTPPolicyEnforcementPointOptions), "*"), pep.ProvideRPCPolicyEnforcementPoint, wire.Struct(new(pep.RPCPolicyEnforcementPointOptions), "*"), authorization.ProvideAuthorizationMiddleware, wire.Struct(new(authorization.AuthorizationMiddlewareOptions), "*"), authentication.ProvideAuthenticationMiddleware, wire.Struct(new(authentication.AuthenticationMiddlewareOptions), "*"), middleware.ProvideHTTPMiddlewareFactory, wire.Struct(new(middleware.HTTPMiddlewareFactoryOptions), "*"), telemetry.ProvideTelemetryMiddleware, wire.Struct(new(telemetry.TelemetryMiddlewareOptions), "*"), tracer.ProvideHTTPContextTracer, wire.Struct(new(tracer.HTTPContextTracerOptions), "*"))
 
 type rpcMiddlewareGraph struct {
 	RPCMiddlewareFactory *middleware.RPCMiddlewareFactory
 }
 
-var rpcMiddlewareSet = wire.NewSet(wire.Struct(new(rpcMiddlewareGraph), "*"), contextvalidation.ProvideRequestContextValidation, wire.Struct(new(contextvalidation.RequestContextValidationOptions), "*"), rpccontextdefinition.ProvideRPCContextDefinitionFromHeaders, wire.Bind(new(contextdefinition.ContextDefinition), new(*rpccontextdefinition.RPCContextDefinition)), wire.Struct(new(rpccontextdefinition.RPCContextDefinitionOptions), "*"), pip.ProvideDefaultAccountsPIPAdapter, wire.Bind(new(pdp.AccountsPolicyInformationPort), new(*pip.DefaultAccountsPIPAdapter)), wire.Struct(new(pip.DefaultAccountsPIPAdapterOptions), "*"), pip.ProvideDefaultAdminsPIPAdapter, wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)), wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"), ProvidePolicyInformationPointYAMLOutputAdapter, wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pipinfile.DefaultRBACActionsPolicyInformationPointYAMLOutputAdapter)), pip.ProvideDefaultUsersPIPAdapter, wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)), wire.Struct(new(pip.DefaultUsersPIPAdapterOptions), "*"), pdp.ProvideDefaultPolicyDecisionPointUseCase, wire.Bind(new(pdp.PolicyDecisionPointUseCase), new(*pdp.DefaultPolicyDecisionPointUseCase)), wire.Struct(new(pdp.DefaultPolicyDecisionPointUseCaseOptions), "*"), pepin.ProvideUserPolicyDecisionPointAdapter, wire.Bind(new(pep.UserPolicyDecisionPointPort), new(*pepin.DefaultUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultUserPolicyDecisionPointAdapterOptions), "*"), pepin.ProvideDefaultAccountUserPolicyDecisionPointAdapter, wire.Bind(new(pep.AccountUserPolicyDecisionPointPort), new(*pepin.DefaultAccountUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultAccountUserPolicyDecisionPointAdapterOptions), "*"), pep.ProvideHTTPPolicyEnforcementPoint, wire.Struct(new(pep.HTTPPolicyEnforcementPointOptions), "*"), pep.ProvideRPCPolicyEnforcementPoint, wire.Struct(new(pep.RPCPolicyEnforcementPointOptions), "*"), authorization.ProvideAuthorizationMiddleware, wire.Struct(new(authorization.AuthorizationMiddlewareOptions), "*"), authentication.ProvideAuthenticationMiddleware, wire.Struct(new(authentication.AuthenticationMiddlewareOptions), "*"), rpcbatchrequestsupport.ProvideRPCBatchRequestSupportMiddleware, wire.Struct(new(rpcbatchrequestsupport.RPCBatchRequestSupportMiddlewareOptions), "*"), middleware.ProvideRPCMiddlewareFactory, wire.Struct(new(middleware.RPCMiddlewareFactoryOptions), "*"), telemetry.ProvideTelemetryMiddleware, wire.Struct(new(telemetry.TelemetryMiddlewareOptions), "*"), tracer.ProvideHTTPContextTracer, wire.Struct(new(tracer.HTTPContextTracerOptions), "*"))
+var rpcMiddlewareSet = wire.NewSet(wire.Struct(new(rpcMiddlewareGraph), "*"), contextvalidation.ProvideRequestContextValidation, wire.Struct(new(contextvalidation.RequestContextValidationOptions), "*"), rpccontextdefinition.ProvideRPCContextDefinitionFromHeaders, provideRPCContextDefinition, wire.Struct(new(rpccontextdefinition.RPCContextDefinitionOptions), "*"), pip.ProvideDefaultAccountsPIPAdapter, wire.Bind(new(pdp.AccountsPolicyInformationPort), new(*pip.DefaultAccountsPIPAdapter)), wire.Struct(new(pip.DefaultAccountsPIPAdapterOptions), "*"), pip.ProvideDefaultAdminsPIPAdapter, wire.Bind(new(pdp.AdminsPolicyInformationPort), new(*pip.DefaultAdminsPIPAdapter)), wire.Struct(new(pip.DefaultAdminsPIPAdapterOptions), "*"), pip.ProvideDefaultActionsPIPAdapter, wire.Bind(new(pdp.ActionsPolicyInformationPointPort), new(*pip.DefaultActionsPIPAdapter)), wire.Struct(new(pip.DefaultActionsPIPAdapterOptions), "*"), pip.ProvideDefaultUsersPIPAdapter, wire.Bind(new(pdp.UsersPolicyInformationPort), new(*pip.DefaultUsersPIPAdapter)), wire.Struct(new(pip.DefaultUsersPIPAdapterOptions), "*"), pdp.ProvideDefaultPolicyDecisionPointUseCase, wire.Bind(new(pdp.PolicyDecisionPointUseCase), new(*pdp.DefaultPolicyDecisionPointUseCase)), wire.Struct(new(pdp.DefaultPolicyDecisionPointUseCaseOptions), "*"), pepin.ProvideUserPolicyDecisionPointAdapter, wire.Bind(new(pep.UserPolicyDecisionPointPort), new(*pepin.DefaultUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultUserPolicyDecisionPointAdapterOptions), "*"), pepin.ProvideDefaultAccountUserPolicyDecisionPointAdapter, wire.Bind(new(pep.AccountUserPolicyDecisionPointPort), new(*pepin.DefaultAccountUserPolicyDecisionPointAdapter)), wire.Struct(new(pepin.DefaultAccountUserPolicyDecisionPointAdapterOptions), "*"), pep.ProvideHTTPPolicyEnforcementPoint, wire.Struct(new(pep.HTTPPolicyEnforcementPointOptions), "*"), pep.ProvideRPCPolicyEnforcementPoint, wire.Struct(new(pep.RPCPolicyEnforcementPointOptions), "*"), authorization.ProvideAuthorizationMiddleware, wire.Struct(new(authorization.AuthorizationMiddlewareOptions), "*"), authentication.ProvideAuthenticationMiddleware, wire.Struct(new(authentication.AuthenticationMiddlewareOptions), "*"), rpcbatchrequestsupport.ProvideRPCBatchRequestSupportMiddleware, wire.Struct(new(rpcbatchrequestsupport.RPCBatchRequestSupportMiddlewareOptions), "*"), middleware.ProvideRPCMiddlewareFactory, wire.Struct(new(middleware.RPCMiddlewareFactoryOptions), "*"), telemetry.ProvideTelemetryMiddleware, wire.Struct(new(telemetry.TelemetryMiddlewareOptions), "*"), tracer.ProvideHTTPContextTracer, wire.Struct(new(tracer.HTTPContextTracerOptions), "*"))
 
 // repositories_injector.go:
 
@@ -1006,9 +1449,61 @@ type repositoriesGraph struct {
 	hsmSlotStorage              hsmslot.HSMSlotStorage
 	referentialIntegrityStorage referentialintegrity.ReferentialIntegrityStorage
 	transactionalStorage        transactionalmanager.TransactionalStorage
+	auditStorage                audit.AuditStorage
+	signingPolicyStorage        signingpolicy.SigningPolicyStorage
+	signingLimitStorage         signinglimit.SigningLimitStorage
+	signingLimitUsageStorage    signinglimit.SigningLimitUsageStorage
+	nonceStorage                nonce.NonceStorage
+	healthStorage               health.HealthStorage
+	roleStorage                 role.RoleStorage
+	permissionStorage           role.PermissionStorage
+}
+
+var repositoriesSet = wire.NewSet(wire.Struct(new(repositoriesGraph), "*"), applicationdb.ProvideApplicationRepositoryInfra, wire.Struct(new(applicationdb.ApplicationRepositoryInfraOptions), "*"), applicationdbout.NewRepository, wire.Bind(new(application.ApplicationStorage), new(*applicationdbout.Repository)), wire.Struct(new(applicationdbout.RepositoryOptions), "*"), userdb.ProvideUserRepositoryInfra, wire.Struct(new(userdb.UserRepositoryInfraOptions), "*"), userdbout.NewRepository, wire.Bind(new(user.UserStorage), new(*userdbout.Repository)), wire.Struct(new(userdbout.RepositoryOptions), "*"), accountdb.ProvideAccountRepositoryInfra, wire.Struct(new(accountdb.AccountRepositoryInfraOptions), "*"), accountdbout.NewRepository, wire.Bind(new(user.AccountStorage), new(*accountdbout.Repository)), wire.Struct(new(accountdbout.RepositoryOptions), "*"), admindb.ProvideAdminRepositoryInfra, wire.Struct(new(admindb.AdminRepositoryInfraOptions), "*"), admindbout.NewRepository, wire.Bind(new(admin.AdminStorage), new(*admindbout.Repository)), wire.Struct(new(admindbout.RepositoryOptions), "*"), hsmmoduledb.ProvideHardwareSecurityModuleRepositoryInfra, wire.Struct(new(hsmmoduledb.HardwareSecurityModuleRepositoryInfraOptions), "*"), hsmdbout.NewRepository, wire.Bind(new(hsmmodule.HSMModuleStorage), new(*hsmdbout.Repository)), wire.Struct(new(hsmdbout.RepositoryOptions), "*"), hsmslotdb.ProvideHSMSlotRepositoryInfra, wire.Struct(new(hsmslotdb.HSMSlotRepositoryInfraOptions), "*"), providePinEncrypter, hsmslotdbout.NewRepository, wire.Bind(new(hsmslot.HSMSlotStorage), new(*hsmslotdbout.Repository)), wire.Struct(new(hsmslotdbout.RepositoryOptions), "*"), referentialintegritydb.ProvideReferentialIntegrityEntryRepositoryInfra, wire.Struct(new(referentialintegritydb.ReferentialIntegrityEntryRepositoryInfraOptions), "*"), referentialintegritydbout.NewRepository, wire.Bind(new(referentialintegrity.ReferentialIntegrityStorage), new(*referentialintegritydbout.Repository)), wire.Struct(new(referentialintegritydbout.RepositoryOptions), "*"), auditdb.ProvideAuditRepositoryInfra, wire.Struct(new(auditdb.AuditRepositoryInfraOptions), "*"), auditdbout.NewRepository, wire.Bind(new(audit.AuditStorage), new(*auditdbout.Repository)), wire.Struct(new(auditdbout.RepositoryOptions), "*"), signingpolicydb.ProvideSigningPolicyRepositoryInfra, wire.Struct(new(signingpolicydb.SigningPolicyRepositoryInfraOptions), "*"), signingpolicydbout.NewRepository, wire.Bind(new(signingpolicy.SigningPolicyStorage), new(*signingpolicydbout.Repository)), wire.Struct(new(signingpolicydbout.RepositoryOptions), "*"), signinglimitdb.ProvideSigningLimitRepositoryInfra, wire.Struct(new(signinglimitdb.SigningLimitRepositoryInfraOptions), "*"), signinglimitdbout.NewRepository, wire.Bind(new(signinglimit.SigningLimitStorage), new(*signinglimitdbout.Repository)), wire.Struct(new(signinglimitdbout.RepositoryOptions), "*"), signinglimitusagedb.ProvideSigningLimitUsageRepositoryInfra, wire.Struct(new(signinglimitusagedb.SigningLimitUsageRepositoryInfraOptions), "*"), signinglimitusagedbout.NewRepository, wire.Bind(new(signinglimit.SigningLimitUsageStorage), new(*signinglimitusagedbout.Repository)), wire.Struct(new(signinglimitusagedbout.RepositoryOptions), "*"), noncedb.ProvideNonceRepositoryInfra, wire.Struct(new(noncedb.NonceRepositoryInfraOptions), "*"), noncedbout.NewRepository, wire.Bind(new(nonce.NonceStorage), new(*noncedbout.Repository)), wire.Struct(new(noncedbout.RepositoryOptions), "*"), roledb.ProvideRoleRepositoryInfra, wire.Struct(new(roledb.RoleRepositoryInfraOptions), "*"), roledbout.NewRepository, wire.Bind(new(role.RoleStorage), new(*roledbout.Repository)), wire.Struct(new(roledbout.RepositoryOptions), "*"), permissiondb.ProvidePermissionRepositoryInfra, wire.Struct(new(permissiondb.PermissionRepositoryInfraOptions), "*"), permissiondbout.NewRepository, wire.Bind(new(role.PermissionStorage), new(*permissiondbout.Repository)), wire.Struct(new(permissiondbout.RepositoryOptions), "*"), provideDatabaseMigrations, healthdbout.NewRepository, wire.Bind(new(health.HealthStorage), new(*healthdbout.Repository)), wire.Struct(new(healthdbout.RepositoryOptions), "*"), transactionaldbout.NewTransactionalRepository, wire.Bind(new(transactionalmanager.TransactionalStorage), new(*transactionaldbout.TransactionalRepository)), wire.Struct(new(transactionaldbout.TransactionalRepositoryOptions), "*"))
+
+func provideDatabaseMigrations() fs.ReadDirFS {
+	return app.DatabaseMigrations
+}
+
+func providePinEncrypter(config Config) (*envelope.Encrypter, error) {
+	pinEncryption := config.Libraries.PinEncryption
+	if pinEncryption == nil {
+		return nil, nil
+	}
+	masterKey, err := envelope.ProvideMasterKey(mapMasterKeyOptions(pinEncryption.MasterKey))
+	if err != nil {
+		return nil, err
+	}
+	previousMasterKeys := make([]envelope.MasterKey, len(pinEncryption.PreviousMasterKeys))
+	for i, previousMasterKey := range pinEncryption.PreviousMasterKeys {
+		previousMasterKeys[i], err = envelope.ProvideMasterKey(mapMasterKeyOptions(previousMasterKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return envelope.ProvideEncrypter(envelope.EncrypterOptions{
+		MasterKey:          masterKey,
+		PreviousMasterKeys: previousMasterKeys,
+	})
 }
 
-var repositoriesSet = wire.NewSet(wire.Struct(new(repositoriesGraph), "*"), applicationdb.ProvideApplicationRepositoryInfra, wire.Struct(new(applicationdb.ApplicationRepositoryInfraOptions), "*"), applicationdbout.NewRepository, wire.Bind(new(application.ApplicationStorage), new(*applicationdbout.Repository)), wire.Struct(new(applicationdbout.RepositoryOptions), "*"), userdb.ProvideUserRepositoryInfra, wire.Struct(new(userdb.UserRepositoryInfraOptions), "*"), userdbout.NewRepository, wire.Bind(new(user.UserStorage), new(*userdbout.Repository)), wire.Struct(new(userdbout.RepositoryOptions), "*"), accountdb.ProvideAccountRepositoryInfra, wire.Struct(new(accountdb.AccountRepositoryInfraOptions), "*"), accountdbout.NewRepository, wire.Bind(new(user.AccountStorage), new(*accountdbout.Repository)), wire.Struct(new(accountdbout.RepositoryOptions), "*"), admindb.ProvideAdminRepositoryInfra, wire.Struct(new(admindb.AdminRepositoryInfraOptions), "*"), admindbout.NewRepository, wire.Bind(new(admin.AdminStorage), new(*admindbout.Repository)), wire.Struct(new(admindbout.RepositoryOptions), "*"), hsmmoduledb.ProvideHardwareSecurityModuleRepositoryInfra, wire.Struct(new(hsmmoduledb.HardwareSecurityModuleRepositoryInfraOptions), "*"), hsmdbout.NewRepository, wire.Bind(new(hsmmodule.HSMModuleStorage), new(*hsmdbout.Repository)), wire.Struct(new(hsmdbout.RepositoryOptions), "*"), hsmslotdb.ProvideHSMSlotRepositoryInfra, wire.Struct(new(hsmslotdb.HSMSlotRepositoryInfraOptions), "*"), hsmslotdbout.NewRepository, wire.Bind(new(hsmslot.HSMSlotStorage), new(*hsmslotdbout.Repository)), wire.Struct(new(hsmslotdbout.RepositoryOptions), "*"), referentialintegritydb.ProvideReferentialIntegrityEntryRepositoryInfra, wire.Struct(new(referentialintegritydb.ReferentialIntegrityEntryRepositoryInfraOptions), "*"), referentialintegritydbout.NewRepository, wire.Bind(new(referentialintegrity.ReferentialIntegrityStorage), new(*referentialintegritydbout.Repository)), wire.Struct(new(referentialintegritydbout.RepositoryOptions), "*"), transactionaldbout.NewTransactionalRepository, wire.Bind(new(transactionalmanager.TransactionalStorage), new(*transactionaldbout.TransactionalRepository)), wire.Struct(new(transactionaldbout.TransactionalRepositoryOptions), "*"))
+func mapMasterKeyOptions(config graphconfig.MasterKeyConfig) envelope.MasterKeyOptions {
+	options := envelope.MasterKeyOptions{}
+	if config.File != nil {
+		options.File = &envelope.FileMasterKeyOptions{
+			Path: config.File.Path,
+		}
+	}
+	if config.PKCS11 != nil {
+		options.PKCS11 = &envelope.PKCS11MasterKeyOptions{
+			Library:  config.PKCS11.Library,
+			Slot:     config.PKCS11.Slot,
+			Pin:      config.PKCS11.Pin,
+			KeyLabel: config.PKCS11.KeyLabel,
+		}
+	}
+	return options
+}
 
 // usecases_injector.go:
 
@@ -1024,11 +1519,22 @@ type useCasesGraph struct {
 	HSMConnectionResolver       hsmconnection.Resolver
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
 	TransactionalManagerUseCase transactionalmanager.TransactionalManagerUseCase
+	AuditUseCase                audit.AuditUseCase
+	SigningPolicyUseCase        signingpolicy.SigningPolicyUseCase
+	SigningLimitUseCase         signinglimit.SigningLimitUseCase
+	NonceUseCase                nonce.NonceUseCase
+	HSMHealthUseCase            hsmhealth.HSMHealthUseCase
+	HSMHealthMonitor            *hsmhealth.Monitor
+	HealthUseCase               health.HealthUseCase
 
 	DigitalSignatureManagerFactory hsmconnector.DigitalSignatureManagerFactory
+
+	PIPCache *pip.Cache
 }
 
-var useCasesSet = wire.NewSet(wire.Struct(new(useCasesGraph), "*"), transactionalmanager.ProvideTransactionalManager, wire.Bind(new(transactionalmanager.TransactionalManagerUseCase), new(*transactionalmanager.TransactionalManager)), wire.Struct(new(transactionalmanager.TransactionalManagerOptions), "*"), referentialintegrity.ProvideDefaultUseCase, wire.Bind(new(referentialintegrity.ReferentialIntegrityUseCase), new(*referentialintegrity.DefaultUseCase)), wire.Struct(new(referentialintegrity.DefaultUseCaseOptions), "*"), application.ProvideDefaultUseCase, wire.Bind(new(application.ApplicationUseCase), new(*application.DefaultUseCase)), wire.Struct(new(application.DefaultUseCaseOptions), "*"), user.ProvideDefaultUseCase, wire.Bind(new(user.UserUseCase), new(*user.DefaultUserUseCase)), wire.Struct(new(user.DefaultUserUseCaseOptions), "*"), user.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(user.AccountUseCase), new(*user.DefaultUserUseCase)), wire.Struct(new(user.DefaultUseCaseTransactionalDecoratorOptions), "*"), admin.ProvideDefaultUseCase, wire.Bind(new(admin.AdminUseCase), new(*admin.DefaultUseCase)), wire.Struct(new(admin.DefaultUseCaseOptions), "*"), hsmmodule.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(hsmmodule.HSMModuleUseCase), new(*hsmmodule.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(hsmmodule.DefaultUseCaseTransactionalDecoratorOptions), "*"), hsmmodule.ProvideDefaultHSMModuleUseCase, wire.Struct(new(hsmmodule.DefaultUseCaseOptions), "*"), hsmslot.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(hsmslot.HSMSlotUseCase), new(*hsmslot.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(hsmslot.DefaultUseCaseTransactionalDecoratorOptions), "*"), hsmslot.ProvideDefaultUseCase, wire.Struct(new(hsmslot.DefaultUseCaseOptions), "*"), hsmconnector.ProvideDefaultHSMConnector, wire.Bind(new(hsmconnector.HSMConnector), new(*hsmconnector.DefaultUseCase)), wire.Struct(new(hsmconnector.DefaultUseCaseOptions), "*"), provideDefaultRoleStorageInFile, role.ProvideDefaultRoleUseCase, wire.Bind(new(role.RoleUseCase), new(*role.DefaultRoleUseCase)), wire.Struct(new(role.DefaultRoleUseCaseOptions), "*"), provideSoftHSMConfiguration, hsmconnector.ProvideDefaultDigitalSignatureManagerFactory, wire.Bind(new(hsmconnector.DigitalSignatureManagerFactory), new(*hsmconnector.DefaultDigitalSignatureManagerFactory)), wire.Struct(new(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions), "*"), hsmconnection.ProvideDefaultHSMConnectionResolver, wire.Bind(new(hsmconnection.Resolver), new(*hsmconnection.DefaultHSMConnectionResolver)), wire.Struct(new(hsmconnection.DefaultHSMConnectionResolverOptions), "*"))
+var useCasesSet = wire.NewSet(wire.Struct(new(useCasesGraph), "*"), transactionalmanager.ProvideTransactionalManager, wire.Bind(new(transactionalmanager.TransactionalManagerUseCase), new(*transactionalmanager.TransactionalManager)), wire.Struct(new(transactionalmanager.TransactionalManagerOptions), "*"), referentialintegrity.ProvideDefaultUseCase, wire.Bind(new(referentialintegrity.ReferentialIntegrityUseCase), new(*referentialintegrity.DefaultUseCase)), wire.Struct(new(referentialintegrity.DefaultUseCaseOptions), "*"), application.ProvideDefaultUseCase, wire.Bind(new(application.ApplicationUseCase), new(*application.DefaultUseCase)), wire.Struct(new(application.DefaultUseCaseOptions), "*"), user.ProvideDefaultUseCase, wire.Bind(new(user.UserUseCase), new(*user.DefaultUserUseCase)), wire.Struct(new(user.DefaultUserUseCaseOptions), "*"), user.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(user.AccountUseCase), new(*user.DefaultUserUseCase)), wire.Struct(new(user.DefaultUseCaseTransactionalDecoratorOptions), "*"), admin.ProvideDefaultUseCase, wire.Bind(new(admin.AdminUseCase), new(*admin.DefaultUseCase)), wire.Struct(new(admin.DefaultUseCaseOptions), "*"), hsmmodule.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(hsmmodule.HSMModuleUseCase), new(*hsmmodule.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(hsmmodule.DefaultUseCaseTransactionalDecoratorOptions), "*"), hsmmodule.ProvideDefaultHSMModuleUseCase, wire.Struct(new(hsmmodule.DefaultUseCaseOptions), "*"), hsmslot.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(hsmslot.HSMSlotUseCase), new(*hsmslot.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(hsmslot.DefaultUseCaseTransactionalDecoratorOptions), "*"), hsmslot.ProvideDefaultUseCase, wire.Struct(new(hsmslot.DefaultUseCaseOptions), "*"), audit.ProvideDefaultUseCase, wire.Bind(new(audit.AuditUseCase), new(*audit.DefaultUseCase)), wire.Struct(new(audit.DefaultUseCaseOptions), "*"), requester.ProvideDefaultAuditIdentityAdapter, wire.Bind(new(audit.IdentityPort), new(*requester.DefaultAuditIdentityAdapter)), wire.Struct(new(requester.DefaultAuditIdentityAdapterOptions), "*"), signingpolicy.ProvideDefaultUseCase, wire.Bind(new(signingpolicy.SigningPolicyUseCase), new(*signingpolicy.DefaultUseCase)), wire.Struct(new(signingpolicy.DefaultUseCaseOptions), "*"), transactionpolicy.ProvideDefaultTransactionPolicyAdapter, wire.Bind(new(hsmconnector.TransactionPolicyPort), new(*transactionpolicy.DefaultTransactionPolicyAdapter)), wire.Struct(new(transactionpolicy.DefaultTransactionPolicyAdapterOptions), "*"), signinglimit.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(signinglimit.SigningLimitUseCase), new(*signinglimit.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(signinglimit.DefaultUseCaseTransactionalDecoratorOptions), "*"), signinglimit.ProvideDefaultUseCase, wire.Struct(new(signinglimit.DefaultUseCaseOptions), "*"), signingquota.ProvideDefaultSigningQuotaAdapter, wire.Bind(new(hsmconnector.SigningQuotaPort), new(*signingquota.DefaultSigningQuotaAdapter)), wire.Struct(new(signingquota.DefaultSigningQuotaAdapterOptions), "*"), nonce.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(nonce.NonceUseCase), new(*nonce.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(nonce.DefaultUseCaseTransactionalDecoratorOptions), "*"), nonce.ProvideDefaultUseCase, wire.Struct(new(nonce.DefaultUseCaseOptions), "*"), nonceallocator.ProvideDefaultNonceAllocatorAdapter, wire.Bind(new(hsmconnector.NoncePort), new(*nonceallocator.DefaultNonceAllocatorAdapter)), wire.Struct(new(nonceallocator.DefaultNonceAllocatorAdapterOptions), "*"), hsmconnector.ProvideDefaultUseCaseAuditDecorator, wire.Bind(new(hsmconnector.HSMConnector), new(*hsmconnector.DefaultUseCaseAuditDecorator)), wire.Struct(new(hsmconnector.DefaultUseCaseAuditDecoratorOptions), "*"), hsmconnector.ProvideDefaultUseCaseTransactionalDecorator, wire.Struct(new(hsmconnector.DefaultUseCaseTransactionalDecoratorOptions), "*"), hsmconnector.ProvideDefaultHSMConnector, wire.Struct(new(hsmconnector.DefaultUseCaseOptions), "*"), role.ProvideDefaultUseCaseTransactionalDecorator, wire.Bind(new(role.RoleUseCase), new(*role.DefaultUseCaseTransactionalDecorator)), wire.Struct(new(role.DefaultUseCaseTransactionalDecoratorOptions), "*"), provideDefaultRoleStorageInFile, role.ProvideDefaultRoleUseCase, wire.Struct(new(role.DefaultRoleUseCaseOptions), "*"), provideSoftHSMConfiguration, provideCloudKMSConfiguration, providePKCS11Libraries, provideOperationTimeouts, hsmconnector.ProvideDefaultDigitalSignatureManagerFactory, wire.Bind(new(hsmconnector.DigitalSignatureManagerFactory), new(*hsmconnector.DefaultDigitalSignatureManagerFactory)), wire.Struct(new(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions), "*"), hsmconnection.ProvideDefaultHSMConnectionResolver, wire.Bind(new(hsmconnection.Resolver), new(*hsmconnection.DefaultHSMConnectionResolver)), wire.Struct(new(hsmconnection.DefaultHSMConnectionResolverOptions), "*"), hsmhealth.ProvideDefaultUseCase, wire.Bind(new(hsmhealth.HSMHealthUseCase), new(*hsmhealth.DefaultUseCase)), wire.Struct(new(hsmhealth.DefaultUseCaseOptions), "*"), provideHSMHealthMonitorInterval, hsmhealth.ProvideMonitor, wire.Struct(new(hsmhealth.MonitorOptions), "*"), health.ProvideDefaultUseCase, wire.Bind(new(health.HealthUseCase), new(*health.DefaultUseCase)), wire.Struct(new(health.DefaultUseCaseOptions), "*"), provideSchemaVersionCheckMode,
+
+	provideCacheConfiguration, cache.ProvideMetrics, wire.Struct(new(cache.MetricsOptions), "*"), hsmconnection.ProvideConnectionCache, wire.Struct(new(hsmconnection.ConnectionCacheOptions), "*"), pip.ProvideCache, wire.Struct(new(pip.CacheOptions), "*"), cacheinvalidation.ProvideDefaultCacheInvalidationAdapter, wire.Bind(new(application.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Bind(new(hsmslot.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Bind(new(hsmmodule.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Bind(new(user.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Bind(new(admin.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Bind(new(role.CacheInvalidationPort), new(*cacheinvalidation.DefaultCacheInvalidationAdapter)), wire.Struct(new(cacheinvalidation.DefaultCacheInvalidationAdapterOptions), "*"))
 
 func provideSoftHSMConfiguration(config Config) *hsmconnector.PKCS11Library {
 	if config.Libraries.HSMModules.SoftHSM != nil {
@@ -1038,7 +1544,46 @@ func provideSoftHSMConfiguration(config Config) *hsmconnector.PKCS11Library {
 	return nil
 }
 
-func provideDefaultRoleStorageInFile() role.RoleStorage {
+func provideCloudKMSConfiguration(config Config) *hsmconnector.CloudKMSConfiguration {
+	cloudKMS := config.Libraries.HSMModules.CloudKMS
+	if cloudKMS == nil {
+		return nil
+	}
+	cloudKMSConfig := hsmconnector.CloudKMSConfiguration{
+		Endpoint: cloudKMS.Endpoint,
+		Region:   cloudKMS.Region,
+	}
+	if cloudKMS.AccessKeyID != nil {
+		cloudKMSConfig.AccessKeyID = *cloudKMS.AccessKeyID
+	}
+	if cloudKMS.SecretAccessKey != nil {
+		cloudKMSConfig.SecretAccessKey = *cloudKMS.SecretAccessKey
+	}
+	if cloudKMS.SessionToken != nil {
+		cloudKMSConfig.SessionToken = *cloudKMS.SessionToken
+	}
+	return &cloudKMSConfig
+}
+
+func providePKCS11Libraries(config Config) hsmconnector.PKCS11Libraries {
+	if config.Libraries.HSMModules.PKCS11 == nil {
+		return nil
+	}
+	libraries := make(hsmconnector.PKCS11Libraries, len(config.Libraries.HSMModules.PKCS11.Libraries))
+	for i, library := range config.Libraries.HSMModules.PKCS11.Libraries {
+		libraries[i] = hsmconnector.PKCS11Library(library)
+	}
+	return libraries
+}
+
+func provideSchemaVersionCheckMode(config Config) health.SchemaVersionCheckMode {
+	if config.SchemaVersionCheck == nil || config.SchemaVersionCheck.Mode == nil {
+		return health.SchemaVersionCheckRefuse
+	}
+	return health.SchemaVersionCheckMode(*config.SchemaVersionCheck.Mode)
+}
+
+func provideDefaultRoleStorageInFile() role.BuiltInStorage {
 	defaultRoleStorageInFileOptions := roleinfile.DefaultRoleStorageInFileOptions{
 		FileSystem: app.RBACFiles,
 		BasePath:   "include/rbac",
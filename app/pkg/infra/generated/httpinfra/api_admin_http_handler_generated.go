@@ -35,6 +35,15 @@ type AdminAPIHTTPHandler interface {
 	// HandleHTTPAdminApplicationsRemove handles an AdminApplicationsRemove request
 	HandleHTTPAdminApplicationsRemove(responseWriter http.ResponseWriter, request *http.Request)
 
+	// HandleHTTPAdminAuditList handles an AdminAuditList request
+	HandleHTTPAdminAuditList(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminAuditVerify handles an AdminAuditVerify request
+	HandleHTTPAdminAuditVerify(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminAuthorizationExplain handles an AdminAuthorizationExplain request
+	HandleHTTPAdminAuthorizationExplain(responseWriter http.ResponseWriter, request *http.Request)
+
 	// HandleHTTPAdminModulesCreate handles an AdminModulesCreate request
 	HandleHTTPAdminModulesCreate(responseWriter http.ResponseWriter, request *http.Request)
 
@@ -44,12 +53,51 @@ type AdminAPIHTTPHandler interface {
 	// HandleHTTPAdminModulesEdit handles an AdminModulesEdit request
 	HandleHTTPAdminModulesEdit(responseWriter http.ResponseWriter, request *http.Request)
 
+	// HandleHTTPAdminModulesHealth handles an AdminModulesHealth request
+	HandleHTTPAdminModulesHealth(responseWriter http.ResponseWriter, request *http.Request)
+
 	// HandleHTTPAdminModulesList handles an AdminModulesList request
 	HandleHTTPAdminModulesList(responseWriter http.ResponseWriter, request *http.Request)
 
 	// HandleHTTPAdminModulesRemove handles an AdminModulesRemove request
 	HandleHTTPAdminModulesRemove(responseWriter http.ResponseWriter, request *http.Request)
 
+	// HandleHTTPAdminNoncesDescribe handles an AdminNoncesDescribe request
+	HandleHTTPAdminNoncesDescribe(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminNoncesReset handles an AdminNoncesReset request
+	HandleHTTPAdminNoncesReset(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminPermissionsCreate handles an AdminPermissionsCreate request
+	HandleHTTPAdminPermissionsCreate(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminPermissionsDescribe handles an AdminPermissionsDescribe request
+	HandleHTTPAdminPermissionsDescribe(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminPermissionsEdit handles an AdminPermissionsEdit request
+	HandleHTTPAdminPermissionsEdit(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminPermissionsList handles an AdminPermissionsList request
+	HandleHTTPAdminPermissionsList(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminPermissionsRemove handles an AdminPermissionsRemove request
+	HandleHTTPAdminPermissionsRemove(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminRolesCreate handles an AdminRolesCreate request
+	HandleHTTPAdminRolesCreate(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminRolesDescribe handles an AdminRolesDescribe request
+	HandleHTTPAdminRolesDescribe(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminRolesEdit handles an AdminRolesEdit request
+	HandleHTTPAdminRolesEdit(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminRolesList handles an AdminRolesList request
+	HandleHTTPAdminRolesList(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPAdminRolesRemove handles an AdminRolesRemove request
+	HandleHTTPAdminRolesRemove(responseWriter http.ResponseWriter, request *http.Request)
+
 	// HandleHTTPAdminSlotsCreate handles an AdminSlotsCreate request
 	HandleHTTPAdminSlotsCreate(responseWriter http.ResponseWriter, request *http.Request)
 
@@ -92,16 +140,48 @@ type AdminAPIAdapter interface {
 
 	AdaptAdminApplicationsRemove(ctx context.Context, data AdminApplicationsRemoveRequest) (*AdminApplicationsRemoveResponseWrapper, *httpinfra.HTTPError)
 
+	AdaptAdminAuditList(ctx context.Context, data AdminAuditListRequest) (*AdminAuditListResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminAuditVerify(ctx context.Context, data AdminAuditVerifyRequest) (*AdminAuditVerifyResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminAuthorizationExplain(ctx context.Context, data AdminAuthorizationExplainRequest) (*AdminAuthorizationExplainResponseWrapper, *httpinfra.HTTPError)
+
 	AdaptAdminModulesCreate(ctx context.Context, data AdminModulesCreateRequest) (*AdminModulesCreateResponseWrapper, *httpinfra.HTTPError)
 
 	AdaptAdminModulesDescribe(ctx context.Context, data AdminModulesDescribeRequest) (*AdminModulesDescribeResponseWrapper, *httpinfra.HTTPError)
 
 	AdaptAdminModulesEdit(ctx context.Context, data AdminModulesEditRequest) (*AdminModulesEditResponseWrapper, *httpinfra.HTTPError)
 
+	AdaptAdminModulesHealth(ctx context.Context, data AdminModulesHealthRequest) (*AdminModulesHealthResponseWrapper, *httpinfra.HTTPError)
+
 	AdaptAdminModulesList(ctx context.Context, data AdminModulesListRequest) (*AdminModulesListResponseWrapper, *httpinfra.HTTPError)
 
 	AdaptAdminModulesRemove(ctx context.Context, data AdminModulesRemoveRequest) (*AdminModulesRemoveResponseWrapper, *httpinfra.HTTPError)
 
+	AdaptAdminNoncesDescribe(ctx context.Context, data AdminNoncesDescribeRequest) (*AdminNoncesDescribeResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminNoncesReset(ctx context.Context, data AdminNoncesResetRequest) (*AdminNoncesResetResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminPermissionsCreate(ctx context.Context, data AdminPermissionsCreateRequest) (*AdminPermissionsCreateResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminPermissionsDescribe(ctx context.Context, data AdminPermissionsDescribeRequest) (*AdminPermissionsDescribeResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminPermissionsEdit(ctx context.Context, data AdminPermissionsEditRequest) (*AdminPermissionsEditResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminPermissionsList(ctx context.Context, data AdminPermissionsListRequest) (*AdminPermissionsListResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminPermissionsRemove(ctx context.Context, data AdminPermissionsRemoveRequest) (*AdminPermissionsRemoveResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminRolesCreate(ctx context.Context, data AdminRolesCreateRequest) (*AdminRolesCreateResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminRolesDescribe(ctx context.Context, data AdminRolesDescribeRequest) (*AdminRolesDescribeResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminRolesEdit(ctx context.Context, data AdminRolesEditRequest) (*AdminRolesEditResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminRolesList(ctx context.Context, data AdminRolesListRequest) (*AdminRolesListResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptAdminRolesRemove(ctx context.Context, data AdminRolesRemoveRequest) (*AdminRolesRemoveResponseWrapper, *httpinfra.HTTPError)
+
 	AdaptAdminSlotsCreate(ctx context.Context, data AdminSlotsCreateRequest) (*AdminSlotsCreateResponseWrapper, *httpinfra.HTTPError)
 
 	AdaptAdminSlotsDescribe(ctx context.Context, data AdminSlotsDescribeRequest) (*AdminSlotsDescribeResponseWrapper, *httpinfra.HTTPError)
@@ -590,21 +670,28 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminApplicationsRemove(w ht
 	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ApplicationDetail)
 }
 
-// AdminModulesCreateSupportedParams AdminModulesCreate supported parameters
-type AdminModulesCreateSupportedParams struct {
+// AdminAuditListSupportedParams AdminAuditList supported parameters
+type AdminAuditListSupportedParams struct {
 	params map[string]bool
 }
 
-// NewAdminModulesCreateSupportedParams returns a new AdminModulesCreateSupportedParams
-func NewAdminModulesCreateSupportedParams() AdminModulesCreateSupportedParams {
+// NewAdminAuditListSupportedParams returns a new AdminAuditListSupportedParams
+func NewAdminAuditListSupportedParams() AdminAuditListSupportedParams {
 	params := make(map[string]bool)
-	params["ModuleCreation"] = true
-	return AdminModulesCreateSupportedParams{
+	params["limit"] = true
+	params["offset"] = true
+	params["orderDirection"] = true
+	params["userId"] = true
+	params["applicationId"] = true
+	params["address"] = true
+	params["operation"] = true
+	params["fromSequenceNumber"] = true
+	return AdminAuditListSupportedParams{
 		params: params,
 	}
 }
 
-func (sp *AdminModulesCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+func (sp *AdminAuditListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
 	unsupportedParams := make([]string, 0)
 	queryParams := r.URL.Query()
 	for param := range queryParams {
@@ -620,12 +707,13 @@ func (sp *AdminModulesCreateSupportedParams) check(r *http.Request) *httpinfra.H
 	return nil
 }
 
-// HandleHTTPAdminModulesCreate handles AdminModulesCreate request
-func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesCreate(w http.ResponseWriter, r *http.Request) {
+// HandleHTTPAdminAuditList handles AdminAuditList request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminAuditList(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	query := r.URL.Query()
 
 	// Parameters supported check
-	supportedParams := NewAdminModulesCreateSupportedParams()
+	supportedParams := NewAdminAuditListSupportedParams()
 	supportedParamsErr := supportedParams.check(r)
 	if supportedParamsErr != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
@@ -633,41 +721,89 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesCreate(w http.Re
 	}
 
 	// Data retrieval
+	limitRawValue := query.Get("limit")
+	limitIsPresent := query.Has("limit")
 	// Conversions
-	// Request body processing
-	moduleCreationValue := ModuleCreation{}
-	errDecoder := json.NewDecoder(r.Body).Decode(&moduleCreationValue)
-	if errDecoder != nil {
-		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
-		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
-		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
-		return
+	var limitValue *int32
+	if limitIsPresent {
+		limitToInt, limitConversionErr := toInt32(limitRawValue, "limit")
+		if limitConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, limitConversionErr)
+			return
+		}
+		limitValue = new(int32)
+		*limitValue = limitToInt
 	}
-	moduleCreationValidationResult, moduleCreationValidationErr := moduleCreationValue.ValidateWith()
-
-	if moduleCreationValidationErr != nil {
-		handler.responseHandler.HandleErrorResponse(ctx, w, moduleCreationValidationErr)
-		return
+	// Data retrieval
+	offsetRawValue := query.Get("offset")
+	offsetIsPresent := query.Has("offset")
+	// Conversions
+	var offsetValue *int32
+	if offsetIsPresent {
+		offsetToInt, offsetConversionErr := toInt32(offsetRawValue, "offset")
+		if offsetConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, offsetConversionErr)
+			return
+		}
+		offsetValue = new(int32)
+		*offsetValue = offsetToInt
 	}
+	// Data retrieval
+	orderDirectionRawValue := query.Get("orderDirection")
+	// Conversions
 
-	if !moduleCreationValidationResult.Valid {
-		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
-		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, moduleCreationValidationResult.NotValidReason))
-		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
-		return
-	}
+	orderDirectionValue := orderDirectionRawValue
+	// Data retrieval
+	userIdRawValue := query.Get("userId")
+	// Conversions
 
-	moduleCreationValue.SetDefaults()
-	reqData := AdminModulesCreateRequest{}
-	reqData.ModuleCreation = moduleCreationValue
+	userIdValue := userIdRawValue
+	// Data retrieval
+	applicationIdRawValue := query.Get("applicationId")
+	// Conversions
 
-	response, adaptError := handler.adapter.AdaptAdminModulesCreate(ctx, reqData)
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	addressRawValue := query.Get("address")
+	// Conversions
+
+	addressValue := addressRawValue
+	// Data retrieval
+	operationRawValue := query.Get("operation")
+	// Conversions
+
+	operationValue := operationRawValue
+	// Data retrieval
+	fromSequenceNumberRawValue := query.Get("fromSequenceNumber")
+	fromSequenceNumberIsPresent := query.Has("fromSequenceNumber")
+	// Conversions
+	var fromSequenceNumberValue *int64
+	if fromSequenceNumberIsPresent {
+		fromSequenceNumberToInt, fromSequenceNumberConversionErr := toInt64(fromSequenceNumberRawValue, "fromSequenceNumber")
+		if fromSequenceNumberConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, fromSequenceNumberConversionErr)
+			return
+		}
+		fromSequenceNumberValue = new(int64)
+		*fromSequenceNumberValue = fromSequenceNumberToInt
+	}
+	reqData := AdminAuditListRequest{}
+	reqData.Limit = limitValue
+	reqData.Offset = offsetValue
+	reqData.OrderDirection = orderDirectionValue
+	reqData.UserId = userIdValue
+	reqData.ApplicationId = applicationIdValue
+	reqData.Address = addressValue
+	reqData.Operation = operationValue
+	reqData.FromSequenceNumber = fromSequenceNumberValue
+
+	response, adaptError := handler.adapter.AdaptAdminAuditList(ctx, reqData)
 	if adaptError != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
 		return
 	}
 
-	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+	responseValidationResult, responseValidationErr := response.AuditRecordCollection.ValidateWith()
 
 	if responseValidationErr != nil || !responseValidationResult.Valid {
 		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
@@ -677,24 +813,23 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesCreate(w http.Re
 		return
 	}
 
-	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.AuditRecordCollection)
 }
 
-// AdminModulesDescribeSupportedParams AdminModulesDescribe supported parameters
-type AdminModulesDescribeSupportedParams struct {
+// AdminAuditVerifySupportedParams AdminAuditVerify supported parameters
+type AdminAuditVerifySupportedParams struct {
 	params map[string]bool
 }
 
-// NewAdminModulesDescribeSupportedParams returns a new AdminModulesDescribeSupportedParams
-func NewAdminModulesDescribeSupportedParams() AdminModulesDescribeSupportedParams {
+// NewAdminAuditVerifySupportedParams returns a new AdminAuditVerifySupportedParams
+func NewAdminAuditVerifySupportedParams() AdminAuditVerifySupportedParams {
 	params := make(map[string]bool)
-	params["moduleId"] = true
-	return AdminModulesDescribeSupportedParams{
+	return AdminAuditVerifySupportedParams{
 		params: params,
 	}
 }
 
-func (sp *AdminModulesDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+func (sp *AdminAuditVerifySupportedParams) check(r *http.Request) *httpinfra.HTTPError {
 	unsupportedParams := make([]string, 0)
 	queryParams := r.URL.Query()
 	for param := range queryParams {
@@ -710,34 +845,27 @@ func (sp *AdminModulesDescribeSupportedParams) check(r *http.Request) *httpinfra
 	return nil
 }
 
-// HandleHTTPAdminModulesDescribe handles AdminModulesDescribe request
-func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesDescribe(w http.ResponseWriter, r *http.Request) {
+// HandleHTTPAdminAuditVerify handles AdminAuditVerify request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminAuditVerify(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	params := mux.Vars(r)
 
 	// Parameters supported check
-	supportedParams := NewAdminModulesDescribeSupportedParams()
+	supportedParams := NewAdminAuditVerifySupportedParams()
 	supportedParamsErr := supportedParams.check(r)
 	if supportedParamsErr != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
 		return
 	}
 
-	// Data retrieval
-	moduleIdRawValue := params["moduleId"]
-	// Conversions
-
-	moduleIdValue := moduleIdRawValue
-	reqData := AdminModulesDescribeRequest{}
-	reqData.ModuleId = moduleIdValue
+	reqData := AdminAuditVerifyRequest{}
 
-	response, adaptError := handler.adapter.AdaptAdminModulesDescribe(ctx, reqData)
+	response, adaptError := handler.adapter.AdaptAdminAuditVerify(ctx, reqData)
 	if adaptError != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
 		return
 	}
 
-	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+	responseValidationResult, responseValidationErr := response.AuditVerification.ValidateWith()
 
 	if responseValidationErr != nil || !responseValidationResult.Valid {
 		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
@@ -747,25 +875,24 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesDescribe(w http.
 		return
 	}
 
-	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.AuditVerification)
 }
 
-// AdminModulesEditSupportedParams AdminModulesEdit supported parameters
-type AdminModulesEditSupportedParams struct {
+// AdminAuthorizationExplainSupportedParams AdminAuthorizationExplain supported parameters
+type AdminAuthorizationExplainSupportedParams struct {
 	params map[string]bool
 }
 
-// NewAdminModulesEditSupportedParams returns a new AdminModulesEditSupportedParams
-func NewAdminModulesEditSupportedParams() AdminModulesEditSupportedParams {
+// NewAdminAuthorizationExplainSupportedParams returns a new AdminAuthorizationExplainSupportedParams
+func NewAdminAuthorizationExplainSupportedParams() AdminAuthorizationExplainSupportedParams {
 	params := make(map[string]bool)
-	params["moduleId"] = true
-	params["ModuleUpdate"] = true
-	return AdminModulesEditSupportedParams{
+	params["AuthorizationQuery"] = true
+	return AdminAuthorizationExplainSupportedParams{
 		params: params,
 	}
 }
 
-func (sp *AdminModulesEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+func (sp *AdminAuthorizationExplainSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
 	unsupportedParams := make([]string, 0)
 	queryParams := r.URL.Query()
 	for param := range queryParams {
@@ -781,61 +908,54 @@ func (sp *AdminModulesEditSupportedParams) check(r *http.Request) *httpinfra.HTT
 	return nil
 }
 
-// HandleHTTPAdminModulesEdit handles AdminModulesEdit request
-func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesEdit(w http.ResponseWriter, r *http.Request) {
+// HandleHTTPAdminAuthorizationExplain handles AdminAuthorizationExplain request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminAuthorizationExplain(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	params := mux.Vars(r)
 
 	// Parameters supported check
-	supportedParams := NewAdminModulesEditSupportedParams()
+	supportedParams := NewAdminAuthorizationExplainSupportedParams()
 	supportedParamsErr := supportedParams.check(r)
 	if supportedParamsErr != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
 		return
 	}
 
-	// Data retrieval
-	moduleIdRawValue := params["moduleId"]
-	// Conversions
-
-	moduleIdValue := moduleIdRawValue
 	// Data retrieval
 	// Conversions
 	// Request body processing
-	moduleUpdateValue := ModuleUpdate{}
-	errDecoder := json.NewDecoder(r.Body).Decode(&moduleUpdateValue)
+	authorizationQueryValue := AuthorizationQuery{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&authorizationQueryValue)
 	if errDecoder != nil {
 		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
 		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
 		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
 		return
 	}
-	moduleUpdateValidationResult, moduleUpdateValidationErr := moduleUpdateValue.ValidateWith()
+	authorizationQueryValidationResult, authorizationQueryValidationErr := authorizationQueryValue.ValidateWith()
 
-	if moduleUpdateValidationErr != nil {
-		handler.responseHandler.HandleErrorResponse(ctx, w, moduleUpdateValidationErr)
+	if authorizationQueryValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, authorizationQueryValidationErr)
 		return
 	}
 
-	if !moduleUpdateValidationResult.Valid {
+	if !authorizationQueryValidationResult.Valid {
 		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
-		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, moduleUpdateValidationResult.NotValidReason))
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, authorizationQueryValidationResult.NotValidReason))
 		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
 		return
 	}
 
-	moduleUpdateValue.SetDefaults()
-	reqData := AdminModulesEditRequest{}
-	reqData.ModuleId = moduleIdValue
-	reqData.ModuleUpdate = moduleUpdateValue
+	authorizationQueryValue.SetDefaults()
+	reqData := AdminAuthorizationExplainRequest{}
+	reqData.AuthorizationQuery = authorizationQueryValue
 
-	response, adaptError := handler.adapter.AdaptAdminModulesEdit(ctx, reqData)
+	response, adaptError := handler.adapter.AdaptAdminAuthorizationExplain(ctx, reqData)
 	if adaptError != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
 		return
 	}
 
-	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+	responseValidationResult, responseValidationErr := response.AuthorizationExplanation.ValidateWith()
 
 	if responseValidationErr != nil || !responseValidationResult.Valid {
 		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
@@ -845,27 +965,24 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesEdit(w http.Resp
 		return
 	}
 
-	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.AuthorizationExplanation)
 }
 
-// AdminModulesListSupportedParams AdminModulesList supported parameters
-type AdminModulesListSupportedParams struct {
+// AdminModulesCreateSupportedParams AdminModulesCreate supported parameters
+type AdminModulesCreateSupportedParams struct {
 	params map[string]bool
 }
 
-// NewAdminModulesListSupportedParams returns a new AdminModulesListSupportedParams
-func NewAdminModulesListSupportedParams() AdminModulesListSupportedParams {
+// NewAdminModulesCreateSupportedParams returns a new AdminModulesCreateSupportedParams
+func NewAdminModulesCreateSupportedParams() AdminModulesCreateSupportedParams {
 	params := make(map[string]bool)
-	params["limit"] = true
-	params["offset"] = true
-	params["orderBy"] = true
-	params["orderDirection"] = true
-	return AdminModulesListSupportedParams{
+	params["ModuleCreation"] = true
+	return AdminModulesCreateSupportedParams{
 		params: params,
 	}
 }
 
-func (sp *AdminModulesListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+func (sp *AdminModulesCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
 	unsupportedParams := make([]string, 0)
 	queryParams := r.URL.Query()
 	for param := range queryParams {
@@ -881,13 +998,12 @@ func (sp *AdminModulesListSupportedParams) check(r *http.Request) *httpinfra.HTT
 	return nil
 }
 
-// HandleHTTPAdminModulesList handles AdminModulesList request
-func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesList(w http.ResponseWriter, r *http.Request) {
+// HandleHTTPAdminModulesCreate handles AdminModulesCreate request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesCreate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	query := r.URL.Query()
 
 	// Parameters supported check
-	supportedParams := NewAdminModulesListSupportedParams()
+	supportedParams := NewAdminModulesCreateSupportedParams()
 	supportedParamsErr := supportedParams.check(r)
 	if supportedParamsErr != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
@@ -895,83 +1011,68 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesList(w http.Resp
 	}
 
 	// Data retrieval
-	limitRawValue := query.Get("limit")
-	limitIsPresent := query.Has("limit")
-	// Conversions
-	var limitValue *int32
-	if limitIsPresent {
-		limitToInt, limitConversionErr := toInt32(limitRawValue, "limit")
-		if limitConversionErr != nil {
-			handler.responseHandler.HandleErrorResponse(ctx, w, limitConversionErr)
-			return
-		}
-		limitValue = new(int32)
-		*limitValue = limitToInt
-	}
-	// Data retrieval
-	offsetRawValue := query.Get("offset")
-	offsetIsPresent := query.Has("offset")
 	// Conversions
-	var offsetValue *int32
-	if offsetIsPresent {
-		offsetToInt, offsetConversionErr := toInt32(offsetRawValue, "offset")
-		if offsetConversionErr != nil {
-			handler.responseHandler.HandleErrorResponse(ctx, w, offsetConversionErr)
-			return
-		}
-		offsetValue = new(int32)
-		*offsetValue = offsetToInt
+	// Request body processing
+	moduleCreationValue := ModuleCreation{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&moduleCreationValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
 	}
-	// Data retrieval
-	orderByRawValue := query.Get("orderBy")
-	// Conversions
-
-	orderByValue := orderByRawValue
-	// Data retrieval
-	orderDirectionRawValue := query.Get("orderDirection")
-	// Conversions
-
-	orderDirectionValue := orderDirectionRawValue
-	reqData := AdminModulesListRequest{}
-	reqData.Limit = limitValue
-	reqData.Offset = offsetValue
-	reqData.OrderBy = orderByValue
-	reqData.OrderDirection = orderDirectionValue
+	moduleCreationValidationResult, moduleCreationValidationErr := moduleCreationValue.ValidateWith()
 
-	response, adaptError := handler.adapter.AdaptAdminModulesList(ctx, reqData)
-	if adaptError != nil {
-		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+	if moduleCreationValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, moduleCreationValidationErr)
 		return
 	}
 
-	responseValidationResult, responseValidationErr := response.ModuleCollection.ValidateWith()
-
-	if responseValidationErr != nil || !responseValidationResult.Valid {
-		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+	if !moduleCreationValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, moduleCreationValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	moduleCreationValue.SetDefaults()
+	reqData := AdminModulesCreateRequest{}
+	reqData.ModuleCreation = moduleCreationValue
+
+	response, adaptError := handler.adapter.AdaptAdminModulesCreate(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
 		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
 		httpError.SetMessage("the response was not successfully validated")
 		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
 		return
 	}
 
-	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleCollection)
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
 }
 
-// AdminModulesRemoveSupportedParams AdminModulesRemove supported parameters
-type AdminModulesRemoveSupportedParams struct {
+// AdminModulesDescribeSupportedParams AdminModulesDescribe supported parameters
+type AdminModulesDescribeSupportedParams struct {
 	params map[string]bool
 }
 
-// NewAdminModulesRemoveSupportedParams returns a new AdminModulesRemoveSupportedParams
-func NewAdminModulesRemoveSupportedParams() AdminModulesRemoveSupportedParams {
+// NewAdminModulesDescribeSupportedParams returns a new AdminModulesDescribeSupportedParams
+func NewAdminModulesDescribeSupportedParams() AdminModulesDescribeSupportedParams {
 	params := make(map[string]bool)
 	params["moduleId"] = true
-	return AdminModulesRemoveSupportedParams{
+	return AdminModulesDescribeSupportedParams{
 		params: params,
 	}
 }
 
-func (sp *AdminModulesRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+func (sp *AdminModulesDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
 	unsupportedParams := make([]string, 0)
 	queryParams := r.URL.Query()
 	for param := range queryParams {
@@ -987,13 +1088,13 @@ func (sp *AdminModulesRemoveSupportedParams) check(r *http.Request) *httpinfra.H
 	return nil
 }
 
-// HandleHTTPAdminModulesRemove handles AdminModulesRemove request
-func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesRemove(w http.ResponseWriter, r *http.Request) {
+// HandleHTTPAdminModulesDescribe handles AdminModulesDescribe request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesDescribe(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	params := mux.Vars(r)
 
 	// Parameters supported check
-	supportedParams := NewAdminModulesRemoveSupportedParams()
+	supportedParams := NewAdminModulesDescribeSupportedParams()
 	supportedParamsErr := supportedParams.check(r)
 	if supportedParamsErr != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
@@ -1005,10 +1106,108 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesRemove(w http.Re
 	// Conversions
 
 	moduleIdValue := moduleIdRawValue
-	reqData := AdminModulesRemoveRequest{}
+	reqData := AdminModulesDescribeRequest{}
 	reqData.ModuleId = moduleIdValue
 
-	response, adaptError := handler.adapter.AdaptAdminModulesRemove(ctx, reqData)
+	response, adaptError := handler.adapter.AdaptAdminModulesDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
+}
+
+// AdminModulesEditSupportedParams AdminModulesEdit supported parameters
+type AdminModulesEditSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminModulesEditSupportedParams returns a new AdminModulesEditSupportedParams
+func NewAdminModulesEditSupportedParams() AdminModulesEditSupportedParams {
+	params := make(map[string]bool)
+	params["moduleId"] = true
+	params["ModuleUpdate"] = true
+	return AdminModulesEditSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminModulesEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminModulesEdit handles AdminModulesEdit request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesEdit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminModulesEditSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	moduleIdRawValue := params["moduleId"]
+	// Conversions
+
+	moduleIdValue := moduleIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	moduleUpdateValue := ModuleUpdate{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&moduleUpdateValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	moduleUpdateValidationResult, moduleUpdateValidationErr := moduleUpdateValue.ValidateWith()
+
+	if moduleUpdateValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, moduleUpdateValidationErr)
+		return
+	}
+
+	if !moduleUpdateValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, moduleUpdateValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	moduleUpdateValue.SetDefaults()
+	reqData := AdminModulesEditRequest{}
+	reqData.ModuleId = moduleIdValue
+	reqData.ModuleUpdate = moduleUpdateValue
+
+	response, adaptError := handler.adapter.AdaptAdminModulesEdit(ctx, reqData)
 	if adaptError != nil {
 		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
 		return
@@ -1027,6 +1226,1209 @@ func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesRemove(w http.Re
 	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
 }
 
+// AdminModulesHealthSupportedParams AdminModulesHealth supported parameters
+type AdminModulesHealthSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminModulesHealthSupportedParams returns a new AdminModulesHealthSupportedParams
+func NewAdminModulesHealthSupportedParams() AdminModulesHealthSupportedParams {
+	params := make(map[string]bool)
+	return AdminModulesHealthSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminModulesHealthSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminModulesHealth handles AdminModulesHealth request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameters supported check
+	supportedParams := NewAdminModulesHealthSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	reqData := AdminModulesHealthRequest{}
+
+	response, adaptError := handler.adapter.AdaptAdminModulesHealth(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.ModulesHealth.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModulesHealth)
+}
+
+// AdminModulesListSupportedParams AdminModulesList supported parameters
+type AdminModulesListSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminModulesListSupportedParams returns a new AdminModulesListSupportedParams
+func NewAdminModulesListSupportedParams() AdminModulesListSupportedParams {
+	params := make(map[string]bool)
+	params["limit"] = true
+	params["offset"] = true
+	params["orderBy"] = true
+	params["orderDirection"] = true
+	return AdminModulesListSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminModulesListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminModulesList handles AdminModulesList request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	// Parameters supported check
+	supportedParams := NewAdminModulesListSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	limitRawValue := query.Get("limit")
+	limitIsPresent := query.Has("limit")
+	// Conversions
+	var limitValue *int32
+	if limitIsPresent {
+		limitToInt, limitConversionErr := toInt32(limitRawValue, "limit")
+		if limitConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, limitConversionErr)
+			return
+		}
+		limitValue = new(int32)
+		*limitValue = limitToInt
+	}
+	// Data retrieval
+	offsetRawValue := query.Get("offset")
+	offsetIsPresent := query.Has("offset")
+	// Conversions
+	var offsetValue *int32
+	if offsetIsPresent {
+		offsetToInt, offsetConversionErr := toInt32(offsetRawValue, "offset")
+		if offsetConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, offsetConversionErr)
+			return
+		}
+		offsetValue = new(int32)
+		*offsetValue = offsetToInt
+	}
+	// Data retrieval
+	orderByRawValue := query.Get("orderBy")
+	// Conversions
+
+	orderByValue := orderByRawValue
+	// Data retrieval
+	orderDirectionRawValue := query.Get("orderDirection")
+	// Conversions
+
+	orderDirectionValue := orderDirectionRawValue
+	reqData := AdminModulesListRequest{}
+	reqData.Limit = limitValue
+	reqData.Offset = offsetValue
+	reqData.OrderBy = orderByValue
+	reqData.OrderDirection = orderDirectionValue
+
+	response, adaptError := handler.adapter.AdaptAdminModulesList(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.ModuleCollection.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleCollection)
+}
+
+// AdminModulesRemoveSupportedParams AdminModulesRemove supported parameters
+type AdminModulesRemoveSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminModulesRemoveSupportedParams returns a new AdminModulesRemoveSupportedParams
+func NewAdminModulesRemoveSupportedParams() AdminModulesRemoveSupportedParams {
+	params := make(map[string]bool)
+	params["moduleId"] = true
+	return AdminModulesRemoveSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminModulesRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminModulesRemove handles AdminModulesRemove request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminModulesRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminModulesRemoveSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	moduleIdRawValue := params["moduleId"]
+	// Conversions
+
+	moduleIdValue := moduleIdRawValue
+	reqData := AdminModulesRemoveRequest{}
+	reqData.ModuleId = moduleIdValue
+
+	response, adaptError := handler.adapter.AdaptAdminModulesRemove(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.ModuleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.ModuleDetail)
+}
+
+// AdminNoncesDescribeSupportedParams AdminNoncesDescribe supported parameters
+type AdminNoncesDescribeSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminNoncesDescribeSupportedParams returns a new AdminNoncesDescribeSupportedParams
+func NewAdminNoncesDescribeSupportedParams() AdminNoncesDescribeSupportedParams {
+	params := make(map[string]bool)
+	params["chainId"] = true
+	params["address"] = true
+	return AdminNoncesDescribeSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminNoncesDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminNoncesDescribe handles AdminNoncesDescribe request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminNoncesDescribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminNoncesDescribeSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	chainIdRawValue := params["chainId"]
+	// Conversions
+
+	chainIdValue := chainIdRawValue
+	// Data retrieval
+	addressRawValue := params["address"]
+	// Conversions
+
+	addressValue := addressRawValue
+	reqData := AdminNoncesDescribeRequest{}
+	reqData.ChainId = chainIdValue
+	reqData.Address = addressValue
+
+	response, adaptError := handler.adapter.AdaptAdminNoncesDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.NonceDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.NonceDetail)
+}
+
+// AdminNoncesResetSupportedParams AdminNoncesReset supported parameters
+type AdminNoncesResetSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminNoncesResetSupportedParams returns a new AdminNoncesResetSupportedParams
+func NewAdminNoncesResetSupportedParams() AdminNoncesResetSupportedParams {
+	params := make(map[string]bool)
+	params["chainId"] = true
+	params["address"] = true
+	params["NonceReset"] = true
+	return AdminNoncesResetSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminNoncesResetSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminNoncesReset handles AdminNoncesReset request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminNoncesReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminNoncesResetSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	chainIdRawValue := params["chainId"]
+	// Conversions
+
+	chainIdValue := chainIdRawValue
+	// Data retrieval
+	addressRawValue := params["address"]
+	// Conversions
+
+	addressValue := addressRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	nonceResetValue := NonceReset{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&nonceResetValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	nonceResetValidationResult, nonceResetValidationErr := nonceResetValue.ValidateWith()
+
+	if nonceResetValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, nonceResetValidationErr)
+		return
+	}
+
+	if !nonceResetValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, nonceResetValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	nonceResetValue.SetDefaults()
+	reqData := AdminNoncesResetRequest{}
+	reqData.ChainId = chainIdValue
+	reqData.Address = addressValue
+	reqData.NonceReset = nonceResetValue
+
+	response, adaptError := handler.adapter.AdaptAdminNoncesReset(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.NonceDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.NonceDetail)
+}
+
+// AdminPermissionsCreateSupportedParams AdminPermissionsCreate supported parameters
+type AdminPermissionsCreateSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminPermissionsCreateSupportedParams returns a new AdminPermissionsCreateSupportedParams
+func NewAdminPermissionsCreateSupportedParams() AdminPermissionsCreateSupportedParams {
+	params := make(map[string]bool)
+	params["PermissionCreation"] = true
+	return AdminPermissionsCreateSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminPermissionsCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminPermissionsCreate handles AdminPermissionsCreate request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminPermissionsCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameters supported check
+	supportedParams := NewAdminPermissionsCreateSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	permissionCreationValue := PermissionCreation{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&permissionCreationValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	permissionCreationValidationResult, permissionCreationValidationErr := permissionCreationValue.ValidateWith()
+
+	if permissionCreationValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, permissionCreationValidationErr)
+		return
+	}
+
+	if !permissionCreationValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, permissionCreationValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	permissionCreationValue.SetDefaults()
+	reqData := AdminPermissionsCreateRequest{}
+	reqData.PermissionCreation = permissionCreationValue
+
+	response, adaptError := handler.adapter.AdaptAdminPermissionsCreate(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.PermissionDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.PermissionDetail)
+}
+
+// AdminPermissionsDescribeSupportedParams AdminPermissionsDescribe supported parameters
+type AdminPermissionsDescribeSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminPermissionsDescribeSupportedParams returns a new AdminPermissionsDescribeSupportedParams
+func NewAdminPermissionsDescribeSupportedParams() AdminPermissionsDescribeSupportedParams {
+	params := make(map[string]bool)
+	params["permissionId"] = true
+	return AdminPermissionsDescribeSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminPermissionsDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminPermissionsDescribe handles AdminPermissionsDescribe request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminPermissionsDescribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminPermissionsDescribeSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	permissionIdRawValue := params["permissionId"]
+	// Conversions
+
+	permissionIdValue := permissionIdRawValue
+	reqData := AdminPermissionsDescribeRequest{}
+	reqData.PermissionId = permissionIdValue
+
+	response, adaptError := handler.adapter.AdaptAdminPermissionsDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.PermissionDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.PermissionDetail)
+}
+
+// AdminPermissionsEditSupportedParams AdminPermissionsEdit supported parameters
+type AdminPermissionsEditSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminPermissionsEditSupportedParams returns a new AdminPermissionsEditSupportedParams
+func NewAdminPermissionsEditSupportedParams() AdminPermissionsEditSupportedParams {
+	params := make(map[string]bool)
+	params["permissionId"] = true
+	params["PermissionUpdate"] = true
+	return AdminPermissionsEditSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminPermissionsEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminPermissionsEdit handles AdminPermissionsEdit request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminPermissionsEdit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminPermissionsEditSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	permissionIdRawValue := params["permissionId"]
+	// Conversions
+
+	permissionIdValue := permissionIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	permissionUpdateValue := PermissionUpdate{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&permissionUpdateValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	permissionUpdateValidationResult, permissionUpdateValidationErr := permissionUpdateValue.ValidateWith()
+
+	if permissionUpdateValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, permissionUpdateValidationErr)
+		return
+	}
+
+	if !permissionUpdateValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, permissionUpdateValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	permissionUpdateValue.SetDefaults()
+	reqData := AdminPermissionsEditRequest{}
+	reqData.PermissionId = permissionIdValue
+	reqData.PermissionUpdate = permissionUpdateValue
+
+	response, adaptError := handler.adapter.AdaptAdminPermissionsEdit(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.PermissionDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.PermissionDetail)
+}
+
+// AdminPermissionsListSupportedParams AdminPermissionsList supported parameters
+type AdminPermissionsListSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminPermissionsListSupportedParams returns a new AdminPermissionsListSupportedParams
+func NewAdminPermissionsListSupportedParams() AdminPermissionsListSupportedParams {
+	params := make(map[string]bool)
+	return AdminPermissionsListSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminPermissionsListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminPermissionsList handles AdminPermissionsList request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminPermissionsList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameters supported check
+	supportedParams := NewAdminPermissionsListSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	reqData := AdminPermissionsListRequest{}
+
+	response, adaptError := handler.adapter.AdaptAdminPermissionsList(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.PermissionCollection.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.PermissionCollection)
+}
+
+// AdminPermissionsRemoveSupportedParams AdminPermissionsRemove supported parameters
+type AdminPermissionsRemoveSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminPermissionsRemoveSupportedParams returns a new AdminPermissionsRemoveSupportedParams
+func NewAdminPermissionsRemoveSupportedParams() AdminPermissionsRemoveSupportedParams {
+	params := make(map[string]bool)
+	params["permissionId"] = true
+	return AdminPermissionsRemoveSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminPermissionsRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminPermissionsRemove handles AdminPermissionsRemove request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminPermissionsRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminPermissionsRemoveSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	permissionIdRawValue := params["permissionId"]
+	// Conversions
+
+	permissionIdValue := permissionIdRawValue
+	reqData := AdminPermissionsRemoveRequest{}
+	reqData.PermissionId = permissionIdValue
+
+	response, adaptError := handler.adapter.AdaptAdminPermissionsRemove(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.PermissionDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.PermissionDetail)
+}
+
+// AdminRolesCreateSupportedParams AdminRolesCreate supported parameters
+type AdminRolesCreateSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminRolesCreateSupportedParams returns a new AdminRolesCreateSupportedParams
+func NewAdminRolesCreateSupportedParams() AdminRolesCreateSupportedParams {
+	params := make(map[string]bool)
+	params["RoleCreation"] = true
+	return AdminRolesCreateSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminRolesCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminRolesCreate handles AdminRolesCreate request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminRolesCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameters supported check
+	supportedParams := NewAdminRolesCreateSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	roleCreationValue := RoleCreation{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&roleCreationValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	roleCreationValidationResult, roleCreationValidationErr := roleCreationValue.ValidateWith()
+
+	if roleCreationValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, roleCreationValidationErr)
+		return
+	}
+
+	if !roleCreationValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, roleCreationValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	roleCreationValue.SetDefaults()
+	reqData := AdminRolesCreateRequest{}
+	reqData.RoleCreation = roleCreationValue
+
+	response, adaptError := handler.adapter.AdaptAdminRolesCreate(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.RoleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.RoleDetail)
+}
+
+// AdminRolesDescribeSupportedParams AdminRolesDescribe supported parameters
+type AdminRolesDescribeSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminRolesDescribeSupportedParams returns a new AdminRolesDescribeSupportedParams
+func NewAdminRolesDescribeSupportedParams() AdminRolesDescribeSupportedParams {
+	params := make(map[string]bool)
+	params["roleId"] = true
+	return AdminRolesDescribeSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminRolesDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminRolesDescribe handles AdminRolesDescribe request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminRolesDescribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminRolesDescribeSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	roleIdRawValue := params["roleId"]
+	// Conversions
+
+	roleIdValue := roleIdRawValue
+	reqData := AdminRolesDescribeRequest{}
+	reqData.RoleId = roleIdValue
+
+	response, adaptError := handler.adapter.AdaptAdminRolesDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.RoleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.RoleDetail)
+}
+
+// AdminRolesEditSupportedParams AdminRolesEdit supported parameters
+type AdminRolesEditSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminRolesEditSupportedParams returns a new AdminRolesEditSupportedParams
+func NewAdminRolesEditSupportedParams() AdminRolesEditSupportedParams {
+	params := make(map[string]bool)
+	params["roleId"] = true
+	params["RoleUpdate"] = true
+	return AdminRolesEditSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminRolesEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminRolesEdit handles AdminRolesEdit request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminRolesEdit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminRolesEditSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	roleIdRawValue := params["roleId"]
+	// Conversions
+
+	roleIdValue := roleIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	roleUpdateValue := RoleUpdate{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&roleUpdateValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	roleUpdateValidationResult, roleUpdateValidationErr := roleUpdateValue.ValidateWith()
+
+	if roleUpdateValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, roleUpdateValidationErr)
+		return
+	}
+
+	if !roleUpdateValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, roleUpdateValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	roleUpdateValue.SetDefaults()
+	reqData := AdminRolesEditRequest{}
+	reqData.RoleId = roleIdValue
+	reqData.RoleUpdate = roleUpdateValue
+
+	response, adaptError := handler.adapter.AdaptAdminRolesEdit(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.RoleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.RoleDetail)
+}
+
+// AdminRolesListSupportedParams AdminRolesList supported parameters
+type AdminRolesListSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminRolesListSupportedParams returns a new AdminRolesListSupportedParams
+func NewAdminRolesListSupportedParams() AdminRolesListSupportedParams {
+	params := make(map[string]bool)
+	return AdminRolesListSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminRolesListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminRolesList handles AdminRolesList request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminRolesList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameters supported check
+	supportedParams := NewAdminRolesListSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	reqData := AdminRolesListRequest{}
+
+	response, adaptError := handler.adapter.AdaptAdminRolesList(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.RoleCollection.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.RoleCollection)
+}
+
+// AdminRolesRemoveSupportedParams AdminRolesRemove supported parameters
+type AdminRolesRemoveSupportedParams struct {
+	params map[string]bool
+}
+
+// NewAdminRolesRemoveSupportedParams returns a new AdminRolesRemoveSupportedParams
+func NewAdminRolesRemoveSupportedParams() AdminRolesRemoveSupportedParams {
+	params := make(map[string]bool)
+	params["roleId"] = true
+	return AdminRolesRemoveSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *AdminRolesRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPAdminRolesRemove handles AdminRolesRemove request
+func (handler DefaultAdminAPIHTTPHandler) HandleHTTPAdminRolesRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewAdminRolesRemoveSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	roleIdRawValue := params["roleId"]
+	// Conversions
+
+	roleIdValue := roleIdRawValue
+	reqData := AdminRolesRemoveRequest{}
+	reqData.RoleId = roleIdValue
+
+	response, adaptError := handler.adapter.AdaptAdminRolesRemove(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.RoleDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.RoleDetail)
+}
+
 // AdminSlotsCreateSupportedParams AdminSlotsCreate supported parameters
 type AdminSlotsCreateSupportedParams struct {
 	params map[string]bool
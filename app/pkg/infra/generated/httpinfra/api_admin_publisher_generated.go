@@ -51,6 +51,18 @@ func ProvideAdminAPIRoutes(options AdminAPIPublisherOptions) (AdminAPIRoutesPubl
 	if err != nil {
 		return 0, err
 	}
+	err = PublishAdminAuditList(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminAuditVerify(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminAuthorizationExplain(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
 	err = PublishAdminModulesCreate(options.HTTPInfra, options.Handler)
 	if err != nil {
 		return 0, err
@@ -63,6 +75,10 @@ func ProvideAdminAPIRoutes(options AdminAPIPublisherOptions) (AdminAPIRoutesPubl
 	if err != nil {
 		return 0, err
 	}
+	err = PublishAdminModulesHealth(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
 	err = PublishAdminModulesList(options.HTTPInfra, options.Handler)
 	if err != nil {
 		return 0, err
@@ -71,6 +87,54 @@ func ProvideAdminAPIRoutes(options AdminAPIPublisherOptions) (AdminAPIRoutesPubl
 	if err != nil {
 		return 0, err
 	}
+	err = PublishAdminNoncesDescribe(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminNoncesReset(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminPermissionsCreate(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminPermissionsDescribe(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminPermissionsEdit(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminPermissionsList(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminPermissionsRemove(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminRolesCreate(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminRolesDescribe(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminRolesEdit(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminRolesList(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishAdminRolesRemove(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
 	err = PublishAdminSlotsCreate(options.HTTPInfra, options.Handler)
 	if err != nil {
 		return 0, err
@@ -185,6 +249,48 @@ func PublishAdminApplicationsRemove(httpInfra httpinfra.HTTPRouter, handler Admi
 	return nil
 }
 
+// PublishAdminAuditList publishes the AdminAuditList endpoint
+func PublishAdminAuditList(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/audit/records", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.audit.list",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminAuditList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminAuditVerify publishes the AdminAuditVerify endpoint
+func PublishAdminAuditVerify(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/audit/records:verify", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.audit.verify",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminAuditVerify)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminAuthorizationExplain publishes the AdminAuthorizationExplain endpoint
+func PublishAdminAuthorizationExplain(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/authorization:explain", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "admin.authorization.explain",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminAuthorizationExplain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // PublishAdminModulesCreate publishes the AdminModulesCreate endpoint
 func PublishAdminModulesCreate(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
 	opts := httpinfra.HandlerMatchOptions{Path: "/admin/modules", Methods: []string{
@@ -227,6 +333,20 @@ func PublishAdminModulesEdit(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTT
 	return nil
 }
 
+// PublishAdminModulesHealth publishes the AdminModulesHealth endpoint
+func PublishAdminModulesHealth(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/modules:health", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.modules.health",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminModulesHealth)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // PublishAdminModulesList publishes the AdminModulesList endpoint
 func PublishAdminModulesList(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
 	opts := httpinfra.HandlerMatchOptions{Path: "/admin/modules", Methods: []string{
@@ -255,6 +375,174 @@ func PublishAdminModulesRemove(httpInfra httpinfra.HTTPRouter, handler AdminAPIH
 	return nil
 }
 
+// PublishAdminNoncesDescribe publishes the AdminNoncesDescribe endpoint
+func PublishAdminNoncesDescribe(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/nonces/{chainId}/{address}", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.nonces.describe",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminNoncesDescribe)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminNoncesReset publishes the AdminNoncesReset endpoint
+func PublishAdminNoncesReset(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/nonces/{chainId}/{address}:reset", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "admin.nonces.reset",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminNoncesReset)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminPermissionsCreate publishes the AdminPermissionsCreate endpoint
+func PublishAdminPermissionsCreate(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/permissions", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "admin.permissions.create",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminPermissionsCreate)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminPermissionsDescribe publishes the AdminPermissionsDescribe endpoint
+func PublishAdminPermissionsDescribe(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/permissions/{permissionId}", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.permissions.describe",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminPermissionsDescribe)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminPermissionsEdit publishes the AdminPermissionsEdit endpoint
+func PublishAdminPermissionsEdit(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/permissions/{permissionId}", Methods: []string{
+		http.MethodPut,
+	},
+		Action: "admin.permissions.edit",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminPermissionsEdit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminPermissionsList publishes the AdminPermissionsList endpoint
+func PublishAdminPermissionsList(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/permissions", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.permissions.list",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminPermissionsList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminPermissionsRemove publishes the AdminPermissionsRemove endpoint
+func PublishAdminPermissionsRemove(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/permissions/{permissionId}", Methods: []string{
+		http.MethodDelete,
+	},
+		Action: "admin.permissions.remove",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminPermissionsRemove)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminRolesCreate publishes the AdminRolesCreate endpoint
+func PublishAdminRolesCreate(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/roles", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "admin.roles.create",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminRolesCreate)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminRolesDescribe publishes the AdminRolesDescribe endpoint
+func PublishAdminRolesDescribe(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/roles/{roleId}", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.roles.describe",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminRolesDescribe)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminRolesEdit publishes the AdminRolesEdit endpoint
+func PublishAdminRolesEdit(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/roles/{roleId}", Methods: []string{
+		http.MethodPut,
+	},
+		Action: "admin.roles.edit",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminRolesEdit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminRolesList publishes the AdminRolesList endpoint
+func PublishAdminRolesList(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/roles", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "admin.roles.list",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminRolesList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishAdminRolesRemove publishes the AdminRolesRemove endpoint
+func PublishAdminRolesRemove(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/admin/roles/{roleId}", Methods: []string{
+		http.MethodDelete,
+	},
+		Action: "admin.roles.remove",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPAdminRolesRemove)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // PublishAdminSlotsCreate publishes the AdminSlotsCreate endpoint
 func PublishAdminSlotsCreate(httpInfra httpinfra.HTTPRouter, handler AdminAPIHTTPHandler) error {
 	opts := httpinfra.HandlerMatchOptions{Path: "/admin/modules/{moduleId}/slots", Methods: []string{
@@ -46,6 +46,27 @@ func Test_PublishAdminApplicationsRemove_Success(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// Test_PublishAdminAuditList_Success test the PublishAdminAuditList happy path
+func Test_PublishAdminAuditList_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminAuditList(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminAuditVerify_Success test the PublishAdminAuditVerify happy path
+func Test_PublishAdminAuditVerify_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminAuditVerify(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminAuthorizationExplain_Success test the PublishAdminAuthorizationExplain happy path
+func Test_PublishAdminAuthorizationExplain_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminAuthorizationExplain(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
 // Test_PublishAdminModulesCreate_Success test the PublishAdminModulesCreate happy path
 func Test_PublishAdminModulesCreate_Success(t *testing.T) {
 	http := httpinfra.ProvideHTTPRouter()
@@ -67,6 +88,13 @@ func Test_PublishAdminModulesEdit_Success(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// Test_PublishAdminModulesHealth_Success test the PublishAdminModulesHealth happy path
+func Test_PublishAdminModulesHealth_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminModulesHealth(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
 // Test_PublishAdminModulesList_Success test the PublishAdminModulesList happy path
 func Test_PublishAdminModulesList_Success(t *testing.T) {
 	http := httpinfra.ProvideHTTPRouter()
@@ -81,6 +109,90 @@ func Test_PublishAdminModulesRemove_Success(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// Test_PublishAdminNoncesDescribe_Success test the PublishAdminNoncesDescribe happy path
+func Test_PublishAdminNoncesDescribe_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminNoncesDescribe(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminNoncesReset_Success test the PublishAdminNoncesReset happy path
+func Test_PublishAdminNoncesReset_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminNoncesReset(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminPermissionsCreate_Success test the PublishAdminPermissionsCreate happy path
+func Test_PublishAdminPermissionsCreate_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminPermissionsCreate(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminPermissionsDescribe_Success test the PublishAdminPermissionsDescribe happy path
+func Test_PublishAdminPermissionsDescribe_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminPermissionsDescribe(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminPermissionsEdit_Success test the PublishAdminPermissionsEdit happy path
+func Test_PublishAdminPermissionsEdit_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminPermissionsEdit(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminPermissionsList_Success test the PublishAdminPermissionsList happy path
+func Test_PublishAdminPermissionsList_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminPermissionsList(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminPermissionsRemove_Success test the PublishAdminPermissionsRemove happy path
+func Test_PublishAdminPermissionsRemove_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminPermissionsRemove(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminRolesCreate_Success test the PublishAdminRolesCreate happy path
+func Test_PublishAdminRolesCreate_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminRolesCreate(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminRolesDescribe_Success test the PublishAdminRolesDescribe happy path
+func Test_PublishAdminRolesDescribe_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminRolesDescribe(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminRolesEdit_Success test the PublishAdminRolesEdit happy path
+func Test_PublishAdminRolesEdit_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminRolesEdit(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminRolesList_Success test the PublishAdminRolesList happy path
+func Test_PublishAdminRolesList_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminRolesList(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishAdminRolesRemove_Success test the PublishAdminRolesRemove happy path
+func Test_PublishAdminRolesRemove_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishAdminRolesRemove(http, generatedHTTPInfra.DefaultAdminAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
 // Test_PublishAdminSlotsCreate_Success test the PublishAdminSlotsCreate happy path
 func Test_PublishAdminSlotsCreate_Success(t *testing.T) {
 	http := httpinfra.ProvideHTTPRouter()
@@ -65,6 +65,45 @@ type AdminApplicationsRemoveRequest struct {
 	ApplicationId string
 }
 
+// AdminAuditListResponseWrapper response definition
+type AdminAuditListResponseWrapper struct {
+	AuditRecordCollection AuditRecordCollection
+	ResponseInfo          httpinfra.ResponseInfo
+}
+
+// AdminAuditListRequest request definition
+type AdminAuditListRequest struct {
+	Limit              *int32
+	Offset             *int32
+	OrderDirection     string
+	UserId             string
+	ApplicationId      string
+	Address            string
+	Operation          string
+	FromSequenceNumber *int64
+}
+
+// AdminAuditVerifyResponseWrapper response definition
+type AdminAuditVerifyResponseWrapper struct {
+	AuditVerification AuditVerification
+	ResponseInfo      httpinfra.ResponseInfo
+}
+
+// AdminAuditVerifyRequest request definition
+type AdminAuditVerifyRequest struct {
+}
+
+// AdminAuthorizationExplainResponseWrapper response definition
+type AdminAuthorizationExplainResponseWrapper struct {
+	AuthorizationExplanation AuthorizationExplanation
+	ResponseInfo             httpinfra.ResponseInfo
+}
+
+// AdminAuthorizationExplainRequest request definition
+type AdminAuthorizationExplainRequest struct {
+	AuthorizationQuery AuthorizationQuery
+}
+
 // AdminModulesCreateResponseWrapper response definition
 type AdminModulesCreateResponseWrapper struct {
 	ModuleDetail ModuleDetail
@@ -99,6 +138,16 @@ type AdminModulesEditRequest struct {
 	ModuleUpdate ModuleUpdate
 }
 
+// AdminModulesHealthResponseWrapper response definition
+type AdminModulesHealthResponseWrapper struct {
+	ModulesHealth ModulesHealth
+	ResponseInfo  httpinfra.ResponseInfo
+}
+
+// AdminModulesHealthRequest request definition
+type AdminModulesHealthRequest struct {
+}
+
 // AdminModulesListResponseWrapper response definition
 type AdminModulesListResponseWrapper struct {
 	ModuleCollection ModuleCollection
@@ -124,6 +173,141 @@ type AdminModulesRemoveRequest struct {
 	ModuleId string
 }
 
+// AdminNoncesDescribeResponseWrapper response definition
+type AdminNoncesDescribeResponseWrapper struct {
+	NonceDetail  NonceDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminNoncesDescribeRequest request definition
+type AdminNoncesDescribeRequest struct {
+	ChainId string
+	Address string
+}
+
+// AdminNoncesResetResponseWrapper response definition
+type AdminNoncesResetResponseWrapper struct {
+	NonceDetail  NonceDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminNoncesResetRequest request definition
+type AdminNoncesResetRequest struct {
+	ChainId    string
+	Address    string
+	NonceReset NonceReset
+}
+
+// AdminPermissionsCreateResponseWrapper response definition
+type AdminPermissionsCreateResponseWrapper struct {
+	PermissionDetail PermissionDetail
+	ResponseInfo     httpinfra.ResponseInfo
+}
+
+// AdminPermissionsCreateRequest request definition
+type AdminPermissionsCreateRequest struct {
+	PermissionCreation PermissionCreation
+}
+
+// AdminPermissionsDescribeResponseWrapper response definition
+type AdminPermissionsDescribeResponseWrapper struct {
+	PermissionDetail PermissionDetail
+	ResponseInfo     httpinfra.ResponseInfo
+}
+
+// AdminPermissionsDescribeRequest request definition
+type AdminPermissionsDescribeRequest struct {
+	PermissionId string
+}
+
+// AdminPermissionsEditResponseWrapper response definition
+type AdminPermissionsEditResponseWrapper struct {
+	PermissionDetail PermissionDetail
+	ResponseInfo     httpinfra.ResponseInfo
+}
+
+// AdminPermissionsEditRequest request definition
+type AdminPermissionsEditRequest struct {
+	PermissionId     string
+	PermissionUpdate PermissionUpdate
+}
+
+// AdminPermissionsListResponseWrapper response definition
+type AdminPermissionsListResponseWrapper struct {
+	PermissionCollection PermissionCollection
+	ResponseInfo         httpinfra.ResponseInfo
+}
+
+// AdminPermissionsListRequest request definition
+type AdminPermissionsListRequest struct {
+}
+
+// AdminPermissionsRemoveResponseWrapper response definition
+type AdminPermissionsRemoveResponseWrapper struct {
+	PermissionDetail PermissionDetail
+	ResponseInfo     httpinfra.ResponseInfo
+}
+
+// AdminPermissionsRemoveRequest request definition
+type AdminPermissionsRemoveRequest struct {
+	PermissionId string
+}
+
+// AdminRolesCreateResponseWrapper response definition
+type AdminRolesCreateResponseWrapper struct {
+	RoleDetail   RoleDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminRolesCreateRequest request definition
+type AdminRolesCreateRequest struct {
+	RoleCreation RoleCreation
+}
+
+// AdminRolesDescribeResponseWrapper response definition
+type AdminRolesDescribeResponseWrapper struct {
+	RoleDetail   RoleDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminRolesDescribeRequest request definition
+type AdminRolesDescribeRequest struct {
+	RoleId string
+}
+
+// AdminRolesEditResponseWrapper response definition
+type AdminRolesEditResponseWrapper struct {
+	RoleDetail   RoleDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminRolesEditRequest request definition
+type AdminRolesEditRequest struct {
+	RoleId     string
+	RoleUpdate RoleUpdate
+}
+
+// AdminRolesListResponseWrapper response definition
+type AdminRolesListResponseWrapper struct {
+	RoleCollection RoleCollection
+	ResponseInfo   httpinfra.ResponseInfo
+}
+
+// AdminRolesListRequest request definition
+type AdminRolesListRequest struct {
+}
+
+// AdminRolesRemoveResponseWrapper response definition
+type AdminRolesRemoveResponseWrapper struct {
+	RoleDetail   RoleDetail
+	ResponseInfo httpinfra.ResponseInfo
+}
+
+// AdminRolesRemoveRequest request definition
+type AdminRolesRemoveRequest struct {
+	RoleId string
+}
+
 // AdminSlotsCreateResponseWrapper response definition
 type AdminSlotsCreateResponseWrapper struct {
 	SlotDetail   SlotDetail
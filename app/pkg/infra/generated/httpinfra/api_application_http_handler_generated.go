@@ -26,6 +26,39 @@ type ApplicationAPIHTTPHandler interface {
 	// HandleHTTPApplicationAccountsRemove handles an ApplicationAccountsRemove request
 	HandleHTTPApplicationAccountsRemove(responseWriter http.ResponseWriter, request *http.Request)
 
+	// HandleHTTPApplicationLimitsCreate handles an ApplicationLimitsCreate request
+	HandleHTTPApplicationLimitsCreate(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationLimitsDescribe handles an ApplicationLimitsDescribe request
+	HandleHTTPApplicationLimitsDescribe(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationLimitsEdit handles an ApplicationLimitsEdit request
+	HandleHTTPApplicationLimitsEdit(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationLimitsList handles an ApplicationLimitsList request
+	HandleHTTPApplicationLimitsList(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationLimitsRemove handles an ApplicationLimitsRemove request
+	HandleHTTPApplicationLimitsRemove(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationLimitsUsage handles an ApplicationLimitsUsage request
+	HandleHTTPApplicationLimitsUsage(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationPoliciesCreate handles an ApplicationPoliciesCreate request
+	HandleHTTPApplicationPoliciesCreate(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationPoliciesDescribe handles an ApplicationPoliciesDescribe request
+	HandleHTTPApplicationPoliciesDescribe(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationPoliciesEdit handles an ApplicationPoliciesEdit request
+	HandleHTTPApplicationPoliciesEdit(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationPoliciesList handles an ApplicationPoliciesList request
+	HandleHTTPApplicationPoliciesList(responseWriter http.ResponseWriter, request *http.Request)
+
+	// HandleHTTPApplicationPoliciesRemove handles an ApplicationPoliciesRemove request
+	HandleHTTPApplicationPoliciesRemove(responseWriter http.ResponseWriter, request *http.Request)
+
 	// HandleHTTPApplicationUsersCreate handles an ApplicationUsersCreate request
 	HandleHTTPApplicationUsersCreate(responseWriter http.ResponseWriter, request *http.Request)
 
@@ -47,6 +80,28 @@ type ApplicationAPIAdapter interface {
 
 	AdaptApplicationAccountsRemove(ctx context.Context, data ApplicationAccountsRemoveRequest) (*ApplicationAccountsRemoveResponseWrapper, *httpinfra.HTTPError)
 
+	AdaptApplicationLimitsCreate(ctx context.Context, data ApplicationLimitsCreateRequest) (*ApplicationLimitsCreateResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationLimitsDescribe(ctx context.Context, data ApplicationLimitsDescribeRequest) (*ApplicationLimitsDescribeResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationLimitsEdit(ctx context.Context, data ApplicationLimitsEditRequest) (*ApplicationLimitsEditResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationLimitsList(ctx context.Context, data ApplicationLimitsListRequest) (*ApplicationLimitsListResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationLimitsRemove(ctx context.Context, data ApplicationLimitsRemoveRequest) (*ApplicationLimitsRemoveResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationLimitsUsage(ctx context.Context, data ApplicationLimitsUsageRequest) (*ApplicationLimitsUsageResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationPoliciesCreate(ctx context.Context, data ApplicationPoliciesCreateRequest) (*ApplicationPoliciesCreateResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationPoliciesDescribe(ctx context.Context, data ApplicationPoliciesDescribeRequest) (*ApplicationPoliciesDescribeResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationPoliciesEdit(ctx context.Context, data ApplicationPoliciesEditRequest) (*ApplicationPoliciesEditResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationPoliciesList(ctx context.Context, data ApplicationPoliciesListRequest) (*ApplicationPoliciesListResponseWrapper, *httpinfra.HTTPError)
+
+	AdaptApplicationPoliciesRemove(ctx context.Context, data ApplicationPoliciesRemoveRequest) (*ApplicationPoliciesRemoveResponseWrapper, *httpinfra.HTTPError)
+
 	AdaptApplicationUsersCreate(ctx context.Context, data ApplicationUsersCreateRequest) (*ApplicationUsersCreateResponseWrapper, *httpinfra.HTTPError)
 
 	AdaptApplicationUsersDescribe(ctx context.Context, data ApplicationUsersDescribeRequest) (*ApplicationUsersDescribeResponseWrapper, *httpinfra.HTTPError)
@@ -277,6 +332,1031 @@ func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationAccountsRem
 	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.UserDetail)
 }
 
+// ApplicationLimitsCreateSupportedParams ApplicationLimitsCreate supported parameters
+type ApplicationLimitsCreateSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsCreateSupportedParams returns a new ApplicationLimitsCreateSupportedParams
+func NewApplicationLimitsCreateSupportedParams() ApplicationLimitsCreateSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["SigningLimitCreation"] = true
+	return ApplicationLimitsCreateSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsCreate handles ApplicationLimitsCreate request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsCreateSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	signingLimitCreationValue := SigningLimitCreation{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&signingLimitCreationValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	signingLimitCreationValidationResult, signingLimitCreationValidationErr := signingLimitCreationValue.ValidateWith()
+
+	if signingLimitCreationValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, signingLimitCreationValidationErr)
+		return
+	}
+
+	if !signingLimitCreationValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, signingLimitCreationValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	signingLimitCreationValue.SetDefaults()
+	reqData := ApplicationLimitsCreateRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.SigningLimitCreation = signingLimitCreationValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsCreate(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitDetail)
+}
+
+// ApplicationLimitsDescribeSupportedParams ApplicationLimitsDescribe supported parameters
+type ApplicationLimitsDescribeSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsDescribeSupportedParams returns a new ApplicationLimitsDescribeSupportedParams
+func NewApplicationLimitsDescribeSupportedParams() ApplicationLimitsDescribeSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limitId"] = true
+	return ApplicationLimitsDescribeSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsDescribe handles ApplicationLimitsDescribe request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsDescribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsDescribeSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitIdRawValue := params["limitId"]
+	// Conversions
+
+	limitIdValue := limitIdRawValue
+	reqData := ApplicationLimitsDescribeRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.LimitId = limitIdValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitDetail)
+}
+
+// ApplicationLimitsEditSupportedParams ApplicationLimitsEdit supported parameters
+type ApplicationLimitsEditSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsEditSupportedParams returns a new ApplicationLimitsEditSupportedParams
+func NewApplicationLimitsEditSupportedParams() ApplicationLimitsEditSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limitId"] = true
+	params["SigningLimitUpdate"] = true
+	return ApplicationLimitsEditSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsEdit handles ApplicationLimitsEdit request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsEdit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsEditSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitIdRawValue := params["limitId"]
+	// Conversions
+
+	limitIdValue := limitIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	signingLimitUpdateValue := SigningLimitUpdate{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&signingLimitUpdateValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	signingLimitUpdateValidationResult, signingLimitUpdateValidationErr := signingLimitUpdateValue.ValidateWith()
+
+	if signingLimitUpdateValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, signingLimitUpdateValidationErr)
+		return
+	}
+
+	if !signingLimitUpdateValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, signingLimitUpdateValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	signingLimitUpdateValue.SetDefaults()
+	reqData := ApplicationLimitsEditRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.LimitId = limitIdValue
+	reqData.SigningLimitUpdate = signingLimitUpdateValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsEdit(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitDetail)
+}
+
+// ApplicationLimitsListSupportedParams ApplicationLimitsList supported parameters
+type ApplicationLimitsListSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsListSupportedParams returns a new ApplicationLimitsListSupportedParams
+func NewApplicationLimitsListSupportedParams() ApplicationLimitsListSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limit"] = true
+	params["offset"] = true
+	params["orderBy"] = true
+	params["orderDirection"] = true
+	return ApplicationLimitsListSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsList handles ApplicationLimitsList request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsListSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitRawValue := query.Get("limit")
+	limitIsPresent := query.Has("limit")
+	// Conversions
+	var limitValue *int32
+	if limitIsPresent {
+		limitToInt, limitConversionErr := toInt32(limitRawValue, "limit")
+		if limitConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, limitConversionErr)
+			return
+		}
+		limitValue = new(int32)
+		*limitValue = limitToInt
+	}
+	// Data retrieval
+	offsetRawValue := query.Get("offset")
+	offsetIsPresent := query.Has("offset")
+	// Conversions
+	var offsetValue *int32
+	if offsetIsPresent {
+		offsetToInt, offsetConversionErr := toInt32(offsetRawValue, "offset")
+		if offsetConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, offsetConversionErr)
+			return
+		}
+		offsetValue = new(int32)
+		*offsetValue = offsetToInt
+	}
+	// Data retrieval
+	orderByRawValue := query.Get("orderBy")
+	// Conversions
+
+	orderByValue := orderByRawValue
+	// Data retrieval
+	orderDirectionRawValue := query.Get("orderDirection")
+	// Conversions
+
+	orderDirectionValue := orderDirectionRawValue
+	reqData := ApplicationLimitsListRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.Limit = limitValue
+	reqData.Offset = offsetValue
+	reqData.OrderBy = orderByValue
+	reqData.OrderDirection = orderDirectionValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsList(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitCollection.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitCollection)
+}
+
+// ApplicationLimitsRemoveSupportedParams ApplicationLimitsRemove supported parameters
+type ApplicationLimitsRemoveSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsRemoveSupportedParams returns a new ApplicationLimitsRemoveSupportedParams
+func NewApplicationLimitsRemoveSupportedParams() ApplicationLimitsRemoveSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limitId"] = true
+	return ApplicationLimitsRemoveSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsRemove handles ApplicationLimitsRemove request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsRemoveSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitIdRawValue := params["limitId"]
+	// Conversions
+
+	limitIdValue := limitIdRawValue
+	reqData := ApplicationLimitsRemoveRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.LimitId = limitIdValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsRemove(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitDetail)
+}
+
+// ApplicationLimitsUsageSupportedParams ApplicationLimitsUsage supported parameters
+type ApplicationLimitsUsageSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationLimitsUsageSupportedParams returns a new ApplicationLimitsUsageSupportedParams
+func NewApplicationLimitsUsageSupportedParams() ApplicationLimitsUsageSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limitId"] = true
+	return ApplicationLimitsUsageSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationLimitsUsageSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationLimitsUsage handles ApplicationLimitsUsage request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationLimitsUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationLimitsUsageSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitIdRawValue := params["limitId"]
+	// Conversions
+
+	limitIdValue := limitIdRawValue
+	reqData := ApplicationLimitsUsageRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.LimitId = limitIdValue
+
+	response, adaptError := handler.adapter.AdaptApplicationLimitsUsage(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningLimitUsage.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningLimitUsage)
+}
+
+// ApplicationPoliciesCreateSupportedParams ApplicationPoliciesCreate supported parameters
+type ApplicationPoliciesCreateSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationPoliciesCreateSupportedParams returns a new ApplicationPoliciesCreateSupportedParams
+func NewApplicationPoliciesCreateSupportedParams() ApplicationPoliciesCreateSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["SigningPolicyCreation"] = true
+	return ApplicationPoliciesCreateSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationPoliciesCreateSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationPoliciesCreate handles ApplicationPoliciesCreate request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationPoliciesCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationPoliciesCreateSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	signingPolicyCreationValue := SigningPolicyCreation{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&signingPolicyCreationValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	signingPolicyCreationValidationResult, signingPolicyCreationValidationErr := signingPolicyCreationValue.ValidateWith()
+
+	if signingPolicyCreationValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, signingPolicyCreationValidationErr)
+		return
+	}
+
+	if !signingPolicyCreationValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, signingPolicyCreationValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	signingPolicyCreationValue.SetDefaults()
+	reqData := ApplicationPoliciesCreateRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.SigningPolicyCreation = signingPolicyCreationValue
+
+	response, adaptError := handler.adapter.AdaptApplicationPoliciesCreate(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningPolicyDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningPolicyDetail)
+}
+
+// ApplicationPoliciesDescribeSupportedParams ApplicationPoliciesDescribe supported parameters
+type ApplicationPoliciesDescribeSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationPoliciesDescribeSupportedParams returns a new ApplicationPoliciesDescribeSupportedParams
+func NewApplicationPoliciesDescribeSupportedParams() ApplicationPoliciesDescribeSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["policyId"] = true
+	return ApplicationPoliciesDescribeSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationPoliciesDescribeSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationPoliciesDescribe handles ApplicationPoliciesDescribe request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationPoliciesDescribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationPoliciesDescribeSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	policyIdRawValue := params["policyId"]
+	// Conversions
+
+	policyIdValue := policyIdRawValue
+	reqData := ApplicationPoliciesDescribeRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.PolicyId = policyIdValue
+
+	response, adaptError := handler.adapter.AdaptApplicationPoliciesDescribe(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningPolicyDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningPolicyDetail)
+}
+
+// ApplicationPoliciesEditSupportedParams ApplicationPoliciesEdit supported parameters
+type ApplicationPoliciesEditSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationPoliciesEditSupportedParams returns a new ApplicationPoliciesEditSupportedParams
+func NewApplicationPoliciesEditSupportedParams() ApplicationPoliciesEditSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["policyId"] = true
+	params["SigningPolicyUpdate"] = true
+	return ApplicationPoliciesEditSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationPoliciesEditSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationPoliciesEdit handles ApplicationPoliciesEdit request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationPoliciesEdit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationPoliciesEditSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	policyIdRawValue := params["policyId"]
+	// Conversions
+
+	policyIdValue := policyIdRawValue
+	// Data retrieval
+	// Conversions
+	// Request body processing
+	signingPolicyUpdateValue := SigningPolicyUpdate{}
+	errDecoder := json.NewDecoder(r.Body).Decode(&signingPolicyUpdateValue)
+	if errDecoder != nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when parsing the JSON request data [%s]: [%s]", r.Body, errDecoder.Error()))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+	signingPolicyUpdateValidationResult, signingPolicyUpdateValidationErr := signingPolicyUpdateValue.ValidateWith()
+
+	if signingPolicyUpdateValidationErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, signingPolicyUpdateValidationErr)
+		return
+	}
+
+	if !signingPolicyUpdateValidationResult.Valid {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("an error occurred when validating the JSON request data [%s]: [%s]", r.Body, signingPolicyUpdateValidationResult.NotValidReason))
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	signingPolicyUpdateValue.SetDefaults()
+	reqData := ApplicationPoliciesEditRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.PolicyId = policyIdValue
+	reqData.SigningPolicyUpdate = signingPolicyUpdateValue
+
+	response, adaptError := handler.adapter.AdaptApplicationPoliciesEdit(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningPolicyDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningPolicyDetail)
+}
+
+// ApplicationPoliciesListSupportedParams ApplicationPoliciesList supported parameters
+type ApplicationPoliciesListSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationPoliciesListSupportedParams returns a new ApplicationPoliciesListSupportedParams
+func NewApplicationPoliciesListSupportedParams() ApplicationPoliciesListSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["limit"] = true
+	params["offset"] = true
+	params["orderBy"] = true
+	params["orderDirection"] = true
+	return ApplicationPoliciesListSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationPoliciesListSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationPoliciesList handles ApplicationPoliciesList request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationPoliciesList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	// Parameters supported check
+	supportedParams := NewApplicationPoliciesListSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	limitRawValue := query.Get("limit")
+	limitIsPresent := query.Has("limit")
+	// Conversions
+	var limitValue *int32
+	if limitIsPresent {
+		limitToInt, limitConversionErr := toInt32(limitRawValue, "limit")
+		if limitConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, limitConversionErr)
+			return
+		}
+		limitValue = new(int32)
+		*limitValue = limitToInt
+	}
+	// Data retrieval
+	offsetRawValue := query.Get("offset")
+	offsetIsPresent := query.Has("offset")
+	// Conversions
+	var offsetValue *int32
+	if offsetIsPresent {
+		offsetToInt, offsetConversionErr := toInt32(offsetRawValue, "offset")
+		if offsetConversionErr != nil {
+			handler.responseHandler.HandleErrorResponse(ctx, w, offsetConversionErr)
+			return
+		}
+		offsetValue = new(int32)
+		*offsetValue = offsetToInt
+	}
+	// Data retrieval
+	orderByRawValue := query.Get("orderBy")
+	// Conversions
+
+	orderByValue := orderByRawValue
+	// Data retrieval
+	orderDirectionRawValue := query.Get("orderDirection")
+	// Conversions
+
+	orderDirectionValue := orderDirectionRawValue
+	reqData := ApplicationPoliciesListRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.Limit = limitValue
+	reqData.Offset = offsetValue
+	reqData.OrderBy = orderByValue
+	reqData.OrderDirection = orderDirectionValue
+
+	response, adaptError := handler.adapter.AdaptApplicationPoliciesList(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningPolicyCollection.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningPolicyCollection)
+}
+
+// ApplicationPoliciesRemoveSupportedParams ApplicationPoliciesRemove supported parameters
+type ApplicationPoliciesRemoveSupportedParams struct {
+	params map[string]bool
+}
+
+// NewApplicationPoliciesRemoveSupportedParams returns a new ApplicationPoliciesRemoveSupportedParams
+func NewApplicationPoliciesRemoveSupportedParams() ApplicationPoliciesRemoveSupportedParams {
+	params := make(map[string]bool)
+	params["applicationId"] = true
+	params["policyId"] = true
+	return ApplicationPoliciesRemoveSupportedParams{
+		params: params,
+	}
+}
+
+func (sp *ApplicationPoliciesRemoveSupportedParams) check(r *http.Request) *httpinfra.HTTPError {
+	unsupportedParams := make([]string, 0)
+	queryParams := r.URL.Query()
+	for param := range queryParams {
+		if !sp.params[param] {
+			unsupportedParams = append(unsupportedParams, param)
+		}
+	}
+	if len(unsupportedParams) > 0 {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage(fmt.Sprintf("Unsupported parameters in request [%s]", strings.Join(unsupportedParams, ",")))
+		return httpError
+	}
+	return nil
+}
+
+// HandleHTTPApplicationPoliciesRemove handles ApplicationPoliciesRemove request
+func (handler DefaultApplicationAPIHTTPHandler) HandleHTTPApplicationPoliciesRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := mux.Vars(r)
+
+	// Parameters supported check
+	supportedParams := NewApplicationPoliciesRemoveSupportedParams()
+	supportedParamsErr := supportedParams.check(r)
+	if supportedParamsErr != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, supportedParamsErr)
+		return
+	}
+
+	// Data retrieval
+	applicationIdRawValue := params["applicationId"]
+	// Conversions
+
+	applicationIdValue := applicationIdRawValue
+	// Data retrieval
+	policyIdRawValue := params["policyId"]
+	// Conversions
+
+	policyIdValue := policyIdRawValue
+	reqData := ApplicationPoliciesRemoveRequest{}
+	reqData.ApplicationId = applicationIdValue
+	reqData.PolicyId = policyIdValue
+
+	response, adaptError := handler.adapter.AdaptApplicationPoliciesRemove(ctx, reqData)
+	if adaptError != nil {
+		handler.responseHandler.HandleErrorResponse(ctx, w, adaptError)
+		return
+	}
+
+	responseValidationResult, responseValidationErr := response.SigningPolicyDetail.ValidateWith()
+
+	if responseValidationErr != nil || !responseValidationResult.Valid {
+		logger.LogEntry(ctx).Errorf("error validating response [%+v]", response)
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("the response was not successfully validated")
+		handler.responseHandler.HandleErrorResponse(ctx, w, httpError)
+		return
+	}
+
+	handler.responseHandler.HandleSuccessResponse(ctx, w, response.ResponseInfo, response.SigningPolicyDetail)
+}
+
 // ApplicationUsersCreateSupportedParams ApplicationUsersCreate supported parameters
 type ApplicationUsersCreateSupportedParams struct {
 	params map[string]bool
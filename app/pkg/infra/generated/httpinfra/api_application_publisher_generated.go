@@ -39,6 +39,50 @@ func ProvideApplicationAPIRoutes(options ApplicationAPIPublisherOptions) (Applic
 	if err != nil {
 		return 0, err
 	}
+	err = PublishApplicationLimitsCreate(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationLimitsDescribe(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationLimitsEdit(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationLimitsList(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationLimitsRemove(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationLimitsUsage(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationPoliciesCreate(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationPoliciesDescribe(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationPoliciesEdit(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationPoliciesList(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
+	err = PublishApplicationPoliciesRemove(options.HTTPInfra, options.Handler)
+	if err != nil {
+		return 0, err
+	}
 	err = PublishApplicationUsersCreate(options.HTTPInfra, options.Handler)
 	if err != nil {
 		return 0, err
@@ -91,6 +135,160 @@ func PublishApplicationAccountsRemove(httpInfra httpinfra.HTTPRouter, handler Ap
 	return nil
 }
 
+// PublishApplicationLimitsCreate publishes the ApplicationLimitsCreate endpoint
+func PublishApplicationLimitsCreate(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "application.limits.create",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsCreate)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationLimitsDescribe publishes the ApplicationLimitsDescribe endpoint
+func PublishApplicationLimitsDescribe(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits/{limitId}", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "application.limits.describe",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsDescribe)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationLimitsEdit publishes the ApplicationLimitsEdit endpoint
+func PublishApplicationLimitsEdit(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits/{limitId}", Methods: []string{
+		http.MethodPut,
+	},
+		Action: "application.limits.edit",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsEdit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationLimitsList publishes the ApplicationLimitsList endpoint
+func PublishApplicationLimitsList(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "application.limits.list",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationLimitsRemove publishes the ApplicationLimitsRemove endpoint
+func PublishApplicationLimitsRemove(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits/{limitId}", Methods: []string{
+		http.MethodDelete,
+	},
+		Action: "application.limits.remove",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsRemove)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationLimitsUsage publishes the ApplicationLimitsUsage endpoint
+func PublishApplicationLimitsUsage(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/limits/{limitId}/usage", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "application.limits.usage",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationLimitsUsage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationPoliciesCreate publishes the ApplicationPoliciesCreate endpoint
+func PublishApplicationPoliciesCreate(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/policies", Methods: []string{
+		http.MethodPost,
+	},
+		Action: "application.policies.create",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationPoliciesCreate)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationPoliciesDescribe publishes the ApplicationPoliciesDescribe endpoint
+func PublishApplicationPoliciesDescribe(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/policies/{policyId}", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "application.policies.describe",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationPoliciesDescribe)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationPoliciesEdit publishes the ApplicationPoliciesEdit endpoint
+func PublishApplicationPoliciesEdit(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/policies/{policyId}", Methods: []string{
+		http.MethodPut,
+	},
+		Action: "application.policies.edit",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationPoliciesEdit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationPoliciesList publishes the ApplicationPoliciesList endpoint
+func PublishApplicationPoliciesList(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/policies", Methods: []string{
+		http.MethodGet,
+	},
+		Action: "application.policies.list",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationPoliciesList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishApplicationPoliciesRemove publishes the ApplicationPoliciesRemove endpoint
+func PublishApplicationPoliciesRemove(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
+	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/policies/{policyId}", Methods: []string{
+		http.MethodDelete,
+	},
+		Action: "application.policies.remove",
+	}
+	err := httpInfra.RegisterRawHandler(opts, handler.HandleHTTPApplicationPoliciesRemove)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // PublishApplicationUsersCreate publishes the ApplicationUsersCreate endpoint
 func PublishApplicationUsersCreate(httpInfra httpinfra.HTTPRouter, handler ApplicationAPIHTTPHandler) error {
 	opts := httpinfra.HandlerMatchOptions{Path: "/applications/{applicationId}/users", Methods: []string{
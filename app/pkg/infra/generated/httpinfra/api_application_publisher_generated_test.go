@@ -25,6 +25,83 @@ func Test_PublishApplicationAccountsRemove_Success(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// Test_PublishApplicationLimitsCreate_Success test the PublishApplicationLimitsCreate happy path
+func Test_PublishApplicationLimitsCreate_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsCreate(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationLimitsDescribe_Success test the PublishApplicationLimitsDescribe happy path
+func Test_PublishApplicationLimitsDescribe_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsDescribe(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationLimitsEdit_Success test the PublishApplicationLimitsEdit happy path
+func Test_PublishApplicationLimitsEdit_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsEdit(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationLimitsList_Success test the PublishApplicationLimitsList happy path
+func Test_PublishApplicationLimitsList_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsList(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationLimitsRemove_Success test the PublishApplicationLimitsRemove happy path
+func Test_PublishApplicationLimitsRemove_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsRemove(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationLimitsUsage_Success test the PublishApplicationLimitsUsage happy path
+func Test_PublishApplicationLimitsUsage_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationLimitsUsage(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationPoliciesCreate_Success test the PublishApplicationPoliciesCreate happy path
+func Test_PublishApplicationPoliciesCreate_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationPoliciesCreate(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationPoliciesDescribe_Success test the PublishApplicationPoliciesDescribe happy path
+func Test_PublishApplicationPoliciesDescribe_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationPoliciesDescribe(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationPoliciesEdit_Success test the PublishApplicationPoliciesEdit happy path
+func Test_PublishApplicationPoliciesEdit_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationPoliciesEdit(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationPoliciesList_Success test the PublishApplicationPoliciesList happy path
+func Test_PublishApplicationPoliciesList_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationPoliciesList(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
+// Test_PublishApplicationPoliciesRemove_Success test the PublishApplicationPoliciesRemove happy path
+func Test_PublishApplicationPoliciesRemove_Success(t *testing.T) {
+	http := httpinfra.ProvideHTTPRouter()
+	err := generatedHTTPInfra.PublishApplicationPoliciesRemove(http, generatedHTTPInfra.DefaultApplicationAPIHTTPHandler{})
+	require.Nil(t, err)
+}
+
 // Test_PublishApplicationUsersCreate_Success test the PublishApplicationUsersCreate happy path
 func Test_PublishApplicationUsersCreate_Success(t *testing.T) {
 	http := httpinfra.ProvideHTTPRouter()
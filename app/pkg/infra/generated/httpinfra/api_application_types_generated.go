@@ -32,6 +32,146 @@ type ApplicationAccountsRemoveRequest struct {
 	AccountId     string
 }
 
+// ApplicationLimitsCreateResponseWrapper response definition
+type ApplicationLimitsCreateResponseWrapper struct {
+	SigningLimitDetail SigningLimitDetail
+	ResponseInfo       httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsCreateRequest request definition
+type ApplicationLimitsCreateRequest struct {
+	ApplicationId        string
+	SigningLimitCreation SigningLimitCreation
+}
+
+// ApplicationLimitsDescribeResponseWrapper response definition
+type ApplicationLimitsDescribeResponseWrapper struct {
+	SigningLimitDetail SigningLimitDetail
+	ResponseInfo       httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsDescribeRequest request definition
+type ApplicationLimitsDescribeRequest struct {
+	ApplicationId string
+	LimitId       string
+}
+
+// ApplicationLimitsEditResponseWrapper response definition
+type ApplicationLimitsEditResponseWrapper struct {
+	SigningLimitDetail SigningLimitDetail
+	ResponseInfo       httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsEditRequest request definition
+type ApplicationLimitsEditRequest struct {
+	ApplicationId      string
+	LimitId            string
+	SigningLimitUpdate SigningLimitUpdate
+}
+
+// ApplicationLimitsListResponseWrapper response definition
+type ApplicationLimitsListResponseWrapper struct {
+	SigningLimitCollection SigningLimitCollection
+	ResponseInfo           httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsListRequest request definition
+type ApplicationLimitsListRequest struct {
+	ApplicationId  string
+	Limit          *int32
+	Offset         *int32
+	OrderBy        string
+	OrderDirection string
+}
+
+// ApplicationLimitsRemoveResponseWrapper response definition
+type ApplicationLimitsRemoveResponseWrapper struct {
+	SigningLimitDetail SigningLimitDetail
+	ResponseInfo       httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsRemoveRequest request definition
+type ApplicationLimitsRemoveRequest struct {
+	ApplicationId string
+	LimitId       string
+}
+
+// ApplicationLimitsUsageResponseWrapper response definition
+type ApplicationLimitsUsageResponseWrapper struct {
+	SigningLimitUsage SigningLimitUsage
+	ResponseInfo      httpinfra.ResponseInfo
+}
+
+// ApplicationLimitsUsageRequest request definition
+type ApplicationLimitsUsageRequest struct {
+	ApplicationId string
+	LimitId       string
+}
+
+// ApplicationPoliciesCreateResponseWrapper response definition
+type ApplicationPoliciesCreateResponseWrapper struct {
+	SigningPolicyDetail SigningPolicyDetail
+	ResponseInfo        httpinfra.ResponseInfo
+}
+
+// ApplicationPoliciesCreateRequest request definition
+type ApplicationPoliciesCreateRequest struct {
+	ApplicationId         string
+	SigningPolicyCreation SigningPolicyCreation
+}
+
+// ApplicationPoliciesDescribeResponseWrapper response definition
+type ApplicationPoliciesDescribeResponseWrapper struct {
+	SigningPolicyDetail SigningPolicyDetail
+	ResponseInfo        httpinfra.ResponseInfo
+}
+
+// ApplicationPoliciesDescribeRequest request definition
+type ApplicationPoliciesDescribeRequest struct {
+	ApplicationId string
+	PolicyId      string
+}
+
+// ApplicationPoliciesEditResponseWrapper response definition
+type ApplicationPoliciesEditResponseWrapper struct {
+	SigningPolicyDetail SigningPolicyDetail
+	ResponseInfo        httpinfra.ResponseInfo
+}
+
+// ApplicationPoliciesEditRequest request definition
+type ApplicationPoliciesEditRequest struct {
+	ApplicationId       string
+	PolicyId            string
+	SigningPolicyUpdate SigningPolicyUpdate
+}
+
+// ApplicationPoliciesListResponseWrapper response definition
+type ApplicationPoliciesListResponseWrapper struct {
+	SigningPolicyCollection SigningPolicyCollection
+	ResponseInfo            httpinfra.ResponseInfo
+}
+
+// ApplicationPoliciesListRequest request definition
+type ApplicationPoliciesListRequest struct {
+	ApplicationId  string
+	Limit          *int32
+	Offset         *int32
+	OrderBy        string
+	OrderDirection string
+}
+
+// ApplicationPoliciesRemoveResponseWrapper response definition
+type ApplicationPoliciesRemoveResponseWrapper struct {
+	SigningPolicyDetail SigningPolicyDetail
+	ResponseInfo        httpinfra.ResponseInfo
+}
+
+// ApplicationPoliciesRemoveRequest request definition
+type ApplicationPoliciesRemoveRequest struct {
+	ApplicationId string
+	PolicyId      string
+}
+
 // ApplicationUsersCreateResponseWrapper response definition
 type ApplicationUsersCreateResponseWrapper struct {
 	UserDetail   UserDetail
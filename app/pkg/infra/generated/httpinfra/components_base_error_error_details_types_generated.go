@@ -13,6 +13,8 @@ type BaseErrorErrorDetails struct {
 	Message *string `json:"message"`
 	// Unique Error Identifier that can be used to track the error.
 	TraceableErrorId *string `json:"traceableErrorId"`
+	// Step of the authorization that denied the request. Only present in `PERMISSION_DENIED` errors. Use the `/admin/authorization:explain` endpoint to get the full trace of the authorization.
+	DenialReason *string `json:"denialReason,omitempty"`
 }
 
 // ValidateWith check whether BaseErrorErrorDetails is valid
@@ -13,13 +13,17 @@ import (
 type ModuleSpecConfigurationHsmKind string
 
 const (
-	HsmKindSofthsm ModuleSpecConfigurationHsmKind = "softHSM"
+	HsmKindSofthsm  ModuleSpecConfigurationHsmKind = "softHSM"
+	HsmKindCloudkms ModuleSpecConfigurationHsmKind = "cloudKMS"
+	HsmKindPkcs11   ModuleSpecConfigurationHsmKind = "pkcs11"
 )
 
 // ModuleSpecConfiguration - struct for ModuleSpecConfiguration
 type ModuleSpecConfiguration struct {
-	HsmKind ModuleSpecConfigurationHsmKind `json:"hsmkind"`
-	SoftHsm *SoftHsm                       `json:"softhsm,omitempty"`
+	HsmKind  ModuleSpecConfigurationHsmKind `json:"hsmkind"`
+	SoftHsm  *SoftHsm                       `json:"softhsm,omitempty"`
+	CloudKms *CloudKms                      `json:"cloudkms,omitempty"`
+	Pkcs11   *Pkcs11                        `json:"pkcs11,omitempty"`
 }
 
 // AsOneOf return as one of
@@ -30,6 +34,22 @@ func (oneOf *SoftHsm) AsOneOfModuleSpecConfiguration() ModuleSpecConfiguration {
 	}
 }
 
+// AsOneOf return as one of
+func (oneOf *CloudKms) AsOneOfModuleSpecConfiguration() ModuleSpecConfiguration {
+	return ModuleSpecConfiguration{
+		HsmKind:  HsmKindCloudkms,
+		CloudKms: oneOf,
+	}
+}
+
+// AsOneOf return as one of
+func (oneOf *Pkcs11) AsOneOfModuleSpecConfiguration() ModuleSpecConfiguration {
+	return ModuleSpecConfiguration{
+		HsmKind: HsmKindPkcs11,
+		Pkcs11:  oneOf,
+	}
+}
+
 // UnmarshalJSON data into one of the pointers in the struct
 func (dst *ModuleSpecConfiguration) UnmarshalJSON(data []byte) *httpinfra.HTTPError {
 	var err error
@@ -64,6 +84,36 @@ func (dst *ModuleSpecConfiguration) UnmarshalJSON(data []byte) *httpinfra.HTTPEr
 		}
 	}
 
+	// check if the discriminator value is 'cloudKMS'
+	if jsonDict["hsmKind"] == string(HsmKindCloudkms) {
+		dst.HsmKind = HsmKindCloudkms
+		// try to unmarshal JSON data into CloudKms
+		err = json.Unmarshal(data, &dst.CloudKms)
+		if err == nil {
+			return nil // data stored in dst.CloudKms, return on the first match
+		} else {
+			dst.CloudKms = nil
+			httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+			httpError.SetMessage("error unmarshalling ModuleSpecConfiguration as CloudKms")
+			return httpError
+		}
+	}
+
+	// check if the discriminator value is 'pkcs11'
+	if jsonDict["hsmKind"] == string(HsmKindPkcs11) {
+		dst.HsmKind = HsmKindPkcs11
+		// try to unmarshal JSON data into Pkcs11
+		err = json.Unmarshal(data, &dst.Pkcs11)
+		if err == nil {
+			return nil // data stored in dst.Pkcs11, return on the first match
+		} else {
+			dst.Pkcs11 = nil
+			httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+			httpError.SetMessage("error unmarshalling ModuleSpecConfiguration as Pkcs11")
+			return httpError
+		}
+	}
+
 	return nil
 }
 
@@ -73,6 +123,14 @@ func (src ModuleSpecConfiguration) MarshalJSON() ([]byte, error) {
 		return json.Marshal(&src.SoftHsm)
 	}
 
+	if src.CloudKms != nil {
+		return json.Marshal(&src.CloudKms)
+	}
+
+	if src.Pkcs11 != nil {
+		return json.Marshal(&src.Pkcs11)
+	}
+
 	return nil, nil // no data in oneOf schemas
 }
 
@@ -86,6 +144,14 @@ func (obj *ModuleSpecConfiguration) GetActualInstance() interface{} {
 		return obj.SoftHsm
 	}
 
+	if obj.CloudKms != nil {
+		return obj.CloudKms
+	}
+
+	if obj.Pkcs11 != nil {
+		return obj.Pkcs11
+	}
+
 	// all schemas are nil
 	return nil
 }
@@ -102,6 +168,28 @@ func (data *ModuleSpecConfiguration) ValidateWith() (*httpinfra.ValidationResult
 			return validatedSoftHsm, nil
 		}
 	}
+	if data.CloudKms != nil {
+		validatedCloudKms, validateWithFailure := data.CloudKms.ValidateWith()
+		if validateWithFailure != nil {
+			httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+			httpError.SetMessage(fmt.Sprintf("error validating field [%v]", data.CloudKms))
+			return nil, httpError
+		}
+		if !validatedCloudKms.Valid {
+			return validatedCloudKms, nil
+		}
+	}
+	if data.Pkcs11 != nil {
+		validatedPkcs11, validateWithFailure := data.Pkcs11.ValidateWith()
+		if validateWithFailure != nil {
+			httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+			httpError.SetMessage(fmt.Sprintf("error validating field [%v]", data.Pkcs11))
+			return nil, httpError
+		}
+		if !validatedPkcs11.Valid {
+			return validatedPkcs11, nil
+		}
+	}
 
 	return &httpinfra.ValidationResult{
 		Valid: true,
@@ -114,4 +202,12 @@ func (data *ModuleSpecConfiguration) SetDefaults() {
 	if i, ok := instance.(*SoftHsm); ok {
 		i.SetDefaults()
 	}
+
+	if i, ok := instance.(*CloudKms); ok {
+		i.SetDefaults()
+	}
+
+	if i, ok := instance.(*Pkcs11); ok {
+		i.SetDefaults()
+	}
 }
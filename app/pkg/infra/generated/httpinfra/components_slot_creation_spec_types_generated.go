@@ -13,6 +13,8 @@ type SlotCreationSpec struct {
 	Slot *string `json:"slot"`
 	// PIN that provides access to the slot number inside the HSM.
 	Pin *string `json:"pin"`
+	// Order in which the slot is used by the application, the lowest value being the primary slot where keys are generated. Defaults to the next priority after the existing slots of the application.
+	Priority *int64 `json:"priority,omitempty"`
 }
 
 // ValidateWith check whether SlotCreationSpec is valid
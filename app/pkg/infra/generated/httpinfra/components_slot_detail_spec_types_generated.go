@@ -13,6 +13,8 @@ type SlotDetailSpec struct {
 	ApplicationId *string `json:"applicationId"`
 	// Slot number assigned by the HSM.
 	Slot *string `json:"slot"`
+	// Order in which the slot is used by the application, the lowest value being the primary slot where keys are generated.
+	Priority *int64 `json:"priority"`
 }
 
 // ValidateWith check whether SlotDetailSpec is valid
@@ -32,6 +34,11 @@ func (data SlotDetailSpec) ValidateWith() (*httpinfra.ValidationResult, *httpinf
 		httpError.SetMessage("error validating field [slot]")
 		return nil, httpError
 	}
+	if data.Priority == nil {
+		httpError := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument)
+		httpError.SetMessage("error validating field [priority]")
+		return nil, httpError
+	}
 	return &httpinfra.ValidationResult{
 		Valid: true,
 	}, nil
@@ -0,0 +1,110 @@
+// Package healthhttpinfra provides infrastructure to publish the liveness and readiness HTTP endpoints
+package healthhttpinfra
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
+)
+
+const (
+	// LivenessPath path of the liveness endpoint
+	LivenessPath = "/healthz"
+	// ReadinessPath path of the readiness endpoint
+	ReadinessPath = "/readyz"
+)
+
+// StatusUp status of a HealthResponse or HealthCheckResponse that is up. Any other status is down.
+const StatusUp = "up"
+
+// HealthResponse body of the responses of the liveness and readiness endpoints
+type HealthResponse struct {
+	// Status is "down" if any of the checks is down and "up" otherwise
+	Status string `json:"status"`
+	// Checks results of the checks that were run
+	Checks []HealthCheckResponse `json:"checks"`
+}
+
+// HealthCheckResponse result of one of the checks of a HealthResponse
+type HealthCheckResponse struct {
+	// Name of the check
+	Name string `json:"name"`
+	// Status of the check, "up", "down" or "unknown"
+	Status string `json:"status"`
+	// Detail describes the result of the check
+	Detail string `json:"detail,omitempty"`
+}
+
+// HealthAPIAdapter adapts the liveness and readiness endpoints to the checks of the health of the signare
+type HealthAPIAdapter interface {
+	// AdaptLiveness returns whether the signare is alive
+	AdaptLiveness(ctx context.Context) (*HealthResponse, *httpinfra.HTTPError)
+	// AdaptReadiness returns whether the signare is ready to serve requests
+	AdaptReadiness(ctx context.Context) (*HealthResponse, *httpinfra.HTTPError)
+}
+
+// HealthHTTPOptions configures ProvideHealthHTTP
+type HealthHTTPOptions struct {
+	// HTTPInfra router where the endpoints are published
+	HTTPInfra *httpinfra.HealthHTTPRouter
+	// Adapter adapts the endpoints to the health checks
+	Adapter HealthAPIAdapter
+	// ResponseHandler handles the responses of the checks that couldn't be run
+	ResponseHandler httpinfra.HTTPResponseHandler
+}
+
+// HealthHTTPRoutesPublished published HTTP health routes
+type HealthHTTPRoutesPublished int
+
+// ProvideHealthHTTP publishes the liveness and readiness endpoints. They respond 200 if the signare is up and 503 if
+// it is down, with the result of the checks in the body.
+func ProvideHealthHTTP(options HealthHTTPOptions) (HealthHTTPRoutesPublished, error) {
+	if options.HTTPInfra == nil {
+		return 0, errors.New("mandatory 'HTTPInfra' not provided")
+	}
+	if options.Adapter == nil {
+		return 0, errors.New("mandatory 'Adapter' not provided")
+	}
+	if options.ResponseHandler == nil {
+		return 0, errors.New("mandatory 'ResponseHandler' not provided")
+	}
+
+	livenessOptions := httpinfra.HandlerMatchOptions{Path: LivenessPath, Methods: []string{http.MethodGet}, Action: "health.liveness"}
+	err := options.HTTPInfra.RegisterRawHandler(livenessOptions, handle(options.Adapter.AdaptLiveness, options.ResponseHandler))
+	if err != nil {
+		return 0, err
+	}
+	readinessOptions := httpinfra.HandlerMatchOptions{Path: ReadinessPath, Methods: []string{http.MethodGet}, Action: "health.readiness"}
+	err = options.HTTPInfra.RegisterRawHandler(readinessOptions, handle(options.Adapter.AdaptReadiness, options.ResponseHandler))
+	if err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+func handle(adapt func(ctx context.Context) (*HealthResponse, *httpinfra.HTTPError), responseHandler httpinfra.HTTPResponseHandler) httpinfra.RawHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		response, httpErr := adapt(ctx)
+		if httpErr != nil {
+			responseHandler.HandleErrorResponse(ctx, w, httpErr)
+			return
+		}
+
+		statusCode := http.StatusOK
+		if response.Status != StatusUp {
+			statusCode = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(statusCode)
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			logger.LogEntry(ctx).Errorf("error encoding json response for data [%v]", response)
+		}
+	}
+}
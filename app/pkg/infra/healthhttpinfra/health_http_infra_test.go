@@ -0,0 +1,74 @@
+package healthhttpinfra_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/healthhttpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvideHealthHTTP(t *testing.T) {
+	router := httpinfra.ProvideHealthHTTPRouter()
+	responseHandler, err := httpinfra.ProvideDefaultHTTPResponseHandler(httpinfra.DefaultHTTPResponseHandlerOptions{
+		HTTPMetrics: httpinfra.DefaultHTTPMetrics{},
+	})
+	require.NoError(t, err)
+	_, err = healthhttpinfra.ProvideHealthHTTP(healthhttpinfra.HealthHTTPOptions{
+		HTTPInfra:       router,
+		Adapter:         healthAPIAdapter{},
+		ResponseHandler: responseHandler,
+	})
+	require.NoError(t, err)
+
+	t.Run("success: up", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		router.MainRouter().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, healthhttpinfra.LivenessPath, nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+		var response healthhttpinfra.HealthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Equal(t, livenessResponse, response)
+	})
+
+	t.Run("success: down", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		router.MainRouter().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, healthhttpinfra.ReadinessPath, nil))
+		require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+		var response healthhttpinfra.HealthResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Equal(t, readinessResponse, response)
+	})
+}
+
+var (
+	livenessResponse = healthhttpinfra.HealthResponse{
+		Status: "up",
+		Checks: []healthhttpinfra.HealthCheckResponse{
+			{Name: "database", Status: "up"},
+		},
+	}
+	readinessResponse = healthhttpinfra.HealthResponse{
+		Status: "down",
+		Checks: []healthhttpinfra.HealthCheckResponse{
+			{Name: "shutdown", Status: "down", Detail: "the signare is shutting down"},
+		},
+	}
+)
+
+type healthAPIAdapter struct{}
+
+func (healthAPIAdapter) AdaptLiveness(_ context.Context) (*healthhttpinfra.HealthResponse, *httpinfra.HTTPError) {
+	return &livenessResponse, nil
+}
+
+func (healthAPIAdapter) AdaptReadiness(_ context.Context) (*healthhttpinfra.HealthResponse, *httpinfra.HTTPError) {
+	return &readinessResponse, nil
+}
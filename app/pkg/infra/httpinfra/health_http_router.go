@@ -0,0 +1,16 @@
+package httpinfra
+
+// HealthHTTPRouter HTTP router used for the liveness and readiness endpoints. It has no middlewares, so that the
+// endpoints can be reached without authentication.
+type HealthHTTPRouter struct {
+	// DefaultHTTPRouter holds the router to handle HTTP incoming connections.
+	DefaultHTTPRouter
+}
+
+// ProvideHealthHTTPRouter creates a HealthHTTPRouter
+func ProvideHealthHTTPRouter() *HealthHTTPRouter {
+	r := ProvideHTTPRouter()
+	return &HealthHTTPRouter{
+		DefaultHTTPRouter: *r,
+	}
+}
@@ -50,6 +50,8 @@ type httpErrorDetails struct {
 	traceableErrorID string
 	// originalError defines the original error
 	originalError error
+	// denialReason defines why the authorization of the request was denied
+	denialReason string
 }
 
 // HTTPError defines a response for a failed HTTP call
@@ -167,6 +169,16 @@ func (e *HTTPError) OriginalError() error {
 	return e.details.originalError
 }
 
+// SetDenialReason sets the reason why the authorization of the request was denied
+func (e *HTTPError) SetDenialReason(reason string) {
+	e.details.denialReason = reason
+}
+
+// DenialReason returns the reason why the authorization of the request was denied, or an empty string if it wasn't
+func (e *HTTPError) DenialReason() string {
+	return e.details.denialReason
+}
+
 func (e *HTTPError) toErrorResponse(ctx context.Context) ErrorResponse {
 	e.logError(ctx)
 	return ErrorResponse{
@@ -175,6 +187,7 @@ func (e *HTTPError) toErrorResponse(ctx context.Context) ErrorResponse {
 		Details: ErrorResponseDetails{
 			TraceableErrorId: e.details.traceableErrorID,
 			Message:          e.details.message,
+			DenialReason:     e.details.denialReason,
 		},
 	}
 }
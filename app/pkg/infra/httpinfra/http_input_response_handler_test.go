@@ -2,6 +2,7 @@ package httpinfra_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -65,6 +66,27 @@ func TestDefaultHTTPResponseHandler_HandleErrorResponse_Correct(t *testing.T) {
 	require.Equal(t, http.StatusForbidden, rr.Code)
 }
 
+// TestDefaultHTTPResponseHandler_HandleErrorResponse_DenialReason tests HandleErrorResponse function with a denied authorization
+func TestDefaultHTTPResponseHandler_HandleErrorResponse_DenialReason(t *testing.T) {
+	options := httpinfra.DefaultHTTPResponseHandlerOptions{
+		HTTPMetrics: httpinfra.DefaultHTTPMetrics{},
+	}
+	handler, err := httpinfra.ProvideDefaultHTTPResponseHandler(options)
+	require.Nil(t, err)
+	require.NotNil(t, handler)
+
+	rr := httptest.NewRecorder()
+	receivedError := httpinfra.NewHTTPError(httpinfra.StatusPermissionDenied)
+	receivedError.SetDenialReason("ACTION_NOT_GRANTED")
+	handler.HandleErrorResponse(context.Background(), rr, receivedError)
+	require.Equal(t, http.StatusForbidden, rr.Code)
+
+	var response httpinfra.ErrorResponse
+	err = json.NewDecoder(rr.Body).Decode(&response)
+	require.Nil(t, err)
+	require.Equal(t, "ACTION_NOT_GRANTED", response.Details.DenialReason)
+}
+
 // TestNewHTTPError tests NewHTTPError function
 func TestNewHTTPError(t *testing.T) {
 	err := httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument).SetMessage(defaultMessage)
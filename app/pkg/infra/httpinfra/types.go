@@ -28,6 +28,8 @@ type ErrorResponseDetails struct {
 	TraceableErrorId string `json:"traceableErrorId"`
 	// Message string message
 	Message string `json:"message"`
+	// DenialReason why the authorization of the request was denied
+	DenialReason string `json:"denialReason,omitempty"`
 }
 
 // HandlerMatcherFuncOptions provides information to register a new route with a custom matcher for a path from an HTTP request with specific HTTP methods
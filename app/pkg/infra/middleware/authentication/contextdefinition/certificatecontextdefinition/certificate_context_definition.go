@@ -0,0 +1,183 @@
+// Package certificatecontextdefinition defines the user and the application of the requests from the fields of the
+// client certificate verified during the TLS handshake.
+package certificatecontextdefinition
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+)
+
+// CertificateField is a field of a certificate that identifies the user or the application of a request.
+type CertificateField string
+
+const (
+	SubjectCommonName         CertificateField = "subject.commonName"
+	SubjectOrganization       CertificateField = "subject.organization"
+	SubjectOrganizationalUnit CertificateField = "subject.organizationalUnit"
+	SANEmail                  CertificateField = "san.email"
+	SANDNS                    CertificateField = "san.dns"
+	SANURI                    CertificateField = "san.uri"
+)
+
+// IdentityMapping defines how an identifier is read from a certificate.
+type IdentityMapping struct {
+	// Field of the certificate that holds the identifier
+	Field CertificateField
+	// Prefix that the value of the field must have, removed from the identifier. If the field has several values, the
+	// first one with the prefix is used, so that several identifiers can be held by the same field, e.g.
+	// 'urn:signare:user:' and 'urn:signare:application:' URIs
+	Prefix string
+}
+
+var _ contextdefinition.ContextDefinition = new(CertificateContextDefinition)
+
+// DefineUser defines the user within the context of the request from the verified client certificate. Requests without
+// a verified certificate are passed on without user, so that they are rejected by the user validation.
+func (m *CertificateContextDefinition) DefineUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		userID := ""
+		certificate, ok := verifiedClientCertificate(r)
+		if ok {
+			userID, ok = m.userMapping.value(certificate)
+			if !ok {
+				logger.LogEntry(ctx).Infof("client certificate [%s] does not have a user in field '%s'", certificate.Subject, m.userMapping.Field)
+			}
+		} else {
+			logger.LogEntry(ctx).Debug("request without verified client certificate")
+		}
+		ctx = context.WithValue(ctx, requestcontext.UserContextKey, userID)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// DefineApplication defines the application within the context of the request from the verified client certificate.
+// The application is not defined if the certificate does not have it.
+func (m *CertificateContextDefinition) DefineApplication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.applicationMapping == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		certificate, ok := verifiedClientCertificate(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		applicationID, ok := m.applicationMapping.value(certificate)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), requestcontext.ApplicationContextKey, applicationID)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// DefineAction defines the action within the context of the request. The action does not depend on the identity of
+// the caller, so it is defined by the wrapped ContextDefinition.
+func (m *CertificateContextDefinition) DefineAction(next http.Handler) http.Handler {
+	return m.actionDefinition.DefineAction(next)
+}
+
+// verifiedClientCertificate returns the leaf of the client certificate chain verified during the handshake. The
+// certificates presented but not verified are ignored.
+func verifiedClientCertificate(r *http.Request) (*x509.Certificate, bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return r.TLS.VerifiedChains[0][0], true
+}
+
+func (m IdentityMapping) value(certificate *x509.Certificate) (string, bool) {
+	var values []string
+	switch m.Field {
+	case SubjectCommonName:
+		values = []string{certificate.Subject.CommonName}
+	case SubjectOrganization:
+		values = certificate.Subject.Organization
+	case SubjectOrganizationalUnit:
+		values = certificate.Subject.OrganizationalUnit
+	case SANEmail:
+		values = certificate.EmailAddresses
+	case SANDNS:
+		values = certificate.DNSNames
+	case SANURI:
+		for _, uri := range certificate.URIs {
+			values = append(values, uri.String())
+		}
+	}
+	for _, value := range values {
+		identifier, found := strings.CutPrefix(value, m.Prefix)
+		identifier = strings.TrimSpace(identifier)
+		if found && len(identifier) > 0 {
+			return identifier, true
+		}
+	}
+	return "", false
+}
+
+func (m IdentityMapping) validate() error {
+	switch m.Field {
+	case SubjectCommonName, SubjectOrganization, SubjectOrganizationalUnit, SANEmail, SANDNS, SANURI:
+		return nil
+	default:
+		return fmt.Errorf("unsupported certificate field '%s'", m.Field)
+	}
+}
+
+// CertificateContextDefinitionOptions configures CertificateContextDefinition
+type CertificateContextDefinitionOptions struct {
+	// ActionDefinition defines the action of the requests, which depends on the protocol of the endpoints
+	ActionDefinition contextdefinition.ContextDefinition
+	// UserMapping defines how the user is read from the certificate. Defaults to the common name of the subject
+	UserMapping *IdentityMapping
+	// ApplicationMapping defines how the application is read from the certificate. The application is not defined from the certificate if nil
+	ApplicationMapping *IdentityMapping
+}
+
+// CertificateContextDefinition defines the user and the application of the requests from the verified client certificate
+type CertificateContextDefinition struct {
+	actionDefinition   contextdefinition.ContextDefinition
+	userMapping        IdentityMapping
+	applicationMapping *IdentityMapping
+}
+
+// ProvideCertificateContextDefinition returns CertificateContextDefinition with the given options
+func ProvideCertificateContextDefinition(options CertificateContextDefinitionOptions) (*CertificateContextDefinition, error) {
+	if options.ActionDefinition == nil {
+		return nil, errors.New("mandatory 'ActionDefinition' not provided")
+	}
+	userMapping := IdentityMapping{
+		Field: SubjectCommonName,
+	}
+	if options.UserMapping != nil {
+		userMapping = *options.UserMapping
+	}
+	err := userMapping.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'UserMapping': %w", err)
+	}
+	if options.ApplicationMapping != nil {
+		err = options.ApplicationMapping.validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'ApplicationMapping': %w", err)
+		}
+	}
+	return &CertificateContextDefinition{
+		actionDefinition:   options.ActionDefinition,
+		userMapping:        userMapping,
+		applicationMapping: options.ApplicationMapping,
+	}, nil
+}
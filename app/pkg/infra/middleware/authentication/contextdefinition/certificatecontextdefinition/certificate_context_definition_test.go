@@ -0,0 +1,160 @@
+package certificatecontextdefinition_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/certificatecontextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateContextDefinition(t *testing.T) {
+	certificate := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "alice",
+			OrganizationalUnit: []string{"signers"},
+		},
+		URIs: []*url.URL{
+			mustParseURL(t, "urn:signare:user:bob"),
+			mustParseURL(t, "urn:signare:application:test-application"),
+		},
+	}
+
+	t.Run("success: user from the common name by default", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{})
+		user, application := serve(t, contextDefinition, verifiedConnection(certificate), nil)
+		require.Equal(t, "alice", *user)
+		require.Nil(t, application)
+	})
+	t.Run("success: user and application from SAN URIs with prefix", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{
+			UserMapping: &certificatecontextdefinition.IdentityMapping{
+				Field:  certificatecontextdefinition.SANURI,
+				Prefix: "urn:signare:user:",
+			},
+			ApplicationMapping: &certificatecontextdefinition.IdentityMapping{
+				Field:  certificatecontextdefinition.SANURI,
+				Prefix: "urn:signare:application:",
+			},
+		})
+		user, application := serve(t, contextDefinition, verifiedConnection(certificate), nil)
+		require.Equal(t, "bob", *user)
+		require.Equal(t, "test-application", *application)
+	})
+	t.Run("success: application not defined if the field is missing", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{
+			ApplicationMapping: &certificatecontextdefinition.IdentityMapping{
+				Field: certificatecontextdefinition.SANEmail,
+			},
+		})
+		user, application := serve(t, contextDefinition, verifiedConnection(certificate), nil)
+		require.Equal(t, "alice", *user)
+		require.Nil(t, application)
+	})
+	t.Run("failure: user field missing", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{
+			UserMapping: &certificatecontextdefinition.IdentityMapping{
+				Field: certificatecontextdefinition.SANDNS,
+			},
+		})
+		user, _ := serve(t, contextDefinition, verifiedConnection(certificate), nil)
+		require.Empty(t, *user)
+	})
+	t.Run("failure: certificate presented but not verified", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{})
+		connection := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+		user, _ := serve(t, contextDefinition, connection, nil)
+		require.Empty(t, *user)
+	})
+	t.Run("failure: identity headers are ignored", func(t *testing.T) {
+		contextDefinition := newCertificateContextDefinition(t, certificatecontextdefinition.CertificateContextDefinitionOptions{})
+		headers := map[string]string{
+			contextdefinition.DefaultUserHeader:        "alice",
+			contextdefinition.DefaultApplicationHeader: "test-application",
+		}
+		user, application := serve(t, contextDefinition, nil, headers)
+		require.Empty(t, *user)
+		require.Nil(t, application)
+	})
+}
+
+func TestProvideCertificateContextDefinition(t *testing.T) {
+	t.Run("failure: missing action definition", func(t *testing.T) {
+		_, err := certificatecontextdefinition.ProvideCertificateContextDefinition(certificatecontextdefinition.CertificateContextDefinitionOptions{})
+		require.Error(t, err)
+	})
+	t.Run("failure: unsupported field", func(t *testing.T) {
+		_, err := certificatecontextdefinition.ProvideCertificateContextDefinition(certificatecontextdefinition.CertificateContextDefinitionOptions{
+			ActionDefinition: actionDefinition{},
+			ApplicationMapping: &certificatecontextdefinition.IdentityMapping{
+				Field: "subject.serialNumber",
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+// serve runs a request through the context definition and returns the user and the application defined in its context.
+func serve(t *testing.T, contextDefinition *certificatecontextdefinition.CertificateContextDefinition, connection *tls.ConnectionState, headers map[string]string) (*string, *string) {
+	var user, application *string
+	handler := contextDefinition.DefineUser(contextDefinition.DefineApplication(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var err error
+		user, err = requestcontext.UserFromContext(r.Context())
+		require.NoError(t, err)
+		application, _ = requestcontext.ApplicationFromContext(r.Context())
+	})))
+
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	request.TLS = connection
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	require.NotNil(t, user)
+	return user, application
+}
+
+func newCertificateContextDefinition(t *testing.T, options certificatecontextdefinition.CertificateContextDefinitionOptions) *certificatecontextdefinition.CertificateContextDefinition {
+	options.ActionDefinition = actionDefinition{}
+	contextDefinition, err := certificatecontextdefinition.ProvideCertificateContextDefinition(options)
+	require.NoError(t, err)
+	return contextDefinition
+}
+
+func verifiedConnection(certificate *x509.Certificate) *tls.ConnectionState {
+	return &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{certificate},
+		VerifiedChains:   [][]*x509.Certificate{{certificate}},
+	}
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	parsed, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return parsed
+}
+
+type actionDefinition struct{}
+
+func (actionDefinition) DefineUser(next http.Handler) http.Handler {
+	return next
+}
+
+func (actionDefinition) DefineApplication(next http.Handler) http.Handler {
+	return next
+}
+
+func (actionDefinition) DefineAction(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestcontext.ActionContextKey, "test-action")))
+	})
+}
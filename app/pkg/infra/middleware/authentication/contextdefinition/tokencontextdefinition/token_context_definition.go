@@ -0,0 +1,133 @@
+// Package tokencontextdefinition defines the user and the application of the requests from the claims of a signed
+// bearer token, so that the identity of the caller is authenticated by an identity provider instead of being trusted
+// from the request headers.
+package tokencontextdefinition
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/jwt"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+)
+
+const (
+	DefaultUserClaim = "sub"
+
+	authorizationHeader = "Authorization"
+	bearerScheme        = "bearer"
+
+	claimsContextKey entities.ContextKey = "X-Auth-Token-Claims"
+)
+
+var _ contextdefinition.ContextDefinition = new(TokenContextDefinition)
+
+// DefineUser defines the user within the context of the request from the user claim of the bearer token. Requests
+// without a valid token are passed on without user, so that they are rejected by the user validation.
+func (m *TokenContextDefinition) DefineUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		token, ok := bearerToken(r)
+		if !ok {
+			logger.LogEntry(ctx).Debug("request without bearer token")
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, requestcontext.UserContextKey, "")))
+			return
+		}
+		claims, err := m.verifier.Verify(ctx, token)
+		if err != nil {
+			logger.LogEntry(ctx).Infof("bearer token rejected: %v", err)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, requestcontext.UserContextKey, "")))
+			return
+		}
+
+		userID, _ := claims.String(m.userClaim)
+		ctx = context.WithValue(ctx, requestcontext.UserContextKey, strings.TrimSpace(userID))
+		ctx = context.WithValue(ctx, claimsContextKey, claims)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// DefineApplication defines the application within the context of the request from the application claim of the
+// bearer token verified by DefineUser. The application is not defined if the token does not have the claim.
+func (m *TokenContextDefinition) DefineApplication(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(claimsContextKey).(jwt.Claims)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		applicationID, ok := claims.String(m.applicationClaim)
+		if !ok || len(strings.TrimSpace(applicationID)) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), requestcontext.ApplicationContextKey, strings.TrimSpace(applicationID))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// DefineAction defines the action within the context of the request. The action does not depend on the identity of
+// the caller, so it is defined by the wrapped ContextDefinition.
+func (m *TokenContextDefinition) DefineAction(next http.Handler) http.Handler {
+	return m.actionDefinition.DefineAction(next)
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(r.Header.Get(authorizationHeader)), " ")
+	if !found || !strings.EqualFold(scheme, bearerScheme) {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, len(token) > 0
+}
+
+// TokenContextDefinitionOptions configures TokenContextDefinition
+type TokenContextDefinitionOptions struct {
+	// ActionDefinition defines the action of the requests, which depends on the protocol of the endpoints
+	ActionDefinition contextdefinition.ContextDefinition
+	// Verifier verifies the bearer tokens
+	Verifier *jwt.Verifier
+	// UserClaim is the claim that holds the ID of the user. Defaults to 'sub'
+	UserClaim string
+	// ApplicationClaim is the claim that holds the ID of the application. Mandatory
+	ApplicationClaim string
+}
+
+// TokenContextDefinition defines the user and the application of the requests from a verified bearer token
+type TokenContextDefinition struct {
+	actionDefinition contextdefinition.ContextDefinition
+	verifier         *jwt.Verifier
+	userClaim        string
+	applicationClaim string
+}
+
+// ProvideTokenContextDefinition returns TokenContextDefinition with the given options
+func ProvideTokenContextDefinition(options TokenContextDefinitionOptions) (*TokenContextDefinition, error) {
+	if options.ActionDefinition == nil {
+		return nil, errors.New("mandatory 'ActionDefinition' not provided")
+	}
+	if options.Verifier == nil {
+		return nil, errors.New("mandatory 'Verifier' not provided")
+	}
+	if len(options.ApplicationClaim) == 0 {
+		return nil, errors.New("mandatory 'ApplicationClaim' not provided")
+	}
+	userClaim := options.UserClaim
+	if len(userClaim) == 0 {
+		userClaim = DefaultUserClaim
+	}
+	return &TokenContextDefinition{
+		actionDefinition: options.ActionDefinition,
+		verifier:         options.Verifier,
+		userClaim:        userClaim,
+		applicationClaim: options.ApplicationClaim,
+	}, nil
+}
@@ -0,0 +1,200 @@
+package tokencontextdefinition_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/jwt"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/authentication/contextdefinition/tokencontextdefinition"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	keyID            = "test-key"
+	applicationClaim = "signare_application"
+)
+
+func TestTokenContextDefinition(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	contextDefinition := newTokenContextDefinition(t, &privateKey.PublicKey)
+
+	t.Run("success: user and application defined from the token", func(t *testing.T) {
+		token := sign(t, privateKey, map[string]any{
+			"sub":            "alice",
+			applicationClaim: "test-application",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		})
+		user, application := serve(t, contextDefinition, "Bearer "+token, nil)
+		require.Equal(t, "alice", *user)
+		require.Equal(t, "test-application", *application)
+	})
+	t.Run("success: application not defined without application claim", func(t *testing.T) {
+		token := sign(t, privateKey, map[string]any{
+			"sub": "admin",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		user, application := serve(t, contextDefinition, "bearer "+token, nil)
+		require.Equal(t, "admin", *user)
+		require.Nil(t, application)
+	})
+	t.Run("failure: identity headers are ignored", func(t *testing.T) {
+		headers := map[string]string{
+			contextdefinition.DefaultUserHeader:        "alice",
+			contextdefinition.DefaultApplicationHeader: "test-application",
+		}
+		user, application := serve(t, contextDefinition, "", headers)
+		require.Empty(t, *user)
+		require.Nil(t, application)
+	})
+	t.Run("failure: expired token", func(t *testing.T) {
+		token := sign(t, privateKey, map[string]any{
+			"sub":            "alice",
+			applicationClaim: "test-application",
+			"exp":            time.Now().Add(-time.Hour).Unix(),
+		})
+		user, application := serve(t, contextDefinition, "Bearer "+token, nil)
+		require.Empty(t, *user)
+		require.Nil(t, application)
+	})
+	t.Run("failure: token signed with another key", func(t *testing.T) {
+		otherKey, keyErr := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, keyErr)
+		token := sign(t, otherKey, map[string]any{
+			"sub": "alice",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		user, _ := serve(t, contextDefinition, "Bearer "+token, nil)
+		require.Empty(t, *user)
+	})
+	t.Run("success: action defined by the wrapped definition", func(t *testing.T) {
+		var action *string
+		handler := contextDefinition.DefineAction(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			action, _ = requestcontext.ActionFromContext(r.Context())
+		}))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+		require.NotNil(t, action)
+		require.Equal(t, "test-action", *action)
+	})
+	t.Run("failure: not a bearer token", func(t *testing.T) {
+		user, _ := serve(t, contextDefinition, "Basic YWxpY2U6c2VjcmV0", nil)
+		require.Empty(t, *user)
+	})
+}
+
+func TestProvideTokenContextDefinition(t *testing.T) {
+	t.Run("failure: missing action definition", func(t *testing.T) {
+		_, err := tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+			Verifier:         &jwt.Verifier{},
+			ApplicationClaim: applicationClaim,
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: missing verifier", func(t *testing.T) {
+		_, err := tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+			ActionDefinition: actionDefinition{},
+			ApplicationClaim: applicationClaim,
+		})
+		require.Error(t, err)
+	})
+	t.Run("failure: missing application claim", func(t *testing.T) {
+		_, err := tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+			ActionDefinition: actionDefinition{},
+			Verifier:         &jwt.Verifier{},
+		})
+		require.Error(t, err)
+	})
+}
+
+// serve runs a request through the context definition and returns the user and the application defined in its context.
+func serve(t *testing.T, contextDefinition *tokencontextdefinition.TokenContextDefinition, authorization string, headers map[string]string) (*string, *string) {
+	var user, application *string
+	handler := contextDefinition.DefineUser(contextDefinition.DefineApplication(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var err error
+		user, err = requestcontext.UserFromContext(r.Context())
+		require.NoError(t, err)
+		application, _ = requestcontext.ApplicationFromContext(r.Context())
+	})))
+
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	require.NotNil(t, user)
+	return user, application
+}
+
+func newTokenContextDefinition(t *testing.T, publicKey *rsa.PublicKey) *tokencontextdefinition.TokenContextDefinition {
+	keySetData, err := json.Marshal(map[string]any{
+		"keys": []any{
+			map[string]any{
+				"kty": "RSA",
+				"kid": keyID,
+				"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			},
+		},
+	})
+	require.NoError(t, err)
+	keySetPath := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(keySetPath, keySetData, 0o600))
+
+	keySet, err := jwt.ProvideStaticKeySet(jwt.StaticKeySetOptions{Path: keySetPath})
+	require.NoError(t, err)
+	verifier, err := jwt.ProvideVerifier(jwt.VerifierOptions{KeySet: keySet})
+	require.NoError(t, err)
+	contextDefinition, err := tokencontextdefinition.ProvideTokenContextDefinition(tokencontextdefinition.TokenContextDefinitionOptions{
+		ActionDefinition: actionDefinition{},
+		Verifier:         verifier,
+		ApplicationClaim: applicationClaim,
+	})
+	require.NoError(t, err)
+	return contextDefinition
+}
+
+func sign(t *testing.T, privateKey *rsa.PrivateKey, claims map[string]any) string {
+	header, err := json.Marshal(map[string]any{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+type actionDefinition struct{}
+
+func (actionDefinition) DefineUser(next http.Handler) http.Handler {
+	return next
+}
+
+func (actionDefinition) DefineApplication(next http.Handler) http.Handler {
+	return next
+}
+
+func (actionDefinition) DefineAction(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestcontext.ActionContextKey, "test-action")))
+	})
+}
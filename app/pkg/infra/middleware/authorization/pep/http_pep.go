@@ -1,6 +1,7 @@
 package pep
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -35,7 +36,7 @@ func (policyEnforcementPoint *HTTPPolicyEnforcementPoint) AuthorizeUser(next htt
 
 		_, err = policyEnforcementPoint.userPolicyDecisionPointAdapter.AuthorizeUser(ctx, authorizeUserInput)
 		if err != nil {
-			policyEnforcementPoint.responseHandler.HandleErrorResponse(ctx, w, httpinfra.NewHTTPErrorFromError(ctx, err, httpinfra.StatusPermissionDenied))
+			policyEnforcementPoint.responseHandler.HandleErrorResponse(ctx, w, newDeniedHTTPError(ctx, err))
 			return
 		}
 
@@ -43,6 +44,16 @@ func (policyEnforcementPoint *HTTPPolicyEnforcementPoint) AuthorizeUser(next htt
 	})
 }
 
+// newDeniedHTTPError creates a permission denied HTTPError with the reason why the authorization was denied, if any
+func newDeniedHTTPError(ctx context.Context, err error) *httpinfra.HTTPError {
+	httpError := httpinfra.NewHTTPErrorFromError(ctx, err, httpinfra.StatusPermissionDenied)
+	var deniedErr *DeniedError
+	if errors.As(err, &deniedErr) {
+		httpError.SetDenialReason(deniedErr.Reason)
+	}
+	return httpError
+}
+
 // HTTPPolicyEnforcementPointOptions are the set of fields to create an HTTPPolicyEnforcementPoint
 type HTTPPolicyEnforcementPointOptions struct {
 	// ResponseHandler exposes functionality to handle HTTP responses
@@ -14,7 +14,22 @@ import (
 	"github.com/hyperledger-labs/signare/app/pkg/utils"
 )
 
-// AuthorizeAccount checks if a user is authorized to use an account if it's performing an 'eth_signTransaction' action
+const (
+	signTransactionMethod = "eth_signTransaction"
+	ethSignMethod         = "eth_sign"
+	personalSignMethod    = "personal_sign"
+	signTypedDataMethod   = "eth_signTypedData_v4"
+
+	// ethSignAddressPosition is the position of the address in the params of 'eth_sign', which are [address, data]
+	ethSignAddressPosition = 0
+	// personalSignAddressPosition is the position of the address in the params of 'personal_sign', which are [data, address]
+	personalSignAddressPosition = 1
+	// signTypedDataAddressPosition is the position of the address in the params of 'eth_signTypedData_v4', which are [address, typedData]
+	signTypedDataAddressPosition = 0
+)
+
+// AuthorizeAccount checks if a user is authorized to use an account if it's performing a signing action, that is,
+// 'eth_signTransaction', 'eth_sign', 'personal_sign' or 'eth_signTypedData_v4'
 func (policyEnforcementPoint *RPCPolicyEnforcementPoint) AuthorizeAccount(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -35,7 +50,8 @@ func (policyEnforcementPoint *RPCPolicyEnforcementPoint) AuthorizeAccount(next h
 			return
 		}
 
-		if !strings.Contains(*actionID, "eth_signTransaction") {
+		method := (*actionID)[strings.LastIndex(*actionID, ".")+1:]
+		if method != signTransactionMethod && method != ethSignMethod && method != personalSignMethod && method != signTypedDataMethod {
 			next.ServeHTTP(w, r.WithContext(ctx))
 			return
 		}
@@ -47,14 +63,24 @@ func (policyEnforcementPoint *RPCPolicyEnforcementPoint) AuthorizeAccount(next h
 			return
 		}
 
-		addr, err := getAddressFromParamsArray(ctx, authorizeAccountRPCBody)
-		if err != nil {
-			addr, err = getAddressFromParamsObject(ctx, authorizeAccountRPCBody)
+		var addr *address.Address
+		switch method {
+		case ethSignMethod:
+			addr, err = getAddressFromPositionalParams(ctx, authorizeAccountRPCBody, ethSignAddressPosition)
+		case personalSignMethod:
+			addr, err = getAddressFromPositionalParams(ctx, authorizeAccountRPCBody, personalSignAddressPosition)
+		case signTypedDataMethod:
+			addr, err = getAddressFromPositionalParams(ctx, authorizeAccountRPCBody, signTypedDataAddressPosition)
+		default:
+			addr, err = getAddressFromParamsArray(ctx, authorizeAccountRPCBody)
 			if err != nil {
-				policyEnforcementPoint.responseHandler.HandleErrorResponse(r.Context(), w, httpinfra.NewHTTPErrorFromError(ctx, err, httpinfra.StatusInvalidArgument))
-				return
+				addr, err = getAddressFromParamsObject(ctx, authorizeAccountRPCBody)
 			}
 		}
+		if err != nil {
+			policyEnforcementPoint.responseHandler.HandleErrorResponse(r.Context(), w, httpinfra.NewHTTPErrorFromError(ctx, err, httpinfra.StatusInvalidArgument))
+			return
+		}
 
 		authorizeAccountInput := AuthorizeAccountUserInput{
 			UserID:        *user,
@@ -64,7 +90,7 @@ func (policyEnforcementPoint *RPCPolicyEnforcementPoint) AuthorizeAccount(next h
 		_, err = policyEnforcementPoint.accountUserPolicyDecisionPointAdapter.AuthorizeAccountUser(ctx, authorizeAccountInput)
 		if err != nil {
 			logger.LogEntry(ctx).Errorf("user [%s] is not authorized to use request's account [%s]", authorizeAccountInput.UserID, authorizeAccountInput.Address.String())
-			policyEnforcementPoint.responseHandler.HandleErrorResponse(ctx, w, httpinfra.NewHTTPErrorFromError(ctx, err, httpinfra.StatusPermissionDenied))
+			policyEnforcementPoint.responseHandler.HandleErrorResponse(ctx, w, newDeniedHTTPError(ctx, err))
 			return
 		}
 
@@ -100,6 +126,27 @@ func getAddressFromParamsObject(ctx context.Context, params AuthorizeAccountRPCB
 	return &addr, nil
 }
 
+func getAddressFromPositionalParams(ctx context.Context, params AuthorizeAccountRPCBody, position int) (*address.Address, error) {
+	var rpcParams []any
+	err := json.Unmarshal(params.Params, &rpcParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(rpcParams) <= position {
+		return nil, errors.New("missing address in request params")
+	}
+	rpcAddress, ok := rpcParams[position].(string)
+	if !ok {
+		return nil, errors.New("address in request params must be of type string")
+	}
+	addr, err := address.NewFromHexString(rpcAddress)
+	if err != nil {
+		logger.LogEntry(ctx).Errorf("invalid address: %s", rpcAddress)
+		return nil, err
+	}
+	return &addr, nil
+}
+
 // RPCPolicyEnforcementPointOptions are the set of fields to create an RPCPolicyEnforcementPoint
 type RPCPolicyEnforcementPointOptions struct {
 	// ResponseHandler exposes functionality to handle HTTP responses
@@ -50,3 +50,22 @@ type AuthorizeAccountRPCParams struct {
 	// From is an Ethereum account.
 	From string `json:"from"`
 }
+
+// DeniedError is returned by the Policy Decision Point ports when the authorization is denied, with the reason why it
+// was denied.
+type DeniedError struct {
+	// Reason why the authorization was denied
+	Reason string
+	// Err is the error returned by the Policy Decision Point
+	Err error
+}
+
+// Error implements the error interface
+func (e *DeniedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error returned by the Policy Decision Point
+func (e *DeniedError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,37 @@
+package rpcbatchrequestsupport
+
+import (
+	"bytes"
+	"net/http"
+)
+
+var _ http.ResponseWriter = (*responseRecorder)(nil)
+
+// responseRecorder buffers the response of a request of a batch, so that it can be added to the response of the batch
+type responseRecorder struct {
+	header     http.Header
+	body       bytes.Buffer
+	statusCode int
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		header:     make(http.Header),
+		statusCode: http.StatusOK,
+	}
+}
+
+// Header returns the headers of the response, which are discarded
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+// Write buffers the body of the response
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+// WriteHeader records the status code of the response
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+}
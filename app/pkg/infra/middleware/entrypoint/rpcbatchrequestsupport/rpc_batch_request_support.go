@@ -7,17 +7,24 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"sync"
 
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra/rpcerrors"
 	"github.com/hyperledger-labs/signare/app/pkg/utils"
 
 	"github.com/google/uuid"
 )
 
+const defaultMaxConcurrentRequests = 1
+
 // FanOutRPCBatchRequest is a middleware function to fan-out RPC requests if a batch request is identified.
-// Each of the requests is processed as if it were a single request.
+// Each of the requests is processed by the rest of the middleware chain as if it were a single request, so that it is
+// authenticated and authorized independently, and their responses are returned in a single array in the same order
+// as the requests. Notifications don't have a response, as defined in https://www.jsonrpc.org/specification#batch
 // Before sending the request to be processed, the RPC RequestID will be injected into the context.
 func (m *RPCBatchRequestSupportMiddleware) FanOutRPCBatchRequest(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -29,25 +36,118 @@ func (m *RPCBatchRequestSupportMiddleware) FanOutRPCBatchRequest(next http.Handl
 			return
 		}
 
-		var batchRPCRequest []RPCRequest
+		var batchRPCRequest []json.RawMessage
 		err = utils.ReadAndResetCloser(&r.Body, &batchRPCRequest)
 		if err != nil {
 			m.responseHandler.HandleErrorResponse(r.Context(), w, httpinfra.NewHTTPErrorFromError(r.Context(), err, httpinfra.StatusInvalidArgument))
 			return
 		}
+		if len(batchRPCRequest) == 0 {
+			writeResponse(r.Context(), w, newErrorResponse(r.Context(), nil, rpcerrors.NewInvalidRequestFromErr(errors.New("empty batch"))))
+			return
+		}
+		if m.maxBatchSize > 0 && len(batchRPCRequest) > m.maxBatchSize {
+			logger.LogEntry(r.Context()).Debugf("batch request of %d requests exceeds the maximum of %d", len(batchRPCRequest), m.maxBatchSize)
+			writeResponse(r.Context(), w, newErrorResponse(r.Context(), nil, rpcerrors.NewInvalidRequestFromErr(errors.New("batch too large"))))
+			return
+		}
 
-		for _, rpcRequest := range batchRPCRequest {
-			ctx := context.WithValue(r.Context(), requestcontext.RPCRequestIDKey, ensureContextRequestID(rpcRequest.ID))
-			requestBodyBytes, errMarshal := json.Marshal(rpcRequest)
-			if errMarshal != nil {
-				m.responseHandler.HandleErrorResponse(ctx, w, httpinfra.NewHTTPErrorFromError(ctx, errMarshal, httpinfra.StatusInvalidArgument))
-				continue
+		responses := m.serveBatch(r, next, batchRPCRequest)
+		batchResponse := make([]json.RawMessage, 0, len(responses))
+		for _, response := range responses {
+			if response != nil {
+				batchResponse = append(batchResponse, response)
 			}
-			reader := bytes.NewBuffer(requestBodyBytes)
-			r.Body = io.NopCloser(reader)
-			next.ServeHTTP(w, r.WithContext(ctx))
 		}
+		// a batch of notifications has no response
+		if len(batchResponse) == 0 {
+			return
+		}
+		writeResponse(r.Context(), w, batchResponse)
+	})
+}
+
+// serveBatch processes the requests of the batch, running up to maxConcurrentRequests requests at the same time. The
+// responses are returned in the same order as the requests, nil for notifications.
+func (m *RPCBatchRequestSupportMiddleware) serveBatch(r *http.Request, next http.Handler, batchRPCRequest []json.RawMessage) []json.RawMessage {
+	responses := make([]json.RawMessage, len(batchRPCRequest))
+	if m.maxConcurrentRequests <= 1 {
+		for index, element := range batchRPCRequest {
+			responses[index] = m.serveBatchElement(r, next, element)
+		}
+		return responses
+	}
+
+	var waitGroup sync.WaitGroup
+	semaphore := make(chan struct{}, m.maxConcurrentRequests)
+	for index, element := range batchRPCRequest {
+		semaphore <- struct{}{}
+		waitGroup.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				waitGroup.Done()
+			}()
+			responses[index] = m.serveBatchElement(r, next, element)
+		}()
+	}
+	waitGroup.Wait()
+	return responses
+}
+
+// serveBatchElement processes a request of a batch and returns its response, or nil if it is a notification.
+func (m *RPCBatchRequestSupportMiddleware) serveBatchElement(r *http.Request, next http.Handler, element json.RawMessage) json.RawMessage {
+	var rpcRequest RPCRequest
+	err := json.Unmarshal(element, &rpcRequest)
+	if err != nil {
+		return newErrorResponse(r.Context(), nil, rpcerrors.NewInvalidRequestFromErr(err))
+	}
+
+	ctx := context.WithValue(r.Context(), requestcontext.RPCRequestIDKey, ensureContextRequestID(rpcRequest.ID))
+	elementRequest := r.Clone(ctx)
+	elementRequest.Body = io.NopCloser(bytes.NewReader(element))
+	elementRequest.ContentLength = int64(len(element))
+
+	recorder := newResponseRecorder()
+	next.ServeHTTP(recorder, elementRequest)
+
+	// A Notification is a Request object without an "id" member.
+	// src: https://www.jsonrpc.org/specification
+	if rpcRequest.ID == nil {
+		return nil
+	}
+
+	response := bytes.TrimSpace(recorder.body.Bytes())
+	if len(response) > 0 && json.Valid(response) {
+		return response
+	}
+	// the request was rejected without a JSON-RPC response, e.g. because it could not be routed to a method
+	if recorder.statusCode == http.StatusBadRequest {
+		return newErrorResponse(ctx, rpcRequest.ID, rpcerrors.NewInvalidRequest())
+	}
+	return newErrorResponse(ctx, rpcRequest.ID, rpcerrors.NewInternal())
+}
+
+func newErrorResponse(ctx context.Context, requestID any, rpcError *rpcerrors.RPCError) json.RawMessage {
+	logger.LogEntry(ctx).Debugf("%+v", rpcError)
+	response, err := json.Marshal(rpcinfra.RPCResponse{
+		RPCVersion: rpcinfra.SupportedRPCVersion,
+		ID:         requestID,
+		Error:      rpcError,
 	})
+	if err != nil {
+		logger.LogEntry(ctx).Errorf("error encoding json error response for request [%v]", requestID)
+		return nil
+	}
+	return response
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		logger.LogEntry(ctx).Errorf("error encoding json response for data [%v]", response)
+	}
 }
 
 func ensureContextRequestID(reqID any) any {
@@ -59,8 +159,10 @@ func ensureContextRequestID(reqID any) any {
 
 // RPCBatchRequestSupportMiddleware enables support for batch requests as defined in https://www.jsonrpc.org/specification#batch
 type RPCBatchRequestSupportMiddleware struct {
-	responseHandler httpinfra.HTTPResponseHandler
-	router          rpcinfra.RPCRouter
+	responseHandler       httpinfra.HTTPResponseHandler
+	router                rpcinfra.RPCRouter
+	maxConcurrentRequests int
+	maxBatchSize          int
 }
 
 // RPCBatchRequestSupportMiddlewareOptions define the variables to handle the batch requests fan out
@@ -69,6 +171,8 @@ type RPCBatchRequestSupportMiddlewareOptions struct {
 	ResponseHandler httpinfra.HTTPResponseHandler
 	// RPCRouter are a set of methods to set up an RPC RPCRouter
 	RPCRouter rpcinfra.RPCRouter
+	// Configuration of the processing of the batch requests
+	Configuration BatchConfiguration
 }
 
 // ProvideRPCBatchRequestSupportMiddleware provides an instance of an RPCBatchRequestSupportMiddleware
@@ -79,9 +183,21 @@ func ProvideRPCBatchRequestSupportMiddleware(options RPCBatchRequestSupportMiddl
 	if options.RPCRouter == nil {
 		return nil, errors.New("mandatory 'RPCRouter' not provided")
 	}
+	if options.Configuration.MaxConcurrentRequests < 0 {
+		return nil, errors.New("'MaxConcurrentRequests' cannot be negative")
+	}
+	if options.Configuration.MaxBatchSize < 0 {
+		return nil, errors.New("'MaxBatchSize' cannot be negative")
+	}
+	maxConcurrentRequests := options.Configuration.MaxConcurrentRequests
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = defaultMaxConcurrentRequests
+	}
 
 	return &RPCBatchRequestSupportMiddleware{
-		responseHandler: options.ResponseHandler,
-		router:          options.RPCRouter,
+		responseHandler:       options.ResponseHandler,
+		router:                options.RPCRouter,
+		maxConcurrentRequests: maxConcurrentRequests,
+		maxBatchSize:          options.Configuration.MaxBatchSize,
 	}, nil
 }
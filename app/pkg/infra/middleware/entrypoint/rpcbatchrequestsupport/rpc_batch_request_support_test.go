@@ -0,0 +1,198 @@
+package rpcbatchrequestsupport_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/middleware/entrypoint/rpcbatchrequestsupport"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra/rpcerrors"
+	"github.com/hyperledger-labs/signare/app/pkg/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+type httpMetricsMock struct{}
+
+func (httpMetricsMock) IncrementForbiddenAccessCounter(context.Context) {}
+
+type rpcResponse struct {
+	ID     any                 `json:"id"`
+	Result any                 `json:"result"`
+	Error  *rpcerrors.RPCError `json:"error"`
+}
+
+// rpcHandler mimics the rest of the middleware chain: requests to 'forbidden' are rejected as the policy enforcement
+// point does, requests to 'unknown' as the router does, and the rest return their method as result.
+func rpcHandler(t *testing.T, responseHandler *rpcinfra.DefaultRPCInfraResponseHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request rpcbatchrequestsupport.RPCRequest
+		require.NoError(t, utils.ReadAndResetCloser(&r.Body, &request))
+		requestID, err := requestcontext.RPCRequestIDFromContext(r.Context())
+		require.NoError(t, err)
+
+		switch request.Method {
+		case "forbidden":
+			responseHandler.HandleErrorResponse(r.Context(), w, httpinfra.NewHTTPError(httpinfra.StatusPermissionDenied))
+		case "unknown":
+			responseHandler.HandleErrorResponse(r.Context(), w, httpinfra.NewHTTPError(httpinfra.StatusInvalidArgument))
+		case "slow":
+			time.Sleep(50 * time.Millisecond)
+			fallthrough
+		default:
+			if request.ID == nil {
+				return
+			}
+			responseHandler.HandleSuccessResponse(r.Context(), w, httpinfra.ResponseInfo{}, &rpcinfra.RPCResponse{
+				RPCVersion: rpcinfra.SupportedRPCVersion,
+				ID:         *requestID,
+				Result:     request.Method,
+			})
+		}
+	})
+}
+
+func newBatchMiddleware(t *testing.T, configuration rpcbatchrequestsupport.BatchConfiguration) (*rpcbatchrequestsupport.RPCBatchRequestSupportMiddleware, *rpcinfra.DefaultRPCInfraResponseHandler) {
+	responseHandler, err := rpcinfra.ProvideDefaultRPCInfraResponseHandler(rpcinfra.DefaultRPCInfraResponseHandlerOptions{
+		HTTPMetrics: httpMetricsMock{},
+	})
+	require.NoError(t, err)
+	middleware, err := rpcbatchrequestsupport.ProvideRPCBatchRequestSupportMiddleware(rpcbatchrequestsupport.RPCBatchRequestSupportMiddlewareOptions{
+		ResponseHandler: responseHandler,
+		RPCRouter:       rpcinfra.ProvideDefaultRPCRouter(rpcinfra.DefaultRPCRouterOptions{}),
+		Configuration:   configuration,
+	})
+	require.NoError(t, err)
+	return middleware, responseHandler
+}
+
+func serve(t *testing.T, configuration rpcbatchrequestsupport.BatchConfiguration, body string) *httptest.ResponseRecorder {
+	middleware, responseHandler := newBatchMiddleware(t, configuration)
+	handler := middleware.FanOutRPCBatchRequest(rpcHandler(t, responseHandler))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	return recorder
+}
+
+func TestFanOutRPCBatchRequest_SingleRequest(t *testing.T) {
+	recorder := serve(t, rpcbatchrequestsupport.BatchConfiguration{}, `{"jsonrpc":"2.0","id":1,"method":"eth_accounts"}`)
+
+	var response rpcResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, float64(1), response.ID)
+	require.Equal(t, "eth_accounts", response.Result)
+}
+
+func TestFanOutRPCBatchRequest_Batch(t *testing.T) {
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_accounts"},
+		{"jsonrpc":"2.0","method":"notification"},
+		{"jsonrpc":"2.0","id":"two","method":"forbidden"},
+		{"jsonrpc":"2.0","id":3,"method":"unknown"},
+		1,
+		{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}
+	]`
+
+	for name, configuration := range map[string]rpcbatchrequestsupport.BatchConfiguration{
+		"sequential": {},
+		"concurrent": {MaxConcurrentRequests: 3},
+	} {
+		t.Run(name, func(t *testing.T) {
+			recorder := serve(t, configuration, body)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+			var responses []rpcResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responses))
+			require.Len(t, responses, 5)
+
+			require.Equal(t, float64(1), responses[0].ID)
+			require.Equal(t, "eth_accounts", responses[0].Result)
+			require.Nil(t, responses[0].Error)
+
+			require.Equal(t, "two", responses[1].ID)
+			require.NotNil(t, responses[1].Error)
+			require.Equal(t, rpcerrors.NewUnauthorized().Code, responses[1].Error.Code)
+
+			require.Equal(t, float64(3), responses[2].ID)
+			require.NotNil(t, responses[2].Error)
+			require.Equal(t, rpcerrors.NewInvalidRequest().Code, responses[2].Error.Code)
+
+			require.Nil(t, responses[3].ID)
+			require.NotNil(t, responses[3].Error)
+			require.Equal(t, rpcerrors.NewInvalidRequest().Code, responses[3].Error.Code)
+
+			require.Equal(t, float64(4), responses[4].ID)
+			require.Equal(t, "eth_chainId", responses[4].Result)
+		})
+	}
+}
+
+func TestFanOutRPCBatchRequest_Notifications(t *testing.T) {
+	recorder := serve(t, rpcbatchrequestsupport.BatchConfiguration{}, `[{"jsonrpc":"2.0","method":"a"},{"jsonrpc":"2.0","method":"b"}]`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, recorder.Body.Bytes())
+}
+
+func TestFanOutRPCBatchRequest_InvalidBatch(t *testing.T) {
+	t.Run("empty batch", func(t *testing.T) {
+		recorder := serve(t, rpcbatchrequestsupport.BatchConfiguration{}, `[]`)
+		var response rpcResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Nil(t, response.ID)
+		require.Equal(t, rpcerrors.NewInvalidRequest().Code, response.Error.Code)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		recorder := serve(t, rpcbatchrequestsupport.BatchConfiguration{MaxBatchSize: 1}, `[{"jsonrpc":"2.0","id":1,"method":"a"},{"jsonrpc":"2.0","id":2,"method":"b"}]`)
+		var response rpcResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Equal(t, rpcerrors.NewInvalidRequest().Code, response.Error.Code)
+	})
+}
+
+func TestFanOutRPCBatchRequest_MaxConcurrentRequests(t *testing.T) {
+	middleware, responseHandler := newBatchMiddleware(t, rpcbatchrequestsupport.BatchConfiguration{MaxConcurrentRequests: 2})
+	var running, maxRunning atomic.Int32
+	next := rpcHandler(t, responseHandler)
+	handler := middleware.FanOutRPCBatchRequest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := running.Add(1)
+		for {
+			previous := maxRunning.Load()
+			if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		next.ServeHTTP(w, r)
+		running.Add(-1)
+	}))
+
+	body := `[{"jsonrpc":"2.0","id":1,"method":"slow"},{"jsonrpc":"2.0","id":2,"method":"slow"},{"jsonrpc":"2.0","id":3,"method":"slow"},{"jsonrpc":"2.0","id":4,"method":"slow"}]`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	var responses []rpcResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responses))
+	require.Len(t, responses, 4)
+	for i, response := range responses {
+		require.Equal(t, float64(i+1), response.ID)
+	}
+	require.Equal(t, int32(2), maxRunning.Load())
+}
+
+func TestProvideRPCBatchRequestSupportMiddleware(t *testing.T) {
+	_, err := rpcbatchrequestsupport.ProvideRPCBatchRequestSupportMiddleware(rpcbatchrequestsupport.RPCBatchRequestSupportMiddlewareOptions{
+		ResponseHandler: &rpcinfra.DefaultRPCInfraResponseHandler{},
+		RPCRouter:       rpcinfra.ProvideDefaultRPCRouter(rpcinfra.DefaultRPCRouterOptions{}),
+		Configuration:   rpcbatchrequestsupport.BatchConfiguration{MaxConcurrentRequests: -1},
+	})
+	require.Error(t, err)
+}
@@ -15,3 +15,11 @@ type RPCRequest struct {
 	// Params defines a structured value that holds the parameter values to be used during the invocation of the method.
 	Params json.RawMessage `json:"params"`
 }
+
+// BatchConfiguration configures the processing of the batch requests
+type BatchConfiguration struct {
+	// MaxConcurrentRequests maximum number of requests of a batch processed at the same time. The requests are processed one after another if it is 0 or 1
+	MaxConcurrentRequests int
+	// MaxBatchSize maximum number of requests in a batch. Unlimited if it is 0
+	MaxBatchSize int
+}
@@ -16,4 +16,8 @@ type JSONRPCAPIAdapter interface {
 	AdaptListAccounts(ctx context.Context, data ListAccountsRequestParams) ([]string, *rpcerrors.RPCError)
 	// AdaptSignTx adapts the signature of a transaction with an Ethereum account.
 	AdaptSignTx(ctx context.Context, data SignTXRequestParams) (*string, *rpcerrors.RPCError)
+	// AdaptSignMessage adapts the signature of an arbitrary message with an Ethereum account.
+	AdaptSignMessage(ctx context.Context, data SignMessageRequestParams) (*string, *rpcerrors.RPCError)
+	// AdaptSignTypedData adapts the signature of typed structured data with an Ethereum account.
+	AdaptSignTypedData(ctx context.Context, data SignTypedDataRequestParams) (*string, *rpcerrors.RPCError)
 }
@@ -1,6 +1,8 @@
 package rpcinfra
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 )
@@ -59,12 +61,28 @@ type SignTXRequestParams struct {
 	Gas *string `json:"gas"`
 	// GasPrice to use for each paid gas
 	GasPrice *string `json:"gasPrice"`
+	// MaxFeePerGas maximum total fee per gas for dynamic fee transactions
+	MaxFeePerGas *string `json:"maxFeePerGas"`
+	// MaxPriorityFeePerGas maximum fee per gas given to the block producer for dynamic fee transactions
+	MaxPriorityFeePerGas *string `json:"maxPriorityFeePerGas"`
+	// AccessList of addresses and storage keys the transaction plans to access as defined in EIP-2930
+	AccessList []AccessListEntryParams `json:"accessList"`
+	// Type of the transaction as defined in EIP-2718
+	Type *string `json:"type"`
 	// Value amount sent with this transaction
 	Value *string `json:"value"`
 	// Data arguments packed according to json rpc standard
 	Data string `json:"data"`
-	// Nonce integer to identify request
-	Nonce string `json:"nonce"`
+	// Nonce integer to identify request. If it is not informed, the next nonce of the account is assigned
+	Nonce *string `json:"nonce"`
+}
+
+// AccessListEntryParams entry of the access list of a transaction
+type AccessListEntryParams struct {
+	// Address to be accessed
+	Address string `json:"address"`
+	// StorageKeys of the address to be accessed
+	StorageKeys []string `json:"storageKeys"`
 }
 
 func (p *SignTXRequestParams) SetParamsFrom(params []any) error {
@@ -94,18 +112,8 @@ func (p *SignTXRequestParams) SetParamsFrom(params []any) error {
 	}
 	p.Data = data
 
-	nonceParam, ok := paramMap["nonce"]
-	if !ok {
-		return errors.New("missing required field [nonce]")
-	}
-	nonce, ok := nonceParam.(string)
-	if !ok {
-		return errors.New("[nonce] must be of type string")
-	}
-	p.Nonce = nonce
-
 	// Optional fields
-	var to, gas, gasPrice, value string
+	var to, gas, gasPrice, maxFeePerGas, maxPriorityFeePerGas, txType, value, nonce string
 
 	toParam, ok := paramMap["to"]
 	if ok {
@@ -134,6 +142,42 @@ func (p *SignTXRequestParams) SetParamsFrom(params []any) error {
 		p.GasPrice = &gasPrice
 	}
 
+	maxFeePerGasParam, ok := paramMap["maxFeePerGas"]
+	if ok {
+		maxFeePerGas, ok = maxFeePerGasParam.(string)
+		if !ok {
+			return errors.New("[maxFeePerGas] must be of type string")
+		}
+		p.MaxFeePerGas = &maxFeePerGas
+	}
+
+	maxPriorityFeePerGasParam, ok := paramMap["maxPriorityFeePerGas"]
+	if ok {
+		maxPriorityFeePerGas, ok = maxPriorityFeePerGasParam.(string)
+		if !ok {
+			return errors.New("[maxPriorityFeePerGas] must be of type string")
+		}
+		p.MaxPriorityFeePerGas = &maxPriorityFeePerGas
+	}
+
+	typeParam, ok := paramMap["type"]
+	if ok {
+		txType, ok = typeParam.(string)
+		if !ok {
+			return errors.New("[type] must be of type string")
+		}
+		p.Type = &txType
+	}
+
+	accessListParam, ok := paramMap["accessList"]
+	if ok {
+		accessList, err := accessListFrom(accessListParam)
+		if err != nil {
+			return err
+		}
+		p.AccessList = accessList
+	}
+
 	valueParam, ok := paramMap["value"]
 	if ok {
 		value, ok = valueParam.(string)
@@ -142,15 +186,192 @@ func (p *SignTXRequestParams) SetParamsFrom(params []any) error {
 		}
 		p.Value = &value
 	}
+
+	nonceParam, ok := paramMap["nonce"]
+	if ok {
+		nonce, ok = nonceParam.(string)
+		if !ok {
+			return errors.New("[nonce] must be of type string")
+		}
+		p.Nonce = &nonce
+	}
 	return nil
 }
 
+// accessListFrom parses an access list from its JSON representation. The returned list is never nil so that an empty
+// access list can be told apart from a missing one.
+func accessListFrom(param any) ([]AccessListEntryParams, error) {
+	entries, ok := param.([]any)
+	if !ok {
+		return nil, errors.New("[accessList] must be an array")
+	}
+	accessList := make([]AccessListEntryParams, 0, len(entries))
+	for i, entry := range entries {
+		entryMap, ok := entry.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("[accessList][%d] must be an object", i)
+		}
+		addr, ok := entryMap["address"].(string)
+		if !ok {
+			return nil, fmt.Errorf("[accessList][%d].address must be of type string", i)
+		}
+		storageKeys := make([]string, 0)
+		if storageKeysParam, found := entryMap["storageKeys"]; found {
+			keys, isArray := storageKeysParam.([]any)
+			if !isArray {
+				return nil, fmt.Errorf("[accessList][%d].storageKeys must be an array", i)
+			}
+			for j, key := range keys {
+				storageKey, isString := key.(string)
+				if !isString {
+					return nil, fmt.Errorf("[accessList][%d].storageKeys[%d] must be of type string", i, j)
+				}
+				storageKeys = append(storageKeys, storageKey)
+			}
+		}
+		accessList = append(accessList, AccessListEntryParams{
+			Address:     addr,
+			StorageKeys: storageKeys,
+		})
+	}
+	return accessList, nil
+}
+
 func (p *SignTXRequestParams) ValidateParams() error {
 	if len(p.From) == 0 {
 		return errors.New("[from] cannot be nil")
 	}
-	if len(p.Nonce) == 0 {
-		return errors.New("[nonce] cannot be nil")
+	return nil
+}
+
+// SignMessageRequestParams request definition for both 'eth_sign' and 'personal_sign' methods
+type SignMessageRequestParams struct {
+	ApplicationID string
+	// Address of the account signing the message
+	Address string
+	// Data of the message to sign
+	Data string
+}
+
+// EthSignRequestParams request definition of 'eth_sign', whose params are [address, data]
+type EthSignRequestParams struct {
+	SignMessageRequestParams
+}
+
+func (p *EthSignRequestParams) SetParamsFrom(params []any) error {
+	if len(params) != 2 {
+		return errors.New("expected parameters are [address, data]")
+	}
+	return p.setParamsFrom(params[0], params[1])
+}
+
+// PersonalSignRequestParams request definition of 'personal_sign', whose params are [data, address] with an optional password
+// that is ignored since the keys are stored in the HSM
+type PersonalSignRequestParams struct {
+	SignMessageRequestParams
+}
+
+func (p *PersonalSignRequestParams) SetParamsFrom(params []any) error {
+	if len(params) != 2 && len(params) != 3 {
+		return errors.New("expected parameters are [data, address]")
+	}
+	return p.setParamsFrom(params[1], params[0])
+}
+
+func (p *SignMessageRequestParams) setParamsFrom(addressParam, dataParam any) error {
+	address, ok := addressParam.(string)
+	if !ok {
+		return errors.New("[address] must be of type string")
+	}
+	p.Address = address
+
+	data, ok := dataParam.(string)
+	if !ok {
+		return errors.New("[data] must be of type string")
+	}
+	p.Data = data
+	return nil
+}
+
+func (p *SignMessageRequestParams) ValidateParams() error {
+	if len(p.Address) == 0 {
+		return errors.New("[address] cannot be nil")
+	}
+	if len(p.Data) == 0 {
+		return errors.New("[data] cannot be nil")
+	}
+	return nil
+}
+
+// SignTypedDataRequestParams request definition of 'eth_signTypedData_v4', whose params are [address, typedData]. The
+// typed data can be sent either as a JSON object or as a string containing it.
+type SignTypedDataRequestParams struct {
+	ApplicationID string
+	// Address of the account signing the typed data
+	Address string
+	// TypedData JSON encoded typed structured data to sign
+	TypedData string
+}
+
+// UnmarshalJSON decodes the positional params keeping the raw typed data, so that its numbers don't lose precision.
+func (p *SignTypedDataRequestParams) UnmarshalJSON(input []byte) error {
+	var params []json.RawMessage
+	if err := json.Unmarshal(input, &params); err != nil {
+		return err
+	}
+	if len(params) != 2 {
+		return errors.New("expected parameters are [address, typedData]")
+	}
+
+	if err := json.Unmarshal(params[0], &p.Address); err != nil {
+		return errors.New("[address] must be of type string")
+	}
+
+	typedData := bytes.TrimSpace(params[1])
+	if len(typedData) > 0 && typedData[0] == '"' {
+		if err := json.Unmarshal(typedData, &p.TypedData); err != nil {
+			return errors.New("[typedData] must be an object or a string")
+		}
+		return nil
+	}
+	if len(typedData) == 0 || typedData[0] != '{' {
+		return errors.New("[typedData] must be an object or a string")
+	}
+	p.TypedData = string(typedData)
+	return nil
+}
+
+func (p *SignTypedDataRequestParams) SetParamsFrom(params []any) error {
+	if len(params) != 2 {
+		return errors.New("expected parameters are [address, typedData]")
+	}
+	address, ok := params[0].(string)
+	if !ok {
+		return errors.New("[address] must be of type string")
+	}
+	p.Address = address
+
+	switch typedData := params[1].(type) {
+	case string:
+		p.TypedData = typedData
+	case map[string]any:
+		typedDataJSON, err := json.Marshal(typedData)
+		if err != nil {
+			return err
+		}
+		p.TypedData = string(typedDataJSON)
+	default:
+		return errors.New("[typedData] must be an object or a string")
+	}
+	return nil
+}
+
+func (p *SignTypedDataRequestParams) ValidateParams() error {
+	if len(p.Address) == 0 {
+		return errors.New("[address] cannot be nil")
+	}
+	if len(p.TypedData) == 0 {
+		return errors.New("[typedData] cannot be nil")
 	}
 	return nil
 }
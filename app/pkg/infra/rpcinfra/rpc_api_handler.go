@@ -18,6 +18,12 @@ type JSONRPCAPIHandler interface {
 	HandleListAccounts(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError)
 	// HandleSignTX handles the signature of a transaction with an Ethereum account.
 	HandleSignTX(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError)
+	// HandleEthSign handles the signature of a message with an Ethereum account using the 'eth_sign' parameters order.
+	HandleEthSign(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError)
+	// HandlePersonalSign handles the signature of a message with an Ethereum account using the 'personal_sign' parameters order.
+	HandlePersonalSign(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError)
+	// HandleSignTypedData handles the signature of typed structured data with an Ethereum account.
+	HandleSignTypedData(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError)
 }
 
 func (handler DefaultJSONRPCAPIHandler) HandleGenerateAccount(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError) {
@@ -112,6 +118,72 @@ func (handler DefaultJSONRPCAPIHandler) HandleSignTX(ctx context.Context, r RPCR
 	}, nil
 }
 
+func (handler DefaultJSONRPCAPIHandler) HandleEthSign(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError) {
+	reqParams := EthSignRequestParams{}
+	if err := ProcessParams(r.Params, &reqParams); err != nil {
+		return nil, err
+	}
+	return handler.handleSignMessage(ctx, r, reqParams.SignMessageRequestParams)
+}
+
+func (handler DefaultJSONRPCAPIHandler) HandlePersonalSign(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError) {
+	reqParams := PersonalSignRequestParams{}
+	if err := ProcessParams(r.Params, &reqParams); err != nil {
+		return nil, err
+	}
+	return handler.handleSignMessage(ctx, r, reqParams.SignMessageRequestParams)
+}
+
+func (handler DefaultJSONRPCAPIHandler) handleSignMessage(ctx context.Context, r RPCRequest, reqParams SignMessageRequestParams) (any, *rpcerrors.RPCError) {
+	err := reqParams.ValidateParams()
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(err)
+	}
+
+	applicationID, err := requestcontext.ApplicationFromContext(ctx)
+	if err != nil {
+		return nil, rpcerrors.NewInternalFromErr(err)
+	}
+	reqParams.ApplicationID = *applicationID
+
+	out, rpcErr := handler.adapter.AdaptSignMessage(ctx, reqParams)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return &RPCResponse{
+		RPCVersion: SupportedRPCVersion,
+		ID:         r.ID,
+		Result:     out,
+	}, nil
+}
+
+func (handler DefaultJSONRPCAPIHandler) HandleSignTypedData(ctx context.Context, r RPCRequest) (any, *rpcerrors.RPCError) {
+	reqParams := SignTypedDataRequestParams{}
+	if err := ProcessParams(r.Params, &reqParams); err != nil {
+		return nil, err
+	}
+	err := reqParams.ValidateParams()
+	if err != nil {
+		return nil, rpcerrors.NewInvalidParamsFromErr(err)
+	}
+
+	applicationID, err := requestcontext.ApplicationFromContext(ctx)
+	if err != nil {
+		return nil, rpcerrors.NewInternalFromErr(err)
+	}
+	reqParams.ApplicationID = *applicationID
+
+	out, rpcErr := handler.adapter.AdaptSignTypedData(ctx, reqParams)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return &RPCResponse{
+		RPCVersion: SupportedRPCVersion,
+		ID:         r.ID,
+		Result:     out,
+	}, nil
+}
+
 // DefaultJSONRPCAPIHandlerOptions are the attributes to build a DefaultJSONRPCAPIHandler
 type DefaultJSONRPCAPIHandlerOptions struct {
 	// Adapter  adapts the set of operations that are supported by the RPC protocol
@@ -10,6 +10,9 @@ const (
 	removeAccountMethod   = "eth_removeAccount"
 	listAccountsMethod    = "eth_accounts"
 	signTransactionMethod = "eth_signTransaction"
+	ethSignMethod         = "eth_sign"
+	personalSignMethod    = "personal_sign"
+	signTypedDataMethod   = "eth_signTypedData_v4"
 )
 
 // JSONRPCAPIPublisherOptions options to create a JSONRPCAPIRoutesPublished.
@@ -48,6 +51,18 @@ func ProvideJSONRPCMethods(options JSONRPCAPIPublisherOptions) (JSONRPCAPIRoutes
 	if err != nil {
 		return 0, err
 	}
+	err = options.RPCRouter.RegisterRPCHandlerFunc(ethSignMethod, options.Handler.HandleEthSign)
+	if err != nil {
+		return 0, err
+	}
+	err = options.RPCRouter.RegisterRPCHandlerFunc(personalSignMethod, options.Handler.HandlePersonalSign)
+	if err != nil {
+		return 0, err
+	}
+	err = options.RPCRouter.RegisterRPCHandlerFunc(signTypedDataMethod, options.Handler.HandleSignTypedData)
+	if err != nil {
+		return 0, err
+	}
 
 	// HTTP Handler
 	options.RPCRouter.Router().HandleFunc("/", options.RPCRouter.HandleRPCRequest).Methods("POST").Name("rpc.method")
@@ -43,6 +43,11 @@ func (d DefaultRPCInfraResponseHandler) HandleErrorResponse(ctx context.Context,
 	if receivedError.Code() == http.StatusForbidden {
 		d.httpMetrics.IncrementForbiddenAccessCounter(ctx)
 		rpcError = rpcerrors.NewUnauthorizedFromErr(receivedError)
+		if denialReason := receivedError.DenialReason(); denialReason != "" {
+			rpcError.Data = &rpcerrors.ErrorData{
+				DenialReason: denialReason,
+			}
+		}
 	}
 
 	requestID, requestIDFromContextErr := requestcontext.RPCRequestIDFromContext(ctx)
@@ -77,6 +82,11 @@ func logRPCError(ctx context.Context, error *rpcerrors.RPCError) {
 		logger.LogEntry(ctx).Errorf("%+v", error)
 		return
 	}
+	// timeouts are not caused by the request, so they are reported even if the client can retry it
+	if error.Code == rpcerrors.TimeoutErrorCode {
+		logger.LogEntry(ctx).Warnf("%+v", error)
+		return
+	}
 	logger.LogEntry(ctx).Debugf("%+v", error)
 }
 
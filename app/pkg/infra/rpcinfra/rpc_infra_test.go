@@ -2,9 +2,12 @@ package rpcinfra_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hyperledger-labs/signare/app/pkg/infra/httpinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra"
 	"github.com/hyperledger-labs/signare/app/pkg/infra/rpcinfra/rpcerrors"
 
@@ -70,3 +73,90 @@ func FooFunc(_ context.Context, request rpcinfra.RPCRequest) (any, *rpcerrors.RP
 	}
 	return methodParams.Foo, nil
 }
+
+func TestRPCInfra_ProcessSignMessageParams(t *testing.T) {
+	addr := "0xa2c16184fA76cD6D16685900292683dF905e4Bf2"
+	data := "0x68656c6c6f"
+
+	t.Run("eth_sign params are [address, data]", func(t *testing.T) {
+		params := rpcinfra.EthSignRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s","%s"]`, addr, data)), &params)
+		require.Nil(t, rpcErr)
+		require.Equal(t, addr, params.Address)
+		require.Equal(t, data, params.Data)
+	})
+	t.Run("personal_sign params are [data, address]", func(t *testing.T) {
+		params := rpcinfra.PersonalSignRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s","%s"]`, data, addr)), &params)
+		require.Nil(t, rpcErr)
+		require.Equal(t, addr, params.Address)
+		require.Equal(t, data, params.Data)
+	})
+	t.Run("personal_sign ignores the password", func(t *testing.T) {
+		params := rpcinfra.PersonalSignRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s","%s",""]`, data, addr)), &params)
+		require.Nil(t, rpcErr)
+		require.Equal(t, addr, params.Address)
+		require.Equal(t, data, params.Data)
+	})
+	t.Run("invalid number of params", func(t *testing.T) {
+		params := rpcinfra.EthSignRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s"]`, addr)), &params)
+		require.NotNil(t, rpcErr)
+	})
+}
+
+func TestRPCInfra_ProcessSignTypedDataParams(t *testing.T) {
+	addr := "0xa2c16184fA76cD6D16685900292683dF905e4Bf2"
+	typedData := `{"primaryType":"Permit","message":{"value":115792089237316195423570985008687907853269984665640564039457584007913129639935}}`
+
+	t.Run("typed data as an object keeps the numbers precision", func(t *testing.T) {
+		params := rpcinfra.SignTypedDataRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s",%s]`, addr, typedData)), &params)
+		require.Nil(t, rpcErr)
+		require.Equal(t, addr, params.Address)
+		require.Equal(t, typedData, params.TypedData)
+	})
+	t.Run("typed data as a string", func(t *testing.T) {
+		params := rpcinfra.SignTypedDataRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s",%q]`, addr, typedData)), &params)
+		require.Nil(t, rpcErr)
+		require.Equal(t, addr, params.Address)
+		require.Equal(t, typedData, params.TypedData)
+	})
+	t.Run("invalid typed data", func(t *testing.T) {
+		params := rpcinfra.SignTypedDataRequestParams{}
+		rpcErr := rpcinfra.ProcessParams([]byte(fmt.Sprintf(`["%s",1]`, addr)), &params)
+		require.NotNil(t, rpcErr)
+	})
+}
+
+func TestDefaultRPCInfraResponseHandler_HandleErrorResponse_DenialReason(t *testing.T) {
+	handler, err := rpcinfra.ProvideDefaultRPCInfraResponseHandler(rpcinfra.DefaultRPCInfraResponseHandlerOptions{
+		HTTPMetrics: httpinfra.DefaultHTTPMetrics{},
+	})
+	require.Nil(t, err)
+
+	t.Run("denied authorization with a reason", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		receivedError := httpinfra.NewHTTPError(httpinfra.StatusPermissionDenied)
+		receivedError.SetDenialReason("ACCOUNT_NOT_ENABLED")
+		handler.HandleErrorResponse(context.Background(), rr, receivedError)
+
+		var response rpcinfra.RPCResponse
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.Nil(t, err)
+		require.Equal(t, rpcerrors.UnauthorizedErrorCode, response.Error.Code)
+		require.Equal(t, "ACCOUNT_NOT_ENABLED", response.Error.Data.DenialReason)
+	})
+	t.Run("denied authorization without a reason", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.HandleErrorResponse(context.Background(), rr, httpinfra.NewHTTPError(httpinfra.StatusPermissionDenied))
+
+		var response rpcinfra.RPCResponse
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.Nil(t, err)
+		require.Equal(t, rpcerrors.UnauthorizedErrorCode, response.Error.Code)
+		require.Nil(t, response.Error.Data)
+	})
+}
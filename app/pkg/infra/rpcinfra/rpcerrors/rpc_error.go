@@ -17,6 +17,8 @@ const (
 	UnauthorizedErrorCode       ErrorCode = -32099
 	NotFoundErrorCode           ErrorCode = -32098
 	PreconditionFailedErrorCode ErrorCode = -32097
+	LimitExceededErrorCode      ErrorCode = -32096
+	TimeoutErrorCode            ErrorCode = -32095
 
 	ParseErrorMsg              ErrorMsg = "Parse error"
 	InvalidRequestErrorMsg     ErrorMsg = "Invalid request"
@@ -26,6 +28,8 @@ const (
 	UnauthorizedErrorMsg       ErrorMsg = "Unauthorized"
 	NotFoundErrorMsg           ErrorMsg = "Not found"
 	PreconditionFailedErrorMsg ErrorMsg = "Precondition failed"
+	LimitExceededErrorMsg      ErrorMsg = "Limit exceeded"
+	TimeoutErrorMsg            ErrorMsg = "Timeout"
 )
 
 // NewMethodNotFound creates a new method not found RPCError.
@@ -164,6 +168,40 @@ func NewPreconditionFailedFromErr(err error) *RPCError {
 	}
 }
 
+// NewLimitExceeded creates a new limit exceeded RPCError.
+func NewLimitExceeded() *RPCError {
+	return &RPCError{
+		Code:    LimitExceededErrorCode,
+		Message: LimitExceededErrorMsg,
+	}
+}
+
+// NewLimitExceededFromErr creates a new limit exceeded RPCError wrapping the original error.
+func NewLimitExceededFromErr(err error) *RPCError {
+	return &RPCError{
+		Code:       LimitExceededErrorCode,
+		Message:    LimitExceededErrorMsg,
+		WrappedErr: err,
+	}
+}
+
+// NewTimeout creates a new timeout RPCError.
+func NewTimeout() *RPCError {
+	return &RPCError{
+		Code:    TimeoutErrorCode,
+		Message: TimeoutErrorMsg,
+	}
+}
+
+// NewTimeoutFromErr creates a new timeout RPCError wrapping the original error.
+func NewTimeoutFromErr(err error) *RPCError {
+	return &RPCError{
+		Code:       TimeoutErrorCode,
+		Message:    TimeoutErrorMsg,
+		WrappedErr: err,
+	}
+}
+
 // CastAsRPCError casts the provided error as an RPC error type
 func CastAsRPCError(err error) (*RPCError, bool) {
 	var castedErr *RPCError
@@ -183,10 +221,18 @@ type RPCError struct {
 	Code ErrorCode `json:"code"`
 	// Message provides a short description of the error.
 	Message ErrorMsg `json:"message"`
+	// Data contains additional information about the error (if any).
+	Data *ErrorData `json:"data,omitempty"`
 	// WrappedErr contains the original error (if any).
 	WrappedErr error `json:"-"`
 }
 
+// ErrorData is the additional information about an RPCError.
+type ErrorData struct {
+	// DenialReason why the authorization of the request was denied.
+	DenialReason string `json:"denialReason,omitempty"`
+}
+
 // Marshal implements json.Marshaller.
 func (rpcError *RPCError) Marshal() *json.RawMessage {
 	result, err := json.Marshal(rpcError)
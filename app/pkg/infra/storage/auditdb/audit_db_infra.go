@@ -0,0 +1,49 @@
+package auditdb
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+const (
+	addAuditRecordMapperID   = "signare.auditRecord.insert"
+	listAuditRecordsMapperID = "signare.auditRecord.list"
+	lastAuditRecordMapperID  = "signare.auditRecord.last"
+)
+
+func (repository *AuditRepositoryInfra) Add(ctx context.Context, db AuditRecordDB) error {
+	return repository.genericStorage.ExecuteStmt(ctx, addAuditRecordMapperID, db)
+}
+
+func (repository *AuditRepositoryInfra) Last(ctx context.Context) ([]AuditRecordDB, error) {
+	auditRecordDBItems := make([]AuditRecordDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, lastAuditRecordMapperID, AuditRecordDB{}, &auditRecordDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return auditRecordDBItems, nil
+}
+
+func (repository *AuditRepositoryInfra) List(ctx context.Context, filters AuditRecordDBFilter) ([]AuditRecordDB, error) {
+	auditRecordDBItems := make([]AuditRecordDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, listAuditRecordsMapperID, &filters, &auditRecordDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return auditRecordDBItems, nil
+}
+
+type AuditRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type AuditRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideAuditRepositoryInfra(options AuditRepositoryInfraOptions) *AuditRepositoryInfra {
+	return &AuditRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}
+}
@@ -0,0 +1,45 @@
+package auditdb
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+// AuditRecordDBFilter to filter lists of resources from the database
+type AuditRecordDBFilter struct {
+	// AuditRecordDB is the data struct of the resource in the database
+	AuditRecordDB
+	// Order is the order of the list based on an attribute
+	Order *persistence.Order `valid:"optional"`
+	// FilterGroup is a collection of filters
+	FilterGroup *persistence.FilterGroup `valid:"optional"`
+	// Pagination is the page info of the list
+	Pagination *persistence.Pagination `valid:"optional"`
+}
+
+// AppendFilter Append filter.
+func (filter *AuditRecordDBFilter) AppendFilter(theFilter persistence.Filter) {
+	if filter.FilterGroup == nil {
+		filter.FilterGroup = &persistence.FilterGroup{
+			Filters: make([]persistence.Filter, 0),
+		}
+	}
+	filter.FilterGroup.Filters = append(filter.FilterGroup.Filters, theFilter)
+}
+
+// Paged creates a pagination filter.
+func (filter *AuditRecordDBFilter) Paged(limit, offset int) *AuditRecordDBFilter {
+	filter.Pagination = &persistence.Pagination{
+		Limit:  limit,
+		Offset: offset,
+	}
+	return filter
+}
+
+// Sort creates a sorting filter.
+func (filter *AuditRecordDBFilter) Sort(orderBy string, orderDirection persistence.OrderDirection) *AuditRecordDBFilter {
+	filter.Order = &persistence.Order{
+		By:        persistence.OrderByOption(orderBy),
+		Direction: orderDirection,
+	}
+	return filter
+}
@@ -0,0 +1,31 @@
+package auditdb
+
+// AuditRecordDB is the data struct of the resource in the database
+type AuditRecordDB struct {
+	// SequenceNumber is the position of the record in the audit log
+	SequenceNumber int64 `storage:"sequence_number"`
+	// CreationDate is the timestamp of the moment the operation was performed
+	CreationDate int64 `storage:"creation_date"`
+	// Operation is the audited operation
+	Operation string `storage:"operation"`
+	// UserID is the user that requested the operation
+	UserID string `storage:"user_id"`
+	// ApplicationID is the application the operation was performed in
+	ApplicationID string `storage:"application_id"`
+	// Address is the account involved in the operation
+	Address string `storage:"address"`
+	// ChainID is the chain the operation was performed for
+	ChainID string `storage:"chain_id"`
+	// TxHash is the hash of the signed transaction
+	TxHash string `storage:"tx_hash"`
+	// SignedHash is the hash of the signed message or typed data
+	SignedHash string `storage:"signed_hash"`
+	// Outcome is the outcome of the operation
+	Outcome string `storage:"outcome"`
+	// Error is the description of the failure of the operation
+	Error string `storage:"error"`
+	// PreviousHash is the hash of the previous record in the audit log
+	PreviousHash string `storage:"previous_hash"`
+	// Hash is the hash of the content of the record
+	Hash string `storage:"hash"`
+}
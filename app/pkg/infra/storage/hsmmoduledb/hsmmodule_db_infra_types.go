@@ -3,7 +3,9 @@ package hsmmoduledb
 import "github.com/hyperledger-labs/signare/app/pkg/entities"
 
 const (
-	SoftHSMModuleKind = "SoftHSM"
+	SoftHSMModuleKind  = "SoftHSM"
+	CloudKMSModuleKind = "CloudKMS"
+	PKCS11ModuleKind   = "PKCS11"
 )
 
 // HardwareSecurityModuleDB is the data struct of the resource in the database
@@ -26,6 +28,16 @@ type HardwareSecurityModuleDB struct {
 	LastUpdate int64 `storage:"last_update"`
 }
 
+// PKCS11ConfigurationDB is the configuration of a generic PKCS11 module, stored as JSON in the configuration column
+type PKCS11ConfigurationDB struct {
+	// Library is the path to the PKCS11 library of the vendor
+	Library string `json:"library"`
+	// TokenLabel is the label of the token that the slots must hold
+	TokenLabel *string `json:"tokenLabel,omitempty"`
+	// OmitKeyID is true if the CKA_ID attribute must not be set on generated keys
+	OmitKeyID bool `json:"omitKeyId,omitempty"`
+}
+
 // HardwareSecurityModuleCreateDB is the data struct of the creation of a resource in the database
 type HardwareSecurityModuleCreateDB struct {
 	// HardwareSecurityModuleDB is the data struct of the resource in the database
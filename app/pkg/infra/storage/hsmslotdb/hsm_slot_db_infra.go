@@ -15,6 +15,7 @@ const (
 	getSlotMapperID              = "signare.hardwareSecurityModuleSlot.getById"
 	getSlotByApplicationMapperID = "signare.hardwareSecurityModuleSlot.getByApplication"
 	editPinSlotMapperID          = "signare.hardwareSecurityModuleSlot.updatePin"
+	updateStoredPinSlotMapperID  = "signare.hardwareSecurityModuleSlot.updateStoredPin"
 	removeSlotMapperID           = "signare.hardwareSecurityModuleSlot.delete"
 	listSlotMapperID             = "signare.hardwareSecurityModuleSlot.list"
 	exitsSlotMapperID            = "signare.hardwareSecurityModuleSlot.exists"
@@ -73,6 +74,12 @@ func (repository *HSMSlotRepositoryInfra) EditPin(ctx context.Context, db HSMSlo
 	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editPinSlotMapperID, db)
 }
 
+// UpdateStoredPin replaces the stored value of the pin without changing the resource version, as the pin itself does
+// not change. The value is only replaced if it has not been modified since it was read.
+func (repository *HSMSlotRepositoryInfra) UpdateStoredPin(ctx context.Context, db HSMSlotUpdateStoredPinDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, updateStoredPinSlotMapperID, db)
+}
+
 func (repository *HSMSlotRepositoryInfra) Remove(ctx context.Context, id entities.StandardID) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
 	db := HSMSlotDB{}
 	db.ID = id.ID
@@ -16,6 +16,8 @@ type HSMSlotDB struct {
 	Slot string `storage:"slot"`
 	// Pin the password of the HSM Slot in the HSM
 	Pin string `storage:"pin"`
+	// Priority position of the HSM Slot in the ordered set of slots of the Application
+	Priority int `storage:"priority"`
 	// CreationDate is the timestamp of the moment of the creation of the resource
 	CreationDate int64 `storage:"creation_date"`
 	// LastUpdate is the timestamp of the moment of the last edition of the resource
@@ -44,6 +46,16 @@ type HSMSlotUpdatePinDB struct {
 	NewResourceVersion string `storage:"new_resource_version"`
 }
 
+// HSMSlotUpdateStoredPinDB is the data struct to replace the stored value of the pin of a resource in the database
+type HSMSlotUpdateStoredPinDB struct {
+	// StandardID is the ID of the resource
+	entities.StandardID
+	// Pin the new stored value of the pin
+	Pin string `storage:"pin"`
+	// PreviousPin the stored value of the pin that is replaced
+	PreviousPin string `storage:"previous_pin"`
+}
+
 // HSMSlotExistsDB is the data struct to check if a resource exists in the database
 type HSMSlotExistsDB struct {
 	// Exists is true if the resource exists
@@ -0,0 +1,58 @@
+package noncedb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+const (
+	incrementNonceMapperID = "signare.accountNonce.increment"
+	setNonceMapperID       = "signare.accountNonce.set"
+	advanceNonceMapperID   = "signare.accountNonce.advance"
+	getNonceMapperID       = "signare.accountNonce.getById"
+)
+
+func (repository *NonceRepositoryInfra) Increment(ctx context.Context, db NonceDB) error {
+	return repository.genericStorage.ExecuteStmt(ctx, incrementNonceMapperID, db)
+}
+
+func (repository *NonceRepositoryInfra) Set(ctx context.Context, db NonceDB) error {
+	return repository.genericStorage.ExecuteStmt(ctx, setNonceMapperID, db)
+}
+
+func (repository *NonceRepositoryInfra) Advance(ctx context.Context, db NonceDB) error {
+	return repository.genericStorage.ExecuteStmt(ctx, advanceNonceMapperID, db)
+}
+
+func (repository *NonceRepositoryInfra) Get(ctx context.Context, id NonceDB) ([]NonceDB, error) {
+	var nonceDBItems []NonceDB
+	db := NonceDB{
+		ChainID: id.ChainID,
+		Address: id.Address,
+	}
+
+	err := repository.genericStorage.QueryAll(ctx, getNonceMapperID, db, &nonceDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return nonceDBItems, nil
+}
+
+type NonceRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type NonceRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideNonceRepositoryInfra(options NonceRepositoryInfraOptions) (*NonceRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &NonceRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package noncedb
+
+// NonceDB is the data struct of the resource in the database
+type NonceDB struct {
+	// ChainID is the chain the transactions are signed for
+	ChainID string `storage:"chain_id"`
+	// Address is the account that signs the transactions
+	Address string `storage:"address"`
+	// NextNonce is the nonce to be assigned to the next transaction
+	NextNonce int64 `storage:"next_nonce"`
+	// CreationDate is the timestamp of the moment of the creation of the resource
+	CreationDate int64 `storage:"creation_date"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+}
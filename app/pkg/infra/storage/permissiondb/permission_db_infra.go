@@ -0,0 +1,113 @@
+package permissiondb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+
+	"github.com/google/uuid"
+)
+
+const (
+	addPermissionMapperID    = "signare.rbacPermission.insert"
+	getPermissionMapperID    = "signare.rbacPermission.getById"
+	editPermissionMapperID   = "signare.rbacPermission.update"
+	removePermissionMapperID = "signare.rbacPermission.delete"
+	listPermissionsMapperID  = "signare.rbacPermission.list"
+	existsPermissionMapperID = "signare.rbacPermission.exists"
+)
+
+func (repository *PermissionRepositoryInfra) Add(ctx context.Context, db PermissionCreateDB) (*PermissionDB, error) {
+	db.ResourceVersion = uuid.NewString()
+	err := repository.genericStorage.ExecuteStmt(ctx, addPermissionMapperID, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.Get(ctx, db.StandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, persistence.NewEntryNotAddedError()
+	}
+
+	return &result[0], nil
+}
+
+func (repository *PermissionRepositoryInfra) Get(ctx context.Context, id entities.StandardID) ([]PermissionDB, error) {
+	var permissionDBItems []PermissionDB
+	db := PermissionDB{}
+	db.ID = id.ID
+
+	err := repository.genericStorage.QueryAll(ctx, getPermissionMapperID, db, &permissionDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return permissionDBItems, nil
+}
+
+func (repository *PermissionRepositoryInfra) Edit(ctx context.Context, db PermissionUpdateDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	_, err := repository.Exists(ctx, db.StandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	db.NewResourceVersion = uuid.NewString()
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editPermissionMapperID, db)
+}
+
+func (repository *PermissionRepositoryInfra) Remove(ctx context.Context, id entities.StandardID) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db := PermissionDB{}
+	db.ID = id.ID
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, removePermissionMapperID, db)
+}
+
+func (repository *PermissionRepositoryInfra) List(ctx context.Context) ([]PermissionDB, error) {
+	permissionDBItems := make([]PermissionDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, listPermissionsMapperID, PermissionDB{}, &permissionDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return permissionDBItems, nil
+}
+
+func (repository *PermissionRepositoryInfra) Exists(ctx context.Context, id entities.StandardID) ([]PermissionExistsDB, error) {
+	var existsResult []PermissionExistsDB
+
+	db := PermissionDB{}
+	db.StandardID = id
+
+	err := repository.genericStorage.QueryAll(ctx, existsPermissionMapperID, db, &existsResult)
+	if err != nil {
+		return existsResult, err
+	}
+
+	if len(existsResult) == 0 || !existsResult[0].Exists {
+		return nil, persistence.NewNotFoundError()
+	}
+
+	return existsResult, nil
+}
+
+type PermissionRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type PermissionRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvidePermissionRepositoryInfra(options PermissionRepositoryInfraOptions) (*PermissionRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &PermissionRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package permissiondb
+
+import "github.com/hyperledger-labs/signare/app/pkg/entities"
+
+// PermissionDB is the data struct of the resource in the database
+type PermissionDB struct {
+	// StandardID is the ID of the resource
+	entities.StandardID
+	// Actions are the JSON encoded actions granted by the Permission
+	Actions string `storage:"actions"`
+	// Description of the resource
+	Description string `storage:"description"`
+	// CreationDate is the timestamp of the moment of the creation of the resource
+	CreationDate int64 `storage:"creation_date"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+	// ResourceVersion is the identifier of the current version of the resource
+	ResourceVersion string `storage:"resource_version"`
+}
+
+// PermissionCreateDB is the data struct of the creation of a resource in the database
+type PermissionCreateDB struct {
+	// PermissionDB is the data struct of the resource in the database
+	PermissionDB
+}
+
+// PermissionUpdateDB is the data struct of the update of a resource in the database
+type PermissionUpdateDB struct {
+	// PermissionDB is the data struct of the resource in the database
+	PermissionDB
+	// NewResourceVersion is the new resource version after the edition
+	NewResourceVersion string `storage:"new_resource_version"`
+}
+
+// PermissionExistsDB is the data struct to check if a resource exists in the database
+type PermissionExistsDB struct {
+	// Exists is true if the resource exists
+	Exists bool `storage:"exists_result" valid:"required"`
+}
@@ -3,11 +3,13 @@ package referentialintegritydb
 import "github.com/hyperledger-labs/signare/app/pkg/entities"
 
 const (
-	KindAccount     = "account"
-	KindApplication = "application"
-	KindHSMModule   = "hardware_security_module"
-	KindHSMSlot     = "hardware_security_module_slot"
-	KindUser        = "user"
+	KindAccount       = "account"
+	KindApplication   = "application"
+	KindHSMModule     = "hardware_security_module"
+	KindHSMSlot       = "hardware_security_module_slot"
+	KindSigningLimit  = "signing_limit"
+	KindSigningPolicy = "signing_policy"
+	KindUser          = "user"
 )
 
 // ReferentialIntegrityEntryDB is the data struct of the resource in the database
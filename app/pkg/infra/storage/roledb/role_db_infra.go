@@ -0,0 +1,131 @@
+package roledb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+
+	"github.com/google/uuid"
+)
+
+const (
+	addRoleMapperID      = "signare.rbacRole.insert"
+	getRoleMapperID      = "signare.rbacRole.getById"
+	editRoleMapperID     = "signare.rbacRole.update"
+	removeRoleMapperID   = "signare.rbacRole.delete"
+	listRolesMapperID    = "signare.rbacRole.list"
+	existsRoleMapperID   = "signare.rbacRole.exists"
+	assignedRoleMapperID = "signare.rbacRole.assigned"
+)
+
+func (repository *RoleRepositoryInfra) Add(ctx context.Context, db RoleCreateDB) (*RoleDB, error) {
+	db.ResourceVersion = uuid.NewString()
+	err := repository.genericStorage.ExecuteStmt(ctx, addRoleMapperID, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.Get(ctx, db.StandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, persistence.NewEntryNotAddedError()
+	}
+
+	return &result[0], nil
+}
+
+func (repository *RoleRepositoryInfra) Get(ctx context.Context, id entities.StandardID) ([]RoleDB, error) {
+	var roleDBItems []RoleDB
+	db := RoleDB{}
+	db.ID = id.ID
+
+	err := repository.genericStorage.QueryAll(ctx, getRoleMapperID, db, &roleDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return roleDBItems, nil
+}
+
+func (repository *RoleRepositoryInfra) Edit(ctx context.Context, db RoleUpdateDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	_, err := repository.Exists(ctx, db.StandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	db.NewResourceVersion = uuid.NewString()
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editRoleMapperID, db)
+}
+
+func (repository *RoleRepositoryInfra) Remove(ctx context.Context, id entities.StandardID) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db := RoleDB{}
+	db.ID = id.ID
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, removeRoleMapperID, db)
+}
+
+func (repository *RoleRepositoryInfra) List(ctx context.Context) ([]RoleDB, error) {
+	roleDBItems := make([]RoleDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, listRolesMapperID, RoleDB{}, &roleDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return roleDBItems, nil
+}
+
+func (repository *RoleRepositoryInfra) Exists(ctx context.Context, id entities.StandardID) ([]RoleExistsDB, error) {
+	var existsResult []RoleExistsDB
+
+	db := RoleDB{}
+	db.StandardID = id
+
+	err := repository.genericStorage.QueryAll(ctx, existsRoleMapperID, db, &existsResult)
+	if err != nil {
+		return existsResult, err
+	}
+
+	if len(existsResult) == 0 || !existsResult[0].Exists {
+		return nil, persistence.NewNotFoundError()
+	}
+
+	return existsResult, nil
+}
+
+// Assigned checks whether the role is assigned to any user. The roles of the users are stored as a JSON array, so
+// the role is looked up with its JSON encoded ID.
+func (repository *RoleRepositoryInfra) Assigned(ctx context.Context, id entities.StandardID) (bool, error) {
+	var existsResult []RoleExistsDB
+
+	db := RoleAssignedDB{
+		RolesPattern: fmt.Sprintf("%%%q%%", id.ID),
+	}
+
+	err := repository.genericStorage.QueryAll(ctx, assignedRoleMapperID, db, &existsResult)
+	if err != nil {
+		return false, err
+	}
+
+	return len(existsResult) > 0 && existsResult[0].Exists, nil
+}
+
+type RoleRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type RoleRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideRoleRepositoryInfra(options RoleRepositoryInfraOptions) (*RoleRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &RoleRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package roledb
+
+import "github.com/hyperledger-labs/signare/app/pkg/entities"
+
+// RoleDB is the data struct of the resource in the database
+type RoleDB struct {
+	// StandardID is the ID of the resource
+	entities.StandardID
+	// Permissions are the JSON encoded permissions granted by the Role
+	Permissions string `storage:"permissions"`
+	// Description of the resource
+	Description string `storage:"description"`
+	// CreationDate is the timestamp of the moment of the creation of the resource
+	CreationDate int64 `storage:"creation_date"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+	// ResourceVersion is the identifier of the current version of the resource
+	ResourceVersion string `storage:"resource_version"`
+}
+
+// RoleCreateDB is the data struct of the creation of a resource in the database
+type RoleCreateDB struct {
+	// RoleDB is the data struct of the resource in the database
+	RoleDB
+}
+
+// RoleUpdateDB is the data struct of the update of a resource in the database
+type RoleUpdateDB struct {
+	// RoleDB is the data struct of the resource in the database
+	RoleDB
+	// NewResourceVersion is the new resource version after the edition
+	NewResourceVersion string `storage:"new_resource_version"`
+}
+
+// RoleExistsDB is the data struct to check if a resource exists in the database
+type RoleExistsDB struct {
+	// Exists is true if the resource exists
+	Exists bool `storage:"exists_result" valid:"required"`
+}
+
+// RoleAssignedDB is the data struct to check if a resource is assigned to any user in the database
+type RoleAssignedDB struct {
+	// RolesPattern is the pattern that matches the JSON encoded roles of the users that have the resource assigned
+	RolesPattern string `storage:"roles_pattern"`
+}
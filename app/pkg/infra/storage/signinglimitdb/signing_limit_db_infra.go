@@ -0,0 +1,115 @@
+package signinglimitdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+
+	"github.com/google/uuid"
+)
+
+const (
+	addSigningLimitMapperID    = "signare.signingLimit.insert"
+	getSigningLimitMapperID    = "signare.signingLimit.getById"
+	editSigningLimitMapperID   = "signare.signingLimit.update"
+	removeSigningLimitMapperID = "signare.signingLimit.delete"
+	listSigningLimitsMapperID  = "signare.signingLimit.list"
+	existsSigningLimitMapperID = "signare.signingLimit.exists"
+)
+
+func (repository *SigningLimitRepositoryInfra) Add(ctx context.Context, db SigningLimitCreateDB) (*SigningLimitDB, error) {
+	db.ResourceVersion = uuid.NewString()
+	err := repository.genericStorage.ExecuteStmt(ctx, addSigningLimitMapperID, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.Get(ctx, db.ApplicationStandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, persistence.NewEntryNotAddedError()
+	}
+
+	return &result[0], nil
+}
+
+func (repository *SigningLimitRepositoryInfra) Get(ctx context.Context, id entities.ApplicationStandardID) ([]SigningLimitDB, error) {
+	var signingLimitDBItems []SigningLimitDB
+	db := SigningLimitDB{}
+	db.ID = id.ID
+	db.ApplicationID = id.ApplicationID
+
+	err := repository.genericStorage.QueryAll(ctx, getSigningLimitMapperID, db, &signingLimitDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingLimitDBItems, nil
+}
+
+func (repository *SigningLimitRepositoryInfra) Edit(ctx context.Context, db SigningLimitUpdateDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	_, err := repository.Exists(ctx, db.ApplicationStandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	db.NewResourceVersion = uuid.NewString()
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editSigningLimitMapperID, db)
+}
+
+func (repository *SigningLimitRepositoryInfra) Remove(ctx context.Context, id entities.ApplicationStandardID) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db := SigningLimitDB{}
+	db.ID = id.ID
+	db.ApplicationID = id.ApplicationID
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, removeSigningLimitMapperID, db)
+}
+
+func (repository *SigningLimitRepositoryInfra) List(ctx context.Context, filters SigningLimitDBFilter) ([]SigningLimitDB, error) {
+	signingLimitDBItems := make([]SigningLimitDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, listSigningLimitsMapperID, &filters, &signingLimitDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingLimitDBItems, nil
+}
+
+func (repository *SigningLimitRepositoryInfra) Exists(ctx context.Context, id entities.ApplicationStandardID) ([]SigningLimitExistsDB, error) {
+	var existsResult []SigningLimitExistsDB
+
+	db := SigningLimitDB{}
+	db.ApplicationStandardID = id
+
+	err := repository.genericStorage.QueryAll(ctx, existsSigningLimitMapperID, db, &existsResult)
+	if err != nil {
+		return existsResult, err
+	}
+
+	if len(existsResult) == 0 || !existsResult[0].Exists {
+		return nil, persistence.NewNotFoundError()
+	}
+
+	return existsResult, nil
+}
+
+type SigningLimitRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type SigningLimitRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideSigningLimitRepositoryInfra(options SigningLimitRepositoryInfraOptions) (*SigningLimitRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &SigningLimitRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
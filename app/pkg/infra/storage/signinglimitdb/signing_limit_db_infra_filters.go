@@ -0,0 +1,45 @@
+package signinglimitdb
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+// SigningLimitDBFilter to filter lists of resources from the database
+type SigningLimitDBFilter struct {
+	// SigningLimitDB is the data struct of the resource in the database
+	SigningLimitDB
+	// Order is the order of the list based on an attribute
+	Order *persistence.Order `valid:"optional"`
+	// FilterGroup is a collection of filters
+	FilterGroup *persistence.FilterGroup `valid:"optional"`
+	// Pagination is the page info of the list
+	Pagination *persistence.Pagination `valid:"optional"`
+}
+
+// AppendFilter Append filter.
+func (filter *SigningLimitDBFilter) AppendFilter(theFilter persistence.Filter) {
+	if filter.FilterGroup == nil {
+		filter.FilterGroup = &persistence.FilterGroup{
+			Filters: make([]persistence.Filter, 0),
+		}
+	}
+	filter.FilterGroup.Filters = append(filter.FilterGroup.Filters, theFilter)
+}
+
+// Paged creates a pagination filter.
+func (filter *SigningLimitDBFilter) Paged(limit, offset int) *SigningLimitDBFilter {
+	filter.Pagination = &persistence.Pagination{
+		Limit:  limit,
+		Offset: offset,
+	}
+	return filter
+}
+
+// Sort creates a sorting filter.
+func (filter *SigningLimitDBFilter) Sort(orderBy string, orderDirection persistence.OrderDirection) *SigningLimitDBFilter {
+	filter.Order = &persistence.Order{
+		By:        persistence.OrderByOption(orderBy),
+		Direction: orderDirection,
+	}
+	return filter
+}
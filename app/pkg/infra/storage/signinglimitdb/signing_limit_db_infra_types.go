@@ -0,0 +1,49 @@
+package signinglimitdb
+
+import "github.com/hyperledger-labs/signare/app/pkg/entities"
+
+// SigningLimitDB is the data struct of the resource in the database
+type SigningLimitDB struct {
+	// ApplicationStandardID is the ID of the resource
+	entities.ApplicationStandardID
+	// InternalResourceID is the ID used to reference a resource internally in the application
+	InternalResourceID string `storage:"internal_resource_id"`
+	// Scope defines whether the usage is counted by account or by user
+	Scope string `storage:"scope"`
+	// Subject is the address or the user the limit applies to. It is empty if it applies to all of them
+	Subject string `storage:"subject"`
+	// WindowSeconds is the duration in seconds of the rolling window
+	WindowSeconds int64 `storage:"window_seconds"`
+	// MaxSignatures is the maximum amount of signatures in the window. It is nil if signatures are not limited
+	MaxSignatures *int64 `storage:"max_signatures"`
+	// MaxValue is the maximum amount of wei in the window, in decimal format. It is empty if value is not limited
+	MaxValue string `storage:"max_value"`
+	// Description of the resource
+	Description string `storage:"description"`
+	// CreationDate is the timestamp of the moment of the creation of the resource
+	CreationDate int64 `storage:"creation_date"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+	// ResourceVersion is the identifier of the current version of the resource
+	ResourceVersion string `storage:"resource_version"`
+}
+
+// SigningLimitCreateDB is the data struct of the creation of a resource in the database
+type SigningLimitCreateDB struct {
+	// SigningLimitDB is the data struct of the resource in the database
+	SigningLimitDB
+}
+
+// SigningLimitUpdateDB is the data struct of the update of a resource in the database
+type SigningLimitUpdateDB struct {
+	// SigningLimitDB is the data struct of the resource in the database
+	SigningLimitDB
+	// NewResourceVersion is the new resource version after the edition
+	NewResourceVersion string `storage:"new_resource_version"`
+}
+
+// SigningLimitExistsDB is the data struct to check if a resource exists in the database
+type SigningLimitExistsDB struct {
+	// Exists is true if the resource exists
+	Exists bool `storage:"exists_result" valid:"required"`
+}
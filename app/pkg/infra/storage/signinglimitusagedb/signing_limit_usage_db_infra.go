@@ -0,0 +1,103 @@
+package signinglimitusagedb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+
+	"github.com/google/uuid"
+)
+
+const (
+	addSigningLimitUsageMapperID          = "signare.signingLimitUsage.insert"
+	getSigningLimitUsageMapperID          = "signare.signingLimitUsage.getById"
+	editSigningLimitUsageMapperID         = "signare.signingLimitUsage.update"
+	removeSigningLimitUsagesMapperID      = "signare.signingLimitUsage.deleteByLimit"
+	listSigningLimitUsagesByLimitMapperID = "signare.signingLimitUsage.listByLimit"
+)
+
+func (repository *SigningLimitUsageRepositoryInfra) Add(ctx context.Context, db SigningLimitUsageDB) (*SigningLimitUsageDB, error) {
+	db.ResourceVersion = uuid.NewString()
+	err := repository.genericStorage.ExecuteStmt(ctx, addSigningLimitUsageMapperID, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.Get(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, persistence.NewEntryNotAddedError()
+	}
+
+	// the insertion is ignored if the usage was added concurrently, so that the error doesn't abort the transaction
+	if result[0].ResourceVersion != db.ResourceVersion {
+		return nil, persistence.NewAlreadyExistsError()
+	}
+
+	return &result[0], nil
+}
+
+func (repository *SigningLimitUsageRepositoryInfra) Get(ctx context.Context, id SigningLimitUsageDB) ([]SigningLimitUsageDB, error) {
+	var signingLimitUsageDBItems []SigningLimitUsageDB
+	db := SigningLimitUsageDB{
+		ApplicationID: id.ApplicationID,
+		LimitID:       id.LimitID,
+		Subject:       id.Subject,
+	}
+
+	err := repository.genericStorage.QueryAll(ctx, getSigningLimitUsageMapperID, db, &signingLimitUsageDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingLimitUsageDBItems, nil
+}
+
+func (repository *SigningLimitUsageRepositoryInfra) Edit(ctx context.Context, db SigningLimitUsageUpdateDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db.NewResourceVersion = uuid.NewString()
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editSigningLimitUsageMapperID, db)
+}
+
+func (repository *SigningLimitUsageRepositoryInfra) RemoveByLimit(ctx context.Context, applicationID string, limitID string) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db := SigningLimitUsageDB{
+		ApplicationID: applicationID,
+		LimitID:       limitID,
+	}
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, removeSigningLimitUsagesMapperID, db)
+}
+
+func (repository *SigningLimitUsageRepositoryInfra) ListByLimit(ctx context.Context, applicationID string, limitID string) ([]SigningLimitUsageDB, error) {
+	signingLimitUsageDBItems := make([]SigningLimitUsageDB, 0)
+	db := SigningLimitUsageDB{
+		ApplicationID: applicationID,
+		LimitID:       limitID,
+	}
+
+	err := repository.genericStorage.QueryAll(ctx, listSigningLimitUsagesByLimitMapperID, db, &signingLimitUsageDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingLimitUsageDBItems, nil
+}
+
+type SigningLimitUsageRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type SigningLimitUsageRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideSigningLimitUsageRepositoryInfra(options SigningLimitUsageRepositoryInfraOptions) (*SigningLimitUsageRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &SigningLimitUsageRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package signinglimitusagedb
+
+// SigningLimitUsageDB is the data struct of the resource in the database
+type SigningLimitUsageDB struct {
+	// ApplicationID is the ID of the application of the limit
+	ApplicationID string `storage:"application_id"`
+	// LimitID is the ID of the limit
+	LimitID string `storage:"limit_id"`
+	// Subject is the address or the user the usage is counted for
+	Subject string `storage:"subject"`
+	// Buckets are the JSON encoded usage grouped by periods of time
+	Buckets string `storage:"buckets"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+	// ResourceVersion is the identifier of the current version of the resource
+	ResourceVersion string `storage:"resource_version"`
+}
+
+// SigningLimitUsageUpdateDB is the data struct of the update of a resource in the database
+type SigningLimitUsageUpdateDB struct {
+	// SigningLimitUsageDB is the data struct of the resource in the database
+	SigningLimitUsageDB
+	// NewResourceVersion is the new resource version after the edition
+	NewResourceVersion string `storage:"new_resource_version"`
+}
@@ -0,0 +1,115 @@
+package signingpolicydb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+
+	"github.com/google/uuid"
+)
+
+const (
+	addSigningPolicyMapperID    = "signare.signingPolicy.insert"
+	getSigningPolicyMapperID    = "signare.signingPolicy.getById"
+	editSigningPolicyMapperID   = "signare.signingPolicy.update"
+	removeSigningPolicyMapperID = "signare.signingPolicy.delete"
+	listSigningPoliciesMapperID = "signare.signingPolicy.list"
+	existsSigningPolicyMapperID = "signare.signingPolicy.exists"
+)
+
+func (repository *SigningPolicyRepositoryInfra) Add(ctx context.Context, db SigningPolicyCreateDB) (*SigningPolicyDB, error) {
+	db.ResourceVersion = uuid.NewString()
+	err := repository.genericStorage.ExecuteStmt(ctx, addSigningPolicyMapperID, db)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := repository.Get(ctx, db.ApplicationStandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, persistence.NewEntryNotAddedError()
+	}
+
+	return &result[0], nil
+}
+
+func (repository *SigningPolicyRepositoryInfra) Get(ctx context.Context, id entities.ApplicationStandardID) ([]SigningPolicyDB, error) {
+	var signingPolicyDBItems []SigningPolicyDB
+	db := SigningPolicyDB{}
+	db.ID = id.ID
+	db.ApplicationID = id.ApplicationID
+
+	err := repository.genericStorage.QueryAll(ctx, getSigningPolicyMapperID, db, &signingPolicyDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingPolicyDBItems, nil
+}
+
+func (repository *SigningPolicyRepositoryInfra) Edit(ctx context.Context, db SigningPolicyUpdateDB) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	_, err := repository.Exists(ctx, db.ApplicationStandardID)
+	if err != nil {
+		return nil, err
+	}
+
+	db.NewResourceVersion = uuid.NewString()
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, editSigningPolicyMapperID, db)
+}
+
+func (repository *SigningPolicyRepositoryInfra) Remove(ctx context.Context, id entities.ApplicationStandardID) (*persistence.ExecuteStmtWithStorageResultOutput, error) {
+	db := SigningPolicyDB{}
+	db.ID = id.ID
+	db.ApplicationID = id.ApplicationID
+
+	return repository.genericStorage.ExecuteStmtWithStorageResult(ctx, removeSigningPolicyMapperID, db)
+}
+
+func (repository *SigningPolicyRepositoryInfra) List(ctx context.Context, filters SigningPolicyDBFilter) ([]SigningPolicyDB, error) {
+	signingPolicyDBItems := make([]SigningPolicyDB, 0)
+	err := repository.genericStorage.QueryAll(ctx, listSigningPoliciesMapperID, &filters, &signingPolicyDBItems)
+	if err != nil {
+		return nil, err
+	}
+	return signingPolicyDBItems, nil
+}
+
+func (repository *SigningPolicyRepositoryInfra) Exists(ctx context.Context, id entities.ApplicationStandardID) ([]SigningPolicyExistsDB, error) {
+	var existsResult []SigningPolicyExistsDB
+
+	db := SigningPolicyDB{}
+	db.ApplicationStandardID = id
+
+	err := repository.genericStorage.QueryAll(ctx, existsSigningPolicyMapperID, db, &existsResult)
+	if err != nil {
+		return existsResult, err
+	}
+
+	if len(existsResult) == 0 || !existsResult[0].Exists {
+		return nil, persistence.NewNotFoundError()
+	}
+
+	return existsResult, nil
+}
+
+type SigningPolicyRepositoryInfraOptions struct {
+	GenericStorage persistence.Storage
+}
+
+type SigningPolicyRepositoryInfra struct {
+	genericStorage persistence.Storage
+}
+
+func ProvideSigningPolicyRepositoryInfra(options SigningPolicyRepositoryInfraOptions) (*SigningPolicyRepositoryInfra, error) {
+	if options.GenericStorage == nil {
+		return nil, fmt.Errorf("mandatory 'GenericStorage' not provided")
+	}
+	return &SigningPolicyRepositoryInfra{
+		genericStorage: options.GenericStorage,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package signingpolicydb
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+// SigningPolicyDBFilter to filter lists of resources from the database
+type SigningPolicyDBFilter struct {
+	// SigningPolicyDB is the data struct of the resource in the database
+	SigningPolicyDB
+	// Order is the order of the list based on an attribute
+	Order *persistence.Order `valid:"optional"`
+	// FilterGroup is a collection of filters
+	FilterGroup *persistence.FilterGroup `valid:"optional"`
+	// Pagination is the page info of the list
+	Pagination *persistence.Pagination `valid:"optional"`
+}
+
+// AppendFilter Append filter.
+func (filter *SigningPolicyDBFilter) AppendFilter(theFilter persistence.Filter) {
+	if filter.FilterGroup == nil {
+		filter.FilterGroup = &persistence.FilterGroup{
+			Filters: make([]persistence.Filter, 0),
+		}
+	}
+	filter.FilterGroup.Filters = append(filter.FilterGroup.Filters, theFilter)
+}
+
+// Paged creates a pagination filter.
+func (filter *SigningPolicyDBFilter) Paged(limit, offset int) *SigningPolicyDBFilter {
+	filter.Pagination = &persistence.Pagination{
+		Limit:  limit,
+		Offset: offset,
+	}
+	return filter
+}
+
+// Sort creates a sorting filter.
+func (filter *SigningPolicyDBFilter) Sort(orderBy string, orderDirection persistence.OrderDirection) *SigningPolicyDBFilter {
+	filter.Order = &persistence.Order{
+		By:        persistence.OrderByOption(orderBy),
+		Direction: orderDirection,
+	}
+	return filter
+}
@@ -0,0 +1,43 @@
+package signingpolicydb
+
+import "github.com/hyperledger-labs/signare/app/pkg/entities"
+
+// SigningPolicyDB is the data struct of the resource in the database
+type SigningPolicyDB struct {
+	// ApplicationStandardID is the ID of the resource
+	entities.ApplicationStandardID
+	// InternalResourceID is the ID used to reference a resource internally in the application
+	InternalResourceID string `storage:"internal_resource_id"`
+	// Address of the account the policy applies to. It is empty if it applies to all the accounts of the application
+	Address string `storage:"address"`
+	// Rules are the JSON encoded restrictions of the policy
+	Rules string `storage:"rules"`
+	// Description of the resource
+	Description string `storage:"description"`
+	// CreationDate is the timestamp of the moment of the creation of the resource
+	CreationDate int64 `storage:"creation_date"`
+	// LastUpdate is the timestamp of the moment of the last edition of the resource
+	LastUpdate int64 `storage:"last_update"`
+	// ResourceVersion is the identifier of the current version of the resource
+	ResourceVersion string `storage:"resource_version"`
+}
+
+// SigningPolicyCreateDB is the data struct of the creation of a resource in the database
+type SigningPolicyCreateDB struct {
+	// SigningPolicyDB is the data struct of the resource in the database
+	SigningPolicyDB
+}
+
+// SigningPolicyUpdateDB is the data struct of the update of a resource in the database
+type SigningPolicyUpdateDB struct {
+	// SigningPolicyDB is the data struct of the resource in the database
+	SigningPolicyDB
+	// NewResourceVersion is the new resource version after the edition
+	NewResourceVersion string `storage:"new_resource_version"`
+}
+
+// SigningPolicyExistsDB is the data struct to check if a resource exists in the database
+type SigningPolicyExistsDB struct {
+	// Exists is true if the resource exists
+	Exists bool `storage:"exists_result" valid:"required"`
+}
@@ -0,0 +1,403 @@
+// Package cloudkms adds support for cloud Key Management Services exposing the AWS KMS REST API by satisfying the DigitalSignatureManager interface.
+package cloudkms
+
+import (
+	"context"
+	"encoding/asn1"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	curves "github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+)
+
+const (
+	standard = "CloudKMS"
+	// aliasPrefix prefixes the aliases of the keys managed by the signare. Keys are grouped by slot as 'alias/signare/<slot>/<address>'.
+	aliasPrefix = "alias/signare/"
+	// listAliasesPageSize is the maximum amount of aliases requested per page.
+	listAliasesPageSize = 100
+	// signatureComponentLength is the length of each of the R and S components of a secp256k1 signature.
+	signatureComponentLength = 32
+)
+
+// slotRegexp restricts slots to the characters allowed in KMS alias names.
+var slotRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// CloudKMSSignatureManager implements the DigitalSignatureManager interface.
+type CloudKMSSignatureManager struct {
+	client *kmsClient
+	// operationTimeout maximum time an operation can take. Operations are only bound by their context if it is 0.
+	operationTimeout time.Duration
+}
+
+// CloudKMSSignatureManagerOptions defines options to create a new instance of CloudKMSSignatureManager.
+type CloudKMSSignatureManagerOptions struct {
+	// Endpoint URL of the KMS REST API, e.g. 'https://kms.eu-west-1.amazonaws.com' or the URL of a local KMS emulator.
+	Endpoint string
+	// Region where the keys are managed.
+	Region string
+	// AccessKeyID to sign the requests. Requests are not signed if it is empty.
+	AccessKeyID string
+	// SecretAccessKey to sign the requests.
+	SecretAccessKey string
+	// SessionToken to sign the requests with temporary credentials.
+	SessionToken string
+	// HTTPClient to send the requests. The http.DefaultClient is used if it is nil.
+	HTTPClient *http.Client
+	// OperationTimeout maximum time an operation can take before it is abandoned. Operations are only bound by their context if it is 0.
+	OperationTimeout time.Duration
+}
+
+var _ signaturemanager.DigitalSignatureManager = (*CloudKMSSignatureManager)(nil)
+
+// ProvideCloudKMSSignatureManager creates a new instance of CloudKMSSignatureManager using the provided options, returning an error if it fails.
+func ProvideCloudKMSSignatureManager(options CloudKMSSignatureManagerOptions) (*CloudKMSSignatureManager, error) {
+	endpoint, err := url.Parse(options.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, signaturemanager.NewInvalidArgumentError().WithMessage(fmt.Sprintf("invalid KMS endpoint '%s'", options.Endpoint))
+	}
+	if len(options.Region) == 0 {
+		return nil, signaturemanager.NewInvalidArgumentError().WithMessage("mandatory KMS region was not provided")
+	}
+
+	var credentials *kmsCredentials
+	if len(options.AccessKeyID) > 0 {
+		if len(options.SecretAccessKey) == 0 {
+			return nil, signaturemanager.NewInvalidArgumentError().WithMessage("KMS secret access key is mandatory when an access key ID is provided")
+		}
+		credentials = &kmsCredentials{
+			accessKeyID:     options.AccessKeyID,
+			secretAccessKey: options.SecretAccessKey,
+			sessionToken:    options.SessionToken,
+		}
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &CloudKMSSignatureManager{
+		client: &kmsClient{
+			endpoint:    endpoint.String(),
+			region:      options.Region,
+			credentials: credentials,
+			httpClient:  httpClient,
+		},
+		operationTimeout: options.OperationTimeout,
+	}, nil
+}
+
+func (s *CloudKMSSignatureManager) GenerateKey(ctx context.Context, input signaturemanager.GenerateKeyInput) (*signaturemanager.GenerateKeyOutput, error) {
+	tracer := input.Tracer
+	err := validateSlot(input.Slot)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("standard", standard)
+
+	ctx, cancel := s.withOperationTimeout(ctx)
+	defer cancel()
+
+	tracer.Debug("generating key pair")
+	var createKeyResp createKeyResponse
+	err = s.client.do(ctx, "CreateKey", createKeyRequest{
+		KeySpec:     keySpecSecp256k1,
+		KeyUsage:    keyUsageSignVerify,
+		Description: fmt.Sprintf("signare key of slot '%s'", input.Slot),
+	}, &createKeyResp)
+	if signaturemanager.IsTimeoutError(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, signaturemanager.NewKeyGenerationError().WithMessage(fmt.Sprintf("error generating key: %v", err))
+	}
+	keyID := createKeyResp.KeyMetadata.KeyID
+
+	tracer.Debug("getting address from public key")
+	addr, err := s.getAddress(ctx, keyID)
+	if err != nil {
+		s.scheduleKeyDeletion(ctx, input, keyID)
+		return nil, err
+	}
+
+	tracer.Debugf("setting alias for address '%s'", addr.String())
+	err = s.client.do(ctx, "CreateAlias", createAliasRequest{
+		AliasName:   calculateAlias(input.Slot, *addr),
+		TargetKeyID: keyID,
+	}, nil)
+	if err != nil {
+		s.scheduleKeyDeletion(ctx, input, keyID)
+		if signaturemanager.IsTimeoutError(err) {
+			return nil, err
+		}
+		return nil, signaturemanager.NewKeyGenerationError().WithMessage(fmt.Sprintf("error setting the alias of the key for address '%s': %v", addr.String(), err))
+	}
+
+	return &signaturemanager.GenerateKeyOutput{
+		Address: *addr,
+	}, nil
+}
+
+func (s *CloudKMSSignatureManager) RemoveKey(ctx context.Context, input signaturemanager.RemoveKeyInput) (*signaturemanager.RemoveKeyOutput, error) {
+	tracer := input.Tracer
+	tracer.AddProperty("address", input.Address.String())
+	err := validateSlot(input.Slot)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("standard", standard)
+
+	ctx, cancel := s.withOperationTimeout(ctx)
+	defer cancel()
+
+	alias := calculateAlias(input.Slot, input.Address)
+	var describeKeyResp describeKeyResponse
+	err = s.client.do(ctx, "DescribeKey", describeKeyRequest{
+		KeyID: alias,
+	}, &describeKeyResp)
+	if err != nil {
+		if signaturemanager.IsNotFoundError(err) {
+			return nil, signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("key pair not found for address '%s'", input.Address))
+		}
+		return nil, err
+	}
+
+	tracer.Debug("removing key alias")
+	err = s.client.do(ctx, "DeleteAlias", deleteAliasRequest{
+		AliasName: alias,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Debug("scheduling key deletion")
+	err = s.client.do(ctx, "ScheduleKeyDeletion", scheduleKeyDeletionRequest{
+		KeyID:               describeKeyResp.KeyMetadata.KeyID,
+		PendingWindowInDays: minPendingWindowInDays,
+	}, nil)
+	if err != nil {
+		tracer.Warn(fmt.Sprintf("failed to schedule the deletion of key '%s'. Error: %v", describeKeyResp.KeyMetadata.KeyID, err))
+	}
+	return &signaturemanager.RemoveKeyOutput{}, nil
+}
+
+func (s *CloudKMSSignatureManager) ListKeys(ctx context.Context, input signaturemanager.ListKeysInput) (*signaturemanager.ListKeysOutput, error) {
+	tracer := input.Tracer
+	err := validateSlot(input.Slot)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("standard", standard)
+
+	ctx, cancel := s.withOperationTimeout(ctx)
+	defer cancel()
+
+	slotAliasPrefix := calculateSlotAliasPrefix(input.Slot)
+	aliases := make([]aliasListEntry, 0)
+	marker := ""
+	for {
+		var listAliasesResp listAliasesResponse
+		err = s.client.do(ctx, "ListAliases", listAliasesRequest{
+			Limit:  listAliasesPageSize,
+			Marker: marker,
+		}, &listAliasesResp)
+		if err != nil {
+			return nil, err
+		}
+		for _, alias := range listAliasesResp.Aliases {
+			if strings.HasPrefix(alias.AliasName, slotAliasPrefix) {
+				aliases = append(aliases, alias)
+			}
+		}
+		if !listAliasesResp.Truncated || len(listAliasesResp.NextMarker) == 0 {
+			break
+		}
+		marker = listAliasesResp.NextMarker
+	}
+
+	// sort by creation date, so that keys are listed in the order they were generated
+	sort.SliceStable(aliases, func(i, j int) bool {
+		return aliases[i].CreationDate < aliases[j].CreationDate
+	})
+	result := make([]address.Address, 0, len(aliases))
+	for _, alias := range aliases {
+		addr, addrErr := address.NewFromHexString(strings.TrimPrefix(alias.AliasName, slotAliasPrefix))
+		if addrErr != nil {
+			tracer.Warn(fmt.Sprintf("ignoring alias '%s' not matching an address", alias.AliasName))
+			continue
+		}
+		result = append(result, addr)
+	}
+	return &signaturemanager.ListKeysOutput{
+		Items: result,
+	}, nil
+}
+
+func (s *CloudKMSSignatureManager) Sign(ctx context.Context, input signaturemanager.SignInput) (*signaturemanager.SignOutput, error) {
+	tracer := input.Tracer
+	err := validateSlot(input.Slot)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("address", input.From.String())
+	tracer.AddProperty("standard", standard)
+
+	ctx, cancel := s.withOperationTimeout(ctx)
+	defer cancel()
+	tracer.Debug("signing transaction")
+
+	var signResp signResponse
+	err = s.client.do(ctx, "Sign", signRequest{
+		KeyID:            calculateAlias(input.Slot, input.From),
+		Message:          input.Data[:],
+		MessageType:      messageTypeDigest,
+		SigningAlgorithm: signingAlgorithmECDSASHA256,
+	}, &signResp)
+	if err != nil {
+		if signaturemanager.IsNotFoundError(err) {
+			return nil, signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("private key not found for address '%s'. Error: %v", input.From.String(), err))
+		}
+		return nil, err
+	}
+
+	sig, err := decodeSignature(signResp.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &signaturemanager.SignOutput{
+		Signature: sig,
+	}, nil
+}
+
+// Close does nothing, as requests to KMS are stateless.
+func (s *CloudKMSSignatureManager) Close(_ context.Context, _ signaturemanager.CloseInput) (*signaturemanager.CloseOutput, error) {
+	return &signaturemanager.CloseOutput{}, nil
+}
+
+// Open does nothing, as requests to KMS are stateless.
+func (s *CloudKMSSignatureManager) Open(_ context.Context, _ signaturemanager.OpenInput) (*signaturemanager.OpenOutput, error) {
+	return &signaturemanager.OpenOutput{}, nil
+}
+
+func (s *CloudKMSSignatureManager) IsAlive(ctx context.Context, input signaturemanager.IsAliveInput) (*signaturemanager.IsAliveOutput, error) {
+	tracer := input.Tracer
+	err := validateSlot(input.Slot)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("standard", standard)
+
+	ctx, cancel := s.withOperationTimeout(ctx)
+	defer cancel()
+
+	err = s.client.do(ctx, "ListAliases", listAliasesRequest{
+		Limit: 1,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signaturemanager.IsAliveOutput{
+		IsAlive: true,
+	}, nil
+}
+
+// getAddress returns the address of the public key of the given KMS key.
+func (s *CloudKMSSignatureManager) getAddress(ctx context.Context, keyID string) (*address.Address, error) {
+	var getPublicKeyResp getPublicKeyResponse
+	err := s.client.do(ctx, "GetPublicKey", getPublicKeyRequest{
+		KeyID: keyID,
+	}, &getPublicKeyResp)
+	if signaturemanager.IsTimeoutError(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, signaturemanager.NewKeyGenerationError().WithMessage(fmt.Sprintf("error getting public key: %v", err))
+	}
+
+	var publicKeyInfo subjectPublicKeyInfo
+	_, err = asn1.Unmarshal(getPublicKeyResp.PublicKey, &publicKeyInfo)
+	if err != nil {
+		return nil, signaturemanager.NewKeyGenerationError().WithMessage(fmt.Sprintf("unable to decode public key. Error: %v", err))
+	}
+
+	pubKey, err := curves.ParsePubKey(publicKeyInfo.SubjectPublicKey.Bytes)
+	if err != nil {
+		return nil, signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("unable to parse public key. Error: %v", err))
+	}
+
+	derivedAddr, err := signaturemanager.DeriveAddressFromPublicKey(pubKey.SerializeUncompressed())
+	if err != nil {
+		return nil, err
+	}
+	return derivedAddr, nil
+}
+
+// scheduleKeyDeletion deletes a key that could not be completely generated. The deletion is requested even if the
+// generation was abandoned, so that the key is not left behind.
+func (s *CloudKMSSignatureManager) scheduleKeyDeletion(ctx context.Context, input signaturemanager.GenerateKeyInput, keyID string) {
+	ctx, cancel := s.withOperationTimeout(context.WithoutCancel(ctx))
+	defer cancel()
+	err := s.client.do(ctx, "ScheduleKeyDeletion", scheduleKeyDeletionRequest{
+		KeyID:               keyID,
+		PendingWindowInDays: minPendingWindowInDays,
+	}, nil)
+	if err != nil {
+		input.Tracer.Warn(fmt.Sprintf("failed to schedule the deletion of key '%s'. Error: %v", keyID, err))
+	}
+}
+
+// decodeSignature converts a DER encoded ECDSA signature into the concatenation of its R and S components.
+func decodeSignature(derSignature []byte) ([]byte, error) {
+	var sig ecdsaSignature
+	_, err := asn1.Unmarshal(derSignature, &sig)
+	if err != nil {
+		return nil, signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("unable to decode signature. Error: %v", err))
+	}
+	if sig.R == nil || sig.S == nil || sig.R.Sign() <= 0 || sig.S.Sign() <= 0 ||
+		len(sig.R.Bytes()) > signatureComponentLength || len(sig.S.Bytes()) > signatureComponentLength {
+		return nil, signaturemanager.NewInternalError().WithMessage("invalid signature returned by KMS")
+	}
+
+	result := make([]byte, 2*signatureComponentLength)
+	sig.R.FillBytes(result[:signatureComponentLength])
+	sig.S.FillBytes(result[signatureComponentLength:])
+	return result, nil
+}
+
+func validateSlot(slot string) error {
+	if !slotRegexp.MatchString(slot) {
+		return signaturemanager.NewInvalidSlotError().WithMessage(fmt.Sprintf("invalid slot: '%s'", slot))
+	}
+	return nil
+}
+
+func calculateSlotAliasPrefix(slot string) string {
+	return aliasPrefix + slot + "/"
+}
+
+func calculateAlias(slot string, addr address.Address) string {
+	return calculateSlotAliasPrefix(slot) + strings.ToLower(addr.String())
+}
+
+// withOperationTimeout bounds the context of an operation by the operation timeout, if any.
+func (s *CloudKMSSignatureManager) withOperationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.operationTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.operationTimeout)
+}
@@ -0,0 +1,145 @@
+package cloudkms
+
+import (
+	"encoding/asn1"
+	"math/big"
+)
+
+const (
+	// keySpecSecp256k1 is the KMS key spec of secp256k1 key pairs.
+	keySpecSecp256k1 = "ECC_SECG_P256K1"
+	// keyUsageSignVerify is the KMS key usage of key pairs used to sign and verify.
+	keyUsageSignVerify = "SIGN_VERIFY"
+	// messageTypeDigest tells KMS that the message to sign has already been hashed.
+	messageTypeDigest = "DIGEST"
+	// signingAlgorithmECDSASHA256 is the only signing algorithm that KMS allows for secp256k1 keys. As the message is a digest, it is not hashed again.
+	signingAlgorithmECDSASHA256 = "ECDSA_SHA_256"
+	// minPendingWindowInDays is the minimum waiting period before KMS deletes a key scheduled for deletion.
+	minPendingWindowInDays = 7
+)
+
+// KMS error types returned in the '__type' attribute of the error responses.
+const (
+	notFoundException         = "NotFoundException"
+	validationException       = "ValidationException"
+	invalidKeyUsageException  = "InvalidKeyUsageException"
+	invalidAliasNameException = "InvalidAliasNameException"
+	invalidArnException       = "InvalidArnException"
+)
+
+// createKeyRequest is the request of the CreateKey operation.
+type createKeyRequest struct {
+	KeySpec     string `json:"KeySpec"`
+	KeyUsage    string `json:"KeyUsage"`
+	Description string `json:"Description,omitempty"`
+}
+
+// createKeyResponse is the response of the CreateKey operation.
+type createKeyResponse struct {
+	KeyMetadata keyMetadata `json:"KeyMetadata"`
+}
+
+// keyMetadata is the metadata of a KMS key.
+type keyMetadata struct {
+	KeyID string `json:"KeyId"`
+}
+
+// getPublicKeyRequest is the request of the GetPublicKey operation.
+type getPublicKeyRequest struct {
+	KeyID string `json:"KeyId"`
+}
+
+// getPublicKeyResponse is the response of the GetPublicKey operation.
+type getPublicKeyResponse struct {
+	KeyID string `json:"KeyId"`
+	// PublicKey DER encoded SubjectPublicKeyInfo as defined in RFC 5280.
+	PublicKey []byte `json:"PublicKey"`
+}
+
+// createAliasRequest is the request of the CreateAlias operation.
+type createAliasRequest struct {
+	AliasName   string `json:"AliasName"`
+	TargetKeyID string `json:"TargetKeyId"`
+}
+
+// deleteAliasRequest is the request of the DeleteAlias operation.
+type deleteAliasRequest struct {
+	AliasName string `json:"AliasName"`
+}
+
+// describeKeyRequest is the request of the DescribeKey operation.
+type describeKeyRequest struct {
+	KeyID string `json:"KeyId"`
+}
+
+// describeKeyResponse is the response of the DescribeKey operation.
+type describeKeyResponse struct {
+	KeyMetadata keyMetadata `json:"KeyMetadata"`
+}
+
+// scheduleKeyDeletionRequest is the request of the ScheduleKeyDeletion operation.
+type scheduleKeyDeletionRequest struct {
+	KeyID               string `json:"KeyId"`
+	PendingWindowInDays int    `json:"PendingWindowInDays"`
+}
+
+// listAliasesRequest is the request of the ListAliases operation.
+type listAliasesRequest struct {
+	Limit  int    `json:"Limit,omitempty"`
+	Marker string `json:"Marker,omitempty"`
+}
+
+// listAliasesResponse is the response of the ListAliases operation.
+type listAliasesResponse struct {
+	Aliases    []aliasListEntry `json:"Aliases"`
+	NextMarker string           `json:"NextMarker,omitempty"`
+	Truncated  bool             `json:"Truncated"`
+}
+
+// aliasListEntry is an alias returned by the ListAliases operation.
+type aliasListEntry struct {
+	AliasName    string  `json:"AliasName"`
+	TargetKeyID  string  `json:"TargetKeyId"`
+	CreationDate float64 `json:"CreationDate"`
+}
+
+// signRequest is the request of the Sign operation.
+type signRequest struct {
+	KeyID            string `json:"KeyId"`
+	Message          []byte `json:"Message"`
+	MessageType      string `json:"MessageType"`
+	SigningAlgorithm string `json:"SigningAlgorithm"`
+}
+
+// signResponse is the response of the Sign operation.
+type signResponse struct {
+	KeyID string `json:"KeyId"`
+	// Signature DER encoded ECDSA signature as defined in ANSI X9.62.
+	Signature []byte `json:"Signature"`
+}
+
+// errorResponse is the body of the KMS error responses.
+type errorResponse struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+	// MessageUpper is used by some KMS implementations instead of message.
+	MessageUpper string `json:"Message"`
+}
+
+// subjectPublicKeyInfo is the ASN.1 structure of a DER encoded public key.
+type subjectPublicKeyInfo struct {
+	Algorithm        algorithmIdentifier
+	SubjectPublicKey asn1.BitString
+}
+
+// algorithmIdentifier is the ASN.1 structure that identifies the algorithm of a public key.
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+// ecdsaSignature is the ASN.1 structure of a DER encoded ECDSA signature.
+type ecdsaSignature struct {
+	R *big.Int
+	S *big.Int
+}
@@ -0,0 +1,183 @@
+package cloudkms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+)
+
+const (
+	// targetPrefix prefixes the operation name in the X-Amz-Target header of the KMS JSON protocol.
+	targetPrefix = "TrentService."
+	// contentType of the KMS JSON protocol.
+	contentType = "application/x-amz-json-1.1"
+	// signingService is the service name used to sign requests.
+	signingService = "kms"
+	// signingAlgorithm is the algorithm used to sign requests.
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	// amzDateFormat is the format of the X-Amz-Date header.
+	amzDateFormat = "20060102T150405Z"
+	// maxErrorBodySize limits how much of an error response body is read.
+	maxErrorBodySize = 1 << 16
+)
+
+// kmsCredentials are the credentials used to sign the requests sent to KMS.
+type kmsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// kmsClient sends requests to a KMS REST API using the JSON protocol.
+type kmsClient struct {
+	endpoint    string
+	region      string
+	credentials *kmsCredentials
+	httpClient  *http.Client
+}
+
+// do sends the given operation to KMS and decodes its response into the output, unless it is nil.
+func (c *kmsClient) do(ctx context.Context, operation string, input, output any) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("error encoding KMS '%s' request: %v", operation, err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("error creating KMS '%s' request: %v", operation, err))
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Target", targetPrefix+operation)
+	if c.credentials != nil {
+		c.sign(req, body)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return signaturemanager.NewTimeoutError().WithMessage(fmt.Sprintf("KMS '%s' request was abandoned: %v", operation, ctx.Err()))
+		}
+		return signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("error sending KMS '%s' request: %v", operation, err))
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return toSignatureManagerErr(operation, resp)
+	}
+
+	if output == nil {
+		return nil
+	}
+	err = json.NewDecoder(resp.Body).Decode(output)
+	if err != nil {
+		return signaturemanager.NewInternalError().WithMessage(fmt.Sprintf("error decoding KMS '%s' response: %v", operation, err))
+	}
+	return nil
+}
+
+// sign adds the AWS Signature Version 4 headers to the request.
+func (c *kmsClient) sign(req *http.Request, body []byte) {
+	now := time.Now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if c.credentials.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.credentials.sessionToken)
+	}
+
+	signedHeaderNames := []string{"content-type", "host", "x-amz-date", "x-amz-target"}
+	if c.credentials.sessionToken != "" {
+		signedHeaderNames = append(signedHeaderNames, "x-amz-security-token")
+	}
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaderNames {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(signedHeaderNames, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	credentialScope := strings.Join([]string{date, c.region, signingService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		credentialScope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.credentials.secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, signingService)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, c.credentials.accessKeyID, credentialScope, signedHeaders, signature))
+}
+
+// toSignatureManagerErr translates a KMS error response into a signature manager error.
+func toSignatureManagerErr(operation string, resp *http.Response) error {
+	var errResp errorResponse
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	_ = json.Unmarshal(body, &errResp)
+
+	message := errResp.Message
+	if message == "" {
+		message = errResp.MessageUpper
+	}
+	// the error type may be prefixed with a namespace, e.g. 'com.amazonaws.kms#NotFoundException'
+	errType := errResp.Type
+	if i := strings.LastIndex(errType, "#"); i >= 0 {
+		errType = errType[i+1:]
+	}
+	errMsg := fmt.Sprintf("KMS '%s' request failed with status '%d' and error '%s': %s", operation, resp.StatusCode, errType, message)
+
+	switch errType {
+	case notFoundException:
+		return signaturemanager.NewNotFoundError().WithMessage(errMsg)
+	case validationException, invalidKeyUsageException, invalidAliasNameException, invalidArnException:
+		return signaturemanager.NewInvalidArgumentError().WithMessage(errMsg)
+	default:
+		return signaturemanager.NewInternalError().WithMessage(errMsg)
+	}
+}
+
+func hexSHA256(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
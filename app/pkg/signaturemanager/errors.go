@@ -20,6 +20,8 @@ var (
 	errInternal            = errors.New("internal error")
 	errNotFound            = errors.New("not found")
 	errInvalidArgument     = errors.New("invalid argument")
+	errTimeout             = errors.New("operation timed out")
+	errDeviceUnavailable   = errors.New("device not available")
 )
 
 func (e *Error) Error() string {
@@ -82,6 +84,18 @@ func NewInvalidArgumentError() *Error {
 	}
 }
 
+func NewTimeoutError() *Error {
+	return &Error{
+		err: errTimeout,
+	}
+}
+
+func NewDeviceUnavailableError() *Error {
+	return &Error{
+		err: errDeviceUnavailable,
+	}
+}
+
 func IsLibFailedFailedError(err error) bool {
 	var pkcsErr *Error
 	if errors.As(err, &pkcsErr) {
@@ -145,3 +159,19 @@ func IsInvalidArgumentError(err error) bool {
 	}
 	return false
 }
+
+func IsTimeoutError(err error) bool {
+	var pkcsErr *Error
+	if errors.As(err, &pkcsErr) {
+		return errors.Is(pkcsErr.err, errTimeout)
+	}
+	return false
+}
+
+func IsDeviceUnavailableError(err error) bool {
+	var pkcsErr *Error
+	if errors.As(err, &pkcsErr) {
+		return errors.Is(pkcsErr.err, errDeviceUnavailable)
+	}
+	return false
+}
@@ -27,6 +27,14 @@ func TestError_IsError(t *testing.T) {
 
 	err = signaturemanager.NewInvalidArgumentError()
 	assert.True(t, signaturemanager.IsInvalidArgumentError(err))
+
+	err = signaturemanager.NewTimeoutError()
+	assert.True(t, signaturemanager.IsTimeoutError(err))
+	assert.False(t, signaturemanager.IsInternalError(err))
+
+	err = signaturemanager.NewDeviceUnavailableError()
+	assert.True(t, signaturemanager.IsDeviceUnavailableError(err))
+	assert.False(t, signaturemanager.IsTimeoutError(err))
 }
 
 func TestError_Description(t *testing.T) {
@@ -10,12 +10,13 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	curves "github.com/btcsuite/btcd/btcec/v2"
 	"github.com/miekg/pkcs11"
 
 	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
-	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
 	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
@@ -29,11 +30,22 @@ const (
 type PKCS11HSMSignatureManager struct {
 	pkcsContext       *pkcs11.Ctx
 	connectionDetails PKCS11HSMConnectionDetails
+	// sessionPools pools of logged in sessions by slot.
+	sessionPools      map[uint]*sessionPool
+	sessionPoolsMutex sync.Mutex
+	// operationTimeout maximum time an operation can take. Operations are only bound by their context if it is 0.
+	operationTimeout time.Duration
 }
 
 // PKCS11HSMSignatureManagerOptions defines options to create a new instance of PKCS11HSMSignatureManager.
 type PKCS11HSMSignatureManagerOptions struct {
 	PkcsContext *pkcs11.Ctx
+	// TokenLabel of the token that the slots must hold. It is not checked if it is nil.
+	TokenLabel *string
+	// OmitKeyID whether the CKA_ID attribute must not be set on generated keys, for libraries that restrict it.
+	OmitKeyID bool
+	// OperationTimeout maximum time an operation can take before it is abandoned. Operations are only bound by their context if it is 0.
+	OperationTimeout time.Duration
 }
 
 var _ signaturemanager.DigitalSignatureManager = (*PKCS11HSMSignatureManager)(nil)
@@ -48,13 +60,17 @@ func ProvidePKCS11HSMSignatureManager(options PKCS11HSMSignatureManagerOptions)
 		pkcsContext: options.PkcsContext,
 		connectionDetails: PKCS11HSMConnectionDetails{
 			Configuration: PKCS11HSMConfiguration{
-				Curve: CurveDefault,
+				Curve:      CurveDefault,
+				TokenLabel: options.TokenLabel,
+				OmitKeyID:  options.OmitKeyID,
 			},
 		},
+		sessionPools:     make(map[uint]*sessionPool),
+		operationTimeout: options.OperationTimeout,
 	}, nil
 }
 
-func (s *PKCS11HSMSignatureManager) GenerateKey(_ context.Context, input signaturemanager.GenerateKeyInput) (*signaturemanager.GenerateKeyOutput, error) {
+func (s *PKCS11HSMSignatureManager) GenerateKey(ctx context.Context, input signaturemanager.GenerateKeyInput) (*signaturemanager.GenerateKeyOutput, error) {
 	tracer := input.Tracer
 	slot, err := strconv.ParseUint(input.Slot, 10, 32)
 	if err != nil {
@@ -62,18 +78,24 @@ func (s *PKCS11HSMSignatureManager) GenerateKey(_ context.Context, input signatu
 	}
 	tracer.AddProperty("slot", slot)
 	tracer.AddProperty("standard", standard)
-	session, err := s.pkcsContext.OpenSession(uint(slot), pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
-	if err != nil {
-		return nil, toSignatureManagerErr(err, fmt.Sprintf("could not open PKCS11 session. Error: %v", err))
-	}
-	defer s.closeSession(tracer, session)
 
-	err = s.pkcsContext.Login(session, pkcs11.CKU_USER, input.Pin)
+	var addr *address.Address
+	err = s.runOperation(ctx, tracer, func(ctx context.Context) error {
+		return s.sessionPool(uint(slot)).withSession(ctx, tracer, input.Pin, func(session pkcs11.SessionHandle) error {
+			var generateErr error
+			addr, generateErr = s.generateKey(tracer, session)
+			return generateErr
+		})
+	})
 	if err != nil {
-		return nil, toSignatureManagerErr(err, fmt.Sprintf("failed to login with the PKCS11 session. Error: %v", err))
+		return nil, err
 	}
-	defer s.logOut(tracer, session)
+	return &signaturemanager.GenerateKeyOutput{
+		Address: *addr,
+	}, nil
+}
 
+func (s *PKCS11HSMSignatureManager) generateKey(tracer logger.Tracer, session pkcs11.SessionHandle) (*address.Address, error) {
 	ecParams := s.getEllipticCurveParameters()
 
 	timestamp := generateTimestampId()
@@ -86,7 +108,9 @@ func (s *PKCS11HSMSignatureManager) GenerateKey(_ context.Context, input signatu
 		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
 		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, false),
 		pkcs11.NewAttribute(pkcs11.CKA_LABEL, lb),
-		pkcs11.NewAttribute(pkcs11.CKA_ID, timestamp),
+	}
+	if !s.connectionDetails.Configuration.OmitKeyID {
+		publicKeyTemplate = append(publicKeyTemplate, pkcs11.NewAttribute(pkcs11.CKA_ID, timestamp))
 	}
 	timestamp = generateTimestampId()
 	lb = base64.StdEncoding.EncodeToString(timestamp)
@@ -98,7 +122,9 @@ func (s *PKCS11HSMSignatureManager) GenerateKey(_ context.Context, input signatu
 		pkcs11.NewAttribute(pkcs11.CKA_DERIVE, false),
 		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
 		pkcs11.NewAttribute(pkcs11.CKA_LABEL, lb),
-		pkcs11.NewAttribute(pkcs11.CKA_ID, timestamp),
+	}
+	if !s.connectionDetails.Configuration.OmitKeyID {
+		privateKeyTemplate = append(privateKeyTemplate, pkcs11.NewAttribute(pkcs11.CKA_ID, timestamp))
 	}
 
 	tracer.Debug("generating key pair")
@@ -127,12 +153,10 @@ func (s *PKCS11HSMSignatureManager) GenerateKey(_ context.Context, input signatu
 	if err != nil {
 		tracer.Warn(fmt.Sprintf("failed to set the private key label for privateKeyHandle '%d'. Error: %v", privateKeyHandle, err))
 	}
-	return &signaturemanager.GenerateKeyOutput{
-		Address: *addr,
-	}, nil
+	return addr, nil
 }
 
-func (s *PKCS11HSMSignatureManager) RemoveKey(_ context.Context, input signaturemanager.RemoveKeyInput) (*signaturemanager.RemoveKeyOutput, error) {
+func (s *PKCS11HSMSignatureManager) RemoveKey(ctx context.Context, input signaturemanager.RemoveKeyInput) (*signaturemanager.RemoveKeyOutput, error) {
 	tracer := input.Tracer
 	tracer.AddProperty("address", input.Address.String())
 	slot, err := strconv.ParseUint(input.Slot, 10, 32)
@@ -141,63 +165,66 @@ func (s *PKCS11HSMSignatureManager) RemoveKey(_ context.Context, input signature
 	}
 	tracer.AddProperty("slot", slot)
 	tracer.AddProperty("standard", standard)
-	session, err := s.pkcsContext.OpenSession(uint(slot), pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
-	if err != nil {
-		return nil, toSignatureManagerErr(err, fmt.Sprintf("could not open PKCS11 session. Error: %v", err))
-	}
-	defer s.closeSession(tracer, session)
 
-	err = s.pkcsContext.Login(session, pkcs11.CKU_USER, input.Pin)
+	pool := s.sessionPool(uint(slot))
+	err = s.runOperation(ctx, tracer, func(ctx context.Context) error {
+		return pool.withSession(ctx, tracer, input.Pin, func(session pkcs11.SessionHandle) error {
+			return s.removeKey(tracer, session, input.Address)
+		})
+	})
+	pool.forgetPrivateKey(input.Address)
 	if err != nil {
-		return nil, toSignatureManagerErr(err, fmt.Sprintf("could not login PKCS11 session. Error: %v", err))
+		return nil, err
 	}
-	defer s.logOut(tracer, session)
+	return &signaturemanager.RemoveKeyOutput{}, nil
+}
 
+func (s *PKCS11HSMSignatureManager) removeKey(tracer logger.Tracer, session pkcs11.SessionHandle, addr address.Address) error {
 	// Private key
 	tracer.Debug("removing private key")
-	privateKeyLabel := calculatePrivateKeyLabel(input.Address)
+	privateKeyLabel := calculatePrivateKeyLabel(addr)
 	templatePrivate := []*pkcs11.Attribute{
 		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
 		pkcs11.NewAttribute(pkcs11.CKA_LABEL, privateKeyLabel),
 	}
 	privateKeyObjects, err := s.findObjects(session, templatePrivate)
 	if err != nil {
-		return nil, signererrors.InternalFromErr(err).WithMessage(fmt.Sprintf("error finding PKCS11 attributes: %v", err))
+		return signererrors.InternalFromErr(err).WithMessage(fmt.Sprintf("error finding PKCS11 attributes: %v", err))
 	}
 
 	// Public key
 	tracer.Trace("removing public key")
-	publicKeyLabel := calculatePublicKeyLabel(input.Address)
+	publicKeyLabel := calculatePublicKeyLabel(addr)
 	templatePublic := []*pkcs11.Attribute{
 		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
 		pkcs11.NewAttribute(pkcs11.CKA_LABEL, publicKeyLabel),
 	}
 	publicKeyObjects, err := s.findObjects(session, templatePublic)
 	if err != nil {
-		return nil, signererrors.InternalFromErr(err).WithMessage(fmt.Sprintf("error finding PKCS11 attributes: %v", err))
+		return signererrors.InternalFromErr(err).WithMessage(fmt.Sprintf("error finding PKCS11 attributes: %v", err))
 	}
 
 	if len(privateKeyObjects) == 0 && len(publicKeyObjects) == 0 {
-		return nil, signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("key pair not found for address '%s'", input.Address))
+		return signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("key pair not found for address '%s'", addr))
 	}
 
 	for _, object := range privateKeyObjects {
 		err = s.pkcsContext.DestroyObject(session, object)
 		if err != nil {
-			return nil, toSignatureManagerErr(err, "call to PKCS11 destroy object function failed for the private key")
+			return toSignatureManagerErr(err, "call to PKCS11 destroy object function failed for the private key")
 		}
 	}
 
 	for _, object := range publicKeyObjects {
 		err = s.pkcsContext.DestroyObject(session, object)
 		if err != nil {
-			return nil, toSignatureManagerErr(err, "call to PKCS11 destroy object function failed for the public key")
+			return toSignatureManagerErr(err, "call to PKCS11 destroy object function failed for the public key")
 		}
 	}
-	return &signaturemanager.RemoveKeyOutput{}, nil
+	return nil
 }
 
-func (s *PKCS11HSMSignatureManager) ListKeys(_ context.Context, input signaturemanager.ListKeysInput) (*signaturemanager.ListKeysOutput, error) {
+func (s *PKCS11HSMSignatureManager) ListKeys(ctx context.Context, input signaturemanager.ListKeysInput) (*signaturemanager.ListKeysOutput, error) {
 	tracer := input.Tracer
 	slot, err := strconv.ParseUint(input.Slot, 10, 32)
 	if err != nil {
@@ -206,18 +233,24 @@ func (s *PKCS11HSMSignatureManager) ListKeys(_ context.Context, input signaturem
 
 	tracer.AddProperty("slot", slot)
 	tracer.AddProperty("standard", standard)
-	session, err := s.pkcsContext.OpenSession(uint(slot), pkcs11.CKF_SERIAL_SESSION)
-	if err != nil {
-		return nil, toSignatureManagerErr(err, "error opening PKCS11 session")
-	}
-	defer s.closeSession(tracer, session)
 
-	err = s.pkcsContext.Login(session, pkcs11.CKU_USER, input.Pin)
+	var addresses []address.Address
+	err = s.runOperation(ctx, tracer, func(ctx context.Context) error {
+		return s.sessionPool(uint(slot)).withSession(ctx, tracer, input.Pin, func(session pkcs11.SessionHandle) error {
+			var listErr error
+			addresses, listErr = s.listKeys(ctx, session)
+			return listErr
+		})
+	})
 	if err != nil {
-		return nil, toSignatureManagerErr(err, "error logging in with the PKCS11 session")
+		return nil, err
 	}
-	defer s.logOut(tracer, session)
+	return &signaturemanager.ListKeysOutput{
+		Items: addresses,
+	}, nil
+}
 
+func (s *PKCS11HSMSignatureManager) listKeys(ctx context.Context, session pkcs11.SessionHandle) ([]address.Address, error) {
 	pubKeyTemplate := []*pkcs11.Attribute{
 		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
 	}
@@ -232,6 +265,10 @@ func (s *PKCS11HSMSignatureManager) ListKeys(_ context.Context, input signaturem
 
 	// For each of the public objects, determine the address and check that it matches the label
 	for _, o := range objects {
+		// the keys are read one by one, so the listing is stopped as soon as it is abandoned
+		if ctx.Err() != nil {
+			return nil, contextErr(ctx)
+		}
 		label, getLabelErr := s.getLabel(session, o)
 		if getLabelErr != nil {
 			continue
@@ -241,17 +278,19 @@ func (s *PKCS11HSMSignatureManager) ListKeys(_ context.Context, input signaturem
 		if toCompare != *label {
 			continue
 		}
-		t, getTimestampErr := s.getTimestamp(session, o)
-		if getTimestampErr != nil {
-			continue
+		var t uint64
+		// keys generated without CKA_ID have no timestamp, so they are listed in the order the library finds them
+		if !s.connectionDetails.Configuration.OmitKeyID {
+			timestamp, getTimestampErr := s.getTimestamp(session, o)
+			if getTimestampErr != nil {
+				continue
+			}
+			t = timestamp
 		}
 		addresses = append(addresses, addressTime{*addr, t})
 	}
-	sort.Sort(ByTime(addresses))
-	result := ByTime(addresses).Addresses()
-	return &signaturemanager.ListKeysOutput{
-		Items: result,
-	}, nil
+	sort.Stable(ByTime(addresses))
+	return ByTime(addresses).Addresses(), nil
 }
 
 func (s *PKCS11HSMSignatureManager) Sign(ctx context.Context, input signaturemanager.SignInput) (*signaturemanager.SignOutput, error) {
@@ -275,7 +314,8 @@ func (s *PKCS11HSMSignatureManager) Sign(ctx context.Context, input signatureman
 	}, nil
 }
 
-func (s *PKCS11HSMSignatureManager) Close(_ context.Context, _ signaturemanager.CloseInput) (*signaturemanager.CloseOutput, error) {
+func (s *PKCS11HSMSignatureManager) Close(ctx context.Context, _ signaturemanager.CloseInput) (*signaturemanager.CloseOutput, error) {
+	s.closeSessionPools(ctx)
 	err := s.pkcsContext.Finalize()
 	if err != nil {
 		return nil, toSignatureManagerErr(err, "error calling PKCS11 finalize")
@@ -293,7 +333,7 @@ func (s *PKCS11HSMSignatureManager) Open(_ context.Context, _ signaturemanager.O
 	return &signaturemanager.OpenOutput{}, nil
 }
 
-func (s *PKCS11HSMSignatureManager) IsAlive(_ context.Context, input signaturemanager.IsAliveInput) (*signaturemanager.IsAliveOutput, error) {
+func (s *PKCS11HSMSignatureManager) IsAlive(ctx context.Context, input signaturemanager.IsAliveInput) (*signaturemanager.IsAliveOutput, error) {
 	tracer := input.Tracer
 	slot, err := strconv.ParseUint(input.Slot, 10, 32)
 	if err != nil {
@@ -302,38 +342,75 @@ func (s *PKCS11HSMSignatureManager) IsAlive(_ context.Context, input signaturema
 
 	tracer.AddProperty("slot", slot)
 	tracer.AddProperty("standard", standard)
-	session, openSessionErr := s.pkcsContext.OpenSession(uint(slot), pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
-	if openSessionErr != nil {
-		return nil, toSignatureManagerErr(openSessionErr, "error opening PKCS11 session")
-	}
-	defer s.closeSession(tracer, session)
 
-	err = s.pkcsContext.Login(session, pkcs11.CKU_USER, input.Pin)
+	// the session is checked even if it was used recently, so that a slot that is no longer reachable is reported
+	err = s.runOperation(ctx, tracer, func(ctx context.Context) error {
+		return s.sessionPool(uint(slot)).withSession(ctx, tracer, input.Pin, func(session pkcs11.SessionHandle) error {
+			_, sessionInfoErr := s.pkcsContext.GetSessionInfo(session)
+			if sessionInfoErr != nil {
+				return toSignatureManagerErr(sessionInfoErr, "error getting the PKCS11 session information")
+			}
+			return nil
+		})
+	})
 	if err != nil {
-		return nil, toSignatureManagerErr(err, "error logging in with the PKCS11 session")
+		return nil, err
 	}
-	defer s.logOut(tracer, session)
 
 	return &signaturemanager.IsAliveOutput{
 		IsAlive: true,
 	}, nil
 }
 
-func (s *PKCS11HSMSignatureManager) sign(_ context.Context, tracer logger.Tracer, slot uint, pin string, payloadToSign []byte, address address.Address) ([]byte, error) {
+func (s *PKCS11HSMSignatureManager) sign(ctx context.Context, tracer logger.Tracer, slot uint, pin string, payloadToSign []byte, address address.Address) ([]byte, error) {
 	tracer.AddProperty("slot", slot)
 	tracer.AddProperty("address", address.String())
 	tracer.AddProperty("standard", standard)
-	session, err := s.pkcsContext.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+
+	pool := s.sessionPool(slot)
+	var sig []byte
+	err := s.runOperation(ctx, tracer, func(ctx context.Context) error {
+		return pool.withSession(ctx, tracer, pin, func(session pkcs11.SessionHandle) error {
+			private, cached, err := s.privateKey(tracer, pool, session, address)
+			if err != nil {
+				return err
+			}
+
+			tracer.Debug("signing")
+			mechanism := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}
+			err = s.pkcsContext.SignInit(session, mechanism, private)
+			if err != nil && cached {
+				// the key may have been removed or replaced since it was cached, so it is looked up again
+				tracer.Debugf("cached private key handle '%d' is no longer valid. Error: %v", private, err)
+				pool.forgetPrivateKey(address)
+				private, _, err = s.privateKey(tracer, pool, session, address)
+				if err != nil {
+					return err
+				}
+				err = s.pkcsContext.SignInit(session, mechanism, private)
+			}
+			if err != nil {
+				return toSignatureManagerErr(err).WithMessage(fmt.Sprintf("error initializing signature: %v", err))
+			}
+			sig, err = s.pkcsContext.Sign(session, payloadToSign)
+			if err != nil {
+				return toSignatureManagerErr(err).WithMessage(fmt.Sprintf("error signing data: %v", err))
+			}
+			return nil
+		})
+	})
 	if err != nil {
-		return nil, toSignatureManagerErr(err, "error opening PKCS11 session")
+		return nil, err
 	}
-	defer s.closeSession(tracer, session)
+	return sig, nil
+}
 
-	err = s.pkcsContext.Login(session, pkcs11.CKU_USER, pin)
-	if err != nil {
-		return nil, toSignatureManagerErr(err, "error logging in with the PKCS11 session")
+// privateKey returns the handle of the private key of the given address, and whether it was cached. Handles are
+// cached once found, so that the key doesn't need to be searched on every signature.
+func (s *PKCS11HSMSignatureManager) privateKey(tracer logger.Tracer, pool *sessionPool, session pkcs11.SessionHandle, address address.Address) (pkcs11.ObjectHandle, bool, error) {
+	if handle, ok := pool.privateKey(address); ok {
+		return handle, true, nil
 	}
-	defer s.logOut(tracer, session)
 
 	tracer.Debug("retrieving private key")
 	privateKeyLabel := calculatePrivateKeyLabel(address)
@@ -344,21 +421,66 @@ func (s *PKCS11HSMSignatureManager) sign(_ context.Context, tracer logger.Tracer
 	private, err := s.findObject(session, template)
 	if err != nil {
 		if signaturemanager.IsNotFoundError(err) {
-			return nil, signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("private key not found for address '%s'. Error: %v", address.String(), err))
+			return 0, false, signaturemanager.NewNotFoundError().WithMessage(fmt.Sprintf("private key not found for address '%s'. Error: %v", address.String(), err))
 		}
-		return nil, err
+		return 0, false, err
 	}
+	pool.cachePrivateKey(address, *private)
+	return *private, false, nil
+}
 
-	tracer.Debug("signing")
-	err = s.pkcsContext.SignInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, *private)
-	if err != nil {
-		return nil, toSignatureManagerErr(err).WithMessage(fmt.Sprintf("error initializing signature: %v", err))
+// runOperation runs fn until it finishes, the context is done or the operation timeout expires, whichever happens first.
+// PKCS11 calls block and can't be interrupted, so an operation that is abandoned keeps running in the background until
+// its current call returns, but it doesn't start any further operation on the session.
+func (s *PKCS11HSMSignatureManager) runOperation(ctx context.Context, tracer logger.Tracer, fn func(ctx context.Context) error) error {
+	if s.operationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.operationTimeout)
+		defer cancel()
+	}
+	if ctx.Err() != nil {
+		return contextErr(ctx)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- fn(ctx)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		// the operation may have finished at the same time, in which case its result is not discarded
+		select {
+		case err := <-result:
+			return err
+		default:
+		}
+		tracer.Warn(fmt.Sprintf("abandoning PKCS11 operation that did not finish in time. Error: %v", ctx.Err()))
+		return contextErr(ctx)
 	}
-	sig, err := s.pkcsContext.Sign(session, payloadToSign)
-	if err != nil {
-		return nil, toSignatureManagerErr(err).WithMessage(fmt.Sprintf("error signing data: %v", err))
+}
+
+// sessionPool returns the pool of sessions of the given slot, creating it if it doesn't exist yet.
+func (s *PKCS11HSMSignatureManager) sessionPool(slot uint) *sessionPool {
+	s.sessionPoolsMutex.Lock()
+	defer s.sessionPoolsMutex.Unlock()
+	pool, ok := s.sessionPools[slot]
+	if !ok {
+		pool = newSessionPool(s.pkcsContext, slot, s.connectionDetails.Configuration.TokenLabel)
+		s.sessionPools[slot] = pool
+	}
+	return pool
+}
+
+// closeSessionPools closes the sessions of every slot before the library is finalized.
+func (s *PKCS11HSMSignatureManager) closeSessionPools(ctx context.Context) {
+	s.sessionPoolsMutex.Lock()
+	defer s.sessionPoolsMutex.Unlock()
+	tracer := logger.NewTracer(ctx)
+	for _, pool := range s.sessionPools {
+		pool.close(tracer)
 	}
-	return sig, nil
 }
 
 // setLabel sets the label for the given object.
@@ -505,24 +627,6 @@ func (s *PKCS11HSMSignatureManager) getTimestamp(session pkcs11.SessionHandle, o
 	return 0, err
 }
 
-// logOutAndClose logout and close the pkcs11 session
-func (s *PKCS11HSMSignatureManager) logOut(tracer logger.Tracer, session pkcs11.SessionHandle) {
-	tracer.Debug("logging out")
-	err := s.pkcsContext.Logout(session)
-	if err != nil {
-		tracer.Errorf("logout failed. Error: %v", err)
-	}
-}
-
-// logOutAndClose logout and close the pkcs11 session
-func (s *PKCS11HSMSignatureManager) closeSession(tracer logger.Tracer, session pkcs11.SessionHandle) {
-	tracer.Debug("closing session")
-	err := s.pkcsContext.CloseSession(session)
-	if err != nil {
-		tracer.Errorf("closing session failed. Error: %v", err)
-	}
-}
-
 // GenerateTimestampId returns a timestamp to use as CKA_ID so keys can be sorted chronologically
 func generateTimestampId() []byte {
 	t := time.Now().UnixNano()
@@ -568,6 +672,11 @@ func (a ByTime) Addresses() []address.Address {
 	return addresses
 }
 
+// contextErr returns the error of an operation that was abandoned because its context is done.
+func contextErr(ctx context.Context) *signaturemanager.Error {
+	return signaturemanager.NewTimeoutError().WithMessage(fmt.Sprintf("the PKCS11 operation was abandoned: %v", ctx.Err()))
+}
+
 // toSignatureManagerErr translates pkcs11 errors into signature manager errors.
 func toSignatureManagerErr(originalErr error, message ...string) *signaturemanager.Error {
 	errMsg := originalErr.Error()
@@ -577,9 +686,9 @@ func toSignatureManagerErr(originalErr error, message ...string) *signaturemanag
 
 	var pkcs11Err pkcs11.Error
 	if errors.As(originalErr, &pkcs11Err) {
-		err, ok := pkcsErrTranslator[pkcs11Err]
+		newErr, ok := pkcsErrTranslator[pkcs11Err]
 		if ok {
-			return err.WithMessage(errMsg)
+			return newErr().WithMessage(errMsg)
 		}
 	}
 	return signaturemanager.NewInternalError().WithMessage(errMsg)
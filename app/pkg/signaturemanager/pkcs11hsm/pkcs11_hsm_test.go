@@ -0,0 +1,295 @@
+package pkcs11hsm_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager/pkcs11hsm"
+	"github.com/hyperledger-labs/signare/app/test/signaturemanagertesthelper"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx              = context.Background()
+	tracer           = logger.NewTracer(ctx)
+	pkcsContext      *pkcs11.Ctx
+	signatureManager *pkcs11hsm.PKCS11HSMSignatureManager
+	slot             string
+	otherSlot        string
+	importedAddress  = address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+	digest           = mustDecodeHex("1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8")
+)
+
+func TestMain(m *testing.M) {
+	slotOne, slotTwo, err := signaturemanagertesthelper.InitializeSoftHSMSlot()
+	if err != nil {
+		panic(err)
+	}
+	slot = *slotOne
+	otherSlot = *slotTwo
+
+	pkcsContext = pkcs11.New(signaturemanagertesthelper.SoftHSMLib)
+	if pkcsContext == nil {
+		panic("error instantiating the PKCS11 interface for SoftHSM")
+	}
+	signatureManager, err = pkcs11hsm.ProvidePKCS11HSMSignatureManager(pkcs11hsm.PKCS11HSMSignatureManagerOptions{
+		PkcsContext: pkcsContext,
+	})
+	if err != nil {
+		panic(err)
+	}
+	_, err = signatureManager.Open(ctx, signaturemanager.OpenInput{Tracer: tracer})
+	if err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	_, _ = signatureManager.Close(ctx, signaturemanager.CloseInput{Tracer: tracer})
+	os.Exit(code)
+}
+
+func TestProvidePKCS11HSMSignatureManager(t *testing.T) {
+	t.Run("failure: nil pkcs11 context", func(t *testing.T) {
+		manager, err := pkcs11hsm.ProvidePKCS11HSMSignatureManager(pkcs11hsm.PKCS11HSMSignatureManagerOptions{})
+		require.True(t, signaturemanager.IsInvalidArgumentError(err))
+		require.Nil(t, manager)
+	})
+}
+
+func TestPKCS11HSMSignatureManager_Sign(t *testing.T) {
+	t.Run("success: consecutive signatures", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			output, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+			require.NoError(t, err)
+			require.NotEmpty(t, output.Signature)
+		}
+	})
+
+	t.Run("success: concurrent signatures", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make(chan error, 16)
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("success: after the library is reinitialized", func(t *testing.T) {
+		_, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.NoError(t, err)
+
+		_, err = signatureManager.Close(ctx, signaturemanager.CloseInput{Tracer: tracer})
+		require.NoError(t, err)
+		_, err = signatureManager.Open(ctx, signaturemanager.OpenInput{Tracer: tracer})
+		require.NoError(t, err)
+
+		output, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.NoError(t, err)
+		require.NotEmpty(t, output.Signature)
+	})
+
+	t.Run("failure: pin incorrect", func(t *testing.T) {
+		_, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.NoError(t, err)
+
+		// the sessions already logged in must not allow signing with a different pin
+		_, err = signatureManager.Sign(ctx, signInput(slot, "wrong-pin", importedAddress))
+		require.True(t, signaturemanager.IsPinIncorrectError(err))
+
+		output, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.NoError(t, err)
+		require.NotEmpty(t, output.Signature)
+	})
+
+	t.Run("failure: key not found", func(t *testing.T) {
+		_, err := signatureManager.Sign(ctx, signInput(otherSlot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.True(t, signaturemanager.IsNotFoundError(err))
+	})
+
+	t.Run("failure: context canceled", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := signatureManager.Sign(canceledCtx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.True(t, signaturemanager.IsTimeoutError(err))
+
+		// the sessions are still usable after an abandoned operation
+		output, err := signatureManager.Sign(ctx, signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress))
+		require.NoError(t, err)
+		require.NotEmpty(t, output.Signature)
+	})
+}
+
+func TestPKCS11HSMSignatureManager_RemoveKey(t *testing.T) {
+	t.Run("success: removed key can't sign", func(t *testing.T) {
+		generated, err := signatureManager.GenerateKey(ctx, signaturemanager.GenerateKeyInput{
+			Slot:   otherSlot,
+			Pin:    signaturemanagertesthelper.SlotPin,
+			Tracer: tracer,
+		})
+		require.NoError(t, err)
+
+		// the handle of the key is cached by the first signature
+		_, err = signatureManager.Sign(ctx, signInput(otherSlot, signaturemanagertesthelper.SlotPin, generated.Address))
+		require.NoError(t, err)
+
+		_, err = signatureManager.RemoveKey(ctx, signaturemanager.RemoveKeyInput{
+			Slot:    otherSlot,
+			Pin:     signaturemanagertesthelper.SlotPin,
+			Tracer:  tracer,
+			Address: generated.Address,
+		})
+		require.NoError(t, err)
+
+		_, err = signatureManager.Sign(ctx, signInput(otherSlot, signaturemanagertesthelper.SlotPin, generated.Address))
+		require.True(t, signaturemanager.IsNotFoundError(err))
+	})
+}
+
+func TestPKCS11HSMSignatureManager_IsAlive(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		output, err := signatureManager.IsAlive(ctx, signaturemanager.IsAliveInput{
+			Slot:   slot,
+			Pin:    signaturemanagertesthelper.SlotPin,
+			Tracer: tracer,
+		})
+		require.NoError(t, err)
+		require.True(t, output.IsAlive)
+	})
+
+	t.Run("failure: pin incorrect", func(t *testing.T) {
+		_, err := signatureManager.IsAlive(ctx, signaturemanager.IsAliveInput{
+			Slot:   slot,
+			Pin:    "wrong-pin",
+			Tracer: tracer,
+		})
+		require.True(t, signaturemanager.IsPinIncorrectError(err))
+	})
+}
+
+// BenchmarkSign compares signing with the pooled sessions against opening, logging in, finding the key, logging out
+// and closing a session on every signature, as it was done before the sessions were pooled.
+func BenchmarkSign(b *testing.B) {
+	b.Run("session pool", func(b *testing.B) {
+		input := signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err := signatureManager.Sign(ctx, input)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("session pool parallel", func(b *testing.B) {
+		input := signInput(slot, signaturemanagertesthelper.SlotPin, importedAddress)
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, err := signatureManager.Sign(ctx, input)
+				if err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+
+	b.Run("session per operation", func(b *testing.B) {
+		slotID, err := strconv.ParseUint(slot, 10, 32)
+		if err != nil {
+			b.Fatal(err)
+		}
+		// the pooled sessions keep the slot logged in, so they are closed first
+		_, err = signatureManager.Close(ctx, signaturemanager.CloseInput{Tracer: tracer})
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, err = signatureManager.Open(ctx, signaturemanager.OpenInput{Tracer: tracer})
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			err = signWithNewSession(uint(slotID), signaturemanagertesthelper.SlotPin, importedAddress)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// signWithNewSession signs the digest opening and logging in a new session, and finding the private key.
+func signWithNewSession(slotID uint, pin string, addr address.Address) error {
+	session, err := pkcsContext.OpenSession(slotID, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = pkcsContext.CloseSession(session) }()
+	err = pkcsContext.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = pkcsContext.Logout(session) }()
+
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, addr.String()),
+	}
+	err = pkcsContext.FindObjectsInit(session, template)
+	if err != nil {
+		return err
+	}
+	objects, _, err := pkcsContext.FindObjects(session, 1)
+	if err != nil {
+		return err
+	}
+	err = pkcsContext.FindObjectsFinal(session)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return signaturemanager.NewNotFoundError()
+	}
+	err = pkcsContext.SignInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, objects[0])
+	if err != nil {
+		return err
+	}
+	_, err = pkcsContext.Sign(session, digest)
+	return err
+}
+
+func signInput(slot, pin string, from address.Address) signaturemanager.SignInput {
+	return signaturemanager.SignInput{
+		Slot:   slot,
+		Pin:    pin,
+		Tracer: tracer,
+		From:   from,
+		Data:   digest,
+	}
+}
+
+func mustDecodeHex(s string) []byte {
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return decoded
+}
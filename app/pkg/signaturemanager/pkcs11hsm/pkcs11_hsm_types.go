@@ -13,10 +13,16 @@ const (
 	CurveSecp256k1 Curve = "secp256k1"
 )
 
-var pkcsErrTranslator = map[pkcs11.Error]*signaturemanager.Error{
-	pkcs11.CKR_SLOT_ID_INVALID:              signaturemanager.NewInvalidSlotError(),
-	pkcs11.CKR_PIN_INCORRECT:                signaturemanager.NewPinIncorrectError(),
-	pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED: signaturemanager.NewAlreadyInitializedError(),
+// pkcsErrTranslator creates the signature manager error of each PKCS11 return value. A new error is created for each
+// translation, as the message is set on the returned error. The device errors require the library to be reinitialized.
+var pkcsErrTranslator = map[pkcs11.Error]func() *signaturemanager.Error{
+	pkcs11.CKR_SLOT_ID_INVALID:              signaturemanager.NewInvalidSlotError,
+	pkcs11.CKR_PIN_INCORRECT:                signaturemanager.NewPinIncorrectError,
+	pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED: signaturemanager.NewAlreadyInitializedError,
+	pkcs11.CKR_CRYPTOKI_NOT_INITIALIZED:     signaturemanager.NewDeviceUnavailableError,
+	pkcs11.CKR_DEVICE_ERROR:                 signaturemanager.NewDeviceUnavailableError,
+	pkcs11.CKR_DEVICE_REMOVED:               signaturemanager.NewDeviceUnavailableError,
+	pkcs11.CKR_TOKEN_NOT_PRESENT:            signaturemanager.NewDeviceUnavailableError,
 }
 
 // PKCS11HSMConnectionDetails configuration to connect to a specific slot in a softHSM instance.
@@ -28,4 +34,8 @@ type PKCS11HSMConnectionDetails struct {
 // PKCS11HSMConfiguration configuration to connect to a softHSM instance.
 type PKCS11HSMConfiguration struct {
 	Curve Curve
+	// TokenLabel of the token that the slots must hold. It is not checked if it is nil.
+	TokenLabel *string
+	// OmitKeyID whether the CKA_ID attribute must not be set on generated keys.
+	OmitKeyID bool
 }
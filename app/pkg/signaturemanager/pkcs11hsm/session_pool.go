@@ -0,0 +1,276 @@
+package pkcs11hsm
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+)
+
+const (
+	// maxIdleSessions maximum amount of sessions of a slot kept open while they are not in use.
+	maxIdleSessions = 8
+	// sessionHealthCheckInterval time after which an idle session is checked before using it again.
+	sessionHealthCheckInterval = 30 * time.Second
+)
+
+// sessionPool keeps the sessions of a slot open and logged in between operations, so that each operation doesn't
+// need to open, log in, log out and close its own session. The login state is shared by all the sessions of the
+// slot, so the pool logs in once and logs in again only if the state is lost or a different pin is used.
+type sessionPool struct {
+	mutex       sync.Mutex
+	pkcsContext *pkcs11.Ctx
+	slot        uint
+	tokenLabel  *string
+	// idleSessions sessions that are open and not in use, the most recently used last.
+	idleSessions []idleSession
+	// generation is incremented when the pool is closed, so that the sessions acquired before are not reused.
+	generation uint64
+	// loggedIn whether the slot was logged in with the pin whose hash is pinHash.
+	loggedIn bool
+	pinHash  [sha256.Size]byte
+	// privateKeys handles of the private keys already found in the slot.
+	privateKeys map[address.Address]pkcs11.ObjectHandle
+}
+
+// idleSession a session that is not in use.
+type idleSession struct {
+	handle pkcs11.SessionHandle
+	since  time.Time
+}
+
+// pooledSession a session acquired from the pool.
+type pooledSession struct {
+	handle     pkcs11.SessionHandle
+	generation uint64
+}
+
+func newSessionPool(pkcsContext *pkcs11.Ctx, slot uint, tokenLabel *string) *sessionPool {
+	return &sessionPool{
+		pkcsContext: pkcsContext,
+		slot:        slot,
+		tokenLabel:  tokenLabel,
+		privateKeys: make(map[address.Address]pkcs11.ObjectHandle),
+	}
+}
+
+// withSession runs fn with a logged in session of the slot. If fn fails and the session turns out to be no longer
+// usable, e.g. because the library was reinitialized or the HSM was restarted, the session is evicted and fn is
+// retried once with a new session. fn is not run, nor retried, once the context is done.
+func (p *sessionPool) withSession(ctx context.Context, tracer logger.Tracer, pin string, fn func(session pkcs11.SessionHandle) error) error {
+	session, err := p.acquire(tracer, pin)
+	if err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		p.release(tracer, *session)
+		return contextErr(ctx)
+	}
+	err = fn(session.handle)
+	if err == nil || p.isUsable(session.handle) {
+		p.release(tracer, *session)
+		return err
+	}
+
+	tracer.Debugf("evicting PKCS11 session '%d' that is no longer usable", session.handle)
+	p.evict(tracer, *session)
+	if ctx.Err() != nil {
+		return err
+	}
+	session, err = p.acquire(tracer, pin)
+	if err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		p.release(tracer, *session)
+		return contextErr(ctx)
+	}
+	err = fn(session.handle)
+	if err != nil && !p.isUsable(session.handle) {
+		p.evict(tracer, *session)
+		return err
+	}
+	p.release(tracer, *session)
+	return err
+}
+
+// acquire returns a logged in session of the slot, reusing an idle one if there is any.
+func (p *sessionPool) acquire(tracer logger.Tracer, pin string) (*pooledSession, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	session, err := p.idleOrNewSession(tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	pinHash := sha256.Sum256([]byte(pin))
+	if !p.loggedIn || p.pinHash != pinHash {
+		err = p.login(tracer, session, pin, pinHash)
+		if err != nil {
+			p.pushIdle(tracer, session)
+			return nil, err
+		}
+	}
+	return &pooledSession{
+		handle:     session,
+		generation: p.generation,
+	}, nil
+}
+
+// login logs in the slot with the given pin. The login state is shared by all the sessions of the slot, so if it is
+// not known to have been logged in with the same pin, it is logged out first so that the pin is checked by the HSM.
+func (p *sessionPool) login(tracer logger.Tracer, session pkcs11.SessionHandle, pin string, pinHash [sha256.Size]byte) error {
+	if p.pinHash != pinHash {
+		p.loggedIn = false
+		err := p.pkcsContext.Logout(session)
+		if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_NOT_LOGGED_IN)) {
+			return toSignatureManagerErr(err, "error logging out the PKCS11 session")
+		}
+	}
+
+	tracer.Debug("logging in")
+	err := p.pkcsContext.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return toSignatureManagerErr(err, "error logging in with the PKCS11 session")
+	}
+	p.loggedIn = true
+	p.pinHash = pinHash
+	return nil
+}
+
+// idleOrNewSession returns the most recently used idle session or opens a new one if there is none. Sessions that
+// have been idle for a while are checked before returning them.
+func (p *sessionPool) idleOrNewSession(tracer logger.Tracer) (pkcs11.SessionHandle, error) {
+	for len(p.idleSessions) > 0 {
+		last := p.idleSessions[len(p.idleSessions)-1]
+		p.idleSessions = p.idleSessions[:len(p.idleSessions)-1]
+		if time.Since(last.since) < sessionHealthCheckInterval || p.isUsable(last.handle) {
+			return last.handle, nil
+		}
+		tracer.Debugf("discarding idle PKCS11 session '%d' that is no longer usable", last.handle)
+		p.closeSession(tracer, last.handle)
+		p.loggedIn = false
+	}
+
+	err := p.checkTokenLabel()
+	if err != nil {
+		return 0, err
+	}
+	tracer.Debug("opening session")
+	session, err := p.pkcsContext.OpenSession(p.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, toSignatureManagerErr(err, fmt.Sprintf("could not open PKCS11 session. Error: %v", err))
+	}
+	return session, nil
+}
+
+// release returns a session to the pool once the operation that acquired it has finished.
+func (p *sessionPool) release(tracer logger.Tracer, session pooledSession) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	// the sessions acquired before the pool was closed are no longer valid, and their handles may have been reused
+	if session.generation != p.generation {
+		return
+	}
+	p.pushIdle(tracer, session.handle)
+}
+
+// evict closes a session that is no longer usable. The login state is checked again with the next session.
+func (p *sessionPool) evict(tracer logger.Tracer, session pooledSession) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if session.generation != p.generation {
+		return
+	}
+	p.closeSession(tracer, session.handle)
+	p.loggedIn = false
+	p.privateKeys = make(map[address.Address]pkcs11.ObjectHandle)
+}
+
+// close closes the idle sessions and forgets the login state and the key handles.
+func (p *sessionPool) close(tracer logger.Tracer) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, idle := range p.idleSessions {
+		p.closeSession(tracer, idle.handle)
+	}
+	p.idleSessions = nil
+	p.generation++
+	p.loggedIn = false
+	p.privateKeys = make(map[address.Address]pkcs11.ObjectHandle)
+}
+
+// privateKey returns the cached handle of the private key of the given address.
+func (p *sessionPool) privateKey(addr address.Address) (pkcs11.ObjectHandle, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	handle, ok := p.privateKeys[addr]
+	return handle, ok
+}
+
+// cachePrivateKey caches the handle of the private key of the given address.
+func (p *sessionPool) cachePrivateKey(addr address.Address, handle pkcs11.ObjectHandle) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.privateKeys[addr] = handle
+}
+
+// forgetPrivateKey removes the cached handle of the private key of the given address.
+func (p *sessionPool) forgetPrivateKey(addr address.Address) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.privateKeys, addr)
+}
+
+// isUsable checks that the session is still open and logged in.
+func (p *sessionPool) isUsable(session pkcs11.SessionHandle) bool {
+	info, err := p.pkcsContext.GetSessionInfo(session)
+	if err != nil {
+		return false
+	}
+	return info.State == pkcs11.CKS_RW_USER_FUNCTIONS || info.State == pkcs11.CKS_RO_USER_FUNCTIONS
+}
+
+// pushIdle keeps the session open for the next operation, or closes it if there are already enough idle sessions.
+func (p *sessionPool) pushIdle(tracer logger.Tracer, session pkcs11.SessionHandle) {
+	if len(p.idleSessions) >= maxIdleSessions {
+		p.closeSession(tracer, session)
+		return
+	}
+	p.idleSessions = append(p.idleSessions, idleSession{
+		handle: session,
+		since:  time.Now(),
+	})
+}
+
+// checkTokenLabel checks that the token in the slot holds the configured label. It does nothing if no label was configured.
+func (p *sessionPool) checkTokenLabel() error {
+	if p.tokenLabel == nil {
+		return nil
+	}
+	tokenInfo, err := p.pkcsContext.GetTokenInfo(p.slot)
+	if err != nil {
+		return toSignatureManagerErr(err, "error getting the PKCS11 token information")
+	}
+	if tokenInfo.Label != *p.tokenLabel {
+		return signaturemanager.NewInvalidSlotError().WithMessage(fmt.Sprintf("the token in slot '%d' has label '%s' but '%s' was expected", p.slot, tokenInfo.Label, *p.tokenLabel))
+	}
+	return nil
+}
+
+func (p *sessionPool) closeSession(tracer logger.Tracer, session pkcs11.SessionHandle) {
+	tracer.Debug("closing session")
+	err := p.pkcsContext.CloseSession(session)
+	if err != nil {
+		tracer.Debugf("closing session failed. Error: %v", err)
+	}
+}
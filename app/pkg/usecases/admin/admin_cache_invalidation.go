@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"context"
+)
+
+// CacheInvalidationPort invalidates the data cached from the Admin resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateAdmin removes the cached data of the given Admin, such as its roles.
+	InvalidateAdmin(ctx context.Context, adminID string)
+}
+
+func (u *DefaultUseCase) invalidateCache(ctx context.Context, adminID string) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateAdmin(ctx, adminID)
+	}
+}
@@ -142,6 +142,8 @@ func (u *DefaultUseCase) EditAdmin(ctx context.Context, input EditAdminInput) (*
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, input.ID)
+
 	return &EditAdminOutput{
 		Admin: *editedAdmin,
 	}, nil
@@ -177,6 +179,8 @@ func (u *DefaultUseCase) DeleteAdmin(ctx context.Context, input DeleteAdminInput
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, input.ID)
+
 	return &DeleteAdminOutput{
 		Admin: *admin,
 	}, nil
@@ -189,6 +193,8 @@ type DefaultUseCaseOptions struct {
 	AdminStorage                AdminStorage
 	RoleUseCase                 role.RoleUseCase
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// CacheInvalidation invalidates the cached data of the edited and deleted admins. Optional
+	CacheInvalidation CacheInvalidationPort
 }
 
 // DefaultUseCase implementation of AdminUseCase.
@@ -196,6 +202,7 @@ type DefaultUseCase struct {
 	adminStorage                AdminStorage
 	roleUseCase                 role.RoleUseCase
 	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	cacheInvalidation           CacheInvalidationPort
 }
 
 // ProvideDefaultUseCase creates a new DefaultUseCase.
@@ -214,5 +221,6 @@ func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, erro
 		adminStorage:                options.AdminStorage,
 		roleUseCase:                 options.RoleUseCase,
 		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+		cacheInvalidation:           options.CacheInvalidation,
 	}, nil
 }
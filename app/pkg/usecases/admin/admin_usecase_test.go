@@ -170,7 +170,7 @@ func TestDefaultUseCase_ListAdmins(t *testing.T) {
 		require.True(t, listAdminsOutput.MoreItems)
 		// Assert order
 		for i := 1; i < len(listAdminsOutput.Items); i++ {
-			require.Less(t, listAdminsOutput.Items[i-1].LastUpdate.ToInt64(), listAdminsOutput.Items[i].LastUpdate.ToInt64())
+			require.LessOrEqual(t, listAdminsOutput.Items[i-1].CreationDate.ToInt64(), listAdminsOutput.Items[i].CreationDate.ToInt64())
 		}
 	})
 }
@@ -0,0 +1,17 @@
+package application
+
+import (
+	"context"
+)
+
+// CacheInvalidationPort invalidates the data cached from the Application resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateApplication removes the cached data of the given Application, including its users, accounts and HSM slots.
+	InvalidateApplication(ctx context.Context, applicationID string)
+}
+
+func (u *DefaultUseCase) invalidateCache(ctx context.Context, applicationID string) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateApplication(ctx, applicationID)
+	}
+}
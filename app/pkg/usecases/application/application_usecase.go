@@ -112,6 +112,8 @@ func (u *DefaultUseCase) EditApplication(ctx context.Context, input EditApplicat
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, input.ID)
+
 	return &EditApplicationOutput{
 		Application: *editedApplication,
 	}, nil
@@ -138,6 +140,8 @@ func (u *DefaultUseCase) DeleteApplication(ctx context.Context, input DeleteAppl
 		}
 		return nil, errors.InternalFromErr(err)
 	}
+	u.invalidateCache(ctx, input.ID)
+
 	return &DeleteApplicationOutput{
 		Application: *application,
 	}, nil
@@ -149,12 +153,15 @@ var _ ApplicationUseCase = new(DefaultUseCase)
 type DefaultUseCase struct {
 	storage                     ApplicationStorage
 	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	cacheInvalidation           CacheInvalidationPort
 }
 
 // DefaultUseCaseOptions configures a DefaultUseCase
 type DefaultUseCaseOptions struct {
 	Storage                     ApplicationStorage
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// CacheInvalidation invalidates the cached data of the edited and deleted applications. Optional
+	CacheInvalidation CacheInvalidationPort
 }
 
 // ProvideDefaultUseCase provides a DefaultUseCase with the given options
@@ -169,5 +176,6 @@ func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, erro
 	return &DefaultUseCase{
 		storage:                     options.Storage,
 		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+		cacheInvalidation:           options.CacheInvalidation,
 	}, nil
 }
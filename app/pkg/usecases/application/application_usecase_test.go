@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/applicationdbout"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
@@ -170,7 +171,7 @@ func TestDefaultUseCase_ListApplications(t *testing.T) {
 		require.True(t, output.MoreItems)
 		// Assert order
 		for i := 1; i < len(output.Items); i++ {
-			require.Greater(t, output.Items[i-1].CreationDate.ToInt64(), output.Items[i].CreationDate.ToInt64())
+			require.GreaterOrEqual(t, output.Items[i-1].CreationDate.ToInt64(), output.Items[i].CreationDate.ToInt64())
 		}
 	})
 
@@ -187,7 +188,7 @@ func TestDefaultUseCase_ListApplications(t *testing.T) {
 		require.True(t, output.MoreItems)
 		// Assert order
 		for i := 1; i < len(output.Items); i++ {
-			require.Less(t, output.Items[i-1].LastUpdate.ToInt64(), output.Items[i].LastUpdate.ToInt64())
+			require.LessOrEqual(t, output.Items[i-1].LastUpdate.ToInt64(), output.Items[i].LastUpdate.ToInt64())
 		}
 	})
 }
@@ -313,6 +314,8 @@ func TestDefaultUseCase_EditApplication(t *testing.T) {
 			Description: &description,
 		})
 		require.NoError(t, err)
+		// the dates have millisecond precision, so the application is edited in a later millisecond for its last update to change
+		time.Sleep(time.Millisecond)
 
 		// Edit the application
 		newDescription := "this is a new description"
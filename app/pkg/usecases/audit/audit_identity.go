@@ -0,0 +1,11 @@
+package audit
+
+import (
+	"context"
+)
+
+// IdentityPort provides the identity of the requester of the audited operations.
+type IdentityPort interface {
+	// GetRequester returns the user and the application of the request in the context.
+	GetRequester(ctx context.Context, input GetRequesterInput) (*GetRequesterOutput, error)
+}
@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+)
+
+// AuditStorage defines the functionality to interact with Record in Storage. Records can only be appended.
+type AuditStorage interface {
+	// Add a Record in AuditStorage. It returns an AlreadyExists error if the sequence number is already taken.
+	Add(ctx context.Context, data Record) (*Record, error)
+	// Last Record in AuditStorage. It returns a NotFound error if the audit log is empty.
+	Last(ctx context.Context) (*Record, error)
+	// All Records in AuditStorage.
+	All(ctx context.Context, filters RecordFilters) (*RecordCollection, error)
+
+	// Filter create a RecordFilters instance.
+	Filter() RecordFilters
+}
+
+// RecordFilters defines filter options for retrieving Records from Storage.
+type RecordFilters interface {
+	// OrderBySequenceNumber orders Records in storage by sequence number
+	OrderBySequenceNumber(orderDirection persistence.OrderDirection) RecordFilters
+	// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter
+	Paged(limit int, offset int) RecordFilters
+	// FilterByUserID filters Records by the user that requested the operation
+	FilterByUserID(userID string) RecordFilters
+	// FilterByApplicationID filters Records by the application the operation was performed in
+	FilterByApplicationID(applicationID string) RecordFilters
+	// FilterByAddress filters Records by the account involved in the operation
+	FilterByAddress(address string) RecordFilters
+	// FilterByOperation filters Records by operation
+	FilterByOperation(operation string) RecordFilters
+	// FilterFromSequenceNumber filters Records with a sequence number greater or equal than the given one
+	FilterFromSequenceNumber(sequenceNumber int64) RecordFilters
+}
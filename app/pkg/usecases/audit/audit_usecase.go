@@ -0,0 +1,277 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/utils"
+
+	"github.com/asaskevich/govalidator"
+)
+
+const (
+	defaultOrderDirection = entities.OrderDesc
+	// maxAppendAttempts times a Record is appended when other instances take the same sequence number
+	maxAppendAttempts = 5
+	// verificationPageSize amount of Records read from storage at once when verifying the audit log
+	verificationPageSize = 500
+)
+
+// AuditUseCase defines the management of the audit log.
+type AuditUseCase interface {
+	// RecordOperation appends a Record of an operation to the audit log and returns an error if it fails
+	RecordOperation(ctx context.Context, input RecordOperationInput) (*RecordOperationOutput, error)
+	// ListRecords lists Records of the audit log and returns an error if it fails
+	ListRecords(ctx context.Context, input ListRecordsInput) (*ListRecordsOutput, error)
+	// VerifyRecords checks that the Records of the audit log have not been removed or modified and returns an error if it fails
+	VerifyRecords(ctx context.Context, input VerifyRecordsInput) (*VerifyRecordsOutput, error)
+}
+
+func (u *DefaultUseCase) RecordOperation(ctx context.Context, input RecordOperationInput) (*RecordOperationOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	requester, err := u.identityPort.GetRequester(ctx, GetRequesterInput{})
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("error getting the requester of the operation")
+	}
+
+	record := Record{
+		Timestamp:     time.Now(),
+		Operation:     input.Operation,
+		UserID:        requester.UserID,
+		ApplicationID: requester.ApplicationID,
+		Address:       input.Address,
+		ChainID:       input.ChainID,
+		TxHash:        input.TxHash,
+		SignedHash:    input.SignedHash,
+		Outcome:       SuccessOutcome,
+	}
+	if input.Err != nil {
+		record.Outcome = FailureOutcome
+		record.Error = input.Err.Error()
+	}
+
+	u.appendMutex.Lock()
+	defer u.appendMutex.Unlock()
+
+	for attempt := 1; ; attempt++ {
+		added, appendErr := u.append(ctx, record)
+		if appendErr == nil {
+			return &RecordOperationOutput{
+				Record: *added,
+			}, nil
+		}
+		if !errors.IsAlreadyExists(appendErr) || attempt == maxAppendAttempts {
+			return nil, errors.InternalFromErr(appendErr).WithMessage("error appending record to the audit log")
+		}
+	}
+}
+
+// append chains the Record to the last one in storage and stores it.
+func (u *DefaultUseCase) append(ctx context.Context, record Record) (*Record, error) {
+	record.SequenceNumber = 1
+	record.PreviousHash = ""
+	last, err := u.auditStorage.Last(ctx)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		record.SequenceNumber = last.SequenceNumber + 1
+		record.PreviousHash = last.Hash
+	}
+
+	hash, err := computeHash(record)
+	if err != nil {
+		return nil, err
+	}
+	record.Hash = hash
+
+	return u.auditStorage.Add(ctx, record)
+}
+
+func (u *DefaultUseCase) ListRecords(ctx context.Context, input ListRecordsInput) (*ListRecordsOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	filters := u.auditStorage.Filter()
+	direction := utils.DefaultString(input.OrderDirection, defaultOrderDirection)
+	filters.OrderBySequenceNumber(persistence.OrderDirection(direction))
+	if input.UserID != nil {
+		filters.FilterByUserID(*input.UserID)
+	}
+	if input.ApplicationID != nil {
+		filters.FilterByApplicationID(*input.ApplicationID)
+	}
+	if input.Address != nil {
+		filters.FilterByAddress(*input.Address)
+	}
+	if input.Operation != nil {
+		filters.FilterByOperation(*input.Operation)
+	}
+	if input.FromSequenceNumber != nil {
+		filters.FilterFromSequenceNumber(*input.FromSequenceNumber)
+	}
+
+	if input.PageLimit > 0 {
+		filters.Paged(input.PageLimit, input.PageOffset)
+	}
+
+	collection, err := u.auditStorage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &ListRecordsOutput{
+		RecordCollection: *collection,
+	}, nil
+}
+
+func (u *DefaultUseCase) VerifyRecords(ctx context.Context, input VerifyRecordsInput) (*VerifyRecordsOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	output := &VerifyRecordsOutput{
+		Valid: true,
+	}
+	previousHash := ""
+	for offset := 0; ; offset += verificationPageSize {
+		filters := u.auditStorage.Filter()
+		filters.OrderBySequenceNumber(persistence.OrderDirection(entities.OrderAsc))
+		filters.Paged(verificationPageSize, offset)
+		collection, allErr := u.auditStorage.All(ctx, filters)
+		if allErr != nil {
+			return nil, errors.InternalFromErr(allErr)
+		}
+
+		for _, record := range collection.Items {
+			reason, verifyErr := verifyRecord(record, output.RecordsVerified+1, previousHash)
+			if verifyErr != nil {
+				return nil, errors.InternalFromErr(verifyErr)
+			}
+			if reason != nil {
+				sequenceNumber := output.RecordsVerified + 1
+				output.Valid = false
+				output.FirstInvalidSequenceNumber = &sequenceNumber
+				output.Reason = reason
+				return output, nil
+			}
+			output.RecordsVerified++
+			previousHash = record.Hash
+		}
+
+		if !collection.MoreItems {
+			break
+		}
+	}
+
+	if output.RecordsVerified > 0 {
+		output.LastHash = &previousHash
+	}
+	return output, nil
+}
+
+// verifyRecord returns the reason why the Record breaks the chain or nil if it is valid.
+func verifyRecord(record Record, expectedSequenceNumber int64, previousHash string) (*string, error) {
+	if record.SequenceNumber != expectedSequenceNumber {
+		reason := fmt.Sprintf("record [%d] is missing", expectedSequenceNumber)
+		return &reason, nil
+	}
+	if record.PreviousHash != previousHash {
+		reason := fmt.Sprintf("record [%d] is not chained to the previous record", record.SequenceNumber)
+		return &reason, nil
+	}
+	hash, err := computeHash(record)
+	if err != nil {
+		return nil, err
+	}
+	if record.Hash != hash {
+		reason := fmt.Sprintf("record [%d] has been modified", record.SequenceNumber)
+		return &reason, nil
+	}
+	return nil, nil
+}
+
+// hashedRecord is the content of a Record covered by its hash. SignedHash is omitted when empty, so that the hashes
+// of the Records appended before it was introduced still match.
+type hashedRecord struct {
+	SequenceNumber int64  `json:"sequenceNumber"`
+	Timestamp      int64  `json:"timestamp"`
+	Operation      string `json:"operation"`
+	UserID         string `json:"userId"`
+	ApplicationID  string `json:"applicationId"`
+	Address        string `json:"address"`
+	ChainID        string `json:"chainId"`
+	TxHash         string `json:"txHash"`
+	SignedHash     string `json:"signedHash,omitempty"`
+	Outcome        string `json:"outcome"`
+	Error          string `json:"error"`
+	PreviousHash   string `json:"previousHash"`
+}
+
+// computeHash returns the hex encoded SHA-256 hash of the content of the Record.
+func computeHash(record Record) (string, error) {
+	content, err := json.Marshal(hashedRecord{
+		SequenceNumber: record.SequenceNumber,
+		Timestamp:      record.Timestamp.ToInt64(),
+		Operation:      string(record.Operation),
+		UserID:         record.UserID,
+		ApplicationID:  record.ApplicationID,
+		Address:        record.Address,
+		ChainID:        record.ChainID,
+		TxHash:         record.TxHash,
+		SignedHash:     record.SignedHash,
+		Outcome:        string(record.Outcome),
+		Error:          record.Error,
+		PreviousHash:   record.PreviousHash,
+	})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+var _ AuditUseCase = new(DefaultUseCase)
+
+// DefaultUseCaseOptions options to create a new DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	AuditStorage AuditStorage
+	IdentityPort IdentityPort
+}
+
+// DefaultUseCase implementation of AuditUseCase.
+type DefaultUseCase struct {
+	auditStorage AuditStorage
+	identityPort IdentityPort
+	appendMutex  sync.Mutex
+}
+
+// ProvideDefaultUseCase creates a new DefaultUseCase.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.AuditStorage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'AuditStorage' not provided")
+	}
+	if options.IdentityPort == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'IdentityPort' not provided")
+	}
+
+	return &DefaultUseCase{
+		auditStorage: options.AuditStorage,
+		identityPort: options.IdentityPort,
+	}, nil
+}
@@ -0,0 +1,252 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/auditdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/requester"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	"github.com/hyperledger-labs/signare/app/pkg/infra/requestcontext"
+	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var app graph.GraphShared
+
+const (
+	testAddress = "0xa2b4e3a7c2a6e3a1d8e0b5c8a4e3b1c2d3e4f5a6"
+	testChainID = "44844"
+)
+
+func TestMain(m *testing.M) {
+	a, err := dbtesthelper.InitializeApp()
+	if err != nil {
+		panic(err)
+	}
+	app = *a
+	validators.SetValidators()
+	os.Exit(m.Run())
+}
+
+func TestProvideDefaultUseCase(t *testing.T) {
+	t.Run("nil audit storage", func(t *testing.T) {
+		useCase, err := audit.ProvideDefaultUseCase(audit.DefaultUseCaseOptions{
+			IdentityPort: &requester.DefaultAuditIdentityAdapter{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil identity port", func(t *testing.T) {
+		useCase, err := audit.ProvideDefaultUseCase(audit.DefaultUseCaseOptions{
+			AuditStorage: &auditdbout.Repository{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		useCase, err := audit.ProvideDefaultUseCase(audit.DefaultUseCaseOptions{
+			AuditStorage: &auditdbout.Repository{},
+			IdentityPort: &requester.DefaultAuditIdentityAdapter{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, useCase)
+	})
+}
+
+func TestDefaultUseCase_RecordOperation(t *testing.T) {
+	ctx := requesterContext(uuid.NewString(), "application-1")
+
+	first, err := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+		Operation: audit.GenerateAddressOperation,
+		Address:   testAddress,
+		ChainID:   testChainID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.Hash)
+	require.Equal(t, "application-1", first.ApplicationID)
+	require.Equal(t, testAddress, first.Address)
+	require.Equal(t, audit.SuccessOutcome, first.Outcome)
+
+	second, err := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+		Operation: audit.SignTxOperation,
+		Address:   testAddress,
+		ChainID:   testChainID,
+		Err:       errors.New("slot not reachable"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, first.SequenceNumber+1, second.SequenceNumber)
+	require.Equal(t, first.Hash, second.PreviousHash)
+	require.Equal(t, first.UserID, second.UserID)
+	require.Equal(t, audit.FailureOutcome, second.Outcome)
+	require.Equal(t, "slot not reachable", second.Error)
+
+	t.Run("failure: invalid operation", func(t *testing.T) {
+		_, recordErr := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+			Operation: "Unknown",
+		})
+		require.True(t, signererrors.IsInvalidArgument(recordErr))
+	})
+
+	t.Run("failure: no requester in the context", func(t *testing.T) {
+		_, recordErr := app.AuditUseCase.RecordOperation(context.Background(), audit.RecordOperationInput{
+			Operation: audit.SignTxOperation,
+		})
+		require.Error(t, recordErr)
+	})
+
+	t.Run("success: admin operations have no application", func(t *testing.T) {
+		adminCtx := context.WithValue(context.Background(), requestcontext.UserContextKey, uuid.NewString())
+		output, recordErr := app.AuditUseCase.RecordOperation(adminCtx, audit.RecordOperationInput{
+			Operation: audit.RemoveAddressOperation,
+			Address:   testAddress,
+		})
+		require.NoError(t, recordErr)
+		require.Empty(t, output.ApplicationID)
+	})
+
+	t.Run("success: signed hash of messages is recorded", func(t *testing.T) {
+		signedHash := "0x9c1f5e8a2b4d7f0a3c6e9b2d5f8a1c4e7b0d3f6a9c2e5b8d1a4f7c0e3b6d9a2c"
+		output, recordErr := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+			Operation:  audit.SignMessageOperation,
+			Address:    testAddress,
+			ChainID:    testChainID,
+			SignedHash: signedHash,
+		})
+		require.NoError(t, recordErr)
+		require.Equal(t, signedHash, output.SignedHash)
+		require.Empty(t, output.TxHash)
+
+		last := lastRecord(t)
+		require.Equal(t, signedHash, last.SignedHash)
+		verification, verifyErr := app.AuditUseCase.VerifyRecords(ctx, audit.VerifyRecordsInput{})
+		require.NoError(t, verifyErr)
+		require.True(t, verification.Valid)
+	})
+
+	t.Run("success: concurrent operations are chained", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, recordErr := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+					Operation: audit.SignTypedDataOperation,
+				})
+				require.NoError(t, recordErr)
+			}()
+		}
+		wg.Wait()
+
+		last := lastRecord(t)
+		verification, verifyErr := app.AuditUseCase.VerifyRecords(ctx, audit.VerifyRecordsInput{})
+		require.NoError(t, verifyErr)
+		require.True(t, verification.Valid)
+		require.Equal(t, last.SequenceNumber, verification.RecordsVerified)
+	})
+}
+
+func TestDefaultUseCase_ListRecords(t *testing.T) {
+	userID := uuid.NewString()
+	ctx := requesterContext(userID, "application-1")
+
+	operations := []audit.Operation{audit.GenerateAddressOperation, audit.SignTxOperation, audit.SignTxOperation, audit.RemoveAddressOperation}
+	records := make([]audit.Record, 0, len(operations))
+	for _, operation := range operations {
+		output, err := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+			Operation: operation,
+		})
+		require.NoError(t, err)
+		records = append(records, output.Record)
+	}
+
+	t.Run("success: default order is descending", func(t *testing.T) {
+		output, err := app.AuditUseCase.ListRecords(ctx, audit.ListRecordsInput{
+			UserID: &userID,
+		})
+		require.NoError(t, err)
+		require.Len(t, output.Items, len(records))
+		require.Equal(t, records[3].SequenceNumber, output.Items[0].SequenceNumber)
+		require.Equal(t, records[3].Hash, output.Items[0].Hash)
+	})
+
+	t.Run("success: filtered and paged", func(t *testing.T) {
+		operation := string(audit.SignTxOperation)
+		output, err := app.AuditUseCase.ListRecords(ctx, audit.ListRecordsInput{
+			PageLimit:      1,
+			OrderDirection: entities.OrderAsc,
+			UserID:         &userID,
+			Operation:      &operation,
+		})
+		require.NoError(t, err)
+		require.Len(t, output.Items, 1)
+		require.Equal(t, records[1].SequenceNumber, output.Items[0].SequenceNumber)
+		require.True(t, output.MoreItems)
+	})
+
+	t.Run("success: from sequence number", func(t *testing.T) {
+		from := records[2].SequenceNumber
+		output, err := app.AuditUseCase.ListRecords(ctx, audit.ListRecordsInput{
+			OrderDirection:     entities.OrderAsc,
+			UserID:             &userID,
+			FromSequenceNumber: &from,
+		})
+		require.NoError(t, err)
+		require.Len(t, output.Items, 2)
+		require.Equal(t, records[2].SequenceNumber, output.Items[0].SequenceNumber)
+	})
+
+	t.Run("failure: invalid page limit", func(t *testing.T) {
+		_, err := app.AuditUseCase.ListRecords(ctx, audit.ListRecordsInput{
+			PageLimit: -1,
+		})
+		require.True(t, signererrors.IsInvalidArgument(err))
+	})
+}
+
+func TestDefaultUseCase_VerifyRecords(t *testing.T) {
+	ctx := requesterContext(uuid.NewString(), "application-1")
+	for i := 0; i < 3; i++ {
+		_, err := app.AuditUseCase.RecordOperation(ctx, audit.RecordOperationInput{
+			Operation: audit.SignTxOperation,
+			TxHash:    "0x01",
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("success: valid audit log", func(t *testing.T) {
+		last := lastRecord(t)
+		output, err := app.AuditUseCase.VerifyRecords(ctx, audit.VerifyRecordsInput{})
+		require.NoError(t, err)
+		require.True(t, output.Valid)
+		require.Equal(t, last.SequenceNumber, output.RecordsVerified)
+		require.Equal(t, last.Hash, *output.LastHash)
+		require.Nil(t, output.FirstInvalidSequenceNumber)
+		require.Nil(t, output.Reason)
+	})
+}
+
+func requesterContext(userID, applicationID string) context.Context {
+	ctx := context.WithValue(context.Background(), requestcontext.UserContextKey, userID)
+	return context.WithValue(ctx, requestcontext.ApplicationContextKey, applicationID)
+}
+
+func lastRecord(t *testing.T) audit.Record {
+	output, err := app.AuditUseCase.ListRecords(context.Background(), audit.ListRecordsInput{
+		PageLimit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	return output.Items[0]
+}
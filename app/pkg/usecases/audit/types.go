@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+)
+
+// Operation performed with the keys of the HSM.
+type Operation string
+
+const (
+	GenerateAddressOperation Operation = "GenerateAddress"
+	RemoveAddressOperation   Operation = "RemoveAddress"
+	SignTxOperation          Operation = "SignTx"
+	SignMessageOperation     Operation = "SignMessage"
+	SignTypedDataOperation   Operation = "SignTypedData"
+)
+
+// Outcome of an audited operation.
+type Outcome string
+
+const (
+	SuccessOutcome Outcome = "success"
+	FailureOutcome Outcome = "failure"
+)
+
+// Record defines an entry of the audit log. Each Record is chained to the previous one through its hash, so that
+// removing or editing a Record invalidates every Record after it.
+type Record struct {
+	// SequenceNumber position of the Record in the audit log, starting at 1.
+	SequenceNumber int64
+	// Timestamp when the operation was performed.
+	Timestamp time.Timestamp
+	// Operation performed.
+	Operation Operation
+	// UserID of the user that requested the operation.
+	UserID string
+	// ApplicationID of the application the operation was performed in.
+	ApplicationID string
+	// Address of the account involved in the operation.
+	Address string
+	// ChainID of the chain the operation was performed for.
+	ChainID string
+	// TxHash hash of the signed transaction. Only set for successful SignTx operations.
+	TxHash string
+	// SignedHash hash of the signed message or typed data. Only set for successful SignMessage and SignTypedData operations.
+	SignedHash string
+	// Outcome of the operation.
+	Outcome Outcome
+	// Error description of the failure. Only set when Outcome is FailureOutcome.
+	Error string
+	// PreviousHash hash of the previous Record in the audit log. Empty for the first Record.
+	PreviousHash string
+	// Hash of the content of the Record, including PreviousHash.
+	Hash string
+}
+
+// RecordCollection defines a collection of Record resources.
+type RecordCollection struct {
+	// Items Record in collection
+	Items []Record
+	// StandardCollectionPage is the page data of the collection
+	entities.StandardCollectionPage
+}
+
+// RecordOperationInput defines the operation to append to the audit log.
+type RecordOperationInput struct {
+	// Operation performed.
+	Operation Operation `valid:"in(GenerateAddress|RemoveAddress|SignTx|SignMessage|SignTypedData)"`
+	// Address of the account involved in the operation.
+	Address string
+	// ChainID of the chain the operation was performed for.
+	ChainID string
+	// TxHash hash of the signed transaction.
+	TxHash string
+	// SignedHash hash of the signed message or typed data.
+	SignedHash string
+	// Err returned by the operation. The operation is recorded as successful if it is nil.
+	Err error `valid:"-"`
+}
+
+// RecordOperationOutput the Record appended to the audit log.
+type RecordOperationOutput struct {
+	Record
+}
+
+// ListRecordsInput defines all possible options to list Record resources.
+type ListRecordsInput struct {
+	// PageLimit maximum amount of Record in list output.
+	PageLimit int `valid:"natural"`
+	// PageOffset amount of Record elapsed in list output.
+	PageOffset int `valid:"natural"`
+	// OrderDirection the direction in which the list will be ordered by sequence number.
+	OrderDirection string
+	// UserID filters the Records of a user.
+	UserID *string `valid:"optional"`
+	// ApplicationID filters the Records of an application.
+	ApplicationID *string `valid:"optional"`
+	// Address filters the Records of an account.
+	Address *string `valid:"optional"`
+	// Operation filters the Records of an operation.
+	Operation *string `valid:"optional"`
+	// FromSequenceNumber filters the Records with a sequence number greater or equal than the given one.
+	FromSequenceNumber *int64 `valid:"optional"`
+}
+
+// ListRecordsOutput collection of Record resources.
+type ListRecordsOutput struct {
+	RecordCollection
+}
+
+// VerifyRecordsInput defines the options to verify the audit log.
+type VerifyRecordsInput struct{}
+
+// VerifyRecordsOutput result of the verification of the audit log.
+type VerifyRecordsOutput struct {
+	// Valid is true if the audit log has not been tampered with.
+	Valid bool
+	// RecordsVerified amount of Records checked.
+	RecordsVerified int64
+	// LastHash hash of the last Record verified. It can be stored outside signare to detect the removal of the latest Records.
+	LastHash *string
+	// FirstInvalidSequenceNumber sequence number where the chain is broken. Only set if Valid is false.
+	FirstInvalidSequenceNumber *int64
+	// Reason why the chain is broken. Only set if Valid is false.
+	Reason *string
+}
+
+// GetRequesterInput input to get the identity of the requester of an operation.
+type GetRequesterInput struct{}
+
+// GetRequesterOutput the identity of the requester of an operation.
+type GetRequesterOutput struct {
+	// UserID of the user that requested the operation.
+	UserID string
+	// ApplicationID of the application the operation was requested in.
+	ApplicationID string
+}
@@ -9,6 +9,10 @@ import (
 type ActionsPolicyInformationPointPort interface {
 	// ListActions list the Actions assigned to the given roles.
 	ListActions(ctx context.Context, input ListActionsInput) (*ListActionsOutput, error)
+	// ActionExists checks whether an action is defined in the RBAC.
+	ActionExists(ctx context.Context, input ActionExistsInput) (*ActionExistsOutput, error)
+	// ExplainAction checks whether an action exists and lists the permissions of the given roles that grant it.
+	ExplainAction(ctx context.Context, input ExplainActionInput) (*ExplainActionOutput, error)
 }
 
 // ListActionsInput are the attributes needed to list actions related to a set of roles.
@@ -57,3 +61,39 @@ func (a Actions) Contains(actionID string) bool {
 	_, ok := a.actionSet[actionID]
 	return ok
 }
+
+// ActionExistsInput are the attributes needed to check whether an action exists.
+type ActionExistsInput struct {
+	// ActionID is the ID of the action.
+	ActionID string
+}
+
+// ActionExistsOutput is the result of checking whether an action exists.
+type ActionExistsOutput struct {
+	// Exists is true if the action is defined in the RBAC.
+	Exists bool
+}
+
+// ExplainActionInput are the attributes needed to explain which roles grant an action.
+type ExplainActionInput struct {
+	// Roles is the set of roles to look for the action in. It can be empty.
+	Roles []string
+	// ActionID is the ID of the action.
+	ActionID string
+}
+
+// ExplainActionOutput is the result of explaining which roles grant an action.
+type ExplainActionOutput struct {
+	// ActionExists is true if the action is defined in the RBAC.
+	ActionExists bool
+	// Grants are the permissions of the roles that grant the action.
+	Grants []Grant
+}
+
+// Grant is a permission of a role that grants an action.
+type Grant struct {
+	// Role is the ID of the role.
+	Role string
+	// Permission is the ID of the permission of the role that grants the action.
+	Permission string
+}
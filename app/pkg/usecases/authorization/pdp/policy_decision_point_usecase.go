@@ -2,19 +2,27 @@ package pdp
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 
 	"github.com/asaskevich/govalidator"
 )
 
+// adminActionPrefix is the prefix of the actions of the admin API, which can only be performed by signare admins.
+const adminActionPrefix = "admin."
+
 // PolicyDecisionPointUseCase is the business logic to perform user authorization for different actions.
 type PolicyDecisionPointUseCase interface {
 	// AuthorizeUserAccount checks if a user is authorized to use an account, returns an error if it doesn't.
 	AuthorizeUserAccount(ctx context.Context, input AuthorizeUserAccountInput) (*AuthorizeUserAccountOutput, error)
 	// AuthorizeUser checks if the user is authorized to perform an action, returns an error if it doesn't.
 	AuthorizeUser(ctx context.Context, input AuthorizeUserInput) (*AuthorizeUserOutput, error)
+	// ExplainAuthorization evaluates whether the user is authorized to perform an action and returns the trace of the
+	// evaluation, without returning an error if it doesn't.
+	ExplainAuthorization(ctx context.Context, input ExplainAuthorizationInput) (*ExplainAuthorizationOutput, error)
 }
 
 // AuthorizeUserAccount checks if a user is authorized to use an account, returns an error if it doesn't.
@@ -32,6 +40,9 @@ func (useCase DefaultPolicyDecisionPointUseCase) AuthorizeUserAccount(ctx contex
 	}
 	_, getAccountErr := useCase.accountsPolicyInformationAdapter.GetAccount(ctx, getAccountInput)
 	if getAccountErr != nil {
+		if errors.IsNotFound(getAccountErr) {
+			return nil, &DeniedError{Reason: DenialReasonAccountNotEnabled, Err: getAccountErr}
+		}
 		return nil, getAccountErr
 	}
 
@@ -45,48 +56,205 @@ func (useCase DefaultPolicyDecisionPointUseCase) AuthorizeUser(ctx context.Conte
 		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
 
-	var roles *[]string
-	if input.ApplicationID != nil && *input.ApplicationID != "" {
-		getUserInput := GetUserRolesInput{
-			UserID:        input.UserID,
-			ApplicationID: *input.ApplicationID,
-		}
-		getUserRolesOutput, getUserRolesErr := useCase.usersPolicyInformationAdapter.GetUserRoles(ctx, getUserInput)
-		if getUserRolesErr != nil {
-			return nil, getUserRolesErr
+	trace, err := useCase.evaluateAuthorization(ctx, authorizationEvaluation{
+		userID:        input.UserID,
+		applicationID: input.ApplicationID,
+		actionID:      input.ActionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !trace.Authorized {
+		return nil, newDeniedError(input.UserID, *trace.DenialReason)
+	}
+
+	return &AuthorizeUserOutput{}, nil
+}
+
+// ExplainAuthorization evaluates the authorization of a user step by step, in the same way as AuthorizeUser and
+// AuthorizeUserAccount, and returns the trace of the evaluation. A denied authorization is not an error.
+func (useCase DefaultPolicyDecisionPointUseCase) ExplainAuthorization(ctx context.Context, input ExplainAuthorizationInput) (*ExplainAuthorizationOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	trace, err := useCase.evaluateAuthorization(ctx, authorizationEvaluation{
+		userID:        input.UserID,
+		applicationID: input.ApplicationID,
+		actionID:      input.ActionID,
+		address:       input.Address,
+		explain:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExplainAuthorizationOutput{
+		AuthorizationTrace: *trace,
+	}, nil
+}
+
+// authorizationEvaluation are the set of fields to evaluate the authorization of a user.
+type authorizationEvaluation struct {
+	userID        string
+	applicationID *string
+	actionID      string
+	// address of the account to be used by the action. It is only checked for application users.
+	address *address.Address
+	// explain evaluates every step and lists the grants of the action, reading the roles without the cache. Otherwise,
+	// the evaluation stops at the first denial and only the cached catalogue of actions is read to know why the action
+	// was denied.
+	explain bool
+}
+
+// evaluateAuthorization evaluates the authorization of a user step by step and returns the trace of the evaluation.
+// Whether the action is granted is decided by the actions of the roles, so that the trace matches the verdict of
+// AuthorizeUser.
+func (useCase DefaultPolicyDecisionPointUseCase) evaluateAuthorization(ctx context.Context, evaluation authorizationEvaluation) (*AuthorizationTrace, error) {
+	trace := AuthorizationTrace{
+		Roles:  make([]string, 0),
+		Grants: make([]Grant, 0),
+	}
+	deny := func(reason DenialReason) {
+		if trace.DenialReason == nil {
+			trace.DenialReason = &reason
 		}
+	}
+	stop := func() bool {
+		return trace.DenialReason != nil && !evaluation.explain
+	}
 
-		if getUserRolesOutput != nil {
-			roles = &getUserRolesOutput.Roles
+	roles, userType, err := useCase.resolveRoles(ctx, evaluation.userID, evaluation.applicationID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	trace.UserType = userType
+	if len(roles) > 0 {
+		trace.Roles = roles
+	} else {
+		deny(DenialReasonNoRoles)
+	}
+	if stop() {
+		return &trace, nil
+	}
+
+	granted := false
+	if len(trace.Roles) > 0 {
+		listActionsOutput, listActionsErr := useCase.actionsPolicyInformationPointPort.ListActions(ctx, ListActionsInput{
+			Roles: trace.Roles,
+		})
+		if listActionsErr != nil {
+			return nil, listActionsErr
 		}
+		_, granted = listActionsOutput.Actions.actionSet[evaluation.actionID]
+	}
+	trace.ActionExists = granted
+	if evaluation.explain {
+		explainActionOutput, explainActionErr := useCase.actionsPolicyInformationPointPort.ExplainAction(ctx, ExplainActionInput{
+			Roles:    trace.Roles,
+			ActionID: evaluation.actionID,
+		})
+		if explainActionErr != nil {
+			return nil, explainActionErr
+		}
+		trace.ActionExists = explainActionOutput.ActionExists
+		trace.Grants = append(trace.Grants, explainActionOutput.Grants...)
+	} else if !granted {
+		actionExistsOutput, actionExistsErr := useCase.actionsPolicyInformationPointPort.ActionExists(ctx, ActionExistsInput{
+			ActionID: evaluation.actionID,
+		})
+		if actionExistsErr != nil {
+			return nil, actionExistsErr
+		}
+		trace.ActionExists = actionExistsOutput.Exists
+	}
+	if !granted && userType != nil {
+		deny(actionDenialReason(*userType, evaluation.actionID, trace.ActionExists))
+	}
+	if stop() {
+		return &trace, nil
 	}
 
-	if roles == nil {
-		getAdminInput := GetAdminRolesInput{
-			AdminID: entities.StandardID{
-				ID: input.UserID,
+	if evaluation.address != nil && userType != nil && *userType == UserTypeUser {
+		_, err = useCase.AuthorizeUserAccount(ctx, AuthorizeUserAccountInput{
+			AccountID: AccountID{
+				UserID:        evaluation.userID,
+				ApplicationID: *evaluation.applicationID,
+				Address:       *evaluation.address,
 			},
+		})
+		if err != nil {
+			if reason, ok := DenialReasonFromError(err); !ok || reason != DenialReasonAccountNotEnabled {
+				return nil, err
+			}
 		}
-		getAdminRolesOutput, getAdminRolesErr := useCase.adminsPolicyInformationAdapter.GetAdminRoles(ctx, getAdminInput)
-		if getAdminRolesErr != nil {
-			return nil, getAdminRolesErr
+		accountEnabled := err == nil
+		trace.AccountEnabled = &accountEnabled
+		if !accountEnabled {
+			deny(DenialReasonAccountNotEnabled)
 		}
-		roles = &getAdminRolesOutput.Roles
 	}
 
-	listAllowedActionsInput := ListActionsInput{
-		Roles: *roles,
+	trace.Authorized = trace.DenialReason == nil
+	return &trace, nil
+}
+
+// newDeniedError returns the error of an authorization denied for the given reason.
+func newDeniedError(userID string, reason DenialReason) error {
+	var err error
+	switch reason {
+	case DenialReasonNoRoles:
+		err = errors.PreconditionFailed().SetHumanReadableMessage("user [%s] has no roles", userID)
+	case DenialReasonAccountNotEnabled:
+		err = errors.PreconditionFailed().SetHumanReadableMessage("account not enabled for user [%s]", userID)
+	default:
+		err = errors.PreconditionFailed().SetHumanReadableMessage("action not authorized for user [%s]", userID)
 	}
-	listActionsOutput, listAllowedActionsErr := useCase.actionsPolicyInformationPointPort.ListActions(ctx, listAllowedActionsInput)
-	if listAllowedActionsErr != nil {
-		return nil, listAllowedActionsErr
+	return &DeniedError{Reason: reason, Err: err}
+}
+
+// resolveRoles returns the roles of the user in the application, or the roles of the admin if no application is
+// provided. It returns a not found error if the user doesn't exist.
+func (useCase DefaultPolicyDecisionPointUseCase) resolveRoles(ctx context.Context, userID string, applicationID *string) ([]string, *UserType, error) {
+	if applicationID != nil && *applicationID != "" {
+		getUserInput := GetUserRolesInput{
+			UserID:        userID,
+			ApplicationID: *applicationID,
+		}
+		getUserRolesOutput, getUserRolesErr := useCase.usersPolicyInformationAdapter.GetUserRoles(ctx, getUserInput)
+		if getUserRolesErr != nil {
+			return nil, nil, getUserRolesErr
+		}
+
+		if getUserRolesOutput != nil {
+			userType := UserTypeUser
+			return getUserRolesOutput.Roles, &userType, nil
+		}
 	}
 
-	if _, ok := listActionsOutput.Actions.actionSet[input.ActionID]; !ok {
-		return nil, errors.PreconditionFailed().SetHumanReadableMessage("action not authorized for user [%s]", input.UserID)
+	getAdminInput := GetAdminRolesInput{
+		AdminID: entities.StandardID{
+			ID: userID,
+		},
 	}
+	getAdminRolesOutput, getAdminRolesErr := useCase.adminsPolicyInformationAdapter.GetAdminRoles(ctx, getAdminInput)
+	if getAdminRolesErr != nil {
+		return nil, nil, getAdminRolesErr
+	}
+	userType := UserTypeAdmin
+	return getAdminRolesOutput.Roles, &userType, nil
+}
 
-	return &AuthorizeUserOutput{}, nil
+// actionDenialReason returns why an action that is not granted by the roles of the user was denied.
+func actionDenialReason(userType UserType, actionID string, actionExists bool) DenialReason {
+	if !actionExists {
+		return DenialReasonUnknownAction
+	}
+	if userType == UserTypeUser && strings.HasPrefix(actionID, adminActionPrefix) {
+		return DenialReasonAdminOnlyAction
+	}
+	return DenialReasonActionNotGranted
 }
 
 var _ PolicyDecisionPointUseCase = (*DefaultPolicyDecisionPointUseCase)(nil)
@@ -0,0 +1,292 @@
+package pdp_test
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/pdp"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applicationID = "application"
+	adminID       = "admin"
+	userID        = "user"
+	signAction    = "rpc.method.eth_signTransaction"
+	listAction    = "application.users.list"
+	adminAction   = "admin.users.list"
+)
+
+var (
+	ctx            = context.Background()
+	enabledAddress = address.MustNewFromHexString("0x970e8128ab834e8eac17ab8e3812f010678cf791")
+	otherAddress   = address.MustNewFromHexString("0xd9fc0d6b1e1e8c52e8a4d7ae3c1c0b2ff3c9a5c1")
+)
+
+func TestMain(m *testing.M) {
+	validators.SetValidators()
+	os.Exit(m.Run())
+}
+
+func TestDefaultPolicyDecisionPointUseCase_AuthorizeUser(t *testing.T) {
+	useCase, actions := newPolicyDecisionPoint(t)
+
+	t.Run("success: application user", func(t *testing.T) {
+		_, err := useCase.AuthorizeUser(ctx, pdp.AuthorizeUserInput{
+			UserID:        userID,
+			ApplicationID: ptr(applicationID),
+			ActionID:      signAction,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("success: admin", func(t *testing.T) {
+		_, err := useCase.AuthorizeUser(ctx, pdp.AuthorizeUserInput{
+			UserID:   adminID,
+			ActionID: adminAction,
+		})
+		require.NoError(t, err)
+	})
+
+	tests := map[string]struct {
+		input  pdp.AuthorizeUserInput
+		reason pdp.DenialReason
+	}{
+		"unknown action": {
+			input:  pdp.AuthorizeUserInput{UserID: userID, ApplicationID: ptr(applicationID), ActionID: "rpc.method.unknown"},
+			reason: pdp.DenialReasonUnknownAction,
+		},
+		"unknown user": {
+			input:  pdp.AuthorizeUserInput{UserID: "unknown", ApplicationID: ptr(applicationID), ActionID: signAction},
+			reason: pdp.DenialReasonNoRoles,
+		},
+		"admin-only action": {
+			input:  pdp.AuthorizeUserInput{UserID: userID, ApplicationID: ptr(applicationID), ActionID: adminAction},
+			reason: pdp.DenialReasonAdminOnlyAction,
+		},
+		"action not granted": {
+			input:  pdp.AuthorizeUserInput{UserID: userID, ApplicationID: ptr(applicationID), ActionID: listAction},
+			reason: pdp.DenialReasonActionNotGranted,
+		},
+	}
+	for name, test := range tests {
+		t.Run("failure: "+name, func(t *testing.T) {
+			explains := actions.explains
+			_, err := useCase.AuthorizeUser(ctx, test.input)
+			require.Error(t, err)
+			reason, ok := pdp.DenialReasonFromError(err)
+			require.True(t, ok)
+			require.Equal(t, test.reason, reason)
+			require.Equal(t, explains, actions.explains)
+
+			output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+				UserID:        test.input.UserID,
+				ApplicationID: test.input.ApplicationID,
+				ActionID:      test.input.ActionID,
+			})
+			require.NoError(t, err)
+			require.False(t, output.Authorized)
+			require.Equal(t, test.reason, *output.DenialReason)
+		})
+	}
+}
+
+func TestDefaultPolicyDecisionPointUseCase_AuthorizeUserAccount(t *testing.T) {
+	useCase, _ := newPolicyDecisionPoint(t)
+
+	_, err := useCase.AuthorizeUserAccount(ctx, pdp.AuthorizeUserAccountInput{
+		AccountID: pdp.AccountID{UserID: userID, ApplicationID: applicationID, Address: enabledAddress},
+	})
+	require.NoError(t, err)
+
+	_, err = useCase.AuthorizeUserAccount(ctx, pdp.AuthorizeUserAccountInput{
+		AccountID: pdp.AccountID{UserID: userID, ApplicationID: applicationID, Address: otherAddress},
+	})
+	reason, ok := pdp.DenialReasonFromError(err)
+	require.True(t, ok)
+	require.Equal(t, pdp.DenialReasonAccountNotEnabled, reason)
+	require.True(t, errors.IsNotFound(err))
+}
+
+func TestDefaultPolicyDecisionPointUseCase_ExplainAuthorization(t *testing.T) {
+	useCase, _ := newPolicyDecisionPoint(t)
+
+	t.Run("success: authorized application user", func(t *testing.T) {
+		output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID:        userID,
+			ApplicationID: ptr(applicationID),
+			ActionID:      signAction,
+			Address:       &enabledAddress,
+		})
+		require.NoError(t, err)
+		require.True(t, output.Authorized)
+		require.Nil(t, output.DenialReason)
+		require.Equal(t, pdp.UserTypeUser, *output.UserType)
+		require.Equal(t, []string{"transaction-signer"}, output.Roles)
+		require.True(t, output.ActionExists)
+		require.Equal(t, []pdp.Grant{{Role: "transaction-signer", Permission: "allow-transaction-signer"}}, output.Grants)
+		require.True(t, *output.AccountEnabled)
+	})
+
+	t.Run("success: authorized admin without account check", func(t *testing.T) {
+		output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID:   adminID,
+			ActionID: adminAction,
+			Address:  &otherAddress,
+		})
+		require.NoError(t, err)
+		require.True(t, output.Authorized)
+		require.Equal(t, pdp.UserTypeAdmin, *output.UserType)
+		require.Nil(t, output.AccountEnabled)
+	})
+
+	t.Run("success: account not enabled", func(t *testing.T) {
+		output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID:        userID,
+			ApplicationID: ptr(applicationID),
+			ActionID:      signAction,
+			Address:       &otherAddress,
+		})
+		require.NoError(t, err)
+		require.False(t, output.Authorized)
+		require.Equal(t, pdp.DenialReasonAccountNotEnabled, *output.DenialReason)
+		require.False(t, *output.AccountEnabled)
+	})
+
+	t.Run("success: first denial reason is reported", func(t *testing.T) {
+		output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID:        userID,
+			ApplicationID: ptr(applicationID),
+			ActionID:      adminAction,
+			Address:       &otherAddress,
+		})
+		require.NoError(t, err)
+		require.False(t, output.Authorized)
+		require.Equal(t, pdp.DenialReasonAdminOnlyAction, *output.DenialReason)
+		require.True(t, output.ActionExists)
+		require.Empty(t, output.Grants)
+		require.False(t, *output.AccountEnabled)
+	})
+
+	t.Run("success: unknown user", func(t *testing.T) {
+		output, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID:        "unknown",
+			ApplicationID: ptr(applicationID),
+			ActionID:      "rpc.method.unknown",
+		})
+		require.NoError(t, err)
+		require.False(t, output.Authorized)
+		require.Equal(t, pdp.DenialReasonNoRoles, *output.DenialReason)
+		require.Nil(t, output.UserType)
+		require.Empty(t, output.Roles)
+		require.False(t, output.ActionExists)
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		_, err := useCase.ExplainAuthorization(ctx, pdp.ExplainAuthorizationInput{
+			UserID: userID,
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+}
+
+func newPolicyDecisionPoint(t *testing.T) (*pdp.DefaultPolicyDecisionPointUseCase, *actionsPIP) {
+	actions := &actionsPIP{roleActions: map[string][]string{
+		"signer-admin":       {adminAction},
+		"application-admin":  {listAction},
+		"transaction-signer": {signAction},
+	}}
+	useCase, err := pdp.ProvideDefaultPolicyDecisionPointUseCase(pdp.DefaultPolicyDecisionPointUseCaseOptions{
+		AccountsPolicyInformationAdapter:  &accountsPIP{},
+		ActionsPolicyInformationPointPort: actions,
+		AdminsPolicyInformationAdapter:    &adminsPIP{},
+		UsersPolicyInformationAdapter:     &usersPIP{},
+	})
+	require.NoError(t, err)
+	return useCase, actions
+}
+
+type usersPIP struct{}
+
+func (u *usersPIP) GetUserRoles(_ context.Context, input pdp.GetUserRolesInput) (*pdp.GetUserRolesOutput, error) {
+	if input.UserID != userID || input.ApplicationID != applicationID {
+		return nil, errors.NotFound()
+	}
+	return &pdp.GetUserRolesOutput{
+		Roles: []string{"transaction-signer"},
+	}, nil
+}
+
+type adminsPIP struct{}
+
+func (a *adminsPIP) GetAdminRoles(_ context.Context, input pdp.GetAdminRolesInput) (*pdp.GetAdminRolesOutput, error) {
+	if input.AdminID.ID != adminID {
+		return nil, errors.NotFound()
+	}
+	return &pdp.GetAdminRolesOutput{
+		Roles: []string{"signer-admin"},
+	}, nil
+}
+
+type accountsPIP struct{}
+
+func (a *accountsPIP) GetAccount(_ context.Context, input pdp.GetAccountInput) (*pdp.GetAccountOutput, error) {
+	if input.Address != enabledAddress {
+		return nil, errors.NotFound()
+	}
+	return &pdp.GetAccountOutput{}, nil
+}
+
+// actionsPIP grants the actions of each role through a single permission named 'allow-<role>'
+type actionsPIP struct {
+	roleActions map[string][]string
+	// explains number of times an action is explained
+	explains int
+}
+
+func (a *actionsPIP) ListActions(_ context.Context, input pdp.ListActionsInput) (*pdp.ListActionsOutput, error) {
+	actions := pdp.NewActions([]string{})
+	for _, role := range input.Roles {
+		actions.Merge(*pdp.NewActions(a.roleActions[role]))
+	}
+	return &pdp.ListActionsOutput{
+		Actions: *actions,
+	}, nil
+}
+
+func (a *actionsPIP) ActionExists(_ context.Context, input pdp.ActionExistsInput) (*pdp.ActionExistsOutput, error) {
+	output := pdp.ActionExistsOutput{}
+	for _, actions := range a.roleActions {
+		if slices.Contains(actions, input.ActionID) {
+			output.Exists = true
+		}
+	}
+	return &output, nil
+}
+
+func (a *actionsPIP) ExplainAction(_ context.Context, input pdp.ExplainActionInput) (*pdp.ExplainActionOutput, error) {
+	a.explains++
+	output := pdp.ExplainActionOutput{
+		Grants: make([]pdp.Grant, 0),
+	}
+	for role, actions := range a.roleActions {
+		if !slices.Contains(actions, input.ActionID) {
+			continue
+		}
+		output.ActionExists = true
+		if slices.Contains(input.Roles, role) {
+			output.Grants = append(output.Grants, pdp.Grant{Role: role, Permission: "allow-" + role})
+		}
+	}
+	return &output, nil
+}
+
+func ptr(value string) *string {
+	return &value
+}
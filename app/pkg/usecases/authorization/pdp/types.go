@@ -1,5 +1,11 @@
 package pdp
 
+import (
+	"errors"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+)
+
 // AuthorizeUserInput are the attributes to check a user permissions
 type AuthorizeUserAccountInput struct {
 	AccountID
@@ -20,3 +26,91 @@ type AuthorizeUserInput struct {
 
 // AuthorizeUserOutput is the result of a user authorization.
 type AuthorizeUserOutput struct{}
+
+// ExplainAuthorizationInput are the attributes to explain the authorization of a user.
+type ExplainAuthorizationInput struct {
+	// UserID is the ID of the user.
+	UserID string `valid:"required"`
+	// ApplicationID is the ID of the application. The user is an admin if it is not provided.
+	ApplicationID *string
+	// ActionID is the ID of the action.
+	ActionID string `valid:"required"`
+	// Address of the account to be used by the action. It is only checked for application users.
+	Address *address.Address
+}
+
+// ExplainAuthorizationOutput is the trace of the authorization of a user.
+type ExplainAuthorizationOutput struct {
+	AuthorizationTrace
+}
+
+// AuthorizationTrace details every step of the evaluation of an authorization.
+type AuthorizationTrace struct {
+	// Authorized is true if the user is authorized to perform the action.
+	Authorized bool
+	// DenialReason is the first step of the evaluation that denied the authorization. It is nil if the user is authorized.
+	DenialReason *DenialReason
+	// UserType is the kind of the user whose roles were resolved. It is nil if the user doesn't exist.
+	UserType *UserType
+	// Roles resolved for the user.
+	Roles []string
+	// ActionExists is true if the action is defined in the RBAC.
+	ActionExists bool
+	// Grants are the permissions of the roles of the user that grant the action.
+	Grants []Grant
+	// AccountEnabled is true if the account is enabled for the user. It is nil if the account wasn't checked.
+	AccountEnabled *bool
+}
+
+// UserType is the kind of user whose roles are resolved by the PDP.
+type UserType string
+
+const (
+	// UserTypeAdmin is a signare admin, which doesn't belong to any application.
+	UserTypeAdmin UserType = "admin"
+	// UserTypeUser is a user of an application.
+	UserTypeUser UserType = "user"
+)
+
+// DenialReason is the step of the evaluation of an authorization that denied it.
+type DenialReason string
+
+const (
+	// DenialReasonUnknownAction the action is not defined in the RBAC.
+	DenialReasonUnknownAction DenialReason = "UNKNOWN_ACTION"
+	// DenialReasonNoRoles the user doesn't exist or has no roles.
+	DenialReasonNoRoles DenialReason = "NO_ROLES"
+	// DenialReasonAdminOnlyAction the action belongs to the admin API, and the user is an application user.
+	DenialReasonAdminOnlyAction DenialReason = "ADMIN_ONLY_ACTION"
+	// DenialReasonActionNotGranted none of the roles of the user grants the action.
+	DenialReasonActionNotGranted DenialReason = "ACTION_NOT_GRANTED"
+	// DenialReasonAccountNotEnabled the account is not enabled for the user in the application.
+	DenialReasonAccountNotEnabled DenialReason = "ACCOUNT_NOT_ENABLED"
+)
+
+// DeniedError is returned when an authorization is denied. It wraps the original error with the reason of the denial.
+type DeniedError struct {
+	// Reason is the step of the evaluation that denied the authorization.
+	Reason DenialReason
+	// Err is the original error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DeniedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *DeniedError) Unwrap() error {
+	return e.Err
+}
+
+// DenialReasonFromError returns the reason of the denial if the error is a DeniedError.
+func DenialReasonFromError(err error) (DenialReason, bool) {
+	var deniedErr *DeniedError
+	if !errors.As(err, &deniedErr) {
+		return "", false
+	}
+	return deniedErr.Reason, true
+}
@@ -0,0 +1,17 @@
+package role
+
+import (
+	"context"
+)
+
+// CacheInvalidationPort invalidates the data cached from the Role and Permission resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateRoles removes the cached data of all the roles, such as the actions they grant.
+	InvalidateRoles(ctx context.Context)
+}
+
+func (d DefaultRoleUseCase) invalidateCache(ctx context.Context) {
+	if d.cacheInvalidation != nil {
+		d.cacheInvalidation.InvalidateRoles(ctx)
+	}
+}
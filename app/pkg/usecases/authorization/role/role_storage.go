@@ -1,19 +1,77 @@
 package role
 
-import "context"
+import (
+	"context"
 
-// RoleStorage defines the access methods to the Role storage.
-type RoleStorage interface {
-	// ListRoles fetches a collection of Role based on the ListRolesInput.
-	ListRoles(ctx context.Context, input ListRolesInput) (*ListRolesOutput, error)
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+)
+
+// BuiltInStorage defines the access methods to the built-in roles, permissions and actions, which are read-only.
+type BuiltInStorage interface {
+	// ListBuiltInRoles fetches the built-in Role collection.
+	ListBuiltInRoles(ctx context.Context, input ListBuiltInRolesInput) (*ListBuiltInRolesOutput, error)
+	// ListBuiltInPermissions fetches the built-in Permission collection.
+	ListBuiltInPermissions(ctx context.Context, input ListBuiltInPermissionsInput) (*ListBuiltInPermissionsOutput, error)
+	// ListActions fetches all the actions that can be granted by a Permission.
+	ListActions(ctx context.Context, input ListActionsInput) (*ListActionsOutput, error)
 }
 
-// ListRolesInput are the attributes to fetch the collection of Role.
-type ListRolesInput struct {
+// ListBuiltInRolesInput are the attributes to fetch the built-in collection of Role.
+type ListBuiltInRolesInput struct {
 }
 
-// ListRolesOutput is the collection of Role in the storage.
-type ListRolesOutput struct {
+// ListBuiltInRolesOutput is the built-in collection of Role.
+type ListBuiltInRolesOutput struct {
 	// Roles is the array of Role.
 	Roles []Role
 }
+
+// ListBuiltInPermissionsInput are the attributes to fetch the built-in collection of Permission.
+type ListBuiltInPermissionsInput struct {
+}
+
+// ListBuiltInPermissionsOutput is the built-in collection of Permission.
+type ListBuiltInPermissionsOutput struct {
+	// Permissions is the array of Permission.
+	Permissions []Permission
+}
+
+// ListActionsInput are the attributes to fetch the actions.
+type ListActionsInput struct {
+}
+
+// ListActionsOutput is the collection of actions.
+type ListActionsOutput struct {
+	// Actions is the array of actions.
+	Actions []string
+}
+
+// RoleStorage defines the functionality to interact with the custom Role resources in storage.
+type RoleStorage interface {
+	// Add a Role in storage.
+	Add(ctx context.Context, data Role) (*Role, error)
+	// Get a Role from storage.
+	Get(ctx context.Context, id entities.StandardID) (*Role, error)
+	// Edit a Role in storage.
+	Edit(ctx context.Context, data Role) (*Role, error)
+	// Remove a Role from storage.
+	Remove(ctx context.Context, id entities.StandardID) (*Role, error)
+	// All Role resources in storage.
+	All(ctx context.Context) ([]Role, error)
+	// Assigned checks whether the Role is assigned to any user.
+	Assigned(ctx context.Context, id entities.StandardID) (bool, error)
+}
+
+// PermissionStorage defines the functionality to interact with the custom Permission resources in storage.
+type PermissionStorage interface {
+	// Add a Permission in storage.
+	Add(ctx context.Context, data Permission) (*Permission, error)
+	// Get a Permission from storage.
+	Get(ctx context.Context, id entities.StandardID) (*Permission, error)
+	// Edit a Permission in storage.
+	Edit(ctx context.Context, data Permission) (*Permission, error)
+	// Remove a Permission from storage.
+	Remove(ctx context.Context, id entities.StandardID) (*Permission, error)
+	// All Permission resources in storage.
+	All(ctx context.Context) ([]Permission, error)
+}
@@ -2,20 +2,54 @@ package role
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/hyperledger-labs/signare/app/pkg/commons/rbacrules"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+
+	"github.com/asaskevich/govalidator"
 )
 
+// idRegexp matches the identifiers of the custom roles and permissions, which are lowercase alphanumeric words
+// separated by hyphens, e.g. 'read-only-auditor'.
+var idRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 // RoleUseCase the set of use cases for the Roles.
 type RoleUseCase interface {
-	// GetSupportedRoles fetches the list of supported roles for the signare users.
+	// GetSupportedRoles fetches the list of supported roles for the signare users, both built-in and custom.
 	GetSupportedRoles(ctx context.Context, input GetSupportedRolesInput) (*GetSupportedRolesOutput, error)
+	// CreateRole creates a custom Role. It returns an error if any of its permissions doesn't exist.
+	CreateRole(ctx context.Context, input CreateRoleInput) (*CreateRoleOutput, error)
+	// GetRole gets a built-in or a custom Role.
+	GetRole(ctx context.Context, input GetRoleInput) (*GetRoleOutput, error)
+	// ListRoles lists the built-in and the custom roles.
+	ListRoles(ctx context.Context, input ListRolesInput) (*ListRolesOutput, error)
+	// EditRole edits a custom Role. Built-in roles can't be edited.
+	EditRole(ctx context.Context, input EditRoleInput) (*EditRoleOutput, error)
+	// RemoveRole removes a custom Role that is not assigned to any user. Built-in roles can't be removed.
+	RemoveRole(ctx context.Context, input RemoveRoleInput) (*RemoveRoleOutput, error)
+	// CreatePermission creates a custom Permission. It returns an error if any of its actions doesn't exist.
+	CreatePermission(ctx context.Context, input CreatePermissionInput) (*CreatePermissionOutput, error)
+	// GetPermission gets a built-in or a custom Permission.
+	GetPermission(ctx context.Context, input GetPermissionInput) (*GetPermissionOutput, error)
+	// ListPermissions lists the built-in and the custom permissions.
+	ListPermissions(ctx context.Context, input ListPermissionsInput) (*ListPermissionsOutput, error)
+	// EditPermission edits a custom Permission. Built-in permissions can't be edited.
+	EditPermission(ctx context.Context, input EditPermissionInput) (*EditPermissionOutput, error)
+	// RemovePermission removes a custom Permission that is not granted by any Role. Built-in permissions can't be removed.
+	RemovePermission(ctx context.Context, input RemovePermissionInput) (*RemovePermissionOutput, error)
+	// ListRoleActions lists the actions granted by the permissions of a Role. It returns an invalid argument error if the
+	// Role doesn't exist.
+	ListRoleActions(ctx context.Context, input ListRoleActionsInput) (*ListRoleActionsOutput, error)
+	// ListActions lists all the actions that can be granted by a Permission.
+	ListActions(ctx context.Context, input ListActionsInput) (*ListActionsOutput, error)
 }
 
 // GetSupportedRoles fetches the list of supported roles for the signare users.
 func (d DefaultRoleUseCase) GetSupportedRoles(ctx context.Context, _ GetSupportedRolesInput) (*GetSupportedRolesOutput, error) {
-	listRolesInput := ListRolesInput{}
-	listRolesOutput, listRolesErr := d.roleStorage.ListRoles(ctx, listRolesInput)
+	listRolesOutput, listRolesErr := d.ListRoles(ctx, ListRolesInput{})
 	if listRolesErr != nil {
 		return nil, listRolesErr
 	}
@@ -25,26 +59,540 @@ func (d DefaultRoleUseCase) GetSupportedRoles(ctx context.Context, _ GetSupporte
 	}, nil
 }
 
+func (d DefaultRoleUseCase) CreateRole(ctx context.Context, input CreateRoleInput) (*CreateRoleOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	err = validateID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := definitions.findRole(input.ID); exists {
+		return nil, errors.AlreadyExists().SetHumanReadableMessage("role [%s] already exists", input.ID)
+	}
+
+	now := time.Now()
+	data := Role{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: input.StandardID,
+				Timestamps: entities.Timestamps{
+					CreationDate: now,
+					LastUpdate:   now,
+				},
+			},
+		},
+		Description: input.Description,
+		Permissions: input.Permissions,
+	}
+	definitions.roles = append(definitions.roles, data)
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := d.roleStorage.Add(ctx, data)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, errors.AlreadyExistsFromErr(err).SetHumanReadableMessage("role [%s] already exists", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &CreateRoleOutput{
+		Role: *role,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) GetRole(ctx context.Context, input GetRoleInput) (*GetRoleOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	builtInRoles, err := d.builtInStorage.ListBuiltInRoles(ctx, ListBuiltInRolesInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, builtInRole := range builtInRoles.Roles {
+		if builtInRole.ID == input.ID {
+			return &GetRoleOutput{
+				Role: builtInRole,
+			}, nil
+		}
+	}
+
+	role, err := d.roleStorage.Get(ctx, input.StandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("role [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+	return &GetRoleOutput{
+		Role: *role,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) ListRoles(ctx context.Context, _ ListRolesInput) (*ListRolesOutput, error) {
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListRolesOutput{
+		Roles: definitions.roles,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) EditRole(ctx context.Context, input EditRoleInput) (*EditRoleOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, exists := definitions.findRole(input.ID)
+	if !exists {
+		return nil, errors.NotFound().SetHumanReadableMessage("role [%s] not found", input.ID)
+	}
+	if definitions.roles[index].BuiltIn {
+		return nil, errors.PreconditionFailed().SetHumanReadableMessage("role [%s] is built-in and can't be edited", input.ID)
+	}
+
+	data := Role{
+		StandardResourceMeta: input.StandardResourceMeta,
+		Description:          input.Description,
+		Permissions:          input.Permissions,
+	}
+	data.LastUpdate = time.Now()
+	definitions.roles[index] = data
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := d.roleStorage.Edit(ctx, data)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("role [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	d.invalidateCache(ctx)
+
+	return &EditRoleOutput{
+		Role: *role,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) RemoveRole(ctx context.Context, input RemoveRoleInput) (*RemoveRoleOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, exists := definitions.findRole(input.ID)
+	if !exists {
+		return nil, errors.NotFound().SetHumanReadableMessage("role [%s] not found", input.ID)
+	}
+	if definitions.roles[index].BuiltIn {
+		return nil, errors.PreconditionFailed().SetHumanReadableMessage("role [%s] is built-in and can't be removed", input.ID)
+	}
+
+	assigned, err := d.roleStorage.Assigned(ctx, input.StandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	if assigned {
+		return nil, errors.PreconditionFailed().SetHumanReadableMessage("role [%s] is assigned to users and can't be removed", input.ID)
+	}
+
+	definitions.roles = append(definitions.roles[:index], definitions.roles[index+1:]...)
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := d.roleStorage.Remove(ctx, input.StandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("role [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	d.invalidateCache(ctx)
+
+	return &RemoveRoleOutput{
+		Role: *role,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) CreatePermission(ctx context.Context, input CreatePermissionInput) (*CreatePermissionOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	err = validateID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := definitions.findPermission(input.ID); exists {
+		return nil, errors.AlreadyExists().SetHumanReadableMessage("permission [%s] already exists", input.ID)
+	}
+
+	now := time.Now()
+	data := Permission{
+		StandardResourceMeta: entities.StandardResourceMeta{
+			StandardResource: entities.StandardResource{
+				StandardID: input.StandardID,
+				Timestamps: entities.Timestamps{
+					CreationDate: now,
+					LastUpdate:   now,
+				},
+			},
+		},
+		Description: input.Description,
+		Actions:     input.Actions,
+	}
+	definitions.permissions = append(definitions.permissions, data)
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	permission, err := d.permissionStorage.Add(ctx, data)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, errors.AlreadyExistsFromErr(err).SetHumanReadableMessage("permission [%s] already exists", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &CreatePermissionOutput{
+		Permission: *permission,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) GetPermission(ctx context.Context, input GetPermissionInput) (*GetPermissionOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	builtInPermissions, err := d.builtInStorage.ListBuiltInPermissions(ctx, ListBuiltInPermissionsInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, builtInPermission := range builtInPermissions.Permissions {
+		if builtInPermission.ID == input.ID {
+			return &GetPermissionOutput{
+				Permission: builtInPermission,
+			}, nil
+		}
+	}
+
+	permission, err := d.permissionStorage.Get(ctx, input.StandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("permission [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+	return &GetPermissionOutput{
+		Permission: *permission,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) ListPermissions(ctx context.Context, _ ListPermissionsInput) (*ListPermissionsOutput, error) {
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListPermissionsOutput{
+		Permissions: definitions.permissions,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) EditPermission(ctx context.Context, input EditPermissionInput) (*EditPermissionOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, exists := definitions.findPermission(input.ID)
+	if !exists {
+		return nil, errors.NotFound().SetHumanReadableMessage("permission [%s] not found", input.ID)
+	}
+	if definitions.permissions[index].BuiltIn {
+		return nil, errors.PreconditionFailed().SetHumanReadableMessage("permission [%s] is built-in and can't be edited", input.ID)
+	}
+
+	data := Permission{
+		StandardResourceMeta: input.StandardResourceMeta,
+		Description:          input.Description,
+		Actions:              input.Actions,
+	}
+	data.LastUpdate = time.Now()
+	definitions.permissions[index] = data
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	permission, err := d.permissionStorage.Edit(ctx, data)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("permission [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	d.invalidateCache(ctx)
+
+	return &EditPermissionOutput{
+		Permission: *permission,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) RemovePermission(ctx context.Context, input RemovePermissionInput) (*RemovePermissionOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, exists := definitions.findPermission(input.ID)
+	if !exists {
+		return nil, errors.NotFound().SetHumanReadableMessage("permission [%s] not found", input.ID)
+	}
+	if definitions.permissions[index].BuiltIn {
+		return nil, errors.PreconditionFailed().SetHumanReadableMessage("permission [%s] is built-in and can't be removed", input.ID)
+	}
+	for _, role := range definitions.roles {
+		for _, permission := range role.Permissions {
+			if permission == input.ID {
+				return nil, errors.PreconditionFailed().SetHumanReadableMessage("permission [%s] is granted by role [%s] and can't be removed", input.ID, role.ID)
+			}
+		}
+	}
+
+	definitions.permissions = append(definitions.permissions[:index], definitions.permissions[index+1:]...)
+	err = definitions.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	permission, err := d.permissionStorage.Remove(ctx, input.StandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("permission [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	d.invalidateCache(ctx)
+
+	return &RemovePermissionOutput{
+		Permission: *permission,
+	}, nil
+}
+
+func (d DefaultRoleUseCase) ListRoleActions(ctx context.Context, input ListRoleActionsInput) (*ListRoleActionsOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	definitions, err := d.loadRBAC(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, exists := definitions.findRole(input.RoleID)
+	if !exists {
+		return nil, errors.InvalidArgument().WithMessage("role %s does not exist", input.RoleID)
+	}
+
+	actions := make([]string, 0)
+	for _, permissionID := range definitions.roles[index].Permissions {
+		permissionIndex, permissionExists := definitions.findPermission(permissionID)
+		if !permissionExists {
+			return nil, errors.Internal().WithMessage("role '%s' points to a permission '%s' that does not exist", input.RoleID, permissionID)
+		}
+		actions = append(actions, definitions.permissions[permissionIndex].Actions...)
+	}
+
+	return &ListRoleActionsOutput{
+		Actions: actions,
+	}, nil
+}
+
+// ListActions lists all the actions that can be granted by a Permission.
+func (d DefaultRoleUseCase) ListActions(ctx context.Context, input ListActionsInput) (*ListActionsOutput, error) {
+	return d.builtInStorage.ListActions(ctx, input)
+}
+
+// rbac is the whole set of roles, permissions and actions, both built-in and custom.
+type rbac struct {
+	roles       []Role
+	permissions []Permission
+	actions     []string
+}
+
+// loadRBAC reads the built-in roles, permissions and actions and the custom roles and permissions.
+func (d DefaultRoleUseCase) loadRBAC(ctx context.Context) (*rbac, error) {
+	builtInRoles, err := d.builtInStorage.ListBuiltInRoles(ctx, ListBuiltInRolesInput{})
+	if err != nil {
+		return nil, err
+	}
+	builtInPermissions, err := d.builtInStorage.ListBuiltInPermissions(ctx, ListBuiltInPermissionsInput{})
+	if err != nil {
+		return nil, err
+	}
+	actions, err := d.builtInStorage.ListActions(ctx, ListActionsInput{})
+	if err != nil {
+		return nil, err
+	}
+	customRoles, err := d.roleStorage.All(ctx)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	customPermissions, err := d.permissionStorage.All(ctx)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &rbac{
+		roles:       append(append(make([]Role, 0), builtInRoles.Roles...), customRoles...),
+		permissions: append(append(make([]Permission, 0), builtInPermissions.Permissions...), customPermissions...),
+		actions:     actions.Actions,
+	}, nil
+}
+
+// findRole returns the index of the Role with the given ID.
+func (r *rbac) findRole(id string) (int, bool) {
+	for index, role := range r.roles {
+		if role.ID == id {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+// findPermission returns the index of the Permission with the given ID.
+func (r *rbac) findPermission(id string) (int, bool) {
+	for index, permission := range r.permissions {
+		if permission.ID == id {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
+// validate checks that every custom and built-in Role and Permission grants something and the rules shared with the
+// RBAC validator tool.
+func (r *rbac) validate() error {
+	permissions := make([]rbacrules.Permission, len(r.permissions))
+	for i, permission := range r.permissions {
+		if len(permission.Actions) == 0 {
+			return errors.InvalidArgument().SetHumanReadableMessage("permission '%s' doesn't grant any action", permission.ID)
+		}
+		permissions[i] = rbacrules.Permission{
+			ID:      permission.ID,
+			Actions: permission.Actions,
+		}
+	}
+	roles := make([]rbacrules.Role, len(r.roles))
+	for i, role := range r.roles {
+		if len(role.Permissions) == 0 {
+			return errors.InvalidArgument().SetHumanReadableMessage("role '%s' doesn't grant any permission", role.ID)
+		}
+		roles[i] = rbacrules.Role{
+			ID:          role.ID,
+			Permissions: role.Permissions,
+		}
+	}
+
+	err := rbacrules.Validate(roles, permissions, r.actions)
+	if err != nil {
+		return errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("%s", err.Error())
+	}
+	return nil
+}
+
+// validateID checks that the identifier of a custom Role or Permission is made of lowercase alphanumeric words
+// separated by hyphens.
+func validateID(id string) error {
+	if !idRegexp.MatchString(id) {
+		return errors.InvalidArgument().SetHumanReadableMessage("identifier '%s' must be made of lowercase alphanumeric words separated by hyphens", id)
+	}
+	return nil
+}
+
 var _ RoleUseCase = new(DefaultRoleUseCase)
 
 // DefaultRoleUseCaseOptions are the set of fields to create an DefaultRoleUseCase.
 type DefaultRoleUseCaseOptions struct {
-	// RoleStorage defines the access methods to the Role storage
+	// BuiltInStorage defines the access methods to the built-in roles, permissions and actions
+	BuiltInStorage BuiltInStorage
+	// RoleStorage defines the access methods to the custom Role storage
 	RoleStorage RoleStorage
+	// PermissionStorage defines the access methods to the custom Permission storage
+	PermissionStorage PermissionStorage
+	// CacheInvalidation invalidates the cached actions of the roles when the roles or the permissions change. Optional
+	CacheInvalidation CacheInvalidationPort
 }
 
 // DefaultRoleUseCase is the struct that implements the RoleUseCase for the RBAC.
 type DefaultRoleUseCase struct {
-	roleStorage RoleStorage
+	builtInStorage    BuiltInStorage
+	roleStorage       RoleStorage
+	permissionStorage PermissionStorage
+	cacheInvalidation CacheInvalidationPort
 }
 
 // ProvideDefaultRoleUseCase provides an instance of an DefaultRoleUseCase.
 func ProvideDefaultRoleUseCase(options DefaultRoleUseCaseOptions) (*DefaultRoleUseCase, error) {
+	if options.BuiltInStorage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'BuiltInStorage' not provided")
+	}
 	if options.RoleStorage == nil {
 		return nil, errors.Internal().WithMessage("mandatory 'RoleStorage' not provided")
 	}
+	if options.PermissionStorage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'PermissionStorage' not provided")
+	}
 
 	return &DefaultRoleUseCase{
-		roleStorage: options.RoleStorage,
+		builtInStorage:    options.BuiltInStorage,
+		roleStorage:       options.RoleStorage,
+		permissionStorage: options.PermissionStorage,
+		cacheInvalidation: options.CacheInvalidation,
 	}, nil
 }
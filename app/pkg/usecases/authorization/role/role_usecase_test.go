@@ -8,8 +8,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/infile/roleinfile"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/permissiondbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/roledbout"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/authorization/role"
 	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
 )
@@ -34,9 +38,21 @@ func TestProvideDefaultRoleUseCase(t *testing.T) {
 		require.Nil(t, defaultUseCase)
 	})
 
+	t.Run("nil role storage", func(t *testing.T) {
+		options := role.DefaultRoleUseCaseOptions{
+			BuiltInStorage:    &roleinfile.DefaultRoleStorageInFile{},
+			PermissionStorage: &permissiondbout.Repository{},
+		}
+		defaultUseCase, err := role.ProvideDefaultRoleUseCase(options)
+		require.Error(t, err)
+		require.Nil(t, defaultUseCase)
+	})
+
 	t.Run("success", func(t *testing.T) {
 		options := role.DefaultRoleUseCaseOptions{
-			RoleStorage: &roleinfile.DefaultRoleStorageInFile{},
+			BuiltInStorage:    &roleinfile.DefaultRoleStorageInFile{},
+			RoleStorage:       &roledbout.Repository{},
+			PermissionStorage: &permissiondbout.Repository{},
 		}
 		defaultUseCase, err := role.ProvideDefaultRoleUseCase(options)
 		require.NoError(t, err)
@@ -53,3 +69,216 @@ func TestProvideDefaultRoleUseCase_CreateHSM(t *testing.T) {
 		require.NotNil(t, getSupportedRolesOutput)
 	})
 }
+
+func TestDefaultRoleUseCase_Permissions(t *testing.T) {
+	ctx := context.Background()
+	description := "List the users"
+
+	t.Run("create, edit and remove a custom permission", func(t *testing.T) {
+		createOutput, err := app.RoleUseCase.CreatePermission(ctx, role.CreatePermissionInput{
+			StandardID: entities.StandardID{
+				ID: "allow-list-users",
+			},
+			Description: &description,
+			Actions:     []string{"admin.users.list"},
+		})
+		require.NoError(t, err)
+		require.False(t, createOutput.BuiltIn)
+		require.Equal(t, []string{"admin.users.list"}, createOutput.Actions)
+		require.Equal(t, description, *createOutput.Description)
+
+		getOutput, err := app.RoleUseCase.GetPermission(ctx, role.GetPermissionInput{
+			StandardID: createOutput.StandardID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, createOutput.ResourceVersion, getOutput.ResourceVersion)
+
+		listOutput, err := app.RoleUseCase.ListPermissions(ctx, role.ListPermissionsInput{})
+		require.NoError(t, err)
+		require.Equal(t, "allow-list-users", listOutput.Permissions[len(listOutput.Permissions)-1].ID)
+
+		editOutput, err := app.RoleUseCase.EditPermission(ctx, role.EditPermissionInput{
+			StandardResourceMeta: createOutput.StandardResourceMeta,
+			Actions:              []string{"admin.users.list", "admin.users.describe"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"admin.users.list", "admin.users.describe"}, editOutput.Actions)
+		require.NotEqual(t, createOutput.ResourceVersion, editOutput.ResourceVersion)
+
+		removeOutput, err := app.RoleUseCase.RemovePermission(ctx, role.RemovePermissionInput{
+			StandardID: createOutput.StandardID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "allow-list-users", removeOutput.ID)
+
+		_, err = app.RoleUseCase.GetPermission(ctx, role.GetPermissionInput{
+			StandardID: createOutput.StandardID,
+		})
+		require.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("get a built-in permission", func(t *testing.T) {
+		output, err := app.RoleUseCase.GetPermission(ctx, role.GetPermissionInput{
+			StandardID: entities.StandardID{
+				ID: "allow-admin-actions",
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, output.BuiltIn)
+	})
+
+	t.Run("action doesn't exist", func(t *testing.T) {
+		_, err := app.RoleUseCase.CreatePermission(ctx, role.CreatePermissionInput{
+			StandardID: entities.StandardID{
+				ID: "allow-unknown",
+			},
+			Actions: []string{"admin.unknown.list"},
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+
+	t.Run("invalid identifier", func(t *testing.T) {
+		_, err := app.RoleUseCase.CreatePermission(ctx, role.CreatePermissionInput{
+			StandardID: entities.StandardID{
+				ID: "Allow_List",
+			},
+			Actions: []string{"admin.users.list"},
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+
+	t.Run("built-in permission already exists", func(t *testing.T) {
+		_, err := app.RoleUseCase.CreatePermission(ctx, role.CreatePermissionInput{
+			StandardID: entities.StandardID{
+				ID: "allow-admin-actions",
+			},
+			Actions: []string{"admin.users.list"},
+		})
+		require.True(t, errors.IsAlreadyExists(err))
+	})
+
+	t.Run("built-in permission can't be edited", func(t *testing.T) {
+		_, err := app.RoleUseCase.EditPermission(ctx, role.EditPermissionInput{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: entities.StandardID{
+						ID: "allow-admin-actions",
+					},
+				},
+			},
+			Actions: []string{"admin.users.list"},
+		})
+		require.True(t, errors.IsPreconditionFailed(err))
+	})
+
+	t.Run("built-in permission can't be removed", func(t *testing.T) {
+		_, err := app.RoleUseCase.RemovePermission(ctx, role.RemovePermissionInput{
+			StandardID: entities.StandardID{
+				ID: "allow-admin-actions",
+			},
+		})
+		require.True(t, errors.IsPreconditionFailed(err))
+	})
+}
+
+func TestDefaultRoleUseCase_Roles(t *testing.T) {
+	ctx := context.Background()
+
+	permission, err := app.RoleUseCase.CreatePermission(ctx, role.CreatePermissionInput{
+		StandardID: entities.StandardID{
+			ID: "allow-describe-users",
+		},
+		Actions: []string{"admin.users.describe", "admin.users.list"},
+	})
+	require.NoError(t, err)
+
+	t.Run("create, edit and remove a custom role", func(t *testing.T) {
+		createOutput, err := app.RoleUseCase.CreateRole(ctx, role.CreateRoleInput{
+			StandardID: entities.StandardID{
+				ID: "users-auditor",
+			},
+			Permissions: []string{permission.ID},
+		})
+		require.NoError(t, err)
+		require.False(t, createOutput.BuiltIn)
+
+		actionsOutput, err := app.RoleUseCase.ListRoleActions(ctx, role.ListRoleActionsInput{
+			RoleID: "users-auditor",
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"admin.users.describe", "admin.users.list"}, actionsOutput.Actions)
+
+		supportedRoles, err := app.RoleUseCase.GetSupportedRoles(ctx, role.GetSupportedRolesInput{})
+		require.NoError(t, err)
+		require.Equal(t, "users-auditor", supportedRoles.Roles[len(supportedRoles.Roles)-1].ID)
+
+		_, err = app.RoleUseCase.RemovePermission(ctx, role.RemovePermissionInput{
+			StandardID: permission.StandardID,
+		})
+		require.True(t, errors.IsPreconditionFailed(err))
+
+		editOutput, err := app.RoleUseCase.EditRole(ctx, role.EditRoleInput{
+			StandardResourceMeta: createOutput.StandardResourceMeta,
+			Permissions:          []string{"allow-application-admin-actions", permission.ID},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"allow-application-admin-actions", permission.ID}, editOutput.Permissions)
+
+		_, err = app.RoleUseCase.RemoveRole(ctx, role.RemoveRoleInput{
+			StandardID: createOutput.StandardID,
+		})
+		require.NoError(t, err)
+
+		_, err = app.RoleUseCase.ListRoleActions(ctx, role.ListRoleActionsInput{
+			RoleID: "users-auditor",
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+
+		_, err = app.RoleUseCase.RemovePermission(ctx, role.RemovePermissionInput{
+			StandardID: permission.StandardID,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("permission doesn't exist", func(t *testing.T) {
+		_, err := app.RoleUseCase.CreateRole(ctx, role.CreateRoleInput{
+			StandardID: entities.StandardID{
+				ID: "unknown-role",
+			},
+			Permissions: []string{"allow-unknown-actions"},
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+
+	t.Run("built-in role can't be edited", func(t *testing.T) {
+		_, err := app.RoleUseCase.EditRole(ctx, role.EditRoleInput{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: entities.StandardID{
+						ID: "signer-admin",
+					},
+				},
+			},
+			Permissions: []string{"allow-admin-actions"},
+		})
+		require.True(t, errors.IsPreconditionFailed(err))
+	})
+
+	t.Run("built-in role can't be removed", func(t *testing.T) {
+		_, err := app.RoleUseCase.RemoveRole(ctx, role.RemoveRoleInput{
+			StandardID: entities.StandardID{
+				ID: "signer-admin",
+			},
+		})
+		require.True(t, errors.IsPreconditionFailed(err))
+	})
+
+	t.Run("role not found", func(t *testing.T) {
+		_, err := app.RoleUseCase.GetRole(ctx, role.GetRoleInput{
+			StandardID: entities.StandardID{
+				ID: "unknown-role",
+			},
+		})
+		require.True(t, errors.IsNotFound(err))
+	})
+}
@@ -0,0 +1,251 @@
+package role
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
+)
+
+// GetSupportedRoles implements DefaultRoleUseCase's GetSupportedRoles to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetSupportedRoles(ctx context.Context, input GetSupportedRolesInput) (*GetSupportedRolesOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getSupportedRolesInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetSupportedRolesOutput), nil
+}
+
+// CreateRole implements DefaultRoleUseCase's CreateRole to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) CreateRole(ctx context.Context, input CreateRoleInput) (*CreateRoleOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.createRoleInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*CreateRoleOutput), nil
+}
+
+// GetRole implements DefaultRoleUseCase's GetRole to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetRole(ctx context.Context, input GetRoleInput) (*GetRoleOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getRoleInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetRoleOutput), nil
+}
+
+// ListRoles implements DefaultRoleUseCase's ListRoles to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ListRoles(ctx context.Context, input ListRolesInput) (*ListRolesOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.listRolesInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ListRolesOutput), nil
+}
+
+// EditRole implements DefaultRoleUseCase's EditRole to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) EditRole(ctx context.Context, input EditRoleInput) (*EditRoleOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.editRoleInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*EditRoleOutput), nil
+}
+
+// RemoveRole implements DefaultRoleUseCase's RemoveRole to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) RemoveRole(ctx context.Context, input RemoveRoleInput) (*RemoveRoleOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.removeRoleInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*RemoveRoleOutput), nil
+}
+
+// CreatePermission implements DefaultRoleUseCase's CreatePermission to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) CreatePermission(ctx context.Context, input CreatePermissionInput) (*CreatePermissionOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.createPermissionInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*CreatePermissionOutput), nil
+}
+
+// GetPermission implements DefaultRoleUseCase's GetPermission to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetPermission(ctx context.Context, input GetPermissionInput) (*GetPermissionOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getPermissionInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetPermissionOutput), nil
+}
+
+// ListPermissions implements DefaultRoleUseCase's ListPermissions to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ListPermissions(ctx context.Context, input ListPermissionsInput) (*ListPermissionsOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.listPermissionsInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ListPermissionsOutput), nil
+}
+
+// EditPermission implements DefaultRoleUseCase's EditPermission to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) EditPermission(ctx context.Context, input EditPermissionInput) (*EditPermissionOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.editPermissionInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*EditPermissionOutput), nil
+}
+
+// RemovePermission implements DefaultRoleUseCase's RemovePermission to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) RemovePermission(ctx context.Context, input RemovePermissionInput) (*RemovePermissionOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.removePermissionInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*RemovePermissionOutput), nil
+}
+
+// ListRoleActions implements DefaultRoleUseCase's ListRoleActions to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ListRoleActions(ctx context.Context, input ListRoleActionsInput) (*ListRoleActionsOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.listRoleActionsInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ListRoleActionsOutput), nil
+}
+
+// ListActions implements DefaultRoleUseCase's ListActions to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ListActions(ctx context.Context, input ListActionsInput) (*ListActionsOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.listActionsInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ListActionsOutput), nil
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getSupportedRolesInternal(_ context.Context, input GetSupportedRolesInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.GetSupportedRoles(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) createRoleInternal(_ context.Context, input CreateRoleInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.CreateRole(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getRoleInternal(_ context.Context, input GetRoleInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.GetRole(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) listRolesInternal(_ context.Context, input ListRolesInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.ListRoles(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) editRoleInternal(_ context.Context, input EditRoleInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.EditRole(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) removeRoleInternal(_ context.Context, input RemoveRoleInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.RemoveRole(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) createPermissionInternal(_ context.Context, input CreatePermissionInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.CreatePermission(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getPermissionInternal(_ context.Context, input GetPermissionInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.GetPermission(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) listPermissionsInternal(_ context.Context, input ListPermissionsInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.ListPermissions(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) editPermissionInternal(_ context.Context, input EditPermissionInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.EditPermission(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) removePermissionInternal(_ context.Context, input RemovePermissionInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.RemovePermission(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) listRoleActionsInternal(_ context.Context, input ListRoleActionsInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.ListRoleActions(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) listActionsInternal(_ context.Context, input ListActionsInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultRoleUseCase.ListActions(ctx2, input)
+	}
+}
+
+var _ RoleUseCase = new(DefaultUseCaseTransactionalDecorator)
+
+// DefaultUseCaseTransactionalDecorator decorates struct DefaultRoleUseCase wrapped with a transactional manager, so that
+// the RBAC read to validate a change and the change itself are done in the same transaction.
+type DefaultUseCaseTransactionalDecorator struct {
+	// DefaultRoleUseCase is the usecase to be decorated.
+	DefaultRoleUseCase
+	// transactionalManager defines the functionality to execute a transaction in a transactional manner.
+	transactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// DefaultUseCaseTransactionalDecoratorOptions is the structure representing the DefaultUseCaseTransactionalDecorator dependencies.
+type DefaultUseCaseTransactionalDecoratorOptions struct {
+	// DefaultRoleUseCase is the usecase to be decorated.
+	DefaultRoleUseCase *DefaultRoleUseCase
+	// TransactionalManager defines the functionality to execute a transaction in a transactional manner.
+	TransactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// ProvideDefaultUseCaseTransactionalDecorator creates a new DefaultUseCaseTransactionalDecorator.
+func ProvideDefaultUseCaseTransactionalDecorator(options DefaultUseCaseTransactionalDecoratorOptions) (*DefaultUseCaseTransactionalDecorator, error) {
+	if options.DefaultRoleUseCase == nil {
+		errorMessage := "'DefaultRoleUseCase' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	if options.TransactionalManager == nil {
+		errorMessage := "'TransactionalManager' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	return &DefaultUseCaseTransactionalDecorator{
+		DefaultRoleUseCase:   *options.DefaultRoleUseCase,
+		transactionalManager: options.TransactionalManager,
+	}, nil
+}
@@ -1,9 +1,31 @@
 package role
 
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+)
+
 // Role is the name of the definition of the collection of permissions.
 type Role struct {
-	// ID is the name of the Role.
-	ID string
+	// StandardResourceMeta identifies the Role. Built-in roles only have an ID.
+	entities.StandardResourceMeta
+	// Description of the Role.
+	Description *string
+	// Permissions granted by the Role.
+	Permissions []string
+	// BuiltIn is true if the Role is defined in the RBAC files of the signare, so it can't be edited or removed.
+	BuiltIn bool
+}
+
+// Permission is a collection of actions that are granted all at once by the roles.
+type Permission struct {
+	// StandardResourceMeta identifies the Permission. Built-in permissions only have an ID.
+	entities.StandardResourceMeta
+	// Description of the Permission.
+	Description *string
+	// Actions granted by the Permission.
+	Actions []string
+	// BuiltIn is true if the Permission is defined in the RBAC files of the signare, so it can't be edited or removed.
+	BuiltIn bool
 }
 
 // GetSupportedRolesInput are the attributes to fetch the supported collection of Role.
@@ -15,3 +37,139 @@ type GetSupportedRolesOutput struct {
 	// Roles group of roles
 	Roles []Role
 }
+
+// CreateRoleInput configures the creation of a Role.
+type CreateRoleInput struct {
+	// StandardID defines the identifier of the Role.
+	entities.StandardID
+	// Description of the Role.
+	Description *string `valid:"optional,length(0|256)"`
+	// Permissions granted by the Role.
+	Permissions []string `valid:"required"`
+}
+
+// CreateRoleOutput the created Role.
+type CreateRoleOutput struct {
+	Role
+}
+
+// GetRoleInput input to get a specific Role.
+type GetRoleInput struct {
+	// StandardID defines the identifier of the Role.
+	entities.StandardID
+}
+
+// GetRoleOutput the requested Role.
+type GetRoleOutput struct {
+	Role
+}
+
+// ListRolesInput defines the options to list the Role resources.
+type ListRolesInput struct {
+}
+
+// ListRolesOutput the built-in and the custom roles, in this order.
+type ListRolesOutput struct {
+	// Roles group of roles
+	Roles []Role
+}
+
+// EditRoleInput configures the update of a Role.
+type EditRoleInput struct {
+	// StandardResourceMeta identifies the Role and the version being edited.
+	entities.StandardResourceMeta
+	// Description of the Role.
+	Description *string `valid:"optional,length(0|256)"`
+	// Permissions granted by the Role.
+	Permissions []string `valid:"required"`
+}
+
+// EditRoleOutput the edited Role.
+type EditRoleOutput struct {
+	Role
+}
+
+// RemoveRoleInput identifies the Role to be removed.
+type RemoveRoleInput struct {
+	// StandardID defines the identifier of the Role.
+	entities.StandardID
+}
+
+// RemoveRoleOutput the removed Role.
+type RemoveRoleOutput struct {
+	Role
+}
+
+// CreatePermissionInput configures the creation of a Permission.
+type CreatePermissionInput struct {
+	// StandardID defines the identifier of the Permission.
+	entities.StandardID
+	// Description of the Permission.
+	Description *string `valid:"optional,length(0|256)"`
+	// Actions granted by the Permission.
+	Actions []string `valid:"required"`
+}
+
+// CreatePermissionOutput the created Permission.
+type CreatePermissionOutput struct {
+	Permission
+}
+
+// GetPermissionInput input to get a specific Permission.
+type GetPermissionInput struct {
+	// StandardID defines the identifier of the Permission.
+	entities.StandardID
+}
+
+// GetPermissionOutput the requested Permission.
+type GetPermissionOutput struct {
+	Permission
+}
+
+// ListPermissionsInput defines the options to list the Permission resources.
+type ListPermissionsInput struct {
+}
+
+// ListPermissionsOutput the built-in and the custom permissions, in this order.
+type ListPermissionsOutput struct {
+	// Permissions group of permissions
+	Permissions []Permission
+}
+
+// EditPermissionInput configures the update of a Permission.
+type EditPermissionInput struct {
+	// StandardResourceMeta identifies the Permission and the version being edited.
+	entities.StandardResourceMeta
+	// Description of the Permission.
+	Description *string `valid:"optional,length(0|256)"`
+	// Actions granted by the Permission.
+	Actions []string `valid:"required"`
+}
+
+// EditPermissionOutput the edited Permission.
+type EditPermissionOutput struct {
+	Permission
+}
+
+// RemovePermissionInput identifies the Permission to be removed.
+type RemovePermissionInput struct {
+	// StandardID defines the identifier of the Permission.
+	entities.StandardID
+}
+
+// RemovePermissionOutput the removed Permission.
+type RemovePermissionOutput struct {
+	Permission
+}
+
+// ListRoleActionsInput identifies the Role to list the actions of.
+type ListRoleActionsInput struct {
+	// RoleID is the identifier of the Role.
+	RoleID string `valid:"required"`
+}
+
+// ListRoleActionsOutput the actions granted by the permissions of a Role.
+type ListRoleActionsOutput struct {
+	// Actions granted by the Role.
+	Actions []string
+}
@@ -0,0 +1,15 @@
+package health
+
+import (
+	"context"
+)
+
+// HealthStorage defines the functionality to check the state of the storage.
+type HealthStorage interface {
+	// Ping checks that the storage is reachable.
+	Ping(ctx context.Context) error
+	// GetSchemaVersion returns the version of the schema of the storage and the version the signare expects.
+	GetSchemaVersion(ctx context.Context) (*SchemaVersion, error)
+	// UpgradeSchema upgrades the schema of the storage to the version the signare expects.
+	UpgradeSchema(ctx context.Context) error
+}
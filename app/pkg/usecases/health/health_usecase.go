@@ -0,0 +1,267 @@
+// Package health defines the checks that decide whether the signare is alive and ready to serve requests.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
+)
+
+// HealthUseCase defines the checks of the health of the signare.
+type HealthUseCase interface {
+	// CheckLiveness checks whether the signare is alive, i.e. whether it is responsive. It doesn't check the
+	// dependencies of the signare, so that an outage of any of them doesn't get the signare restarted.
+	CheckLiveness(ctx context.Context, input CheckLivenessInput) (*CheckLivenessOutput, error)
+	// CheckReadiness checks whether the signare is ready to serve requests, i.e. whether it can reach the database,
+	// the database schema has the expected version and the HSM slots were reachable in the last check of the HSM health
+	// monitor. It reports the signare as not ready once it is shutting down, without running the checks.
+	CheckReadiness(ctx context.Context, input CheckReadinessInput) (*CheckReadinessOutput, error)
+	// StartShutdown reports that the signare is shutting down, so that it is no longer ready to serve requests.
+	StartShutdown(ctx context.Context, input StartShutdownInput) (*StartShutdownOutput, error)
+	// CheckSchemaVersion checks that the database schema has the expected version when the signare starts. If it
+	// doesn't, it returns a precondition failed error, logs a warning or upgrades the schema depending on the configured
+	// SchemaVersionCheckMode.
+	CheckSchemaVersion(ctx context.Context, input CheckSchemaVersionInput) (*CheckSchemaVersionOutput, error)
+}
+
+func (u *DefaultUseCase) CheckLiveness(_ context.Context, _ CheckLivenessInput) (*CheckLivenessOutput, error) {
+	return &CheckLivenessOutput{
+		Health: newHealth(),
+	}, nil
+}
+
+func (u *DefaultUseCase) CheckReadiness(ctx context.Context, _ CheckReadinessInput) (*CheckReadinessOutput, error) {
+	if u.shuttingDown.Load() {
+		return &CheckReadinessOutput{
+			Health: newHealth(Check{
+				Name:   ShutdownCheck,
+				Status: StatusDown,
+				Detail: "the signare is shutting down",
+			}),
+		}, nil
+	}
+
+	databaseCheck := u.checkDatabase(ctx)
+	if databaseCheck.Status == StatusDown {
+		// the schema and the HSM slots can't be checked without the database
+		return &CheckReadinessOutput{
+			Health: newHealth(databaseCheck),
+		}, nil
+	}
+	return &CheckReadinessOutput{
+		Health: newHealth(databaseCheck, u.checkSchema(ctx), u.checkHSM(ctx)),
+	}, nil
+}
+
+func (u *DefaultUseCase) StartShutdown(ctx context.Context, _ StartShutdownInput) (*StartShutdownOutput, error) {
+	if !u.shuttingDown.Swap(true) {
+		logger.LogEntry(ctx).Info("signare is shutting down, it is no longer ready to serve requests")
+	}
+	return &StartShutdownOutput{}, nil
+}
+
+func (u *DefaultUseCase) CheckSchemaVersion(ctx context.Context, _ CheckSchemaVersionInput) (*CheckSchemaVersionOutput, error) {
+	version, err := u.storage.GetSchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	check := newSchemaCheck(*version)
+	if check.Status == StatusUp {
+		return &CheckSchemaVersionOutput{
+			Check: check,
+		}, nil
+	}
+
+	switch u.schemaVersionCheckMode {
+	case SchemaVersionCheckWarn:
+		logger.LogEntry(ctx).Warnf("%s, the signare is not ready to serve requests until the database schema has version %d", check.Detail, version.Expected)
+		return &CheckSchemaVersionOutput{
+			Check: check,
+		}, nil
+	case SchemaVersionCheckMigrate:
+		if version.Dirty || version.Current > version.Expected {
+			return nil, errors.PreconditionFailed().WithMessage("%s, it can't be upgraded automatically", check.Detail)
+		}
+		logger.LogEntry(ctx).Infof("upgrading the database schema from version %d to version %d", version.Current, version.Expected)
+		err = u.storage.UpgradeSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		version, err = u.storage.GetSchemaVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		check = newSchemaCheck(*version)
+		if check.Status == StatusDown {
+			return nil, errors.Internal().WithMessage("%s after upgrading it", check.Detail)
+		}
+		return &CheckSchemaVersionOutput{
+			Check:    check,
+			Upgraded: true,
+		}, nil
+	default:
+		return nil, errors.PreconditionFailed().WithMessage("%s", check.Detail)
+	}
+}
+
+func (u *DefaultUseCase) checkDatabase(ctx context.Context) Check {
+	err := u.storage.Ping(ctx)
+	if err != nil {
+		logger.LogEntry(ctx).Warnf("database is not reachable: %v", err)
+		return Check{
+			Name:   DatabaseCheck,
+			Status: StatusDown,
+			Detail: "the database is not reachable",
+		}
+	}
+	return Check{
+		Name:   DatabaseCheck,
+		Status: StatusUp,
+	}
+}
+
+func (u *DefaultUseCase) checkSchema(ctx context.Context) Check {
+	version, err := u.storage.GetSchemaVersion(ctx)
+	if err != nil {
+		logger.LogEntry(ctx).Warnf("error getting the version of the database schema: %v", err)
+		return Check{
+			Name:   SchemaCheck,
+			Status: StatusDown,
+			Detail: "the version of the database schema could not be read",
+		}
+	}
+	return newSchemaCheck(*version)
+}
+
+// newSchemaCheck returns the check of the given version of the database schema, which is up if it is the expected one.
+func newSchemaCheck(version SchemaVersion) Check {
+	check := Check{
+		Name:   SchemaCheck,
+		Status: StatusDown,
+	}
+	switch {
+	case version.Dirty:
+		check.Detail = fmt.Sprintf("the migration to version %d of the database schema didn't finish", version.Current)
+	case version.Current < version.Expected:
+		check.Detail = fmt.Sprintf("the database schema has version %d but version %d is expected, the database must be upgraded", version.Current, version.Expected)
+	case version.Current > version.Expected:
+		check.Detail = fmt.Sprintf("the database schema has version %d but version %d is expected, the signare must be upgraded", version.Current, version.Expected)
+	default:
+		check.Status = StatusUp
+		check.Detail = fmt.Sprintf("the database schema has version %d", version.Current)
+	}
+	return check
+}
+
+// checkHSM reads the health of the HSM slots as of the last check of the HSM health monitor, so that the probe neither
+// waits for the HSMs nor resets their modules. The HSMs are down only if none of the slots is reachable, since the
+// slots of the other applications can still be used to sign. Their health is unknown if the monitor is disabled or
+// has not checked them yet.
+func (u *DefaultUseCase) checkHSM(ctx context.Context) Check {
+	output, err := u.hsmHealthUseCase.GetHSMHealth(ctx, hsmhealth.GetHSMHealthInput{})
+	if err != nil {
+		logger.LogEntry(ctx).Warnf("error getting the health of the hsm slots: %v", err)
+		return Check{
+			Name:   HSMCheck,
+			Status: StatusDown,
+			Detail: "the health of the HSM slots could not be read",
+		}
+	}
+	if !output.Checked {
+		return Check{
+			Name:   HSMCheck,
+			Status: StatusUnknown,
+			Detail: "the HSM slots have not been checked by the HSM health monitor",
+		}
+	}
+
+	slots, slotsUp := 0, 0
+	for _, module := range output.Modules {
+		for _, slot := range module.Slots {
+			slots++
+			if slot.Up {
+				slotsUp++
+			}
+		}
+	}
+	if slots == 0 {
+		return Check{
+			Name:   HSMCheck,
+			Status: StatusUp,
+			Detail: "there are no HSM slots",
+		}
+	}
+	check := Check{
+		Name:   HSMCheck,
+		Status: StatusUp,
+		Detail: fmt.Sprintf("%d of %d HSM slots are reachable", slotsUp, slots),
+	}
+	if slotsUp == 0 {
+		check.Status = StatusDown
+	}
+	return check
+}
+
+// newHealth returns a Health that is up if none of the checks is down.
+func newHealth(checks ...Check) Health {
+	health := Health{
+		Status: StatusUp,
+		Checks: checks,
+	}
+	for _, check := range checks {
+		if check.Status == StatusDown {
+			health.Status = StatusDown
+		}
+	}
+	return health
+}
+
+var _ HealthUseCase = new(DefaultUseCase)
+
+// DefaultUseCase implements the HealthUseCase interface.
+type DefaultUseCase struct {
+	storage          HealthStorage
+	hsmHealthUseCase hsmhealth.HSMHealthUseCase
+	// schemaVersionCheckMode decides what to do if the database schema doesn't have the expected version on startup
+	schemaVersionCheckMode SchemaVersionCheckMode
+	// shuttingDown is true once the signare is shutting down
+	shuttingDown atomic.Bool
+}
+
+// DefaultUseCaseOptions are the set of fields to create a DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	// HealthStorage checks the state of the storage
+	HealthStorage HealthStorage
+	// HSMHealthUseCase provides the health of the HSM slots as of the last check
+	HSMHealthUseCase hsmhealth.HSMHealthUseCase
+	// SchemaVersionCheckMode decides what to do if the database schema doesn't have the expected version on startup.
+	// Default is SchemaVersionCheckRefuse
+	SchemaVersionCheckMode SchemaVersionCheckMode
+}
+
+// ProvideDefaultUseCase creates a DefaultUseCase with the given options.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.HealthStorage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'HealthStorage' not provided")
+	}
+	if options.HSMHealthUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'HSMHealthUseCase' not provided")
+	}
+	schemaVersionCheckMode := options.SchemaVersionCheckMode
+	switch schemaVersionCheckMode {
+	case "":
+		schemaVersionCheckMode = SchemaVersionCheckRefuse
+	case SchemaVersionCheckRefuse, SchemaVersionCheckWarn, SchemaVersionCheckMigrate:
+	default:
+		return nil, errors.InvalidArgument().WithMessage("invalid schema version check mode '%s'", schemaVersionCheckMode)
+	}
+	return &DefaultUseCase{
+		storage:                options.HealthStorage,
+		hsmHealthUseCase:       options.HSMHealthUseCase,
+		schemaVersionCheckMode: schemaVersionCheckMode,
+	}, nil
+}
@@ -0,0 +1,267 @@
+package health_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
+
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+func TestDefaultUseCase_CheckLiveness(t *testing.T) {
+	t.Run("success: alive", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{}, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckLiveness(ctx, health.CheckLivenessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.Empty(t, output.Checks)
+	})
+
+	t.Run("success: alive if the database is not reachable", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{pingErr: errors.Internal()}, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckLiveness(ctx, health.CheckLivenessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+	})
+}
+
+func TestDefaultUseCase_CheckReadiness(t *testing.T) {
+	t.Run("success: ready", func(t *testing.T) {
+		hsm := &hsmHealthUseCase{slots: []bool{true, false}}
+		useCase := newUseCase(t, &healthStorage{}, hsm)
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.Equal(t, []health.Check{
+			{Name: health.DatabaseCheck, Status: health.StatusUp},
+			{Name: health.SchemaCheck, Status: health.StatusUp, Detail: "the database schema has version 7"},
+			{Name: health.HSMCheck, Status: health.StatusUp, Detail: "1 of 2 HSM slots are reachable"},
+		}, output.Checks)
+		require.Equal(t, 1, hsm.reads)
+	})
+
+	t.Run("success: ready without HSM slots", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{}, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.Equal(t, health.Check{Name: health.HSMCheck, Status: health.StatusUp, Detail: "there are no HSM slots"}, output.Checks[2])
+	})
+
+	t.Run("success: ready if the HSM slots have not been checked", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{}, &hsmHealthUseCase{slots: []bool{false}, notChecked: true})
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.Equal(t, health.StatusUnknown, output.Checks[2].Status)
+	})
+
+	t.Run("success: not ready if no HSM slot is reachable", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{}, &hsmHealthUseCase{slots: []bool{false, false}})
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Equal(t, health.Check{Name: health.HSMCheck, Status: health.StatusDown, Detail: "0 of 2 HSM slots are reachable"}, output.Checks[2])
+	})
+
+	t.Run("success: not ready if the database schema is outdated", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{version: &health.SchemaVersion{Current: 6, Expected: 7}}, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Equal(t, "the database schema has version 6 but version 7 is expected, the database must be upgraded", output.Checks[1].Detail)
+	})
+
+	t.Run("success: not ready if the database schema is dirty", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{version: &health.SchemaVersion{Current: 7, Dirty: true, Expected: 7}}, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Equal(t, "the migration to version 7 of the database schema didn't finish", output.Checks[1].Detail)
+	})
+
+	t.Run("success: not ready if the database is not reachable", func(t *testing.T) {
+		hsm := &hsmHealthUseCase{}
+		useCase := newUseCase(t, &healthStorage{pingErr: errors.Internal()}, hsm)
+
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Len(t, output.Checks, 1)
+		require.Equal(t, 0, hsm.reads)
+	})
+
+	t.Run("success: not ready once shutting down", func(t *testing.T) {
+		hsm := &hsmHealthUseCase{}
+		useCase := newUseCase(t, &healthStorage{}, hsm)
+
+		_, err := useCase.StartShutdown(ctx, health.StartShutdownInput{})
+		require.NoError(t, err)
+		output, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Equal(t, []health.Check{
+			{Name: health.ShutdownCheck, Status: health.StatusDown, Detail: "the signare is shutting down"},
+		}, output.Checks)
+		require.Equal(t, 0, hsm.reads)
+
+		liveness, err := useCase.CheckLiveness(ctx, health.CheckLivenessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, liveness.Status)
+	})
+}
+
+func TestDefaultUseCase_CheckSchemaVersion(t *testing.T) {
+	t.Run("success: expected version", func(t *testing.T) {
+		storage := &healthStorage{}
+		useCase := newUseCase(t, storage, &hsmHealthUseCase{})
+
+		output, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.False(t, output.Upgraded)
+		require.Equal(t, 0, storage.upgrades)
+	})
+
+	t.Run("failure: refuse an outdated version", func(t *testing.T) {
+		useCase := newUseCase(t, &healthStorage{version: &health.SchemaVersion{Current: 6, Expected: 7}}, &hsmHealthUseCase{})
+
+		_, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.True(t, errors.IsPreconditionFailed(err))
+	})
+
+	t.Run("success: warn about an outdated version", func(t *testing.T) {
+		storage := &healthStorage{version: &health.SchemaVersion{Current: 6, Expected: 7}}
+		useCase := newUseCaseWithMode(t, storage, health.SchemaVersionCheckWarn)
+
+		output, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, output.Status)
+		require.Equal(t, 0, storage.upgrades)
+
+		readiness, err := useCase.CheckReadiness(ctx, health.CheckReadinessInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusDown, readiness.Status)
+	})
+
+	t.Run("success: migrate an outdated version", func(t *testing.T) {
+		storage := &healthStorage{version: &health.SchemaVersion{Current: 6, Expected: 7}}
+		useCase := newUseCaseWithMode(t, storage, health.SchemaVersionCheckMigrate)
+
+		output, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.NoError(t, err)
+		require.Equal(t, health.StatusUp, output.Status)
+		require.True(t, output.Upgraded)
+		require.Equal(t, 1, storage.upgrades)
+	})
+
+	t.Run("failure: don't migrate a dirty version", func(t *testing.T) {
+		storage := &healthStorage{version: &health.SchemaVersion{Current: 6, Dirty: true, Expected: 7}}
+		useCase := newUseCaseWithMode(t, storage, health.SchemaVersionCheckMigrate)
+
+		_, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.True(t, errors.IsPreconditionFailed(err))
+		require.Equal(t, 0, storage.upgrades)
+	})
+
+	t.Run("failure: don't migrate a newer version", func(t *testing.T) {
+		storage := &healthStorage{version: &health.SchemaVersion{Current: 8, Expected: 7}}
+		useCase := newUseCaseWithMode(t, storage, health.SchemaVersionCheckMigrate)
+
+		_, err := useCase.CheckSchemaVersion(ctx, health.CheckSchemaVersionInput{})
+		require.True(t, errors.IsPreconditionFailed(err))
+		require.Equal(t, 0, storage.upgrades)
+	})
+
+	t.Run("failure: invalid mode", func(t *testing.T) {
+		_, err := health.ProvideDefaultUseCase(health.DefaultUseCaseOptions{
+			HealthStorage:          &healthStorage{},
+			HSMHealthUseCase:       &hsmHealthUseCase{},
+			SchemaVersionCheckMode: "ignore",
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+}
+
+func newUseCaseWithMode(t *testing.T, storage *healthStorage, mode health.SchemaVersionCheckMode) *health.DefaultUseCase {
+	useCase, err := health.ProvideDefaultUseCase(health.DefaultUseCaseOptions{
+		HealthStorage:          storage,
+		HSMHealthUseCase:       &hsmHealthUseCase{},
+		SchemaVersionCheckMode: mode,
+	})
+	require.NoError(t, err)
+	return useCase
+}
+
+func newUseCase(t *testing.T, storage *healthStorage, hsm *hsmHealthUseCase) *health.DefaultUseCase {
+	useCase, err := health.ProvideDefaultUseCase(health.DefaultUseCaseOptions{
+		HealthStorage:    storage,
+		HSMHealthUseCase: hsm,
+	})
+	require.NoError(t, err)
+	return useCase
+}
+
+type healthStorage struct {
+	pingErr  error
+	version  *health.SchemaVersion
+	upgrades int
+}
+
+func (s *healthStorage) Ping(_ context.Context) error {
+	return s.pingErr
+}
+
+func (s *healthStorage) GetSchemaVersion(_ context.Context) (*health.SchemaVersion, error) {
+	if s.version != nil {
+		return s.version, nil
+	}
+	return &health.SchemaVersion{
+		Current:  7,
+		Expected: 7,
+	}, nil
+}
+
+func (s *healthStorage) UpgradeSchema(_ context.Context) error {
+	s.upgrades++
+	s.version.Current = s.version.Expected
+	return nil
+}
+
+type hsmHealthUseCase struct {
+	hsmhealth.HSMHealthUseCase
+	// slots whether each slot is up
+	slots []bool
+	// notChecked is true if the HSM slots have not been checked
+	notChecked bool
+	reads      int
+}
+
+func (u *hsmHealthUseCase) GetHSMHealth(_ context.Context, _ hsmhealth.GetHSMHealthInput) (*hsmhealth.GetHSMHealthOutput, error) {
+	u.reads++
+	module := hsmhealth.ModuleHealth{
+		ModuleID: "module",
+	}
+	for _, up := range u.slots {
+		module.Slots = append(module.Slots, hsmhealth.SlotHealth{
+			Up: up,
+		})
+	}
+	return &hsmhealth.GetHSMHealthOutput{
+		Modules: []hsmhealth.ModuleHealth{module},
+		Checked: !u.notChecked,
+	}, nil
+}
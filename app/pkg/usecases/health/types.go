@@ -0,0 +1,104 @@
+package health
+
+// Status of the signare or of one of the dependencies it checks.
+type Status string
+
+const (
+	// StatusUp the signare or the dependency is working.
+	StatusUp Status = "up"
+	// StatusDown the signare or the dependency is not working.
+	StatusDown Status = "down"
+	// StatusUnknown the dependency has not been checked. It doesn't make the signare down.
+	StatusUnknown Status = "unknown"
+)
+
+const (
+	// DatabaseCheck name of the check of the connection with the database.
+	DatabaseCheck = "database"
+	// SchemaCheck name of the check of the version of the database schema.
+	SchemaCheck = "schema"
+	// HSMCheck name of the check of the reachability of the HSM slots.
+	HSMCheck = "hsm"
+	// ShutdownCheck name of the check that fails once the signare is shutting down.
+	ShutdownCheck = "shutdown"
+)
+
+// Check result of checking one of the dependencies of the signare.
+type Check struct {
+	// Name of the check.
+	Name string
+	// Status of the dependency.
+	Status Status
+	// Detail describes the result of the check, e.g. why the dependency is down.
+	Detail string
+}
+
+// Health of the signare, which is up if all its checks are up.
+type Health struct {
+	// Status of the signare.
+	Status Status
+	// Checks the checks that were run, in the order they were run.
+	Checks []Check
+}
+
+// SchemaVersion version of the schema of the storage.
+type SchemaVersion struct {
+	// Current version of the schema in the storage.
+	Current int
+	// Dirty is true if the last migration of the schema didn't finish.
+	Dirty bool
+	// Expected version of the schema, i.e. the latest one the signare knows.
+	Expected int
+}
+
+// SchemaVersionCheckMode decides what the signare does when it starts and the version of the database schema is not
+// the version it expects.
+type SchemaVersionCheckMode string
+
+const (
+	// SchemaVersionCheckRefuse the signare refuses to start.
+	SchemaVersionCheckRefuse SchemaVersionCheckMode = "refuse"
+	// SchemaVersionCheckWarn the signare starts and logs a warning. It is not ready to serve requests until the schema
+	// has the expected version.
+	SchemaVersionCheckWarn SchemaVersionCheckMode = "warn"
+	// SchemaVersionCheckMigrate the signare upgrades the schema before starting. It refuses to start if the schema can't
+	// be upgraded, i.e. if it is dirty or newer than the expected version.
+	SchemaVersionCheckMigrate SchemaVersionCheckMode = "migrate"
+)
+
+// CheckLivenessInput input to check whether the signare is alive.
+type CheckLivenessInput struct {
+}
+
+// CheckLivenessOutput health of the signare that decides whether it is alive. It has no checks.
+type CheckLivenessOutput struct {
+	Health
+}
+
+// CheckReadinessInput input to check whether the signare is ready to serve requests.
+type CheckReadinessInput struct {
+}
+
+// CheckReadinessOutput health of the signare that decides whether it is ready to serve requests.
+type CheckReadinessOutput struct {
+	Health
+}
+
+// StartShutdownInput input to report that the signare is shutting down.
+type StartShutdownInput struct {
+}
+
+// StartShutdownOutput output of reporting that the signare is shutting down.
+type StartShutdownOutput struct {
+}
+
+// CheckSchemaVersionInput input to check the version of the database schema when the signare starts.
+type CheckSchemaVersionInput struct {
+}
+
+// CheckSchemaVersionOutput result of checking the version of the database schema when the signare starts.
+type CheckSchemaVersionOutput struct {
+	Check
+	// Upgraded is true if the schema was upgraded by the check.
+	Upgraded bool
+}
@@ -0,0 +1,73 @@
+package hsmconnection
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+)
+
+const (
+	connectionCacheName  = "hsm_connections"
+	addressSlotCacheName = "hsm_address_slots"
+)
+
+// ConnectionCache caches the connections to the HSM slots of the applications, so that the application, its slots and
+// their modules are not read from the storage on every request. It must be invalidated when any of them changes. It
+// also caches the slot that holds each address, so that the slots are not listed on every request. It must be
+// invalidated when the address is enabled, disabled or removed.
+type ConnectionCache struct {
+	// connections of the slots of each application ordered by priority, by application ID
+	connections *cache.Cache[string, []slotConnection]
+	// addressSlots identifier of the slot that holds each address of each application
+	addressSlots *cache.Cache[addressSlotKey, string]
+}
+
+// addressSlotKey identifies an address of an application.
+type addressSlotKey struct {
+	applicationID string
+	address       address.Address
+}
+
+// slotConnection the connection to an HSM slot.
+type slotConnection struct {
+	// slotID identifier of the slot resource
+	slotID     string
+	connection HSMConnection
+}
+
+// InvalidateApplication removes the cached connections and address slots of the given application.
+func (c *ConnectionCache) InvalidateApplication(applicationID string) {
+	c.connections.Delete(applicationID)
+	c.addressSlots.DeleteFunc(func(key addressSlotKey) bool {
+		return key.applicationID == applicationID
+	})
+}
+
+// InvalidateAddress removes the cached slot that holds the given address of the given application.
+func (c *ConnectionCache) InvalidateAddress(applicationID string, addr address.Address) {
+	c.addressSlots.Delete(addressSlotKey{
+		applicationID: applicationID,
+		address:       addr,
+	})
+}
+
+// InvalidateAll removes the cached connections and address slots of all the applications.
+func (c *ConnectionCache) InvalidateAll() {
+	c.connections.Purge()
+	c.addressSlots.Purge()
+}
+
+// ConnectionCacheOptions defines options to create a new instance of ConnectionCache.
+type ConnectionCacheOptions struct {
+	// Configuration of the time to live and the size of the cache
+	Configuration cache.Configuration
+	// Metrics counts the hits and misses of the cache
+	Metrics *cache.Metrics
+}
+
+// ProvideConnectionCache creates a new instance of ConnectionCache using the provided options, returning an error if it fails.
+func ProvideConnectionCache(options ConnectionCacheOptions) (*ConnectionCache, error) {
+	return &ConnectionCache{
+		connections:  cache.New[string, []slotConnection](connectionCacheName, options.Configuration, options.Metrics),
+		addressSlots: cache.New[addressSlotKey, string](addressSlotCacheName, options.Configuration, options.Metrics),
+	}, nil
+}
@@ -2,8 +2,11 @@ package hsmconnection
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
@@ -15,8 +18,13 @@ import (
 
 // Resolver finds what HSMConnection is required depending on the constraints.
 type Resolver interface {
-	// ByApplication returns the HSMConnection to use by a specific application depending on its configuration. It returns an error if it fails.
+	// ByApplication returns the HSMConnection to use by a specific application depending on its configuration. If an
+	// address is provided, it is the first alive slot that holds it, which is cached after it is found. Otherwise, it is
+	// the first alive slot in order of priority. It returns an error if it fails.
 	ByApplication(ctx context.Context, input ByApplicationInput) (*HSMConnection, error)
+	// ForKeyGeneration returns the HSMConnection of the slot where the keys of a specific application are generated. It
+	// is the primary slot of the application and there is no failover. It returns an error if it fails.
+	ForKeyGeneration(ctx context.Context, input ForKeyGenerationInput) (*HSMConnection, error)
 }
 
 func (u *DefaultHSMConnectionResolver) ByApplication(ctx context.Context, input ByApplicationInput) (*HSMConnection, error) {
@@ -25,26 +33,99 @@ func (u *DefaultHSMConnectionResolver) ByApplication(ctx context.Context, input
 		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
 
-	getApplicationInput := application.GetApplicationInput{
-		StandardID: entities.StandardID{
-			ID: input.ApplicationID,
-		},
+	slots, err := u.slotConnections(ctx, input.ApplicationID)
+	if err != nil {
+		return nil, err
 	}
-	app, err := u.applicationUseCase.GetApplication(ctx, getApplicationInput)
+	// a single slot is returned as it is, so that errors are reported by the operation that uses it
+	if len(slots) == 1 {
+		connection := slots[0].connection
+		return &connection, nil
+	}
+
+	if input.Address != nil {
+		return u.slotHoldingAddress(ctx, input.ApplicationID, slots, *input.Address)
+	}
+	for _, slot := range slots {
+		if u.isAlive(ctx, slot.connection) {
+			return &slot.connection, nil
+		}
+		logger.LogEntry(ctx).Warnf("hsm slot [%s] of application [%s] is not alive, failing over to the next slot", slot.slotID, input.ApplicationID)
+	}
+
+	msg := fmt.Sprintf("none of the hsm slots of application [%s] is alive", input.ApplicationID)
+	return nil, errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+}
+
+func (u *DefaultHSMConnectionResolver) ForKeyGeneration(ctx context.Context, input ForKeyGenerationInput) (*HSMConnection, error) {
+	_, err := govalidator.ValidateStruct(input)
 	if err != nil {
-		return nil, errors.InternalFromErr(err)
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
 
-	getHSMSlotInput := hsmslot.GetHSMSlotByApplicationInput{
-		ApplicationID: entities.StandardID{
-			ID: app.Application.ID,
+	slots, err := u.slotConnections(ctx, input.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	// the slots are ordered by priority, the first one is the primary slot
+	connection := slots[0].connection
+	return &connection, nil
+}
+
+// slotConnections returns the connections to the slots of the application ordered by priority. They are cached until
+// the application, its slots or their modules change, or the cache entry expires. The returned slice is shared with
+// the cache and must not be modified.
+func (u *DefaultHSMConnectionResolver) slotConnections(ctx context.Context, applicationID string) ([]slotConnection, error) {
+	return u.cache.connections.GetOrLoad(applicationID, func() ([]slotConnection, error) {
+		app, err := u.getApplication(ctx, applicationID)
+		if err != nil {
+			return nil, err
+		}
+
+		listHSMSlotsInput := hsmslot.ListHSMSlotsByApplicationInput{
+			ApplicationID: entities.StandardID{
+				ID: app.ID,
+			},
+			OrderBy:        hsmslot.OrderByPriority,
+			OrderDirection: entities.OrderAsc,
+		}
+		slots, err := u.slotUseCase.ListHSMSlotsByApplication(ctx, listHSMSlotsInput)
+		if err != nil {
+			return nil, errors.InternalFromErr(err)
+		}
+		if len(slots.Items) == 0 {
+			return nil, errors.NotFound().WithMessage("hsm slot not found for application [%s]", app.ID)
+		}
+
+		connections := make([]slotConnection, 0, len(slots.Items))
+		for _, slot := range slots.Items {
+			connection, connectionErr := u.connectionForSlot(ctx, app.ChainID, slot)
+			if connectionErr != nil {
+				return nil, connectionErr
+			}
+			connections = append(connections, slotConnection{
+				slotID:     slot.ID,
+				connection: *connection,
+			})
+		}
+		return connections, nil
+	})
+}
+
+func (u *DefaultHSMConnectionResolver) getApplication(ctx context.Context, applicationID string) (*application.Application, error) {
+	getApplicationInput := application.GetApplicationInput{
+		StandardID: entities.StandardID{
+			ID: applicationID,
 		},
 	}
-	slot, err := u.slotUseCase.GetHSMSlotByApplication(ctx, getHSMSlotInput)
+	app, err := u.applicationUseCase.GetApplication(ctx, getApplicationInput)
 	if err != nil {
 		return nil, errors.InternalFromErr(err)
 	}
+	return &app.Application, nil
+}
 
+func (u *DefaultHSMConnectionResolver) connectionForSlot(ctx context.Context, chainID entities.Int256, slot hsmslot.HSMSlot) (*HSMConnection, error) {
 	getHSMModuleInput := hsmmodule.GetHSMModuleInput{
 		StandardID: entities.StandardID{
 			ID: slot.HSMModuleID,
@@ -60,16 +141,128 @@ func (u *DefaultHSMConnectionResolver) ByApplication(ctx context.Context, input
 	}
 
 	return &HSMConnection{
-		Slot:       slot.Slot,
-		Pin:        slot.Pin,
-		ChainID:    app.ChainID,
-		ModuleKind: string(*moduleKind),
+		Slot:                slot.Slot,
+		Pin:                 slot.Pin,
+		ChainID:             chainID,
+		ModuleKind:          string(*moduleKind),
+		PKCS11Configuration: mapPKCS11Configuration(module.Configuration.PKCS11Configuration),
 	}, nil
 }
 
+// slotHoldingAddress returns the connection to the slot that holds the address. The slot is cached until the address is
+// enabled, disabled or removed, the application, its slots or their modules change, or the cache entry expires, so
+// that the slots are only listed the first time the address is used. The cached slot is only returned while it is
+// alive, otherwise the entry is removed and the slots are listed again. The slots are listed in order of priority and
+// the ones that are not alive or can't be listed are skipped. If no slot holds the address, the first slot that could
+// be listed is returned, so that the operation reports that the address is not found.
+func (u *DefaultHSMConnectionResolver) slotHoldingAddress(ctx context.Context, applicationID string, slots []slotConnection, addr address.Address) (*HSMConnection, error) {
+	key := addressSlotKey{
+		applicationID: applicationID,
+		address:       addr,
+	}
+	var firstReachable *HSMConnection
+	// the slots are listed again at most once if the cached slot is not alive or is no longer a slot of the application
+	for attempt := 0; attempt < 2; attempt++ {
+		loaded := false
+		slotID, err := u.cache.addressSlots.GetOrLoad(key, func() (string, error) {
+			loaded = true
+			return u.findSlotHoldingAddress(ctx, applicationID, slots, addr, &firstReachable)
+		})
+		if err != nil {
+			break
+		}
+		slot := findSlot(slots, slotID)
+		// a slot found by this call has already been checked to be alive
+		if slot != nil && (loaded || u.isAlive(ctx, slot.connection)) {
+			return &slot.connection, nil
+		}
+		if slot != nil {
+			logger.LogEntry(ctx).Warnf("hsm slot [%s] holding address [%s] of application [%s] is not alive, failing over to the next slot", slotID, addr, applicationID)
+		}
+		u.cache.InvalidateAddress(applicationID, addr)
+	}
+	if firstReachable != nil {
+		return firstReachable, nil
+	}
+
+	msg := fmt.Sprintf("none of the hsm slots of application [%s] is alive", applicationID)
+	return nil, errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+}
+
+// findSlotHoldingAddress returns the identifier of the first alive slot that holds the address, in order of priority.
+// It sets firstReachable to the first alive slot whose addresses could be listed, if it is not set yet.
+func (u *DefaultHSMConnectionResolver) findSlotHoldingAddress(ctx context.Context, applicationID string, slots []slotConnection, addr address.Address, firstReachable **HSMConnection) (string, error) {
+	for _, slot := range slots {
+		if !u.isAlive(ctx, slot.connection) {
+			logger.LogEntry(ctx).Warnf("hsm slot [%s] of application [%s] is not alive, failing over to the next slot", slot.slotID, applicationID)
+			continue
+		}
+		holdsAddress, holdsAddressErr := u.holdsAddress(ctx, slot.connection, addr)
+		if holdsAddressErr != nil {
+			logger.LogEntry(ctx).Warnf("could not list the addresses of hsm slot [%s] of application [%s], failing over to the next slot: %v", slot.slotID, applicationID, holdsAddressErr)
+			continue
+		}
+		if holdsAddress {
+			return slot.slotID, nil
+		}
+		if *firstReachable == nil {
+			connection := slot.connection
+			*firstReachable = &connection
+		}
+	}
+	return "", errors.NotFound().WithMessage("address [%s] not found in the hsm slots of application [%s]", addr, applicationID)
+}
+
+// findSlot returns the slot with the given identifier, or nil if it is not one of the given slots.
+func findSlot(slots []slotConnection, slotID string) *slotConnection {
+	for i := range slots {
+		if slots[i].slotID == slotID {
+			return &slots[i]
+		}
+	}
+	return nil
+}
+
+func (u *DefaultHSMConnectionResolver) isAlive(ctx context.Context, connection HSMConnection) bool {
+	isAliveInput := hsmconnector.IsAliveInput{
+		Slot:                connection.Slot,
+		Pin:                 connection.Pin,
+		ModuleKind:          hsmconnector.ModuleKind(connection.ModuleKind),
+		PKCS11Configuration: connection.PKCS11Configuration,
+	}
+	isAliveOutput, err := u.hsmConnector.IsAlive(ctx, isAliveInput)
+	if err != nil {
+		return false
+	}
+	return isAliveOutput.IsAlive
+}
+
+func (u *DefaultHSMConnectionResolver) holdsAddress(ctx context.Context, connection HSMConnection, addr address.Address) (bool, error) {
+	listAddressesInput := hsmconnector.ListAddressesInput{
+		SlotConnectionData: hsmconnector.SlotConnectionData{
+			Slot:                connection.Slot,
+			Pin:                 connection.Pin,
+			ModuleKind:          hsmconnector.ModuleKind(connection.ModuleKind),
+			PKCS11Configuration: connection.PKCS11Configuration,
+			ChainID:             connection.ChainID,
+		},
+	}
+	listAddressesOutput, err := u.hsmConnector.ListAddresses(ctx, listAddressesInput)
+	if err != nil {
+		return false, err
+	}
+	for _, item := range listAddressesOutput.Items {
+		if item == addr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 var _ Resolver = new(DefaultHSMConnectionResolver)
 
-// DefaultHSMConnectionResolver implements the HSMRouter interface. It doesn't cache connections.
+// DefaultHSMConnectionResolver implements the HSMRouter interface. It caches the connections to the slots of each
+// application and the slot that holds each address.
 type DefaultHSMConnectionResolver struct {
 	// moduleUseCase provides the HSM resources
 	moduleUseCase hsmmodule.HSMModuleUseCase
@@ -77,6 +270,10 @@ type DefaultHSMConnectionResolver struct {
 	slotUseCase hsmslot.HSMSlotUseCase
 	// applicationUseCase provides the Application resources
 	applicationUseCase application.ApplicationUseCase
+	// hsmConnector checks the availability and the addresses of the slots
+	hsmConnector hsmconnector.HSMConnector
+	// cache of the connections to the slots of the applications
+	cache *ConnectionCache
 }
 
 // DefaultHSMConnectionResolverOptions defines options to create a new instance of DefaultHSMConnectionResolver.
@@ -87,6 +284,10 @@ type DefaultHSMConnectionResolverOptions struct {
 	SlotUseCase hsmslot.HSMSlotUseCase
 	// ApplicationUseCase provides the Application resources
 	ApplicationUseCase application.ApplicationUseCase
+	// HSMConnector checks the availability and the addresses of the slots
+	HSMConnector hsmconnector.HSMConnector
+	// Cache of the connections to the slots of the applications. Connections are not cached if it is not provided
+	Cache *ConnectionCache
 }
 
 // ProvideDefaultHSMConnectionResolver creates a new instance of DefaultHSMConnectionResolver using the provided options, returning an error if it fails.
@@ -100,11 +301,25 @@ func ProvideDefaultHSMConnectionResolver(options DefaultHSMConnectionResolverOpt
 	if options.ApplicationUseCase == nil {
 		return nil, errors.Internal().WithMessage("mandatory 'ApplicationUseCase' was not provided")
 	}
+	if options.HSMConnector == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'HSMConnector' was not provided")
+	}
+
+	connectionCache := options.Cache
+	if connectionCache == nil {
+		var err error
+		connectionCache, err = ProvideConnectionCache(ConnectionCacheOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &DefaultHSMConnectionResolver{
 		moduleUseCase:      options.ModuleUseCase,
 		slotUseCase:        options.SlotUseCase,
 		applicationUseCase: options.ApplicationUseCase,
+		hsmConnector:       options.HSMConnector,
+		cache:              connectionCache,
 	}, nil
 }
 
@@ -114,7 +329,24 @@ func getModuleKind(kind hsmmodule.ModuleKind) (*hsmconnector.ModuleKind, error)
 	case hsmmodule.SoftHSMModuleKind:
 		result = hsmconnector.SoftHSMModuleKind
 		return &result, nil
+	case hsmmodule.CloudKMSModuleKind:
+		result = hsmconnector.CloudKMSModuleKind
+		return &result, nil
+	case hsmmodule.PKCS11ModuleKind:
+		result = hsmconnector.PKCS11ModuleKind
+		return &result, nil
 	default:
 		return nil, errors.InvalidArgument().WithMessage("module kind '%s' not found", kind)
 	}
 }
+
+func mapPKCS11Configuration(configuration *hsmmodule.PKCS11Configuration) *hsmconnector.PKCS11ModuleConfiguration {
+	if configuration == nil {
+		return nil
+	}
+	return &hsmconnector.PKCS11ModuleConfiguration{
+		Library:    hsmconnector.PKCS11Library(configuration.Library),
+		TokenLabel: configuration.TokenLabel,
+		OmitKeyID:  configuration.Quirks.OmitKeyID,
+	}
+}
@@ -0,0 +1,344 @@
+package hsmconnection_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/cache"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnection"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applicationID = "resolver-application"
+	primarySlot   = "primary-slot"
+	secondarySlot = "secondary-slot"
+	tertiarySlot  = "tertiary-slot"
+)
+
+func TestDefaultHSMConnectionResolver_ByApplication(t *testing.T) {
+	addr, err := address.NewFromHexString("0xc24D7A0D4Bf2b2D7bD8B1F9f4A63c6A5E0F1D2C3")
+	require.NoError(t, err)
+
+	t.Run("success: first alive slot in order of priority", func(t *testing.T) {
+		connector := newFakeHSMConnector(primarySlot, secondarySlot, tertiarySlot)
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot, tertiarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, primarySlot, connection.Slot)
+	})
+
+	t.Run("success: fail over to the next alive slot", func(t *testing.T) {
+		connector := newFakeHSMConnector(tertiarySlot)
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot, tertiarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tertiarySlot, connection.Slot)
+	})
+
+	t.Run("success: slot holding the address", func(t *testing.T) {
+		connector := newFakeHSMConnector(primarySlot, secondarySlot, tertiarySlot)
+		connector.addresses[secondarySlot] = []address.Address{addr}
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot, tertiarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, secondarySlot, connection.Slot)
+	})
+
+	t.Run("success: slot holding the address is cached", func(t *testing.T) {
+		connector := newFakeHSMConnector(primarySlot, secondarySlot)
+		connector.addresses[secondarySlot] = []address.Address{addr}
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot)
+
+		for i := 0; i < 3; i++ {
+			connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+				ApplicationID: applicationID,
+				Address:       &addr,
+			})
+			require.NoError(t, err)
+			require.Equal(t, secondarySlot, connection.Slot)
+		}
+		require.Equal(t, 2, connector.listAddressesCalls)
+	})
+
+	t.Run("success: fail over from the cached slot holding the address", func(t *testing.T) {
+		connector := newFakeHSMConnector(primarySlot, secondarySlot, tertiarySlot)
+		connector.addresses[primarySlot] = []address.Address{addr}
+		connector.addresses[tertiarySlot] = []address.Address{addr}
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot, tertiarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, primarySlot, connection.Slot)
+
+		connector.alive[primarySlot] = false
+		connection, err = resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tertiarySlot, connection.Slot)
+
+		connector.alive[primarySlot] = true
+		connection, err = resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tertiarySlot, connection.Slot)
+	})
+
+	t.Run("success: address not held by any slot", func(t *testing.T) {
+		connector := newFakeHSMConnector(secondarySlot, tertiarySlot)
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot, tertiarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, secondarySlot, connection.Slot)
+	})
+
+	t.Run("success: slots listed again after invalidating the address", func(t *testing.T) {
+		connectionCache := newConnectionCache(t)
+		connector := newFakeHSMConnector(primarySlot, secondarySlot)
+		connector.addresses[secondarySlot] = []address.Address{addr}
+		resolver := newResolver(t, connector, connectionCache, primarySlot, secondarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, secondarySlot, connection.Slot)
+
+		connector.addresses[primarySlot] = []address.Address{addr}
+		connector.addresses[secondarySlot] = nil
+		connectionCache.InvalidateAddress(applicationID, addr)
+		connection, err = resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, primarySlot, connection.Slot)
+	})
+
+	t.Run("success: slots read again after invalidating the application", func(t *testing.T) {
+		connectionCache := newConnectionCache(t)
+		connector := newFakeHSMConnector(primarySlot, secondarySlot, tertiarySlot)
+		connector.addresses[secondarySlot] = []address.Address{addr}
+		connector.addresses[tertiarySlot] = []address.Address{addr}
+		slotUseCase := &fakeHSMSlotUseCase{
+			slots: []string{primarySlot, secondarySlot},
+		}
+		resolver := newResolverWithSlots(t, connector, connectionCache, slotUseCase)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, secondarySlot, connection.Slot)
+
+		slotUseCase.slots = []string{primarySlot, tertiarySlot}
+		connectionCache.InvalidateApplication(applicationID)
+		connection, err = resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tertiarySlot, connection.Slot)
+		require.Equal(t, 2, slotUseCase.listCalls)
+	})
+
+	t.Run("failure: none of the slots is alive", func(t *testing.T) {
+		connector := newFakeHSMConnector()
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot)
+
+		connection, err := resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+		})
+		require.Error(t, err)
+		require.Nil(t, connection)
+
+		connection, err = resolver.ByApplication(context.Background(), hsmconnection.ByApplicationInput{
+			ApplicationID: applicationID,
+			Address:       &addr,
+		})
+		require.Error(t, err)
+		require.Nil(t, connection)
+	})
+}
+
+func TestDefaultHSMConnectionResolver_ForKeyGeneration(t *testing.T) {
+	t.Run("success: primary slot even if it is not alive", func(t *testing.T) {
+		connector := newFakeHSMConnector(secondarySlot)
+		resolver := newResolver(t, connector, nil, primarySlot, secondarySlot)
+
+		connection, err := resolver.ForKeyGeneration(context.Background(), hsmconnection.ForKeyGenerationInput{
+			ApplicationID: applicationID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, primarySlot, connection.Slot)
+	})
+}
+
+func newConnectionCache(t *testing.T) *hsmconnection.ConnectionCache {
+	connectionCache, err := hsmconnection.ProvideConnectionCache(hsmconnection.ConnectionCacheOptions{
+		Configuration: cache.Configuration{
+			TTL:        time.Minute,
+			MaxEntries: 100,
+		},
+	})
+	require.NoError(t, err)
+	return connectionCache
+}
+
+func newResolver(t *testing.T, connector *fakeHSMConnector, connectionCache *hsmconnection.ConnectionCache, slots ...string) *hsmconnection.DefaultHSMConnectionResolver {
+	if connectionCache == nil {
+		connectionCache = newConnectionCache(t)
+	}
+	return newResolverWithSlots(t, connector, connectionCache, &fakeHSMSlotUseCase{
+		slots: slots,
+	})
+}
+
+func newResolverWithSlots(t *testing.T, connector *fakeHSMConnector, connectionCache *hsmconnection.ConnectionCache, slotUseCase *fakeHSMSlotUseCase) *hsmconnection.DefaultHSMConnectionResolver {
+	resolver, err := hsmconnection.ProvideDefaultHSMConnectionResolver(hsmconnection.DefaultHSMConnectionResolverOptions{
+		ModuleUseCase:      &fakeHSMModuleUseCase{},
+		SlotUseCase:        slotUseCase,
+		ApplicationUseCase: &fakeApplicationUseCase{},
+		HSMConnector:       connector,
+		Cache:              connectionCache,
+	})
+	require.NoError(t, err)
+	return resolver
+}
+
+// fakeApplicationUseCase returns any requested application.
+type fakeApplicationUseCase struct {
+	application.ApplicationUseCase
+}
+
+func (f *fakeApplicationUseCase) GetApplication(_ context.Context, input application.GetApplicationInput) (*application.GetApplicationOutput, error) {
+	return &application.GetApplicationOutput{
+		Application: application.Application{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: input.StandardID,
+				},
+			},
+			ChainID: *entities.NewInt256FromInt(44844),
+		},
+	}, nil
+}
+
+// fakeHSMModuleUseCase returns a SoftHSM module for any requested module.
+type fakeHSMModuleUseCase struct {
+	hsmmodule.HSMModuleUseCase
+}
+
+func (f *fakeHSMModuleUseCase) GetHSMModule(_ context.Context, input hsmmodule.GetHSMModuleInput) (*hsmmodule.GetHSMModuleOutput, error) {
+	return &hsmmodule.GetHSMModuleOutput{
+		HSMModule: hsmmodule.HSMModule{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: input.StandardID,
+				},
+			},
+			Kind: hsmmodule.SoftHSMModuleKind,
+		},
+	}, nil
+}
+
+// fakeHSMSlotUseCase returns the slots of the application in order of priority.
+type fakeHSMSlotUseCase struct {
+	hsmslot.HSMSlotUseCase
+	// slots of the application in order of priority
+	slots []string
+	// listCalls number of times the slots of the application are listed
+	listCalls int
+}
+
+func (f *fakeHSMSlotUseCase) ListHSMSlotsByApplication(_ context.Context, input hsmslot.ListHSMSlotsByApplicationInput) (*hsmslot.ListHSMSlotsByApplicationOutput, error) {
+	f.listCalls++
+	items := make([]hsmslot.HSMSlot, 0, len(f.slots))
+	for i, slot := range f.slots {
+		items = append(items, hsmslot.HSMSlot{
+			StandardResourceMeta: entities.StandardResourceMeta{
+				StandardResource: entities.StandardResource{
+					StandardID: entities.StandardID{
+						ID: slot,
+					},
+				},
+			},
+			ApplicationID: input.ApplicationID.ID,
+			HSMModuleID:   "resolver-module",
+			Slot:          slot,
+			Pin:           "1234",
+			Priority:      i + 1,
+		})
+	}
+	return &hsmslot.ListHSMSlotsByApplicationOutput{
+		HSMSlotCollection: hsmslot.HSMSlotCollection{
+			Items: items,
+		},
+	}, nil
+}
+
+// fakeHSMConnector reports the availability and the addresses of each slot.
+type fakeHSMConnector struct {
+	hsmconnector.HSMConnector
+	// alive whether each slot is alive
+	alive map[string]bool
+	// addresses held by each slot
+	addresses map[string][]address.Address
+	// listAddressesCalls number of times the addresses of a slot are listed
+	listAddressesCalls int
+}
+
+func newFakeHSMConnector(aliveSlots ...string) *fakeHSMConnector {
+	alive := make(map[string]bool)
+	for _, slot := range aliveSlots {
+		alive[slot] = true
+	}
+	return &fakeHSMConnector{
+		alive:     alive,
+		addresses: make(map[string][]address.Address),
+	}
+}
+
+func (f *fakeHSMConnector) IsAlive(_ context.Context, input hsmconnector.IsAliveInput) (*hsmconnector.IsAliveOutput, error) {
+	return &hsmconnector.IsAliveOutput{
+		IsAlive: f.alive[input.Slot],
+	}, nil
+}
+
+func (f *fakeHSMConnector) ListAddresses(_ context.Context, input hsmconnector.ListAddressesInput) (*hsmconnector.ListAddressesOutput, error) {
+	f.listAddressesCalls++
+	return &hsmconnector.ListAddressesOutput{
+		Items: f.addresses[input.Slot],
+	}, nil
+}
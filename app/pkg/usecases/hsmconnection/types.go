@@ -2,12 +2,22 @@ package hsmconnection
 
 import (
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
 )
 
 // ByApplicationInput input to get an HSMConnector given an application.
 type ByApplicationInput struct {
 	// ApplicationID identifier of the application.
 	ApplicationID string
+	// Address the slot must hold. Any alive slot is returned if it is not provided.
+	Address *address.Address `valid:"optional"`
+}
+
+// ForKeyGenerationInput input to get the HSMConnector where the keys of an application are generated.
+type ForKeyGenerationInput struct {
+	// ApplicationID identifier of the application.
+	ApplicationID string
 }
 
 // HSMConnection HSM connection details.
@@ -18,6 +28,8 @@ type HSMConnection struct {
 	Pin string
 	// ModuleKind type of the HSM module.
 	ModuleKind string
+	// PKCS11Configuration configuration of the library of a generic PKCS11 module. It is nil for other module kinds.
+	PKCS11Configuration *hsmconnector.PKCS11ModuleConfiguration
 	// ChainID application's chain ID.
 	ChainID entities.Int256
 }
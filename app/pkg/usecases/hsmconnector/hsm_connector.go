@@ -10,6 +10,7 @@ import (
 
 	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
 )
@@ -24,6 +25,10 @@ type HSMConnector interface {
 	ListAddresses(ctx context.Context, input ListAddressesInput) (*ListAddressesOutput, error)
 	// SignTx signs an Ethereum transaction using the private key associated with the address specific in the "From" input attribute.
 	SignTx(ctx context.Context, input SignTxInput) (*SignTxOutput, error)
+	// SignMessage signs an arbitrary message prefixed as defined in EIP-191 using the private key associated with the address specific in the "From" input attribute.
+	SignMessage(ctx context.Context, input SignMessageInput) (*SignMessageOutput, error)
+	// SignTypedData signs typed structured data as defined in EIP-712 using the private key associated with the address specific in the "From" input attribute.
+	SignTypedData(ctx context.Context, input SignTypedDataInput) (*SignTypedDataOutput, error)
 	// CloseAll closes all signature manager resources.
 	CloseAll(ctx context.Context, input CloseAllInput) (*CloseAllOutput, error)
 	// IsAlive checks the availability of a given slot.
@@ -50,7 +55,8 @@ func (d DefaultUseCase) GenerateAddress(ctx context.Context, input GenerateAddre
 	tracer.AddProperty("operation", "GenerateAddress")
 
 	createInput := CreateInput{
-		ModuleKind: input.ModuleKind,
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
 	}
 	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
 	if createErr != nil {
@@ -64,6 +70,9 @@ func (d DefaultUseCase) GenerateAddress(ctx context.Context, input GenerateAddre
 	}
 	generateKeyOutput, generateKeyErr := digitalSignatureManager.GenerateKey(ctx, generateKeyInput)
 	if generateKeyErr != nil {
+		if timeoutErr := adaptTimeoutError(input.Slot, generateKeyErr); timeoutErr != nil {
+			return nil, timeoutErr
+		}
 		if signaturemanager.IsInvalidSlotError(generateKeyErr) {
 			msg := fmt.Sprintf("the slot '%s' is not reachable in the HSM module", input.Slot)
 			return nil, errors.PreconditionFailedFromErr(generateKeyErr).WithMessage(msg).SetHumanReadableMessage(msg)
@@ -90,7 +99,8 @@ func (d DefaultUseCase) RemoveAddress(ctx context.Context, input RemoveAddressIn
 	tracer.AddProperty("operation", "RemoveAddress")
 
 	createInput := CreateInput{
-		ModuleKind: input.ModuleKind,
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
 	}
 	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
 	if createErr != nil {
@@ -105,6 +115,9 @@ func (d DefaultUseCase) RemoveAddress(ctx context.Context, input RemoveAddressIn
 	}
 	_, err = digitalSignatureManager.RemoveKey(ctx, removeKeyInput)
 	if err != nil {
+		if timeoutErr := adaptTimeoutError(input.Slot, err); timeoutErr != nil {
+			return nil, timeoutErr
+		}
 		if signaturemanager.IsInvalidSlotError(err) {
 			msg := fmt.Sprintf("the slot '%s' is not reachable in the HSM module", input.Slot)
 			return nil, errors.PreconditionFailedFromErr(err).WithMessage(msg).SetHumanReadableMessage(msg)
@@ -135,7 +148,8 @@ func (d DefaultUseCase) ListAddresses(ctx context.Context, input ListAddressesIn
 	tracer.AddProperty("operation", "ListAddresses")
 
 	createInput := CreateInput{
-		ModuleKind: input.ModuleKind,
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
 	}
 	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
 	if createErr != nil {
@@ -149,6 +163,9 @@ func (d DefaultUseCase) ListAddresses(ctx context.Context, input ListAddressesIn
 	}
 	keys, listKeysErr := digitalSignatureManager.ListKeys(ctx, listKeysInput)
 	if listKeysErr != nil {
+		if timeoutErr := adaptTimeoutError(input.Slot, listKeysErr); timeoutErr != nil {
+			return nil, timeoutErr
+		}
 		if signaturemanager.IsInvalidSlotError(listKeysErr) {
 			logger.LogEntry(ctx).Warnf("could not obtain keys from the configured HSM slot '%s' because it does not exist in the HSM of type '%s'", input.Slot, input.ModuleKind)
 		}
@@ -180,16 +197,38 @@ func (d DefaultUseCase) SignTx(ctx context.Context, input SignTxInput) (*SignTxO
 		gas = *input.Gas
 	}
 
+	txType, err := resolveTransactionType(input)
+	if err != nil {
+		return nil, err
+	}
+	tracer.AddProperty("txType", txType)
+
 	defaultGasPrice := entities.NewHexInt256(big.NewInt(0))
 	gasPrice := *defaultGasPrice
 	if input.GasPrice != nil {
 		gasPrice = *input.GasPrice
 	}
 
+	var maxFeePerGas, maxPriorityFeePerGas *entities.HexInt256
+	if txType == DynamicFeeTxType {
+		maxFeePerGas = defaultGasPrice
+		if input.MaxFeePerGas != nil {
+			maxFeePerGas = input.MaxFeePerGas
+		}
+		maxPriorityFeePerGas = defaultGasPrice
+		if input.MaxPriorityFeePerGas != nil {
+			maxPriorityFeePerGas = input.MaxPriorityFeePerGas
+		}
+		if maxPriorityFeePerGas.BigInt().Cmp(maxFeePerGas.BigInt()) > 0 {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'maxPriorityFeePerGas' cannot be higher than 'maxFeePerGas'")
+		}
+	}
+
 	chainID := entities.NewHexInt256(input.ChainID.BigInt())
 
 	createInput := CreateInput{
-		ModuleKind: input.ModuleKind,
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
 	}
 	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
 	if createErr != nil {
@@ -202,31 +241,276 @@ func (d DefaultUseCase) SignTx(ctx context.Context, input SignTxInput) (*SignTxO
 	}
 
 	transaction := EthereumTransaction{
-		From:     input.From,
-		To:       input.To,
-		Gas:      gas,
-		GasPrice: gasPrice,
-		Value:    input.Value,
-		Data:     input.Data,
-		Nonce:    input.Nonce,
-		ChainID:  *chainID,
+		Type:                 txType,
+		From:                 input.From,
+		To:                   input.To,
+		Gas:                  gas,
+		GasPrice:             gasPrice,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		AccessList:           input.AccessList,
+		Value:                input.Value,
+		Data:                 input.Data,
+		ChainID:              *chainID,
+	}
+
+	evaluateTransactionInput := EvaluateTransactionInput{
+		ApplicationID: input.ApplicationID,
+		Transaction:   transaction,
+	}
+	_, evaluateErr := d.transactionPolicyPort.EvaluateTransaction(ctx, evaluateTransactionInput)
+	if evaluateErr != nil {
+		if errors.IsPreconditionFailed(evaluateErr) || errors.IsInvalidArgument(evaluateErr) {
+			return nil, evaluateErr
+		}
+		return nil, errors.InternalFromErr(evaluateErr).WithMessage("error evaluating the policies of the transaction")
+	}
+
+	consumeQuotaInput := ConsumeQuotaInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+	}
+	if input.Value != nil {
+		consumeQuotaInput.Value = &input.Value.Int256
 	}
+	err = d.consumeQuota(ctx, consumeQuotaInput)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonce is allocated once the transaction has been accepted, so rejected transactions don't leave nonce gaps.
+	// It is allocated in the same database transaction as the signature, so it is released if the signature fails.
+	if input.Nonce != nil {
+		transaction.Nonce = *input.Nonce
+		_, advanceErr := d.noncePort.AdvanceNonce(ctx, AdvanceNonceInput{
+			ChainID: input.ChainID,
+			From:    input.From,
+			Nonce:   *input.Nonce,
+		})
+		if advanceErr != nil {
+			if errors.IsInvalidArgument(advanceErr) {
+				return nil, advanceErr
+			}
+			return nil, errors.InternalFromErr(advanceErr).WithMessage("error advancing the nonce of the account")
+		}
+	} else {
+		allocateNonceOutput, allocateErr := d.noncePort.AllocateNonce(ctx, AllocateNonceInput{
+			ChainID: input.ChainID,
+			From:    input.From,
+		})
+		if allocateErr != nil {
+			return nil, errors.InternalFromErr(allocateErr).WithMessage("error allocating the nonce of the transaction")
+		}
+		transaction.Nonce = allocateNonceOutput.Nonce
+		tracer.AddProperty("nonce", transaction.Nonce.String())
+	}
+
 	payload, err := transaction.Hash()
 	if err != nil {
 		return nil, err
 	}
 
+	signatureWithV, err := signDigest(ctx, digitalSignatureManager, input.SlotConnectionData, input.From, *payload, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionSignature := generateEthereumTransactionSignature(signatureWithV, *chainID, txType)
+	transaction.Signature = transactionSignature
+
+	tracer.Debug("generated transaction signature")
+
+	transactionRLPEncode, err := transaction.RLPEncode()
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("error signing transaction: failed to RLP encode transaction with '%v'", err.Error())
+	}
+	result := transactionRLPEncode.Encode()
+
+	return &SignTxOutput{
+		SignedTx:    result,
+		Transaction: transaction,
+	}, nil
+}
+
+func (d DefaultUseCase) SignMessage(ctx context.Context, input SignMessageInput) (*SignMessageOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	if input.From.IsEmpty() {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'from' cannot be empty")
+	}
+
+	tracer := logger.NewTracer(ctx)
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("moduleKind", input.ModuleKind)
+	tracer.AddProperty("operation", "SignMessage")
+
+	evaluateMessageInput := EvaluateMessageInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+	}
+	_, evaluateErr := d.transactionPolicyPort.EvaluateMessage(ctx, evaluateMessageInput)
+	if evaluateErr != nil {
+		if errors.IsPreconditionFailed(evaluateErr) || errors.IsInvalidArgument(evaluateErr) {
+			return nil, evaluateErr
+		}
+		return nil, errors.InternalFromErr(evaluateErr).WithMessage("error evaluating the policies of the message")
+	}
+
+	consumeQuotaInput := ConsumeQuotaInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+	}
+	err = d.consumeQuota(ctx, consumeQuotaInput)
+	if err != nil {
+		return nil, err
+	}
+
+	message := EthereumMessage{
+		Data: input.Message,
+	}
+	payload, err := message.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := d.signHash(ctx, input.SlotConnectionData, input.From, *payload, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Debug("generated message signature")
+
+	return &SignMessageOutput{
+		Signature: *signature,
+		Hash:      *payload,
+	}, nil
+}
+
+func (d DefaultUseCase) SignTypedData(ctx context.Context, input SignTypedDataInput) (*SignTypedDataOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	if input.From.IsEmpty() {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'from' cannot be empty")
+	}
+
+	tracer := logger.NewTracer(ctx)
+	tracer.AddProperty("slot", input.Slot)
+	tracer.AddProperty("moduleKind", input.ModuleKind)
+	tracer.AddProperty("operation", "SignTypedData")
+	tracer.AddProperty("primaryType", input.TypedData.PrimaryType)
+
+	verifyingContract, err := input.TypedData.VerifyingContract()
+	if err != nil {
+		return nil, err
+	}
+	evaluateTypedDataInput := EvaluateTypedDataInput{
+		ApplicationID:     input.ApplicationID,
+		From:              input.From,
+		VerifyingContract: verifyingContract,
+	}
+	_, evaluateErr := d.transactionPolicyPort.EvaluateTypedData(ctx, evaluateTypedDataInput)
+	if evaluateErr != nil {
+		if errors.IsPreconditionFailed(evaluateErr) || errors.IsInvalidArgument(evaluateErr) {
+			return nil, evaluateErr
+		}
+		return nil, errors.InternalFromErr(evaluateErr).WithMessage("error evaluating the policies of the typed data")
+	}
+
+	consumeQuotaInput := ConsumeQuotaInput{
+		ApplicationID: input.ApplicationID,
+		From:          input.From,
+	}
+	err = d.consumeQuota(ctx, consumeQuotaInput)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := input.TypedData.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := d.signHash(ctx, input.SlotConnectionData, input.From, *payload, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer.Debug("generated typed data signature")
+
+	return &SignTypedDataOutput{
+		Signature: *signature,
+		Hash:      *payload,
+	}, nil
+}
+
+// consumeQuota counts a signature against the limits of the application.
+func (d DefaultUseCase) consumeQuota(ctx context.Context, input ConsumeQuotaInput) error {
+	_, err := d.signingQuotaPort.ConsumeQuota(ctx, input)
+	if err != nil {
+		if errors.IsTooManyReq(err) || errors.IsInvalidArgument(err) || errors.IsUnavailable(err) {
+			return err
+		}
+		return errors.InternalFromErr(err).WithMessage("error counting the signature against the limits of the application")
+	}
+	return nil
+}
+
+// signHash signs an already hashed payload and returns the signature encoded as R || S || V, with V being 27 or 28, as
+// Ethereum expects for messages and typed data.
+func (d DefaultUseCase) signHash(ctx context.Context, slotConnectionData SlotConnectionData, from address.Address, hash entities.HexBytes, tracer logger.Tracer) (*entities.HexBytes, error) {
+	createInput := CreateInput{
+		ModuleKind:          slotConnectionData.ModuleKind,
+		PKCS11Configuration: slotConnectionData.PKCS11Configuration,
+	}
+	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
+	if createErr != nil {
+		return nil, errors.InternalFromErr(createErr).WithMessage("error signing: %s", createErr.Error())
+	}
+
+	signatureWithV, err := signDigest(ctx, digitalSignatureManager, slotConnectionData, from, hash, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := make([]byte, signatureLength)
+	copy(signature, signatureWithV[1:])
+	signature[signatureLength-1] = signatureWithV[0]
+	return entities.NewHexBytes(signature), nil
+}
+
+// adaptTimeoutError adapts the error of the signature manager if the slot of the HSM module did not respond in time. It
+// returns nil if the error is not a timeout.
+func adaptTimeoutError(slot string, err error) error {
+	if !signaturemanager.IsTimeoutError(err) {
+		return nil
+	}
+	msg := fmt.Sprintf("the slot '%s' of the HSM module did not respond in time", slot)
+	return errors.TimeoutFromErr(err).WithMessage(msg).SetHumanReadableMessage(msg)
+}
+
+// signDigest signs the given digest with the private key of the 'from' address and returns the signature in the [V || R || S]
+// format used by btcec, where V is the recovery value (27 or 28) and S is normalized to its low value.
+func signDigest(ctx context.Context, digitalSignatureManager signaturemanager.DigitalSignatureManager, slotConnectionData SlotConnectionData, from address.Address, digest entities.HexBytes, tracer logger.Tracer) ([]byte, error) {
 	signInput := signaturemanager.SignInput{
-		Slot:   input.Slot,
-		Pin:    input.Pin,
+		Slot:   slotConnectionData.Slot,
+		Pin:    slotConnectionData.Pin,
 		Tracer: tracer,
-		From:   input.From,
-		Data:   *payload,
+		From:   from,
+		Data:   digest,
 	}
 	signOutput, signErr := digitalSignatureManager.Sign(ctx, signInput)
 	if signErr != nil {
+		if timeoutErr := adaptTimeoutError(slotConnectionData.Slot, signErr); timeoutErr != nil {
+			return nil, timeoutErr
+		}
 		if signaturemanager.IsInvalidSlotError(signErr) {
-			msg := fmt.Sprintf("the slot '%s' is not reachable in the HSM module", input.Slot)
+			msg := fmt.Sprintf("the slot '%s' is not reachable in the HSM module", slotConnectionData.Slot)
 			return nil, errors.PreconditionFailedFromErr(signErr).WithMessage(msg).SetHumanReadableMessage(msg)
 		}
 		return nil, errors.InternalFromErr(signErr)
@@ -241,7 +525,7 @@ func (d DefaultUseCase) SignTx(ctx context.Context, input SignTxInput) (*SignTxO
 	for i := minSignatureOffsetBitcoin; i < maxSignatureOffsetBitcoin; i++ { // iterate over the possible solutions for the elliptic curve equation
 		// btcec lib format with the recovery ID (v) at the beginning
 		signatureWithV[0] = byte(i)
-		recoveredPublicKey, _, recoverCompactErr := btcececdsa.RecoverCompact(signatureWithV, *payload)
+		recoveredPublicKey, _, recoverCompactErr := btcececdsa.RecoverCompact(signatureWithV, digest)
 		if recoverCompactErr != nil {
 			tracer.Errorf("EC Recover failed. Error: %v", recoverCompactErr)
 			continue
@@ -249,38 +533,62 @@ func (d DefaultUseCase) SignTx(ctx context.Context, input SignTxInput) (*SignTxO
 		if recoveredPublicKey != nil {
 			pubKey, unmarshalECDSAKeyErr := unmarshalECDSAKey(recoveredPublicKey.SerializeUncompressed())
 			if unmarshalECDSAKeyErr != nil {
-				tracer.Errorf("unable to unmarshal public key after signing for address '%s'. Error: %v", input.From.String(), unmarshalECDSAKeyErr)
+				tracer.Errorf("unable to unmarshal public key after signing for address '%s'. Error: %v", from.String(), unmarshalECDSAKeyErr)
 				continue
 			}
 			recoveredAddr, deriveAddressFromPublicKeyErr := signaturemanager.DeriveAddressFromPublicKey(pubKey.SerializeUncompressed())
 			if deriveAddressFromPublicKeyErr != nil {
 				return nil, deriveAddressFromPublicKeyErr
 			}
-			if recoveredAddr.String() == input.From.String() {
+			if recoveredAddr.String() == from.String() {
 				recovered = true
 				break
 			}
 		}
 	}
 	if !recovered {
-		return nil, errors.Internal().WithMessage("error signing transaction: unable to find EC recovery value for address '%s'", input.From.String())
+		return nil, errors.Internal().WithMessage("error signing: unable to find EC recovery value for address '%s'", from.String())
 	}
 
-	transactionSignature := generateEthereumTransactionSignature(signatureWithV, *chainID)
-	transaction.Signature = transactionSignature
-
-	tracer.Debug("generated transaction signature")
+	return signatureWithV, nil
+}
 
-	transactionRLPEncode, err := transaction.RLPEncode()
-	if err != nil {
-		return nil, errors.InternalFromErr(err).WithMessage("error signing transaction: failed to RLP encode transaction with '%v'", err.Error())
+// resolveTransactionType determines the type of the transaction to sign. If the type is not explicitly requested, a
+// dynamic fee transaction is signed whenever any of the EIP-1559 fee fields is provided, and an access list transaction
+// is signed if only the access list is provided.
+func resolveTransactionType(input SignTxInput) (TransactionType, error) {
+	hasDynamicFee := input.MaxFeePerGas != nil || input.MaxPriorityFeePerGas != nil
+	hasAccessList := input.AccessList != nil
+	txType := LegacyTxType
+	if input.Type != nil {
+		txType = *input.Type
+	} else if hasDynamicFee {
+		txType = DynamicFeeTxType
+	} else if hasAccessList {
+		txType = AccessListTxType
+	}
+
+	switch txType {
+	case LegacyTxType:
+		if hasDynamicFee {
+			return 0, errors.InvalidArgument().SetHumanReadableMessage("fields 'maxFeePerGas' and 'maxPriorityFeePerGas' are not supported by legacy transactions")
+		}
+		if hasAccessList {
+			return 0, errors.InvalidArgument().SetHumanReadableMessage("field 'accessList' is not supported by legacy transactions")
+		}
+	case AccessListTxType:
+		if hasDynamicFee {
+			return 0, errors.InvalidArgument().SetHumanReadableMessage("fields 'maxFeePerGas' and 'maxPriorityFeePerGas' are not supported by access list transactions")
+		}
+	case DynamicFeeTxType:
+		if input.GasPrice != nil {
+			return 0, errors.InvalidArgument().SetHumanReadableMessage("field 'gasPrice' is not supported by dynamic fee transactions, use 'maxFeePerGas' and 'maxPriorityFeePerGas' instead")
+		}
+	default:
+		return 0, errors.InvalidArgument().SetHumanReadableMessage("transaction type '%d' is not supported", txType)
 	}
-	result := transactionRLPEncode.Encode()
 
-	return &SignTxOutput{
-		SignedTx:    result,
-		Transaction: transaction,
-	}, nil
+	return txType, nil
 }
 
 func (d DefaultUseCase) CloseAll(ctx context.Context, _ CloseAllInput) (*CloseAllOutput, error) {
@@ -306,7 +614,8 @@ func (d DefaultUseCase) IsAlive(ctx context.Context, input IsAliveInput) (*IsAli
 	tracer.AddProperty("operation", "IsAlive")
 
 	createInput := CreateInput{
-		ModuleKind: input.ModuleKind,
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
 	}
 	digitalSignatureManager, createErr := d.digitalSignatureManagerFactory.Create(ctx, createInput)
 	if createErr != nil {
@@ -320,6 +629,9 @@ func (d DefaultUseCase) IsAlive(ctx context.Context, input IsAliveInput) (*IsAli
 	}
 	isAliveOutput, isAliveOutputErr := digitalSignatureManager.IsAlive(ctx, isAliveInput)
 	if isAliveOutputErr != nil {
+		if timeoutErr := adaptTimeoutError(input.Slot, isAliveOutputErr); timeoutErr != nil {
+			return nil, timeoutErr
+		}
 		if signaturemanager.IsInvalidSlotError(isAliveOutputErr) {
 			msg := fmt.Sprintf("the slot '%s' is not reachable in the HSM module", input.Slot)
 			return nil, errors.PreconditionFailedFromErr(isAliveOutputErr).WithMessage(msg).SetHumanReadableMessage(msg)
@@ -328,6 +640,10 @@ func (d DefaultUseCase) IsAlive(ctx context.Context, input IsAliveInput) (*IsAli
 			msg := fmt.Sprintf("the pin provided for the slot '%s' is not correct", input.Slot)
 			return nil, errors.PreconditionFailedFromErr(isAliveOutputErr).WithMessage(msg).SetHumanReadableMessage(msg)
 		}
+		if signaturemanager.IsDeviceUnavailableError(isAliveOutputErr) {
+			msg := fmt.Sprintf("the HSM module of the slot '%s' is not available and must be reset", input.Slot)
+			return nil, errors.UnavailableFromErr(isAliveOutputErr).WithMessage(msg).SetHumanReadableMessage(msg)
+		}
 		return nil, errors.InternalFromErr(isAliveOutputErr)
 	}
 
@@ -344,7 +660,7 @@ func (d DefaultUseCase) Reset(ctx context.Context, input ResetInput) (*ResetOutp
 		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
 
-	resetErr := d.digitalSignatureManagerFactory.Reset(ctx, input.ModuleKind)
+	resetErr := d.digitalSignatureManagerFactory.Reset(ctx, input)
 	if resetErr != nil {
 		return nil, errors.InternalFromErr(resetErr).WithMessage("failed to reset digital signature manager: %v", resetErr.Error())
 	}
@@ -359,12 +675,21 @@ var _ HSMConnector = new(DefaultUseCase)
 // DefaultUseCase implements the HSMConnector interface.
 type DefaultUseCase struct {
 	digitalSignatureManagerFactory DigitalSignatureManagerFactory
+	transactionPolicyPort          TransactionPolicyPort
+	signingQuotaPort               SigningQuotaPort
+	noncePort                      NoncePort
 }
 
 // DefaultUseCaseOptions options to create a new DefaultUseCase.
 type DefaultUseCaseOptions struct {
 	// DigitalSignatureManagerFactory defines the factory to create DigitalSignatureManager connections
 	DigitalSignatureManagerFactory DigitalSignatureManagerFactory
+	// TransactionPolicyPort evaluates the policies that restrict the transactions, messages and typed data that can be signed
+	TransactionPolicyPort TransactionPolicyPort
+	// SigningQuotaPort counts the signatures against the limits of the application
+	SigningQuotaPort SigningQuotaPort
+	// NoncePort assigns nonces to the transactions signed without one
+	NoncePort NoncePort
 }
 
 // ProvideDefaultHSMConnector creates a new DefaultUseCase instance, returning an error if it fails.
@@ -372,7 +697,19 @@ func ProvideDefaultHSMConnector(options DefaultUseCaseOptions) (*DefaultUseCase,
 	if options.DigitalSignatureManagerFactory == nil {
 		return nil, errors.Internal().WithMessage("mandatory 'DigitalSignatureManagerFactory' was not provided")
 	}
+	if options.TransactionPolicyPort == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'TransactionPolicyPort' was not provided")
+	}
+	if options.SigningQuotaPort == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'SigningQuotaPort' was not provided")
+	}
+	if options.NoncePort == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'NoncePort' was not provided")
+	}
 	return &DefaultUseCase{
 		digitalSignatureManagerFactory: options.DigitalSignatureManagerFactory,
+		transactionPolicyPort:          options.TransactionPolicyPort,
+		signingQuotaPort:               options.SigningQuotaPort,
+		noncePort:                      options.NoncePort,
 	}, nil
 }
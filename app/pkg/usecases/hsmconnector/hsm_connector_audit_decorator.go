@@ -0,0 +1,169 @@
+package hsmconnector
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/audit"
+)
+
+// DefaultUseCaseAuditDecorator decorates the transactional DefaultUseCase to append a record to the audit log for every operation
+// that generates, removes or uses a key. The operations fail if their record can't be appended.
+type DefaultUseCaseAuditDecorator struct {
+	// DefaultUseCaseTransactionalDecorator is the usecase to be decorated. The records are appended out of its
+	// transactions, so that failed operations are recorded too.
+	DefaultUseCaseTransactionalDecorator
+	// auditUseCase appends the records to the audit log.
+	auditUseCase audit.AuditUseCase
+}
+
+func (d DefaultUseCaseAuditDecorator) GenerateAddress(ctx context.Context, input GenerateAddressInput) (*GenerateAddressOutput, error) {
+	output, err := d.DefaultUseCaseTransactionalDecorator.GenerateAddress(ctx, input)
+	recordInput := audit.RecordOperationInput{
+		Operation: audit.GenerateAddressOperation,
+		ChainID:   input.ChainID.String(),
+		Err:       err,
+	}
+	if output != nil {
+		recordInput.Address = output.Address.String()
+	}
+	recordErr := d.recordOperation(ctx, recordInput)
+	if err != nil {
+		return nil, err
+	}
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	return output, nil
+}
+
+func (d DefaultUseCaseAuditDecorator) RemoveAddress(ctx context.Context, input RemoveAddressInput) (*RemoveAddressOutput, error) {
+	output, err := d.DefaultUseCaseTransactionalDecorator.RemoveAddress(ctx, input)
+	recordErr := d.recordOperation(ctx, audit.RecordOperationInput{
+		Operation: audit.RemoveAddressOperation,
+		Address:   input.Address.String(),
+		ChainID:   input.ChainID.String(),
+		Err:       err,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	return output, nil
+}
+
+func (d DefaultUseCaseAuditDecorator) SignTx(ctx context.Context, input SignTxInput) (*SignTxOutput, error) {
+	output, err := d.DefaultUseCaseTransactionalDecorator.SignTx(ctx, input)
+	recordInput := audit.RecordOperationInput{
+		Operation: audit.SignTxOperation,
+		Address:   input.From.String(),
+		ChainID:   input.ChainID.String(),
+		Err:       err,
+	}
+	if output != nil {
+		txHash, txHashErr := transactionHash(output.Transaction)
+		if txHashErr != nil {
+			return nil, errors.InternalFromErr(txHashErr).WithMessage("error computing the hash of the signed transaction")
+		}
+		recordInput.TxHash = txHash
+	}
+	recordErr := d.recordOperation(ctx, recordInput)
+	if err != nil {
+		return nil, err
+	}
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	return output, nil
+}
+
+func (d DefaultUseCaseAuditDecorator) SignMessage(ctx context.Context, input SignMessageInput) (*SignMessageOutput, error) {
+	output, err := d.DefaultUseCaseTransactionalDecorator.SignMessage(ctx, input)
+	recordInput := audit.RecordOperationInput{
+		Operation: audit.SignMessageOperation,
+		Address:   input.From.String(),
+		ChainID:   input.ChainID.String(),
+		Err:       err,
+	}
+	if output != nil {
+		recordInput.SignedHash = output.Hash.String()
+	}
+	recordErr := d.recordOperation(ctx, recordInput)
+	if err != nil {
+		return nil, err
+	}
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	return output, nil
+}
+
+func (d DefaultUseCaseAuditDecorator) SignTypedData(ctx context.Context, input SignTypedDataInput) (*SignTypedDataOutput, error) {
+	output, err := d.DefaultUseCaseTransactionalDecorator.SignTypedData(ctx, input)
+	recordInput := audit.RecordOperationInput{
+		Operation: audit.SignTypedDataOperation,
+		Address:   input.From.String(),
+		ChainID:   input.ChainID.String(),
+		Err:       err,
+	}
+	if output != nil {
+		recordInput.SignedHash = output.Hash.String()
+	}
+	recordErr := d.recordOperation(ctx, recordInput)
+	if err != nil {
+		return nil, err
+	}
+	if recordErr != nil {
+		return nil, recordErr
+	}
+	return output, nil
+}
+
+// recordOperation appends the operation to the audit log.
+func (d DefaultUseCaseAuditDecorator) recordOperation(ctx context.Context, input audit.RecordOperationInput) error {
+	_, err := d.auditUseCase.RecordOperation(ctx, input)
+	if err != nil {
+		return errors.InternalFromErr(err).WithMessage("the operation could not be recorded in the audit log")
+	}
+	return nil
+}
+
+// transactionHash returns the hash of the signed transaction, as it is identified in the network.
+func transactionHash(transaction EthereumTransaction) (string, error) {
+	encoded, err := transaction.RLPEncode()
+	if err != nil {
+		return "", err
+	}
+	hash, err := hashKeccak256(*encoded)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(hash), nil
+}
+
+var _ HSMConnector = new(DefaultUseCaseAuditDecorator)
+
+// DefaultUseCaseAuditDecoratorOptions options to create a new DefaultUseCaseAuditDecorator.
+type DefaultUseCaseAuditDecoratorOptions struct {
+	// DefaultUseCaseTransactionalDecorator is the usecase to be decorated.
+	DefaultUseCaseTransactionalDecorator *DefaultUseCaseTransactionalDecorator
+	// AuditUseCase appends the records to the audit log
+	AuditUseCase audit.AuditUseCase
+}
+
+// ProvideDefaultUseCaseAuditDecorator creates a new DefaultUseCaseAuditDecorator instance, returning an error if it fails.
+func ProvideDefaultUseCaseAuditDecorator(options DefaultUseCaseAuditDecoratorOptions) (*DefaultUseCaseAuditDecorator, error) {
+	if options.DefaultUseCaseTransactionalDecorator == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'DefaultUseCaseTransactionalDecorator' was not provided")
+	}
+	if options.AuditUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'AuditUseCase' was not provided")
+	}
+	return &DefaultUseCaseAuditDecorator{
+		DefaultUseCaseTransactionalDecorator: *options.DefaultUseCaseTransactionalDecorator,
+		auditUseCase:                         options.AuditUseCase,
+	}, nil
+}
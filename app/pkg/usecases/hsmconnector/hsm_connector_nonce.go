@@ -0,0 +1,14 @@
+package hsmconnector
+
+import (
+	"context"
+)
+
+// NoncePort assigns nonces to the transactions signed without one.
+type NoncePort interface {
+	// AllocateNonce returns the next nonce of an account in a chain. The same nonce isn't returned twice.
+	AllocateNonce(ctx context.Context, input AllocateNonceInput) (*AllocateNonceOutput, error)
+	// AdvanceNonce records the nonce of a transaction signed with a nonce that wasn't allocated, so that it isn't
+	// allocated afterwards.
+	AdvanceNonce(ctx context.Context, input AdvanceNonceInput) (*AdvanceNonceOutput, error)
+}
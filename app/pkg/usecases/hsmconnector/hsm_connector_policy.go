@@ -0,0 +1,15 @@
+package hsmconnector
+
+import (
+	"context"
+)
+
+// TransactionPolicyPort evaluates the policies that restrict the transactions, messages and typed data that can be signed.
+type TransactionPolicyPort interface {
+	// EvaluateTransaction returns a precondition failed error if the transaction violates any of the policies of the application.
+	EvaluateTransaction(ctx context.Context, input EvaluateTransactionInput) (*EvaluateTransactionOutput, error)
+	// EvaluateMessage returns a precondition failed error if any of the policies of the application doesn't allow the message.
+	EvaluateMessage(ctx context.Context, input EvaluateMessageInput) (*EvaluateMessageOutput, error)
+	// EvaluateTypedData returns a precondition failed error if any of the policies of the application doesn't allow the typed data.
+	EvaluateTypedData(ctx context.Context, input EvaluateTypedDataInput) (*EvaluateTypedDataOutput, error)
+}
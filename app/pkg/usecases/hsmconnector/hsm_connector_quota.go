@@ -0,0 +1,11 @@
+package hsmconnector
+
+import (
+	"context"
+)
+
+// SigningQuotaPort counts the signatures against the limits of the application.
+type SigningQuotaPort interface {
+	// ConsumeQuota returns a too many requests error if the signature exceeds any of the limits of the application.
+	ConsumeQuota(ctx context.Context, input ConsumeQuotaInput) (*ConsumeQuotaOutput, error)
+}
@@ -5,20 +5,29 @@ import (
 	"encoding/hex"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/nonceallocator"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/signingquota"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/usecaseadapters/transactionpolicy"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
 	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
 	"github.com/hyperledger-labs/signare/app/pkg/graph"
 	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+	noncemanager "github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
 	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
 	"github.com/hyperledger-labs/signare/app/test/signaturemanagertesthelper"
 
+	btcececdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,6 +71,9 @@ func TestProvideDefaultUseCase(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		options := hsmconnector.DefaultUseCaseOptions{
 			DigitalSignatureManagerFactory: app.DigitalSignatureManagerFactory,
+			TransactionPolicyPort:          transactionPolicyPort(t),
+			SigningQuotaPort:               signingQuotaPort(t),
+			NoncePort:                      noncePort(t),
 		}
 		defaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(options)
 		require.Nil(t, err)
@@ -70,6 +82,42 @@ func TestProvideDefaultUseCase(t *testing.T) {
 	t.Run("nil digitalSignatureManagerFactory", func(t *testing.T) {
 		options := hsmconnector.DefaultUseCaseOptions{
 			DigitalSignatureManagerFactory: nil,
+			TransactionPolicyPort:          transactionPolicyPort(t),
+			SigningQuotaPort:               signingQuotaPort(t),
+			NoncePort:                      noncePort(t),
+		}
+		defaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(options)
+		require.Error(t, err)
+		require.Nil(t, defaultUseCase)
+	})
+	t.Run("nil transactionPolicyPort", func(t *testing.T) {
+		options := hsmconnector.DefaultUseCaseOptions{
+			DigitalSignatureManagerFactory: app.DigitalSignatureManagerFactory,
+			TransactionPolicyPort:          nil,
+			SigningQuotaPort:               signingQuotaPort(t),
+			NoncePort:                      noncePort(t),
+		}
+		defaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(options)
+		require.Error(t, err)
+		require.Nil(t, defaultUseCase)
+	})
+	t.Run("nil signingQuotaPort", func(t *testing.T) {
+		options := hsmconnector.DefaultUseCaseOptions{
+			DigitalSignatureManagerFactory: app.DigitalSignatureManagerFactory,
+			TransactionPolicyPort:          transactionPolicyPort(t),
+			SigningQuotaPort:               nil,
+			NoncePort:                      noncePort(t),
+		}
+		defaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(options)
+		require.Error(t, err)
+		require.Nil(t, defaultUseCase)
+	})
+	t.Run("nil noncePort", func(t *testing.T) {
+		options := hsmconnector.DefaultUseCaseOptions{
+			DigitalSignatureManagerFactory: app.DigitalSignatureManagerFactory,
+			TransactionPolicyPort:          transactionPolicyPort(t),
+			SigningQuotaPort:               signingQuotaPort(t),
+			NoncePort:                      nil,
 		}
 		defaultUseCase, err := hsmconnector.ProvideDefaultHSMConnector(options)
 		require.Error(t, err)
@@ -387,6 +435,8 @@ func TestDefaultUseCase_ListAddress(t *testing.T) {
 func TestDefaultUseCase_SignTx(t *testing.T) {
 	toAddress := address.MustNewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")
 	gasPrice := big.NewInt(20)
+	maxFeePerGas := big.NewInt(20000000000)
+	maxPriorityFeePerGas := big.NewInt(1000000000)
 	value := big.NewInt(3)
 	nonce := entities.UInt64(1)
 
@@ -399,8 +449,9 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 				ModuleKind: hsmconnector.SoftHSMModuleKind,
 				ChainID:    *chainID,
 			},
-			From: address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
-			To:   &toAddress,
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
 			Gas: &entities.HexUInt64{
 				UInt64: 1000,
 			},
@@ -415,7 +466,7 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 				},
 			},
 			Data: *data,
-			Nonce: entities.HexUInt64{
+			Nonce: &entities.HexUInt64{
 				UInt64: nonce,
 			},
 		}
@@ -432,13 +483,14 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 				ModuleKind: hsmconnector.SoftHSMModuleKind,
 				ChainID:    *chainID,
 			},
-			From:     address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
-			To:       &toAddress,
-			Gas:      nil,
-			GasPrice: nil,
-			Value:    nil,
-			Data:     *data,
-			Nonce: entities.HexUInt64{
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			Gas:           nil,
+			GasPrice:      nil,
+			Value:         nil,
+			Data:          *data,
+			Nonce: &entities.HexUInt64{
 				UInt64: nonce,
 			},
 		}
@@ -446,6 +498,106 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, signTxOutput)
 	})
+	t.Run("success: nonce assigned when not provided", func(t *testing.T) {
+		from := address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+		_, err := app.NonceUseCase.ResetNonce(ctx, noncemanager.ResetNonceInput{
+			NonceID: noncemanager.NonceID{
+				ChainID: *chainID,
+				Address: from,
+			},
+			NextNonce: 5,
+		})
+		require.Nil(t, err)
+
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			To:            &toAddress,
+			Data:          *data,
+			Nonce:         nil,
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(5), signTxOutput.Transaction.Nonce.UInt64)
+
+		signTxOutput, err = app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(6), signTxOutput.Transaction.Nonce.UInt64)
+	})
+	t.Run("success: provided nonce advances the assigned nonces", func(t *testing.T) {
+		from := address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+		_, err := app.NonceUseCase.ResetNonce(ctx, noncemanager.ResetNonceInput{
+			NonceID: noncemanager.NonceID{
+				ChainID: *chainID,
+				Address: from,
+			},
+			NextNonce: 0,
+		})
+		require.Nil(t, err)
+
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			To:            &toAddress,
+			Data:          *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: 9,
+			},
+		}
+		_, err = app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+
+		signTxInput.Nonce = nil
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(10), signTxOutput.Transaction.Nonce.UInt64)
+	})
+	t.Run("failure: assigned nonce released if the transaction is not signed", func(t *testing.T) {
+		nonceID := noncemanager.NonceID{
+			ChainID: *chainID,
+			Address: validAddress,
+		}
+		_, err := app.NonceUseCase.ResetNonce(ctx, noncemanager.ResetNonceInput{
+			NonceID:   nonceID,
+			NextNonce: 7,
+		})
+		require.Nil(t, err)
+
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          validAddress,
+			To:            &toAddress,
+			Data:          *data,
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Error(t, err)
+		require.Nil(t, signTxOutput)
+
+		getNonceOutput, err := app.NonceUseCase.GetNonce(ctx, noncemanager.GetNonceInput{NonceID: nonceID})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(7), getNonceOutput.NextNonce)
+	})
 	t.Run("success: smart contract deployment (nil to address)", func(t *testing.T) {
 		data := entities.NewHexBytes(hexStringToBytes("0x1234"))
 		signTxInput := hsmconnector.SignTxInput{
@@ -455,8 +607,9 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 				ModuleKind: hsmconnector.SoftHSMModuleKind,
 				ChainID:    *chainID,
 			},
-			From: address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
-			To:   nil,
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            nil,
 			Gas: &entities.HexUInt64{
 				UInt64: 1000,
 			},
@@ -471,13 +624,461 @@ func TestDefaultUseCase_SignTx(t *testing.T) {
 				},
 			},
 			Data: *data,
-			Nonce: entities.HexUInt64{
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+		require.NotNil(t, signTxOutput)
+	})
+	t.Run("success: dynamic fee transaction", func(t *testing.T) {
+		data := entities.NewHexBytes(hexStringToBytes("0x1f170873")) // simpleMethod()
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			Gas: &entities.HexUInt64{
+				UInt64: 1000,
+			},
+			MaxFeePerGas: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *maxFeePerGas,
+				},
+			},
+			MaxPriorityFeePerGas: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *maxPriorityFeePerGas,
+				},
+			},
+			Value: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *value,
+				},
+			},
+			Data: *data,
+			Nonce: &entities.HexUInt64{
 				UInt64: nonce,
 			},
 		}
 		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
 		require.Nil(t, err)
 		require.NotNil(t, signTxOutput)
+		require.Equal(t, hsmconnector.DynamicFeeTxType, signTxOutput.Transaction.Type)
+		require.True(t, strings.HasPrefix(signTxOutput.SignedTx, "0x02"))
+		yParity := signTxOutput.Transaction.Signature.V.BigInt().Int64()
+		require.True(t, yParity == 0 || yParity == 1)
+	})
+	t.Run("failure: gas price in dynamic fee transaction", func(t *testing.T) {
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			GasPrice: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *gasPrice,
+				},
+			},
+			MaxFeePerGas: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *maxFeePerGas,
+				},
+			},
+			Data: *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, signTxOutput)
+	})
+	t.Run("failure: priority fee higher than max fee", func(t *testing.T) {
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			MaxFeePerGas: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *maxPriorityFeePerGas,
+				},
+			},
+			MaxPriorityFeePerGas: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *maxFeePerGas,
+				},
+			},
+			Data: *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, signTxOutput)
+	})
+	t.Run("success: access list transaction", func(t *testing.T) {
+		data := entities.NewHexBytes(hexStringToBytes("0x1f170873")) // simpleMethod()
+		storageKey := entities.HexBytes32{}
+		storageKey.FromBytes(hexStringToBytes("0x0000000000000000000000000000000000000000000000000000000000000003"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			Gas: &entities.HexUInt64{
+				UInt64: 1000,
+			},
+			GasPrice: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *gasPrice,
+				},
+			},
+			AccessList: hsmconnector.AccessList{
+				{
+					Address:     toAddress,
+					StorageKeys: []entities.HexBytes32{storageKey},
+				},
+			},
+			Data: *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Nil(t, err)
+		require.NotNil(t, signTxOutput)
+		require.Equal(t, hsmconnector.AccessListTxType, signTxOutput.Transaction.Type)
+		require.True(t, strings.HasPrefix(signTxOutput.SignedTx, "0x01"))
+		yParity := signTxOutput.Transaction.Signature.V.BigInt().Int64()
+		require.True(t, yParity == 0 || yParity == 1)
+	})
+	t.Run("failure: access list in legacy transaction", func(t *testing.T) {
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		legacyTxType := hsmconnector.LegacyTxType
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress),
+			To:            &toAddress,
+			AccessList:    hsmconnector.AccessList{},
+			Type:          &legacyTxType,
+			Data:          *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, signTxOutput)
+	})
+	t.Run("failure: transaction rejected by signing policy", func(t *testing.T) {
+		from := address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+		createPolicyOutput, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+			ApplicationID: applicationID,
+			Address:       &from,
+			Rules: signingpolicy.Rules{
+				MaxValue: entities.NewInt256FromInt(2),
+			},
+		})
+		require.Nil(t, err)
+		defer func() {
+			_, deleteErr := app.SigningPolicyUseCase.DeleteSigningPolicy(ctx, signingpolicy.DeleteSigningPolicyInput{
+				ApplicationStandardID: createPolicyOutput.ApplicationStandardID,
+			})
+			require.Nil(t, deleteErr)
+		}()
+
+		data := entities.NewHexBytes(hexStringToBytes("0x"))
+		signTxInput := hsmconnector.SignTxInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			To:            &toAddress,
+			Value: &entities.HexInt256{
+				Int256: entities.Int256{
+					Int: *value,
+				},
+			},
+			Data: *data,
+			Nonce: &entities.HexUInt64{
+				UInt64: nonce,
+			},
+		}
+		signTxOutput, err := app.HSMConnector.SignTx(ctx, signTxInput)
+		require.Error(t, err)
+		require.True(t, errors.IsPreconditionFailed(err))
+		require.Nil(t, signTxOutput)
+	})
+}
+
+func TestDefaultUseCase_SignMessage(t *testing.T) {
+	from := address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+
+	t.Run("success: signature recovers the signer address", func(t *testing.T) {
+		message := entities.NewHexBytes([]byte("hello"))
+		signMessageInput := hsmconnector.SignMessageInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			Message:       *message,
+		}
+		signMessageOutput, err := app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Nil(t, err)
+		require.NotNil(t, signMessageOutput)
+		require.Equal(t, "0x50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750", signMessageOutput.Hash.Encode())
+
+		signature := signMessageOutput.Signature.Bytes()
+		require.Len(t, signature, 65)
+		v := signature[64]
+		require.True(t, v == 27 || v == 28)
+
+		compactSignature := append([]byte{v}, signature[:64]...)
+		publicKey, _, err := btcececdsa.RecoverCompact(compactSignature, signMessageOutput.Hash.Bytes())
+		require.Nil(t, err)
+		recoveredAddress, err := signaturemanager.DeriveAddressFromPublicKey(publicKey.SerializeUncompressed())
+		require.Nil(t, err)
+		require.Equal(t, from.String(), recoveredAddress.String())
+	})
+	t.Run("failure: address not found in the slot", func(t *testing.T) {
+		message := entities.NewHexBytes([]byte("hello"))
+		signMessageInput := hsmconnector.SignMessageInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          validAddress,
+			Message:       *message,
+		}
+		signMessageOutput, err := app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Error(t, err)
+		require.Nil(t, signMessageOutput)
+	})
+	t.Run("failure: empty from address", func(t *testing.T) {
+		message := entities.NewHexBytes([]byte("hello"))
+		signMessageInput := hsmconnector.SignMessageInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			Message:       *message,
+		}
+		signMessageOutput, err := app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, signMessageOutput)
+	})
+	t.Run("failure: signing limit exceeded", func(t *testing.T) {
+		subject := from.String()
+		maxSignatures := int64(1)
+		createLimitOutput, err := app.SigningLimitUseCase.CreateSigningLimit(ctx, signinglimit.CreateSigningLimitInput{
+			ApplicationID: applicationID,
+			Scope:         signinglimit.AccountScope,
+			Subject:       &subject,
+			WindowSeconds: 3600,
+			MaxSignatures: &maxSignatures,
+		})
+		require.Nil(t, err)
+		defer func() {
+			_, deleteErr := app.SigningLimitUseCase.DeleteSigningLimit(ctx, signinglimit.DeleteSigningLimitInput{
+				ApplicationStandardID: createLimitOutput.ApplicationStandardID,
+			})
+			require.Nil(t, deleteErr)
+		}()
+
+		message := entities.NewHexBytes([]byte("hello"))
+		signMessageInput := hsmconnector.SignMessageInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			Message:       *message,
+		}
+		signMessageOutput, err := app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Nil(t, err)
+		require.NotNil(t, signMessageOutput)
+
+		signMessageOutput, err = app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Error(t, err)
+		require.True(t, errors.IsTooManyReq(err))
+		require.Nil(t, signMessageOutput)
+	})
+	t.Run("failure: message rejected by signing policy", func(t *testing.T) {
+		createPolicyOutput, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+			ApplicationID: applicationID,
+			Address:       &from,
+			Rules: signingpolicy.Rules{
+				AllowTypedData: true,
+			},
+		})
+		require.Nil(t, err)
+		defer func() {
+			_, deleteErr := app.SigningPolicyUseCase.DeleteSigningPolicy(ctx, signingpolicy.DeleteSigningPolicyInput{
+				ApplicationStandardID: createPolicyOutput.ApplicationStandardID,
+			})
+			require.Nil(t, deleteErr)
+		}()
+
+		message := entities.NewHexBytes([]byte("hello"))
+		signMessageInput := hsmconnector.SignMessageInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			Message:       *message,
+		}
+		signMessageOutput, err := app.HSMConnector.SignMessage(ctx, signMessageInput)
+		require.Error(t, err)
+		require.True(t, errors.IsPreconditionFailed(err))
+		require.Nil(t, signMessageOutput)
+	})
+}
+
+func TestDefaultUseCase_SignTypedData(t *testing.T) {
+	from := address.MustNewFromHexString(signaturemanagertesthelper.ImportedKeyAddress)
+
+	t.Run("success: signature recovers the signer address", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailTypedData))
+		require.Nil(t, err)
+		signTypedDataInput := hsmconnector.SignTypedDataInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			TypedData:     *typedData,
+		}
+		signTypedDataOutput, err := app.HSMConnector.SignTypedData(ctx, signTypedDataInput)
+		require.Nil(t, err)
+		require.NotNil(t, signTypedDataOutput)
+		require.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", signTypedDataOutput.Hash.Encode())
+
+		signature := signTypedDataOutput.Signature.Bytes()
+		require.Len(t, signature, 65)
+		v := signature[64]
+		require.True(t, v == 27 || v == 28)
+
+		compactSignature := append([]byte{v}, signature[:64]...)
+		publicKey, _, err := btcececdsa.RecoverCompact(compactSignature, signTypedDataOutput.Hash.Bytes())
+		require.Nil(t, err)
+		recoveredAddress, err := signaturemanager.DeriveAddressFromPublicKey(publicKey.SerializeUncompressed())
+		require.Nil(t, err)
+		require.Equal(t, from.String(), recoveredAddress.String())
+	})
+	t.Run("failure: primary type not defined", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailTypedData))
+		require.Nil(t, err)
+		typedData.PrimaryType = "Letter"
+		signTypedDataInput := hsmconnector.SignTypedDataInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			TypedData:     *typedData,
+		}
+		signTypedDataOutput, err := app.HSMConnector.SignTypedData(ctx, signTypedDataInput)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, signTypedDataOutput)
+	})
+	t.Run("failure: verifying contract rejected by signing policy", func(t *testing.T) {
+		createPolicyOutput, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+			ApplicationID: applicationID,
+			Address:       &from,
+			Rules: signingpolicy.Rules{
+				AllowTypedData:            true,
+				AllowedVerifyingContracts: []address.Address{address.MustNewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")},
+			},
+		})
+		require.Nil(t, err)
+		defer func() {
+			_, deleteErr := app.SigningPolicyUseCase.DeleteSigningPolicy(ctx, signingpolicy.DeleteSigningPolicyInput{
+				ApplicationStandardID: createPolicyOutput.ApplicationStandardID,
+			})
+			require.Nil(t, deleteErr)
+		}()
+
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailTypedData))
+		require.Nil(t, err)
+		signTypedDataInput := hsmconnector.SignTypedDataInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:       slotID,
+				Pin:        slotPin,
+				ModuleKind: hsmconnector.SoftHSMModuleKind,
+				ChainID:    *chainID,
+			},
+			ApplicationID: applicationID,
+			From:          from,
+			TypedData:     *typedData,
+		}
+		signTypedDataOutput, err := app.HSMConnector.SignTypedData(ctx, signTypedDataInput)
+		require.Error(t, err)
+		require.True(t, errors.IsPreconditionFailed(err))
+		require.Nil(t, signTypedDataOutput)
 	})
 }
 
@@ -495,6 +1096,30 @@ func hexStringToBytes(input string) []byte {
 	return b
 }
 
+func transactionPolicyPort(t *testing.T) hsmconnector.TransactionPolicyPort {
+	port, err := transactionpolicy.ProvideDefaultTransactionPolicyAdapter(transactionpolicy.DefaultTransactionPolicyAdapterOptions{
+		SigningPolicyUseCase: app.SigningPolicyUseCase,
+	})
+	require.Nil(t, err)
+	return port
+}
+
+func signingQuotaPort(t *testing.T) hsmconnector.SigningQuotaPort {
+	port, err := signingquota.ProvideDefaultSigningQuotaAdapter(signingquota.DefaultSigningQuotaAdapterOptions{
+		SigningLimitUseCase: app.SigningLimitUseCase,
+	})
+	require.Nil(t, err)
+	return port
+}
+
+func noncePort(t *testing.T) hsmconnector.NoncePort {
+	port, err := nonceallocator.ProvideDefaultNonceAllocatorAdapter(nonceallocator.DefaultNonceAllocatorAdapterOptions{
+		NonceUseCase: app.NonceUseCase,
+	})
+	require.Nil(t, err)
+	return port
+}
+
 func has0xPrefix(input string) bool {
 	return len(input) >= 2 && input[0] == '0' && (input[1] == 'x' || input[1] == 'X')
 }
@@ -0,0 +1,94 @@
+package hsmconnector
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
+)
+
+// SignTx implements DefaultUseCase's SignTx to be a transactional operation. The nonce allocated to the transaction and
+// the quota consumed by it are released if the transaction can't be signed.
+func (_d *DefaultUseCaseTransactionalDecorator) SignTx(ctx context.Context, input SignTxInput) (*SignTxOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.signTxInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*SignTxOutput), nil
+}
+
+// SignMessage implements DefaultUseCase's SignMessage to be a transactional operation. The quota consumed by the
+// message is released if it can't be signed.
+func (_d *DefaultUseCaseTransactionalDecorator) SignMessage(ctx context.Context, input SignMessageInput) (*SignMessageOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.signMessageInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*SignMessageOutput), nil
+}
+
+// SignTypedData implements DefaultUseCase's SignTypedData to be a transactional operation. The quota consumed by the
+// typed data is released if it can't be signed.
+func (_d *DefaultUseCaseTransactionalDecorator) SignTypedData(ctx context.Context, input SignTypedDataInput) (*SignTypedDataOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.signTypedDataInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*SignTypedDataOutput), nil
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) signTxInternal(_ context.Context, input SignTxInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.SignTx(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) signMessageInternal(_ context.Context, input SignMessageInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.SignMessage(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) signTypedDataInternal(_ context.Context, input SignTypedDataInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.SignTypedData(ctx2, input)
+	}
+}
+
+var _ HSMConnector = new(DefaultUseCaseTransactionalDecorator)
+
+// DefaultUseCaseTransactionalDecorator decorates struct DefaultUseCase wrapped with a transactional manager. Only the
+// operations that store data along with the signature are decorated.
+type DefaultUseCaseTransactionalDecorator struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase
+	// transactionalManager defines the functionality to execute a transaction in a transactional manner.
+	transactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// DefaultUseCaseTransactionalDecoratorOptions is the structure representing the DefaultUseCaseTransactionalDecorator dependencies.
+type DefaultUseCaseTransactionalDecoratorOptions struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase *DefaultUseCase
+	// TransactionalManager defines the functionality to execute a transaction in a transactional manner.
+	TransactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// ProvideDefaultUseCaseTransactionalDecorator creates a new DefaultUseCaseTransactionalDecorator.
+func ProvideDefaultUseCaseTransactionalDecorator(options DefaultUseCaseTransactionalDecoratorOptions) (*DefaultUseCaseTransactionalDecorator, error) {
+	if options.DefaultUseCase == nil {
+		errorMessage := "'DefaultUseCase' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	if options.TransactionalManager == nil {
+		errorMessage := "'TransactionalManager' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	return &DefaultUseCaseTransactionalDecorator{
+		DefaultUseCase:       *options.DefaultUseCase,
+		transactionalManager: options.TransactionalManager,
+	}, nil
+}
@@ -2,13 +2,16 @@ package hsmconnector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/miekg/pkcs11"
 
 	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
 	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager/cloudkms"
 	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager/pkcs11hsm"
 )
 
@@ -18,37 +21,40 @@ type DigitalSignatureManagerFactory interface {
 	Create(ctx context.Context, input CreateInput) (signaturemanager.DigitalSignatureManager, error)
 	// Close closes open resources to the digital signature manager.
 	Close(ctx context.Context, input CloseInput) (*CloseOutput, error)
-	// Reset the snapshot of a given module kind to include slots created after the initialization.
-	Reset(_ context.Context, kind ModuleKind) error
+	// Reset the snapshot of a given module to include slots created after the initialization.
+	Reset(ctx context.Context, input ResetInput) error
 }
 
-func (u *DefaultDigitalSignatureManagerFactory) Reset(ctx context.Context, kind ModuleKind) error {
-	digitalSignatureManager, ok := u.digitalSignatureManagerMap[kind]
-	if !ok {
-		return signererrors.InvalidArgument().WithMessage("error during reset of the digital signature manager: the HSM type '%s' is not supported", kind)
+func (u *DefaultDigitalSignatureManagerFactory) Reset(ctx context.Context, input ResetInput) error {
+	digitalSignatureManager, err := u.get(CreateInput{
+		ModuleKind:          input.ModuleKind,
+		PKCS11Configuration: input.PKCS11Configuration,
+	})
+	if err != nil {
+		return err
 	}
+	// a library that lost its device, or that is no longer initialized, can't be closed cleanly but can be opened again
 	_, closeErr := digitalSignatureManager.Close(ctx, signaturemanager.CloseInput{})
-	if closeErr != nil {
-		return signererrors.Internal().WithMessage("error closing digital signature manager connection '%s'. Error: %v", kind, closeErr)
+	if closeErr != nil && !signaturemanager.IsDeviceUnavailableError(closeErr) {
+		return signererrors.Internal().WithMessage("error closing digital signature manager connection '%s'. Error: %v", input.ModuleKind, closeErr)
 	}
 	_, openErr := digitalSignatureManager.Open(ctx, signaturemanager.OpenInput{})
-	if openErr != nil {
-		return signererrors.Internal().WithMessage("error opening digital signature manager connection '%s'. Error: %v", kind, openErr)
+	if openErr != nil && !signaturemanager.IsAlreadyInitializedErr(openErr) {
+		return signererrors.Internal().WithMessage("error opening digital signature manager connection '%s'. Error: %v", input.ModuleKind, openErr)
 	}
-	u.digitalSignatureManagerMap[kind] = digitalSignatureManager
 
 	return nil
 }
 
 func (u *DefaultDigitalSignatureManagerFactory) Create(ctx context.Context, input CreateInput) (signaturemanager.DigitalSignatureManager, error) {
-	if input.ModuleKind != SoftHSMModuleKind {
+	if input.ModuleKind != SoftHSMModuleKind && input.ModuleKind != CloudKMSModuleKind && input.ModuleKind != PKCS11ModuleKind {
 		errMsg := fmt.Sprintf("the provided module kind '%s' is not supported", input.ModuleKind)
 		return nil, signererrors.InvalidArgument().SetHumanReadableMessage(errMsg).WithMessage(errMsg)
 	}
 
-	digitalSignatureManager, ok := u.digitalSignatureManagerMap[input.ModuleKind]
-	if !ok {
-		return nil, signererrors.InvalidArgument().WithMessage("the provided module kind '%s' is not supported", input.ModuleKind)
+	digitalSignatureManager, err := u.get(input)
+	if err != nil {
+		return nil, err
 	}
 
 	_, openErr := digitalSignatureManager.Open(ctx, signaturemanager.OpenInput{})
@@ -66,22 +72,118 @@ func (u *DefaultDigitalSignatureManagerFactory) Close(ctx context.Context, _ Clo
 			return nil, signererrors.InternalFromErr(err).WithMessage("error closing digital signature manager: '%s'. Error: %v", key, err)
 		}
 	}
+
+	u.pkcs11Mutex.Lock()
+	defer u.pkcs11Mutex.Unlock()
+	// the managers of the same library share its context, so it is finalized only once
+	for library, pkcs11Context := range u.pkcs11Contexts {
+		err := pkcs11Context.Finalize()
+		if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_NOT_INITIALIZED)) {
+			return nil, signererrors.InternalFromErr(err).WithMessage("error finalizing PKCS11 library: '%s'. Error: %v", library, err)
+		}
+	}
 	return &CloseOutput{}, nil
 }
 
+// get returns the digital signature manager for the given input without opening it.
+func (u *DefaultDigitalSignatureManagerFactory) get(input CreateInput) (signaturemanager.DigitalSignatureManager, error) {
+	if input.ModuleKind == PKCS11ModuleKind {
+		return u.getPKCS11(input.PKCS11Configuration)
+	}
+
+	digitalSignatureManager, ok := u.digitalSignatureManagerMap[input.ModuleKind]
+	if !ok {
+		return nil, signererrors.InvalidArgument().WithMessage("the provided module kind '%s' is not supported", input.ModuleKind)
+	}
+	return digitalSignatureManager, nil
+}
+
+// getPKCS11 returns the digital signature manager for the given PKCS11 configuration. Contexts are created lazily, one
+// per library, so that modules of different vendors can be loaded side by side.
+func (u *DefaultDigitalSignatureManagerFactory) getPKCS11(configuration *PKCS11ModuleConfiguration) (signaturemanager.DigitalSignatureManager, error) {
+	if configuration == nil {
+		return nil, signererrors.InvalidArgument().WithMessage("the PKCS11 configuration is required for the module kind '%s'", PKCS11ModuleKind)
+	}
+	if _, ok := u.pkcs11Libraries[configuration.Library]; !ok {
+		errMsg := fmt.Sprintf("the PKCS11 library '%s' is not allowed", configuration.Library)
+		return nil, signererrors.InvalidArgument().SetHumanReadableMessage(errMsg).WithMessage(errMsg)
+	}
+
+	key := pkcs11ManagerKey{
+		library:   configuration.Library,
+		omitKeyID: configuration.OmitKeyID,
+	}
+	if configuration.TokenLabel != nil {
+		key.tokenLabel = *configuration.TokenLabel
+		key.checkTokenLabel = true
+	}
+
+	u.pkcs11Mutex.Lock()
+	defer u.pkcs11Mutex.Unlock()
+	if signatureManager, ok := u.pkcs11Managers[key]; ok {
+		return signatureManager, nil
+	}
+
+	pkcs11Context, ok := u.pkcs11Contexts[configuration.Library]
+	if !ok {
+		pkcs11Context = pkcs11.New(string(configuration.Library))
+		if pkcs11Context == nil {
+			return nil, signererrors.Internal().WithMessage("error instantiating the PKCS11 interface for library '%s'", configuration.Library)
+		}
+		u.pkcs11Contexts[configuration.Library] = pkcs11Context
+	}
+	pkcs11HSMSignatureManagerOptions := pkcs11hsm.PKCS11HSMSignatureManagerOptions{
+		PkcsContext:      pkcs11Context,
+		TokenLabel:       configuration.TokenLabel,
+		OmitKeyID:        configuration.OmitKeyID,
+		OperationTimeout: u.operationTimeouts[PKCS11ModuleKind],
+	}
+	signatureManager, err := pkcs11hsm.ProvidePKCS11HSMSignatureManager(pkcs11HSMSignatureManagerOptions)
+	if err != nil {
+		return nil, signererrors.InternalFromErr(err)
+	}
+	u.pkcs11Managers[key] = signatureManager
+	return signatureManager, nil
+}
+
 var _ DigitalSignatureManagerFactory = new(DefaultDigitalSignatureManagerFactory)
 
-// DefaultDigitalSignatureManagerFactory implements DigitalSignatureManagerFactory to create PKCS11 digital signature
+// DefaultDigitalSignatureManagerFactory implements DigitalSignatureManagerFactory to create PKCS11 and cloud KMS digital signature
 // manager compatible instances.
 // It Initializes the pkcs11 library at creation time so that there is one pkcs11.Ctx per digital signature manager supported type.
+// The libraries of generic PKCS11 modules are initialized the first time they are used, with one pkcs11.Ctx per library.
 type DefaultDigitalSignatureManagerFactory struct {
 	digitalSignatureManagerMap map[ModuleKind]signaturemanager.DigitalSignatureManager
+	// pkcs11Libraries libraries allowed for generic PKCS11 modules.
+	pkcs11Libraries map[PKCS11Library]struct{}
+	// pkcs11Contexts contexts of the generic PKCS11 libraries in use.
+	pkcs11Contexts map[PKCS11Library]*pkcs11.Ctx
+	// pkcs11Managers digital signature managers of the generic PKCS11 modules in use.
+	pkcs11Managers map[pkcs11ManagerKey]signaturemanager.DigitalSignatureManager
+	// pkcs11Mutex guards the generic PKCS11 contexts and managers.
+	pkcs11Mutex sync.Mutex
+	// operationTimeouts maximum time the operations of each module kind can take.
+	operationTimeouts OperationTimeouts
+}
+
+// pkcs11ManagerKey identifies the digital signature manager of a generic PKCS11 module configuration.
+type pkcs11ManagerKey struct {
+	library         PKCS11Library
+	tokenLabel      string
+	checkTokenLabel bool
+	omitKeyID       bool
 }
 
 // DefaultDigitalSignatureManagerFactoryOptions options to create a new DigitalSignatureManagerFactory instance.
 type DefaultDigitalSignatureManagerFactoryOptions struct {
 	// SoftHSMLibrary path to the library to connect to a PKCS11 compatible HSM.
 	SoftHSMLibrary *PKCS11Library
+	// CloudKMS configuration to connect to a cloud KMS REST API.
+	CloudKMS *CloudKMSConfiguration
+	// PKCS11Libraries paths to the libraries that generic PKCS11 modules are allowed to load.
+	PKCS11Libraries PKCS11Libraries
+	// OperationTimeouts maximum time the operations of each module kind can take before they are abandoned.
+	OperationTimeouts OperationTimeouts
 }
 
 // ProvideDefaultDigitalSignatureManagerFactory creates a new DigitalSignatureManagerFactory with the given options.
@@ -96,12 +198,14 @@ func ProvideDefaultDigitalSignatureManagerFactory(options DefaultDigitalSignatur
 		if pkcs11Context == nil {
 			return nil, signererrors.Internal().WithMessage("error instantiating the PKCS11 interface for '%s'", SoftHSMModuleKind)
 		}
+		// the library may have been initialized already by the master key that encrypts the slot pins
 		errInitialize := pkcs11Context.Initialize()
-		if errInitialize != nil {
+		if errInitialize != nil && !errors.Is(errInitialize, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
 			return nil, signererrors.Internal().WithMessage("error calling the PKCS11 interface initialize function for '%s'. Error: %v", SoftHSMModuleKind, errInitialize)
 		}
 		pkcs11HSMSignatureManagerOptions := pkcs11hsm.PKCS11HSMSignatureManagerOptions{
-			PkcsContext: pkcs11Context,
+			PkcsContext:      pkcs11Context,
+			OperationTimeout: options.OperationTimeouts[SoftHSMModuleKind],
 		}
 		signatureManager, err := pkcs11hsm.ProvidePKCS11HSMSignatureManager(pkcs11HSMSignatureManagerOptions)
 		if err != nil {
@@ -110,11 +214,40 @@ func ProvideDefaultDigitalSignatureManagerFactory(options DefaultDigitalSignatur
 		digitalSignatureManagerMap[SoftHSMModuleKind] = signatureManager
 	}
 
-	if len(digitalSignatureManagerMap) == 0 {
-		return nil, signererrors.InvalidArgument().WithMessage("no HSM libraries were provided. At least one is required")
+	if options.CloudKMS != nil {
+		cloudKMSSignatureManagerOptions := cloudkms.CloudKMSSignatureManagerOptions{
+			Endpoint:         options.CloudKMS.Endpoint,
+			Region:           options.CloudKMS.Region,
+			AccessKeyID:      options.CloudKMS.AccessKeyID,
+			SecretAccessKey:  options.CloudKMS.SecretAccessKey,
+			SessionToken:     options.CloudKMS.SessionToken,
+			OperationTimeout: options.OperationTimeouts[CloudKMSModuleKind],
+		}
+		signatureManager, err := cloudkms.ProvideCloudKMSSignatureManager(cloudKMSSignatureManagerOptions)
+		if err != nil {
+			return nil, signererrors.InvalidArgumentFromErr(err).WithMessage("error creating the digital signature manager for '%s'. Error: %v", CloudKMSModuleKind, err)
+		}
+		digitalSignatureManagerMap[CloudKMSModuleKind] = signatureManager
+	}
+
+	pkcs11Libraries := make(map[PKCS11Library]struct{})
+	for _, library := range options.PKCS11Libraries {
+		_, err := os.Stat(string(library))
+		if os.IsNotExist(err) {
+			return nil, signererrors.InvalidArgument().WithMessage("PKCS11 library path '%s' does not exist", library)
+		}
+		pkcs11Libraries[library] = struct{}{}
+	}
+
+	if len(digitalSignatureManagerMap) == 0 && len(pkcs11Libraries) == 0 {
+		return nil, signererrors.InvalidArgument().WithMessage("no HSM modules were configured. At least one is required")
 	}
 
 	return &DefaultDigitalSignatureManagerFactory{
 		digitalSignatureManagerMap: digitalSignatureManagerMap,
+		pkcs11Libraries:            pkcs11Libraries,
+		pkcs11Contexts:             make(map[PKCS11Library]*pkcs11.Ctx),
+		pkcs11Managers:             make(map[pkcs11ManagerKey]signaturemanager.DigitalSignatureManager),
+		operationTimeouts:          options.OperationTimeouts,
 	}, nil
 }
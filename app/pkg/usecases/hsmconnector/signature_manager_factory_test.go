@@ -0,0 +1,133 @@
+package hsmconnector_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/signaturemanager"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/test/signaturemanagertesthelper"
+
+	"github.com/stretchr/testify/require"
+)
+
+// softHSMTokenLabel is the label of the token initialized by signaturemanagertesthelper.InitializeSoftHSMSlot.
+const softHSMTokenLabel = "WALLET-000"
+
+func TestProvideDefaultDigitalSignatureManagerFactory(t *testing.T) {
+	t.Run("success: only PKCS11 libraries", func(t *testing.T) {
+		factory, err := hsmconnector.ProvideDefaultDigitalSignatureManagerFactory(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions{
+			PKCS11Libraries: hsmconnector.PKCS11Libraries{signaturemanagertesthelper.SoftHSMLib},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, factory)
+	})
+	t.Run("failure: no modules configured", func(t *testing.T) {
+		factory, err := hsmconnector.ProvideDefaultDigitalSignatureManagerFactory(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions{})
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, factory)
+	})
+	t.Run("failure: PKCS11 library does not exist", func(t *testing.T) {
+		factory, err := hsmconnector.ProvideDefaultDigitalSignatureManagerFactory(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions{
+			PKCS11Libraries: hsmconnector.PKCS11Libraries{"/nonexistent/pkcs11.so"},
+		})
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, factory)
+	})
+}
+
+func TestDefaultDigitalSignatureManagerFactory_PKCS11(t *testing.T) {
+	factory, err := hsmconnector.ProvideDefaultDigitalSignatureManagerFactory(hsmconnector.DefaultDigitalSignatureManagerFactoryOptions{
+		PKCS11Libraries: hsmconnector.PKCS11Libraries{signaturemanagertesthelper.SoftHSMLib},
+	})
+	require.NoError(t, err)
+
+	t.Run("success: generate, list and remove keys without CKA_ID", func(t *testing.T) {
+		tokenLabel := softHSMTokenLabel
+		signatureManager, createErr := factory.Create(ctx, hsmconnector.CreateInput{
+			ModuleKind: hsmconnector.PKCS11ModuleKind,
+			PKCS11Configuration: &hsmconnector.PKCS11ModuleConfiguration{
+				Library:    signaturemanagertesthelper.SoftHSMLib,
+				TokenLabel: &tokenLabel,
+				OmitKeyID:  true,
+			},
+		})
+		require.NoError(t, createErr)
+
+		generated, generateErr := signatureManager.GenerateKey(ctx, signaturemanager.GenerateKeyInput{
+			Slot:   slotID,
+			Pin:    slotPin,
+			Tracer: logger.NewTracer(ctx),
+		})
+		require.NoError(t, generateErr)
+
+		listed, listErr := signatureManager.ListKeys(ctx, signaturemanager.ListKeysInput{
+			Slot:   slotID,
+			Pin:    slotPin,
+			Tracer: logger.NewTracer(ctx),
+		})
+		require.NoError(t, listErr)
+		require.Contains(t, listed.Items, generated.Address)
+
+		_, removeErr := signatureManager.RemoveKey(ctx, signaturemanager.RemoveKeyInput{
+			Slot:    slotID,
+			Pin:     slotPin,
+			Tracer:  logger.NewTracer(ctx),
+			Address: generated.Address,
+		})
+		require.NoError(t, removeErr)
+	})
+
+	t.Run("success: the same configuration returns the same signature manager", func(t *testing.T) {
+		input := hsmconnector.CreateInput{
+			ModuleKind: hsmconnector.PKCS11ModuleKind,
+			PKCS11Configuration: &hsmconnector.PKCS11ModuleConfiguration{
+				Library: signaturemanagertesthelper.SoftHSMLib,
+			},
+		}
+		signatureManager, createErr := factory.Create(ctx, input)
+		require.NoError(t, createErr)
+		otherSignatureManager, createErr := factory.Create(ctx, input)
+		require.NoError(t, createErr)
+		require.Same(t, signatureManager, otherSignatureManager)
+	})
+
+	t.Run("failure: token label does not match", func(t *testing.T) {
+		tokenLabel := "other-token"
+		signatureManager, createErr := factory.Create(ctx, hsmconnector.CreateInput{
+			ModuleKind: hsmconnector.PKCS11ModuleKind,
+			PKCS11Configuration: &hsmconnector.PKCS11ModuleConfiguration{
+				Library:    signaturemanagertesthelper.SoftHSMLib,
+				TokenLabel: &tokenLabel,
+			},
+		})
+		require.NoError(t, createErr)
+
+		_, isAliveErr := signatureManager.IsAlive(ctx, signaturemanager.IsAliveInput{
+			Slot:   slotID,
+			Pin:    slotPin,
+			Tracer: logger.NewTracer(ctx),
+		})
+		require.True(t, signaturemanager.IsInvalidSlotError(isAliveErr))
+	})
+
+	t.Run("failure: library not allowed", func(t *testing.T) {
+		signatureManager, createErr := factory.Create(ctx, hsmconnector.CreateInput{
+			ModuleKind: hsmconnector.PKCS11ModuleKind,
+			PKCS11Configuration: &hsmconnector.PKCS11ModuleConfiguration{
+				Library: "/usr/lib/pkcs11/vendor_pkcs11.so",
+			},
+		})
+		require.True(t, errors.IsInvalidArgument(createErr))
+		require.Nil(t, signatureManager)
+	})
+
+	t.Run("failure: missing PKCS11 configuration", func(t *testing.T) {
+		signatureManager, createErr := factory.Create(ctx, hsmconnector.CreateInput{
+			ModuleKind: hsmconnector.PKCS11ModuleKind,
+		})
+		require.True(t, errors.IsInvalidArgument(createErr))
+		require.Nil(t, signatureManager)
+	})
+}
@@ -0,0 +1,393 @@
+package hsmconnector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+)
+
+const (
+	// typedDataDomainType is the name of the type that defines the domain of typed data.
+	typedDataDomainType = "EIP712Domain"
+	// typedDataVerifyingContractField is the name of the field of the domain with the address of the verifying contract.
+	typedDataVerifyingContractField = "verifyingContract"
+	// typedDataWordLength is the length in bytes of each encoded value of typed data.
+	typedDataWordLength = 32
+)
+
+var (
+	typedDataIntegerTypeRegexp = regexp.MustCompile(`^(u?)int(\d*)$`)
+	typedDataBytesTypeRegexp   = regexp.MustCompile(`^bytes(\d+)$`)
+	typedDataArrayTypeRegexp   = regexp.MustCompile(`^(.+)\[(\d*)]$`)
+)
+
+// TypedData represents typed structured data to be signed as defined in EIP-712.
+type TypedData struct {
+	// Types definitions of the structs used by the domain and the message, including the 'EIP712Domain' type.
+	Types map[string][]TypedDataField `json:"types"`
+	// PrimaryType of the message.
+	PrimaryType string `json:"primaryType"`
+	// Domain separator values, whose type is 'EIP712Domain'.
+	Domain map[string]any `json:"domain"`
+	// Message values, whose type is the PrimaryType.
+	Message map[string]any `json:"message"`
+}
+
+// TypedDataField is a member of a typed data struct.
+type TypedDataField struct {
+	// Name of the member.
+	Name string `json:"name"`
+	// Type of the member.
+	Type string `json:"type"`
+}
+
+// NewTypedDataFromJSON decodes typed data from its JSON representation, keeping numbers with arbitrary precision.
+func NewTypedDataFromJSON(input []byte) (*TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	var typedData TypedData
+	if err := decoder.Decode(&typedData); err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("typed data is not a valid JSON object")
+	}
+	return &typedData, nil
+}
+
+// Hash calculates the hash to be signed for the typed data as defined in EIP-712, that is,
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message)). The hash of the message is omitted if the primary type
+// is the domain type.
+func (t TypedData) Hash() (*entities.HexBytes, error) {
+	if _, ok := t.Types[typedDataDomainType]; !ok {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("typed data must define the '%s' type", typedDataDomainType)
+	}
+	if _, ok := t.Types[t.PrimaryType]; !ok {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("primary type '%s' is not defined in the typed data types", t.PrimaryType)
+	}
+
+	domainSeparator, err := t.hashStruct(typedDataDomainType, t.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := append([]byte{0x19, 0x01}, domainSeparator...)
+	if t.PrimaryType != typedDataDomainType {
+		messageHash, hashErr := t.hashStruct(t.PrimaryType, t.Message)
+		if hashErr != nil {
+			return nil, hashErr
+		}
+		payload = append(payload, messageHash...)
+	}
+
+	hash, err := hashKeccak256(payload)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the Keccak256 of the typed data to sign")
+	}
+
+	return entities.NewHexBytes(hash), nil
+}
+
+// VerifyingContract returns the verifying contract of the domain of the typed data, or nil if the domain type doesn't
+// define it. Values of the domain that are not defined by its type are ignored, as they are not part of the signed hash.
+func (t TypedData) VerifyingContract() (*address.Address, error) {
+	for _, field := range t.Types[typedDataDomainType] {
+		if field.Name != typedDataVerifyingContractField {
+			continue
+		}
+		value, ok := t.Domain[field.Name].(string)
+		if field.Type != "address" || !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("typed data field '%s' of the domain must be an address", field.Name)
+		}
+		addr, err := address.NewFromHexString(value)
+		if err != nil {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("typed data field '%s' of the domain must be an address", field.Name)
+		}
+		return &addr, nil
+	}
+	return nil, nil
+}
+
+// hashStruct calculates keccak256(typeHash || encodeData(data)) for the given struct type.
+func (t TypedData) hashStruct(typeName string, data map[string]any) ([]byte, error) {
+	encodedData, err := t.encodeData(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hashKeccak256(encodedData)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the Keccak256 of struct '%s'", typeName)
+	}
+	return hash, nil
+}
+
+// encodeData encodes the values of a struct as typeHash || enc(value1) || enc(value2) || ...
+func (t TypedData) encodeData(typeName string, data map[string]any) ([]byte, error) {
+	if data == nil {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("missing values of struct '%s'", typeName)
+	}
+	typeHash, err := hashKeccak256([]byte(t.encodeType(typeName)))
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the type hash of struct '%s'", typeName)
+	}
+
+	encoded := typeHash
+	for _, field := range t.Types[typeName] {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("missing field '%s' of struct '%s'", field.Name, typeName)
+		}
+		encodedValue, encodeErr := t.encodeValue(field.Type, value)
+		if encodeErr != nil {
+			return nil, encodeErr
+		}
+		encoded = append(encoded, encodedValue...)
+	}
+	return encoded, nil
+}
+
+// encodeType encodes a struct type as name(type1 name1,type2 name2,...), followed by all the struct types it references
+// sorted by name.
+func (t TypedData) encodeType(typeName string) string {
+	dependencies := make(map[string]bool)
+	t.collectDependencies(typeName, dependencies)
+	delete(dependencies, typeName)
+
+	sortedDependencies := make([]string, 0, len(dependencies))
+	for dependency := range dependencies {
+		sortedDependencies = append(sortedDependencies, dependency)
+	}
+	sort.Strings(sortedDependencies)
+
+	var builder strings.Builder
+	for _, name := range append([]string{typeName}, sortedDependencies...) {
+		members := make([]string, len(t.Types[name]))
+		for i, field := range t.Types[name] {
+			members[i] = fmt.Sprintf("%s %s", field.Type, field.Name)
+		}
+		builder.WriteString(fmt.Sprintf("%s(%s)", name, strings.Join(members, ",")))
+	}
+	return builder.String()
+}
+
+// collectDependencies adds to dependencies the given type and all the struct types referenced by it.
+func (t TypedData) collectDependencies(typeName string, dependencies map[string]bool) {
+	typeName = typedDataBaseType(typeName)
+	if dependencies[typeName] {
+		return
+	}
+	fields, ok := t.Types[typeName]
+	if !ok {
+		return
+	}
+	dependencies[typeName] = true
+	for _, field := range fields {
+		t.collectDependencies(field.Type, dependencies)
+	}
+}
+
+// encodeValue encodes a single value as a 32 bytes word.
+func (t TypedData) encodeValue(typeName string, value any) ([]byte, error) {
+	if matches := typedDataArrayTypeRegexp.FindStringSubmatch(typeName); matches != nil {
+		return t.encodeArray(matches[1], matches[2], value)
+	}
+
+	if _, ok := t.Types[typeName]; ok {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type '%s' must be an object", typeName)
+		}
+		return t.hashStruct(typeName, data)
+	}
+
+	switch typeName {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type 'string' must be a string")
+		}
+		return hashTypedDataValue([]byte(str))
+	case "bytes":
+		data, err := typedDataBytes(typeName, value)
+		if err != nil {
+			return nil, err
+		}
+		return hashTypedDataValue(data)
+	case "bool":
+		boolean, ok := value.(bool)
+		if !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type 'bool' must be a boolean")
+		}
+		if boolean {
+			return leftPadWord(big.NewInt(1).Bytes()), nil
+		}
+		return leftPadWord(nil), nil
+	case "address":
+		str, ok := value.(string)
+		if !ok {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type 'address' must be a string")
+		}
+		addr, err := address.NewFromHexString(str)
+		if err != nil {
+			return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("invalid address '%s'", str)
+		}
+		return leftPadWord(addr[:]), nil
+	}
+
+	if matches := typedDataBytesTypeRegexp.FindStringSubmatch(typeName); matches != nil {
+		size, err := strconv.Atoi(matches[1])
+		if err != nil || size < 1 || size > typedDataWordLength {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("type '%s' is not supported", typeName)
+		}
+		data, err := typedDataBytes(typeName, value)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > size {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type '%s' exceeds %d bytes", typeName, size)
+		}
+		word := make([]byte, typedDataWordLength)
+		copy(word, data)
+		return word, nil
+	}
+
+	if matches := typedDataIntegerTypeRegexp.FindStringSubmatch(typeName); matches != nil {
+		return encodeTypedDataInteger(typeName, matches[1] == "u", matches[2], value)
+	}
+
+	return nil, errors.InvalidArgument().SetHumanReadableMessage("type '%s' is not supported", typeName)
+}
+
+// encodeArray encodes an array as keccak256(enc(item1) || enc(item2) || ...).
+func (t TypedData) encodeArray(itemType string, length string, value any) ([]byte, error) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type '%s[%s]' must be an array", itemType, length)
+	}
+	if len(length) > 0 {
+		expectedLength, err := strconv.Atoi(length)
+		if err != nil || expectedLength != len(items) {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type '%s[%s]' must have %s items", itemType, length, length)
+		}
+	}
+
+	var encoded []byte
+	for _, item := range items {
+		encodedItem, err := t.encodeValue(itemType, item)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, encodedItem...)
+	}
+	return hashTypedDataValue(encoded)
+}
+
+// encodeTypedDataInteger encodes a signed or unsigned integer as a 32 bytes two's complement word, checking its range.
+func encodeTypedDataInteger(typeName string, unsigned bool, bitsSize string, value any) ([]byte, error) {
+	bits := 256
+	if len(bitsSize) > 0 {
+		var err error
+		bits, err = strconv.Atoi(bitsSize)
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("type '%s' is not supported", typeName)
+		}
+	}
+
+	number, err := typedDataInteger(value)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("invalid value of type '%s'", typeName)
+	}
+
+	var minValue, maxValue *big.Int
+	if unsigned {
+		minValue = big.NewInt(0)
+		maxValue = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits)), big.NewInt(1))
+	} else {
+		maxValue = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits-1)), big.NewInt(1))
+		minValue = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), uint(bits-1)))
+	}
+	if number.Cmp(minValue) < 0 || number.Cmp(maxValue) > 0 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("value '%s' is out of range for type '%s'", number.String(), typeName)
+	}
+
+	if number.Sign() < 0 {
+		// two's complement in 256 bits
+		number = new(big.Int).Add(number, new(big.Int).Lsh(big.NewInt(1), typedDataWordLength*8))
+	}
+	return leftPadWord(number.Bytes()), nil
+}
+
+// typedDataInteger converts a JSON value to an integer. Strings can be either decimal or hexadecimal with 0x prefix.
+func typedDataInteger(value any) (*big.Int, error) {
+	var str string
+	switch v := value.(type) {
+	case json.Number:
+		str = v.String()
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		str = v
+	default:
+		return nil, fmt.Errorf("value must be a number or a string")
+	}
+
+	number, ok := new(big.Int), false
+	if strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X") {
+		number, ok = number.SetString(str[2:], 16)
+	} else if strings.HasPrefix(str, "-0x") || strings.HasPrefix(str, "-0X") {
+		number, ok = number.SetString(str[3:], 16)
+		if ok {
+			number.Neg(number)
+		}
+	} else {
+		number, ok = number.SetString(str, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("value '%s' is not an integer", str)
+	}
+	return number, nil
+}
+
+// typedDataBytes decodes a hex string with 0x prefix.
+func typedDataBytes(typeName string, value any) ([]byte, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("value of type '%s' must be a hex string", typeName)
+	}
+	data, err := entities.NewHexBytesFromString(str)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("invalid value of type '%s'", typeName)
+	}
+	return data.Bytes(), nil
+}
+
+// typedDataBaseType removes the array suffixes of a type.
+func typedDataBaseType(typeName string) string {
+	for {
+		matches := typedDataArrayTypeRegexp.FindStringSubmatch(typeName)
+		if matches == nil {
+			return typeName
+		}
+		typeName = matches[1]
+	}
+}
+
+func hashTypedDataValue(data []byte) ([]byte, error) {
+	hash, err := hashKeccak256(data)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the Keccak256 of a typed data value")
+	}
+	return hash, nil
+}
+
+func leftPadWord(data []byte) []byte {
+	word := make([]byte, typedDataWordLength)
+	copy(word[typedDataWordLength-len(data):], data)
+	return word
+}
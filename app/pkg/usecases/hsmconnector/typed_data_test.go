@@ -0,0 +1,151 @@
+package hsmconnector_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mailTypedData is the example of EIP-712, see https://github.com/ethereum/EIPs/blob/master/assets/eip-712/Example.js
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+// mailWithArraysTypedData is the example with arrays used to test eth_signTypedData_v4 in MetaMask's eth-sig-util.
+const mailWithArraysTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallets", "type": "address[]"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person[]"},
+			{"name": "contents", "type": "string"}
+		],
+		"Group": [
+			{"name": "name", "type": "string"},
+			{"name": "members", "type": "Person[]"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {
+			"name": "Cow",
+			"wallets": ["0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"]
+		},
+		"to": [
+			{
+				"name": "Bob",
+				"wallets": ["0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB", "0xB0BdaBea57B0BDABeA57b0bdABEA57b0BDabEa57", "0xB0B0b0b0b0b0B000000000000000000000000000"]
+			}
+		],
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestTypedDataHash(t *testing.T) {
+	t.Run("struct with nested structs", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailTypedData))
+		require.Nil(t, err)
+		hash, err := typedData.Hash()
+		require.Nil(t, err)
+		require.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash.Encode())
+	})
+	t.Run("struct with arrays", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailWithArraysTypedData))
+		require.Nil(t, err)
+		hash, err := typedData.Hash()
+		require.Nil(t, err)
+		require.Equal(t, "0xa85c2e2b118698e88db68a8105b794a8cc7cec074e89ef991cb4f5f533819cc2", hash.Encode())
+	})
+	t.Run("failure: missing domain type", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":{"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{},"message":{"contents":"Hello"}}`))
+		require.Nil(t, err)
+		_, err = typedData.Hash()
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+	t.Run("failure: missing message field", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"Ether Mail"},"message":{}}`))
+		require.Nil(t, err)
+		_, err = typedData.Hash()
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+	t.Run("failure: integer out of range", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"amount","type":"uint8"}]},"primaryType":"Mail","domain":{"name":"Ether Mail"},"message":{"amount":256}}`))
+		require.Nil(t, err)
+		_, err = typedData.Hash()
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+	t.Run("failure: invalid JSON", func(t *testing.T) {
+		_, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":`))
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+}
+
+func TestTypedDataVerifyingContract(t *testing.T) {
+	t.Run("verifying contract of the domain", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(mailTypedData))
+		require.Nil(t, err)
+		verifyingContract, err := typedData.VerifyingContract()
+		require.Nil(t, err)
+		require.Equal(t, address.MustNewFromHexString("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"), *verifyingContract)
+	})
+	t.Run("verifying contract not defined by the domain type", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"Ether Mail","verifyingContract":"0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"},"message":{"contents":"Hello"}}`))
+		require.Nil(t, err)
+		verifyingContract, err := typedData.VerifyingContract()
+		require.Nil(t, err)
+		require.Nil(t, verifyingContract)
+	})
+	t.Run("failure: verifying contract is not an address", func(t *testing.T) {
+		typedData, err := hsmconnector.NewTypedDataFromJSON([]byte(`{"types":{"EIP712Domain":[{"name":"verifyingContract","type":"address"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"verifyingContract":"0x1234"},"message":{"contents":"Hello"}}`))
+		require.Nil(t, err)
+		_, err = typedData.VerifyingContract()
+		require.True(t, errors.IsInvalidArgument(err))
+	})
+}
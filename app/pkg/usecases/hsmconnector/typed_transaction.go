@@ -0,0 +1,126 @@
+package hsmconnector
+
+import (
+	"math/big"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/rlp"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+)
+
+// typedRLPEncode encodes a signed typed transaction as defined in EIP-2718, that is, type || rlp(payload, yParity, R, S).
+func (tx EthereumTransaction) typedRLPEncode() (*entities.HexBytes, error) {
+	payload, err := tx.typedPayload()
+	if err != nil {
+		return nil, err
+	}
+	payload = append(payload,
+		tx.Signature.V.BigInt(),
+		tx.Signature.R.BigInt(),
+		tx.Signature.S.BigInt(),
+	)
+
+	rlpEncode, err := rlp.Encode(payload)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to RLP encode the signed typed transaction")
+	}
+
+	return entities.NewHexBytes(append([]byte{byte(tx.Type)}, rlpEncode...)), nil
+}
+
+// typedHash calculates the hash to sign for a typed transaction as defined in EIP-2718, that is, keccak256(type || rlp(payload)).
+func (tx EthereumTransaction) typedHash() (*entities.HexBytes, error) {
+	payload, err := tx.typedPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. RLP encode of the payload
+	rlpEncode, err := rlp.Encode(payload)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to RLP encode the payload to sign")
+	}
+
+	// 2. Keccak256 of the RLP encoded payload prefixed with the transaction type
+	hash, err := hashKeccak256(append([]byte{byte(tx.Type)}, rlpEncode...))
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the Keccak256 of the payload to sign")
+	}
+
+	return entities.NewHexBytes(hash), nil
+}
+
+// typedPayload returns the fields of a typed transaction, without its signature, in the order defined by its EIP.
+func (tx EthereumTransaction) typedPayload() ([]interface{}, error) {
+	var toBytes []byte
+	if tx.To != nil {
+		hexBytes, err := entities.NewHexBytesFromString(tx.To.String())
+		if err != nil {
+			return nil, errors.Internal().WithMessage("could not convert 'to' to hex bytes")
+		}
+		toBytes = hexBytes.Bytes()
+	} else {
+		toBytes = []byte{}
+	}
+
+	data := tx.Data.Bytes()
+	if data == nil {
+		data = []byte{}
+	}
+
+	accessList := tx.AccessList.rlpPayload()
+
+	switch tx.Type {
+	case AccessListTxType:
+		// rlp([chainId, nonce, gasPrice, gasLimit, to, value, data, accessList])
+		// src: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-2930.md#parameters
+		return []interface{}{
+			tx.ChainID.BigInt(),
+			new(big.Int).SetUint64(tx.Nonce.Uint64()),
+			tx.GasPrice.BigInt(),
+			new(big.Int).SetUint64(tx.Gas.Uint64()),
+			toBytes,
+			optionalBigInt(tx.Value),
+			data,
+			accessList,
+		}, nil
+	case DynamicFeeTxType:
+		// rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, to, value, data, accessList])
+		// src: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1559.md#specification
+		return []interface{}{
+			tx.ChainID.BigInt(),
+			new(big.Int).SetUint64(tx.Nonce.Uint64()),
+			optionalBigInt(tx.MaxPriorityFeePerGas),
+			optionalBigInt(tx.MaxFeePerGas),
+			new(big.Int).SetUint64(tx.Gas.Uint64()),
+			toBytes,
+			optionalBigInt(tx.Value),
+			data,
+			accessList,
+		}, nil
+	default:
+		return nil, errors.Internal().WithMessage("unsupported transaction type '%d'", tx.Type)
+	}
+}
+
+// rlpPayload returns the access list as nested lists of [address, [storageKey, ...]] tuples to be RLP encoded.
+func (accessList AccessList) rlpPayload() []interface{} {
+	payload := make([]interface{}, len(accessList))
+	for i, tuple := range accessList {
+		storageKeys := make([][]byte, len(tuple.StorageKeys))
+		for j, storageKey := range tuple.StorageKeys {
+			storageKeys[j] = storageKey.Bytes()
+		}
+		addr := tuple.Address
+		payload[i] = []interface{}{addr[:], storageKeys}
+	}
+	return payload
+}
+
+// optionalBigInt returns the big.Int value of the given number or nil if it is not provided, so that it is RLP encoded as zero.
+func optionalBigInt(value *entities.HexInt256) *big.Int {
+	if value == nil {
+		return nil
+	}
+	return value.BigInt()
+}
@@ -1,7 +1,9 @@
 package hsmconnector
 
 import (
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/hyperledger-labs/signare/app/pkg/commons/rlp"
 	"github.com/hyperledger-labs/signare/app/pkg/entities"
@@ -12,16 +14,80 @@ import (
 // PKCS11Library path to the library to connect to a PKCS11 compatible HSM.
 type PKCS11Library string
 
+// PKCS11Libraries paths to the libraries that generic PKCS11 modules are allowed to load.
+type PKCS11Libraries []PKCS11Library
+
+// PKCS11ModuleConfiguration configuration to connect to a module through a generic PKCS11 library.
+type PKCS11ModuleConfiguration struct {
+	// Library path to the PKCS11 library of the vendor.
+	Library PKCS11Library `valid:"required"`
+	// TokenLabel of the token that the slots must hold. It is not checked if it is nil.
+	TokenLabel *string
+	// OmitKeyID whether the CKA_ID attribute must not be set on generated keys.
+	OmitKeyID bool
+}
+
+// CloudKMSConfiguration configuration to connect to a cloud KMS REST API.
+type CloudKMSConfiguration struct {
+	// Endpoint URL of the KMS REST API.
+	Endpoint string
+	// Region where the keys are managed.
+	Region string
+	// AccessKeyID to sign the requests. Requests are not signed if it is empty, e.g. when using a local KMS emulator.
+	AccessKeyID string
+	// SecretAccessKey to sign the requests.
+	SecretAccessKey string
+	// SessionToken to sign the requests with temporary credentials.
+	SessionToken string
+}
+
 // ModuleKind HSM type.
 type ModuleKind string
 
 const (
-	SoftHSMModuleKind ModuleKind = "SoftHSM"
+	SoftHSMModuleKind  ModuleKind = "SoftHSM"
+	CloudKMSModuleKind ModuleKind = "CloudKMS"
+	PKCS11ModuleKind   ModuleKind = "PKCS11"
 )
 
+// DefaultOperationTimeout maximum time an operation on an HSM can take if no other timeout is configured for its module kind.
+const DefaultOperationTimeout = 10 * time.Second
+
+// OperationTimeouts maximum time the operations on the HSMs of each module kind can take before they are abandoned.
+// Operations on the module kinds with no timeout, or with a timeout of 0, are only bound by the context of the request.
+type OperationTimeouts map[ModuleKind]time.Duration
+
+// ethereumSignedMessagePrefix is prepended to the messages before signing them, see https://github.com/ethereum/EIPs/blob/master/EIPS/eip-191.md.
+const ethereumSignedMessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// TransactionType is the type of an Ethereum transaction as defined in EIP-2718.
+type TransactionType uint8
+
+const (
+	// LegacyTxType identifies legacy transactions, replay protected as defined in EIP-155.
+	LegacyTxType TransactionType = 0x00
+	// AccessListTxType identifies access list transactions as defined in EIP-2930.
+	AccessListTxType TransactionType = 0x01
+	// DynamicFeeTxType identifies dynamic fee transactions as defined in EIP-1559.
+	DynamicFeeTxType TransactionType = 0x02
+)
+
+// AccessList is a list of addresses and storage keys that the transaction plans to access as defined in EIP-2930.
+type AccessList []AccessTuple
+
+// AccessTuple is an entry of an AccessList.
+type AccessTuple struct {
+	// Address of the account or contract to be accessed.
+	Address address.Address
+	// StorageKeys of the Address to be accessed.
+	StorageKeys []entities.HexBytes32
+}
+
 // CreateInput input data to create a new instance using the factory.
 type CreateInput struct {
 	ModuleKind ModuleKind
+	// PKCS11Configuration configuration of the library. Required for the PKCS11 module kind.
+	PKCS11Configuration *PKCS11ModuleConfiguration
 }
 
 // PKCS11ConnectionDetails HSM connection details.
@@ -58,7 +124,9 @@ type SlotConnectionData struct {
 	// Pin that grants access to the slot.
 	Pin string `valid:"required"`
 	// ModuleKind of the Hardware Security Module.
-	ModuleKind ModuleKind `valid:"in(SoftHSM)"`
+	ModuleKind ModuleKind `valid:"in(SoftHSM|CloudKMS|PKCS11)"`
+	// PKCS11Configuration configuration of the library. Required for the PKCS11 module kind.
+	PKCS11Configuration *PKCS11ModuleConfiguration
 	// ChainID id of the chain.
 	ChainID entities.Int256 `valid:"required"`
 }
@@ -99,6 +167,8 @@ type ListAddressesOutput struct {
 type SignTxInput struct {
 	// SlotConnectionData configuration to connect to a slot.
 	SlotConnectionData
+	// ApplicationID of the application whose policies and limits the transaction must comply with.
+	ApplicationID string `valid:"required"`
 	// From address.
 	From address.Address `valid:"address"`
 	// To address.
@@ -107,12 +177,20 @@ type SignTxInput struct {
 	Gas *entities.HexUInt64 `valid:"optional"`
 	// GasPrice to use for each paid gas.
 	GasPrice *entities.HexInt256 `valid:"optional"`
+	// MaxFeePerGas maximum total fee per gas the sender is willing to pay. Only valid for dynamic fee transactions.
+	MaxFeePerGas *entities.HexInt256 `valid:"optional"`
+	// MaxPriorityFeePerGas maximum fee per gas the sender is willing to give to the block producer. Only valid for dynamic fee transactions.
+	MaxPriorityFeePerGas *entities.HexInt256 `valid:"optional"`
+	// AccessList of addresses and storage keys that the transaction plans to access. Not valid for legacy transactions.
+	AccessList AccessList `valid:"-"`
+	// Type of the transaction. If it is not provided, it is inferred from the fee and access list fields.
+	Type *TransactionType `valid:"optional"`
 	// Value amount sent with this transaction.
 	Value *entities.HexInt256 `valid:"optional"`
 	// Data arguments packed according to JSON RPC standard.
 	Data entities.HexBytes // it can be empty (byte array of length 0) in eth-transfers
-	// Nonce integer to identify request.
-	Nonce entities.HexUInt64
+	// Nonce integer to identify request. If it is nil, the next nonce of the account in the chain is assigned.
+	Nonce *entities.HexUInt64 `valid:"optional"`
 }
 
 // SignTxOutput for transaction signing responses.
@@ -123,6 +201,125 @@ type SignTxOutput struct {
 	Transaction EthereumTransaction
 }
 
+// EvaluateTransactionInput transaction to be evaluated against the policies of an application.
+type EvaluateTransactionInput struct {
+	// ApplicationID of the application the transaction is signed in.
+	ApplicationID string
+	// Transaction to be signed.
+	Transaction EthereumTransaction
+}
+
+// EvaluateTransactionOutput the transaction complies with the policies of the application.
+type EvaluateTransactionOutput struct {
+}
+
+// EvaluateMessageInput message to be evaluated against the policies of an application.
+type EvaluateMessageInput struct {
+	// ApplicationID of the application the message is signed in.
+	ApplicationID string
+	// From address of the account that signs the message.
+	From address.Address
+}
+
+// EvaluateMessageOutput the message is allowed by the policies of the application.
+type EvaluateMessageOutput struct {
+}
+
+// EvaluateTypedDataInput typed data to be evaluated against the policies of an application.
+type EvaluateTypedDataInput struct {
+	// ApplicationID of the application the typed data is signed in.
+	ApplicationID string
+	// From address of the account that signs the typed data.
+	From address.Address
+	// VerifyingContract of the domain of the typed data. It is nil if the domain doesn't define it.
+	VerifyingContract *address.Address
+}
+
+// EvaluateTypedDataOutput the typed data is allowed by the policies of the application.
+type EvaluateTypedDataOutput struct {
+}
+
+// ConsumeQuotaInput signature to be counted against the limits of an application.
+type ConsumeQuotaInput struct {
+	// ApplicationID of the application the signature is made in.
+	ApplicationID string
+	// From address of the account that signs.
+	From address.Address
+	// Value amount of wei transferred. It is nil for signatures that are not transactions.
+	Value *entities.Int256
+}
+
+// ConsumeQuotaOutput the signature is within the limits of the application.
+type ConsumeQuotaOutput struct {
+}
+
+// AllocateNonceInput account and chain to allocate a nonce for.
+type AllocateNonceInput struct {
+	// ChainID of the chain the transaction is signed for.
+	ChainID entities.Int256
+	// From address of the account that signs.
+	From address.Address
+}
+
+// AllocateNonceOutput nonce allocated to a transaction.
+type AllocateNonceOutput struct {
+	// Nonce allocated to the transaction.
+	Nonce entities.HexUInt64
+}
+
+// AdvanceNonceInput nonce used by a transaction of an account in a chain.
+type AdvanceNonceInput struct {
+	// ChainID of the chain the transaction is signed for.
+	ChainID entities.Int256
+	// From address of the account that signs.
+	From address.Address
+	// Nonce of the transaction.
+	Nonce entities.HexUInt64
+}
+
+// AdvanceNonceOutput defines the output of advancing the nonce of an account.
+type AdvanceNonceOutput struct{}
+
+// SignMessageInput for message signing requests.
+type SignMessageInput struct {
+	// SlotConnectionData configuration to connect to a slot.
+	SlotConnectionData
+	// ApplicationID of the application whose limits the signature is counted in.
+	ApplicationID string `valid:"required"`
+	// From address.
+	From address.Address `valid:"address"`
+	// Message to be signed, without the EIP-191 prefix.
+	Message entities.HexBytes
+}
+
+// SignMessageOutput for message signing responses.
+type SignMessageOutput struct {
+	// Signature of the message as R || S || V, with V being 27 or 28.
+	Signature entities.HexBytes
+	// Hash of the prefixed message that has been signed.
+	Hash entities.HexBytes
+}
+
+// SignTypedDataInput for typed structured data signing requests.
+type SignTypedDataInput struct {
+	// SlotConnectionData configuration to connect to a slot.
+	SlotConnectionData
+	// ApplicationID of the application whose limits the signature is counted in.
+	ApplicationID string `valid:"required"`
+	// From address.
+	From address.Address `valid:"address"`
+	// TypedData to be signed.
+	TypedData TypedData `valid:"-"`
+}
+
+// SignTypedDataOutput for typed structured data signing responses.
+type SignTypedDataOutput struct {
+	// Signature of the typed data as R || S || V, with V being 27 or 28.
+	Signature entities.HexBytes
+	// Hash of the typed data that has been signed.
+	Hash entities.HexBytes
+}
+
 // CloseAllInput input to close all the signature manager resources.
 type CloseAllInput struct {
 }
@@ -138,7 +335,9 @@ type IsAliveInput struct {
 	// Pin that grants access to the slot.
 	Pin string `valid:"required"`
 	// ModuleKind of the Hardware Security Module.
-	ModuleKind ModuleKind `valid:"in(SoftHSM)"`
+	ModuleKind ModuleKind `valid:"in(SoftHSM|CloudKMS|PKCS11)"`
+	// PKCS11Configuration configuration of the library. Required for the PKCS11 module kind.
+	PKCS11Configuration *PKCS11ModuleConfiguration
 }
 
 // IsAliveOutput whether the slot is available.
@@ -151,6 +350,8 @@ type IsAliveOutput struct {
 type ResetInput struct {
 	// ModuleKind is the kind of the module that will be reset.
 	ModuleKind ModuleKind
+	// PKCS11Configuration configuration of the library. Required for the PKCS11 module kind.
+	PKCS11Configuration *PKCS11ModuleConfiguration
 }
 
 // ResetOutput output from the reset operation.
@@ -159,14 +360,22 @@ type ResetOutput struct {
 
 // EthereumTransaction represents an Ethereum transaction.
 type EthereumTransaction struct {
+	// Type of the transaction. The zero value is a legacy transaction.
+	Type TransactionType
 	// From address.
 	From address.Address
 	// To address.
 	To *address.Address
 	// Gas amount to use for transaction execution.
 	Gas entities.HexUInt64
-	// GasPrice to use for each paid gas.
+	// GasPrice to use for each paid gas. Only used by legacy transactions.
 	GasPrice entities.HexInt256
+	// MaxFeePerGas maximum total fee per gas. Only used by dynamic fee transactions.
+	MaxFeePerGas *entities.HexInt256
+	// MaxPriorityFeePerGas maximum fee per gas given to the block producer. Only used by dynamic fee transactions.
+	MaxPriorityFeePerGas *entities.HexInt256
+	// AccessList of addresses and storage keys that the transaction plans to access. Only used by typed transactions.
+	AccessList AccessList
 	// Value amount sent with this transaction.
 	Value *entities.HexInt256
 	// Data arguments packed according to json rpc standard.
@@ -186,12 +395,16 @@ type EthereumTransactionSignature struct {
 	S entities.Int256
 }
 
-// RLPEncode RLP encodes the Ethereum transaction (including its signature) according to EIP-155. This function fails if the transaction doesn't have a signature yet.
-// As a summary, the result is rlp(nonce, gasPrice, gas, to, value, data, V, R, S)
+// RLPEncode RLP encodes the Ethereum transaction (including its signature). This function fails if the transaction doesn't have a signature yet.
+// Legacy transactions are encoded according to EIP-155, so the result is rlp(nonce, gasPrice, gas, to, value, data, V, R, S).
+// Typed transactions are encoded as the EIP-2718 envelope, so the result is type || rlp(payload, yParity, R, S).
 func (tx EthereumTransaction) RLPEncode() (*entities.HexBytes, error) {
 	if tx.Signature == nil {
 		return nil, errors.Internal().WithMessage("tx doesn't have a signature so it can't be RLP encoded")
 	}
+	if tx.Type != LegacyTxType {
+		return tx.typedRLPEncode()
+	}
 	nonce, err := entities.NewHexBytesFromString(hexStringEvenLength(tx.Nonce.String()))
 	if err != nil {
 		return nil, errors.Internal().WithMessage("could not convert 'nonce' to hex bytes")
@@ -257,8 +470,11 @@ func (tx EthereumTransaction) RLPEncode() (*entities.HexBytes, error) {
 	return entities.NewHexBytes(rlpEncode), nil
 }
 
-// Hash calculates the Ethereum transaction hash.
+// Hash calculates the Ethereum transaction hash to be signed.
 func (tx EthereumTransaction) Hash() (*entities.HexBytes, error) {
+	if tx.Type != LegacyTxType {
+		return tx.typedHash()
+	}
 	nonce, err := entities.NewHexBytesFromString(hexStringEvenLength(tx.Nonce.String()))
 	if err != nil {
 		return nil, errors.Internal().WithMessage("could not convert 'nonce' to hex bytes")
@@ -335,6 +551,25 @@ func (tx EthereumTransaction) Hash() (*entities.HexBytes, error) {
 	return entities.NewHexBytes(hash), nil
 }
 
+// EthereumMessage represents an arbitrary message to be signed by an Ethereum account.
+type EthereumMessage struct {
+	// Data of the message.
+	Data entities.HexBytes
+}
+
+// Hash calculates the hash to be signed for the message as defined in EIP-191 for version 0x45, that is,
+// keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
+func (m EthereumMessage) Hash() (*entities.HexBytes, error) {
+	data := m.Data.Bytes()
+	prefixedMessage := append([]byte(fmt.Sprintf("%s%d", ethereumSignedMessagePrefix, len(data))), data...)
+	hash, err := hashKeccak256(prefixedMessage)
+	if err != nil {
+		return nil, errors.InternalFromErr(err).WithMessage("failed to calculate the Keccak256 of the message to sign")
+	}
+
+	return entities.NewHexBytes(hash), nil
+}
+
 func hexStringEvenLength(input string) string {
 	result := input
 	if len(input)%2 != 0 {
@@ -192,3 +192,397 @@ func TestTransactionRLPEncode(t *testing.T) {
 		require.Equal(t, expectedResult, rlpEncode.Encode())
 	})
 }
+
+func TestDynamicFeeTransactionHash(t *testing.T) {
+	from, err := address.NewFromHexString("0xa2c16184fA76cD6D16685900292683dF905e4Bf2")
+	require.Nil(t, err)
+	to, err := address.NewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")
+	require.Nil(t, err)
+	gas, err := entities.NewHexUInt64FromString("0x3E8")
+	require.Nil(t, err)
+	maxFeePerGas, err := entities.NewHexInt256FromString("0x4A817C800")
+	require.Nil(t, err)
+	maxPriorityFeePerGas, err := entities.NewHexInt256FromString("0x3B9ACA00")
+	require.Nil(t, err)
+	value, err := entities.NewHexInt256FromString("0x3")
+	require.Nil(t, err)
+	data, err := entities.NewHexBytesFromString("0x1f170873")
+	require.Nil(t, err)
+	nonce, err := entities.NewHexUInt64FromString("0x1")
+	require.Nil(t, err)
+	chainID, err := entities.NewHexInt256FromString("0xAF2C")
+	require.Nil(t, err)
+	t.Run("dynamic fee transaction calling a contract function", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","to": "0xA4F666f1860D2aCbe49b342C87867754a21dE850","gas": "0x3E8","maxFeePerGas": "0x4A817C800","maxPriorityFeePerGas": "0x3B9ACA00","value": "", "nonce":"0x1", "data": "0x1f170873"}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x355ada3e7683a5b63ea3fd212b6ca54214712db0e4380a01985b16099fd4b464"
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   &to,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                nil,
+			Data:                 data,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+	t.Run("dynamic fee eth transfer", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","to": "0xA4F666f1860D2aCbe49b342C87867754a21dE850","gas": "0x3E8","maxFeePerGas": "0x4A817C800","maxPriorityFeePerGas": "0x3B9ACA00","value": "0x3", "nonce":"0x1", "data": "0x"}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0xfae4ea4eba13e075c1e4a5b66e1a45e9c6de833435f66c6edf64352d8a905fdf"
+		ethTransferData, errEthTransferData := entities.NewHexBytesFromString("0x")
+		require.Nil(t, errEthTransferData)
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   &to,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                value,
+			Data:                 ethTransferData,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+	t.Run("dynamic fee smart contract deployment", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","gas": "0x3E8","maxFeePerGas": "0x4A817C800","maxPriorityFeePerGas": "0x3B9ACA00","value": "", "nonce":"0x1", "data": "0x1234"}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x6d1bf84cf34a8e4eeea4a88fad867868d75e5fdb16dd9eff9fb9a8b88ba68f4c"
+		smartContractDeploymentData, errSmartContractDeploymentData := entities.NewHexBytesFromString("0x1234") // this data does not represent a real contract code, but it doesn't matter for the test
+		require.Nil(t, errSmartContractDeploymentData)
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   nil,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                nil,
+			Data:                 smartContractDeploymentData,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+}
+
+func TestDynamicFeeTransactionRLPEncode(t *testing.T) {
+	from, err := address.NewFromHexString("0xa2c16184fA76cD6D16685900292683dF905e4Bf2")
+	require.Nil(t, err)
+	to, err := address.NewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")
+	require.Nil(t, err)
+	gas, err := entities.NewHexUInt64FromString("0x3E8")
+	require.Nil(t, err)
+	maxFeePerGas, err := entities.NewHexInt256FromString("0x4A817C800")
+	require.Nil(t, err)
+	maxPriorityFeePerGas, err := entities.NewHexInt256FromString("0x3B9ACA00")
+	require.Nil(t, err)
+	value, err := entities.NewHexInt256FromString("0x3")
+	require.Nil(t, err)
+	data, err := entities.NewHexBytesFromString("0x1f170873")
+	require.Nil(t, err)
+	nonce, err := entities.NewHexUInt64FromString("0x1")
+	require.Nil(t, err)
+	chainID, err := entities.NewHexInt256FromString("0xAF2C")
+	require.Nil(t, err)
+	t.Run("dynamic fee transaction calling a contract function", func(t *testing.T) {
+		v, err := entities.NewInt256FromString("0")
+		require.Nil(t, err)
+		r, err := entities.NewInt256FromString("111511990001157728990087982313507849938780208929659673589157516924269045720878")
+		require.Nil(t, err)
+		s, err := entities.NewInt256FromString("10517542882336129107645783577268249442450085098404526379821944084496345394621")
+		require.Nil(t, err)
+		expectedResult := "0x02f87182af2c01843b9aca008504a817c8008203e894a4f666f1860d2acbe49b342c87867754a21de85080841f170873c080a0f6898cb7e07b86101768ed9d54dc12a9b0db592919692c0b5be222c0dfb3a72ea01740b7e37e280ff8cbaf7541f47a26bc80fa2d1d26408ec38bae376ecb32edbd"
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   &to,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                nil,
+			Data:                 data,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+			Signature: &hsmconnector.EthereumTransactionSignature{
+				V: *v,
+				R: *r,
+				S: *s,
+			},
+		}
+		encode, errEncode := tx.RLPEncode()
+		require.Nil(t, errEncode)
+		require.Equal(t, expectedResult, encode.Encode())
+	})
+	t.Run("dynamic fee eth transfer", func(t *testing.T) {
+		v, err := entities.NewInt256FromString("0")
+		require.Nil(t, err)
+		r, err := entities.NewInt256FromString("24712049833862429150774218510832036185885938705318148764826388432417471988506")
+		require.Nil(t, err)
+		s, err := entities.NewInt256FromString("42566984121537673824142172921791310606762645073390975916004223652547988641143")
+		require.Nil(t, err)
+		expectedResult := "0x02f86d82af2c01843b9aca008504a817c8008203e894a4f666f1860d2acbe49b342c87867754a21de8500380c080a036a2864866086a87bedabbec8a7813a97c3339ecc9b525156bbf15ffc8d2031aa05e1c0f2932be1d6e6a2b0bb9b1d2ad6e7977d0c57167629ee20cf80dc5095577"
+		ethTransferData, errEthTransferData := entities.NewHexBytesFromString("0x")
+		require.Nil(t, errEthTransferData)
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   &to,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                value,
+			Data:                 ethTransferData,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+			Signature: &hsmconnector.EthereumTransactionSignature{
+				V: *v,
+				R: *r,
+				S: *s,
+			},
+		}
+		rlpEncode, errEncode := tx.RLPEncode()
+		require.Nil(t, errEncode)
+		require.Equal(t, expectedResult, rlpEncode.Encode())
+	})
+	t.Run("dynamic fee smart contract deployment", func(t *testing.T) {
+		v, err := entities.NewInt256FromString("1")
+		require.Nil(t, err)
+		r, err := entities.NewInt256FromString("351388117393916208087678138859016566925696571483544270233358834939603049995")
+		require.Nil(t, err)
+		s, err := entities.NewInt256FromString("45649670459801347349692464699069021274504006378588675833971620837414639063742")
+		require.Nil(t, err)
+		expectedResult := "0x02f85a82af2c01843b9aca008504a817c8008203e88080821234c0019fc6e0eda9c4d87ecc70f980536c1fe48ae0fbd49f3a69a001dc1b0ab2cdde0ba064eccc4076a74f68c5816aaa0a29cca4430212b8b79d64818e14f5af0cc152be"
+		smartContractDeploymentData, errSmartContractDeploymentData := entities.NewHexBytesFromString("0x1234") // this data does not represent a real contract code, but it doesn't matter for the test
+		require.Nil(t, errSmartContractDeploymentData)
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   nil,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                nil,
+			Data:                 smartContractDeploymentData,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+			Signature: &hsmconnector.EthereumTransactionSignature{
+				V: *v,
+				R: *r,
+				S: *s,
+			},
+		}
+		rlpEncode, errEncode := tx.RLPEncode()
+		require.Nil(t, errEncode)
+		require.Equal(t, expectedResult, rlpEncode.Encode())
+	})
+}
+
+func TestAccessListTransactionHash(t *testing.T) {
+	from, err := address.NewFromHexString("0xa2c16184fA76cD6D16685900292683dF905e4Bf2")
+	require.Nil(t, err)
+	to, err := address.NewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")
+	require.Nil(t, err)
+	gas, err := entities.NewHexUInt64FromString("0x3E8")
+	require.Nil(t, err)
+	gasPrice, err := entities.NewHexInt256FromString("0x4A817C800")
+	require.Nil(t, err)
+	maxFeePerGas, err := entities.NewHexInt256FromString("0x4A817C800")
+	require.Nil(t, err)
+	maxPriorityFeePerGas, err := entities.NewHexInt256FromString("0x3B9ACA00")
+	require.Nil(t, err)
+	data, err := entities.NewHexBytesFromString("0x1f170873")
+	require.Nil(t, err)
+	nonce, err := entities.NewHexUInt64FromString("0x1")
+	require.Nil(t, err)
+	chainID, err := entities.NewHexInt256FromString("0xAF2C")
+	require.Nil(t, err)
+	firstStorageKey, err := entities.NewHexBytes32FromString("0x0000000000000000000000000000000000000000000000000000000000000003")
+	require.Nil(t, err)
+	secondStorageKey, err := entities.NewHexBytes32FromString("0x0000000000000000000000000000000000000000000000000000000000000007")
+	require.Nil(t, err)
+	accessList := hsmconnector.AccessList{
+		{
+			Address:     to,
+			StorageKeys: []entities.HexBytes32{firstStorageKey, secondStorageKey},
+		},
+	}
+	t.Run("access list transaction calling a contract function", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","to": "0xA4F666f1860D2aCbe49b342C87867754a21dE850","gas": "0x3E8","gasPrice": "0x4A817C800","value": "", "nonce":"0x1", "data": "0x1f170873","accessList":[{"address":"0xA4F666f1860D2aCbe49b342C87867754a21dE850","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000003","0x0000000000000000000000000000000000000000000000000000000000000007"]}]}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x40760f564790eb8eb42324fd5c95053e1eee2732d52a95a1660664e98afaef6e"
+		tx := hsmconnector.EthereumTransaction{
+			Type:       hsmconnector.AccessListTxType,
+			From:       from,
+			To:         &to,
+			Gas:        gas,
+			GasPrice:   *gasPrice,
+			Value:      nil,
+			Data:       data,
+			Nonce:      nonce,
+			ChainID:    *chainID,
+			AccessList: accessList,
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+	t.Run("access list transaction with an empty access list", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","to": "0xA4F666f1860D2aCbe49b342C87867754a21dE850","gas": "0x3E8","gasPrice": "0x4A817C800","value": "", "nonce":"0x1", "data": "0x1f170873","accessList":[]}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x5e3799862515a07c078598d8b682515c9d2ac5f965c77c776914a3af75095920"
+		tx := hsmconnector.EthereumTransaction{
+			Type:       hsmconnector.AccessListTxType,
+			From:       from,
+			To:         &to,
+			Gas:        gas,
+			GasPrice:   *gasPrice,
+			Value:      nil,
+			Data:       data,
+			Nonce:      nonce,
+			ChainID:    *chainID,
+			AccessList: hsmconnector.AccessList{},
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+	t.Run("dynamic fee transaction with an access list", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from": "0xa2c16184fA76cD6D16685900292683dF905e4Bf2","to": "0xA4F666f1860D2aCbe49b342C87867754a21dE850","gas": "0x3E8","maxFeePerGas": "0x4A817C800","maxPriorityFeePerGas": "0x3B9ACA00","value": "", "nonce":"0x1", "data": "0x1f170873","accessList":[{"address":"0xA4F666f1860D2aCbe49b342C87867754a21dE850","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000003","0x0000000000000000000000000000000000000000000000000000000000000007"]}]}], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x5ef947d4c8ebbc3ca31b988859420cbc04dfca69eba6d7af82bfe97a706374fa"
+		tx := hsmconnector.EthereumTransaction{
+			Type:                 hsmconnector.DynamicFeeTxType,
+			From:                 from,
+			To:                   &to,
+			Gas:                  gas,
+			MaxFeePerGas:         maxFeePerGas,
+			MaxPriorityFeePerGas: maxPriorityFeePerGas,
+			Value:                nil,
+			Data:                 data,
+			Nonce:                nonce,
+			ChainID:              *chainID,
+			AccessList:           accessList,
+		}
+		hash, errHash := tx.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+}
+
+func TestAccessListTransactionRLPEncode(t *testing.T) {
+	from, err := address.NewFromHexString("0xa2c16184fA76cD6D16685900292683dF905e4Bf2")
+	require.Nil(t, err)
+	to, err := address.NewFromHexString("0xA4F666f1860D2aCbe49b342C87867754a21dE850")
+	require.Nil(t, err)
+	gas, err := entities.NewHexUInt64FromString("0x3E8")
+	require.Nil(t, err)
+	gasPrice, err := entities.NewHexInt256FromString("0x4A817C800")
+	require.Nil(t, err)
+	data, err := entities.NewHexBytesFromString("0x1f170873")
+	require.Nil(t, err)
+	nonce, err := entities.NewHexUInt64FromString("0x1")
+	require.Nil(t, err)
+	chainID, err := entities.NewHexInt256FromString("0xAF2C")
+	require.Nil(t, err)
+	firstStorageKey, err := entities.NewHexBytes32FromString("0x0000000000000000000000000000000000000000000000000000000000000003")
+	require.Nil(t, err)
+	secondStorageKey, err := entities.NewHexBytes32FromString("0x0000000000000000000000000000000000000000000000000000000000000007")
+	require.Nil(t, err)
+	t.Run("access list transaction calling a contract function", func(t *testing.T) {
+		v, err := entities.NewInt256FromString("0")
+		require.Nil(t, err)
+		r, err := entities.NewInt256FromString("9836719487437858975787553494662277929431195971893921803796023512042681590956")
+		require.Nil(t, err)
+		s, err := entities.NewInt256FromString("47624022555099004724257063661782603152004168957481788554491530158040946994073")
+		require.Nil(t, err)
+		expectedResult := "0x01f8c882af2c018504a817c8008203e894a4f666f1860d2acbe49b342c87867754a21de85080841f170873f85bf85994a4f666f1860d2acbe49b342c87867754a21de850f842a00000000000000000000000000000000000000000000000000000000000000003a0000000000000000000000000000000000000000000000000000000000000000780a015bf62cc4100758a68bd698938ebc01691a2e4c560d5d1917074304613cae4aca0694a3dd5d1890745e7feebf07b55ecf87bca57ddec996291c5a47e1878826b99"
+		tx := hsmconnector.EthereumTransaction{
+			Type:     hsmconnector.AccessListTxType,
+			From:     from,
+			To:       &to,
+			Gas:      gas,
+			GasPrice: *gasPrice,
+			Value:    nil,
+			Data:     data,
+			Nonce:    nonce,
+			ChainID:  *chainID,
+			AccessList: hsmconnector.AccessList{
+				{
+					Address:     to,
+					StorageKeys: []entities.HexBytes32{firstStorageKey, secondStorageKey},
+				},
+			},
+			Signature: &hsmconnector.EthereumTransactionSignature{
+				V: *v,
+				R: *r,
+				S: *s,
+			},
+		}
+		rlpEncode, errEncode := tx.RLPEncode()
+		require.Nil(t, errEncode)
+		require.Equal(t, expectedResult, rlpEncode.Encode())
+	})
+	t.Run("access list transaction with an empty access list", func(t *testing.T) {
+		v, err := entities.NewInt256FromString("1")
+		require.Nil(t, err)
+		r, err := entities.NewInt256FromString("90143530643737914723084362596677867617260706968295697694176832317403857013564")
+		require.Nil(t, err)
+		s, err := entities.NewInt256FromString("24244894833371844004076837433162149331271188506670176697240465331511790200204")
+		require.Nil(t, err)
+		expectedResult := "0x01f86c82af2c018504a817c8008203e894a4f666f1860d2acbe49b342c87867754a21de85080841f170873c001a0c74b6e2715967828410134efe375c78c6dbb459233b315376f67697228c5cb3ca0359a1fca631aa47a81652a9a2e7160028ef022e54f9ca5820c7c6543d66ce58c"
+		tx := hsmconnector.EthereumTransaction{
+			Type:       hsmconnector.AccessListTxType,
+			From:       from,
+			To:         &to,
+			Gas:        gas,
+			GasPrice:   *gasPrice,
+			Value:      nil,
+			Data:       data,
+			Nonce:      nonce,
+			ChainID:    *chainID,
+			AccessList: hsmconnector.AccessList{},
+			Signature: &hsmconnector.EthereumTransactionSignature{
+				V: *v,
+				R: *r,
+				S: *s,
+			},
+		}
+		rlpEncode, errEncode := tx.RLPEncode()
+		require.Nil(t, errEncode)
+		require.Equal(t, expectedResult, rlpEncode.Encode())
+	})
+}
+
+func TestEthereumMessageHash(t *testing.T) {
+	t.Run("text message", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"personal_sign","params":["0x68656c6c6f", "0xa2c16184fA76cD6D16685900292683dF905e4Bf2"], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750"
+		data, err := entities.NewHexBytesFromString("0x68656c6c6f") // "hello"
+		require.Nil(t, err)
+		message := hsmconnector.EthereumMessage{
+			Data: data,
+		}
+		hash, errHash := message.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+	t.Run("empty message", func(t *testing.T) {
+		// curl -X POST --data '{"jsonrpc":"2.0","method":"eth_sign","params":["0xa2c16184fA76cD6D16685900292683dF905e4Bf2", "0x"], "id":1}' http://127.0.0.1:4545
+		expectedResult := "0x5f35dce98ba4fba25530a026ed80b2cecdaa31091ba4958b99b52ea1d068adad"
+		data, err := entities.NewHexBytesFromString("0x")
+		require.Nil(t, err)
+		message := hsmconnector.EthereumMessage{
+			Data: data,
+		}
+		hash, errHash := message.Hash()
+		require.Nil(t, errHash)
+		require.Equal(t, expectedResult, hash.Encode())
+	})
+}
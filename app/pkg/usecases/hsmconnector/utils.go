@@ -11,12 +11,15 @@ import (
 	"golang.org/x/crypto/sha3"
 )
 
-func generateEthereumTransactionSignature(signature []byte, chainID entities.HexInt256) *EthereumTransactionSignature {
+func generateEthereumTransactionSignature(signature []byte, chainID entities.HexInt256, txType TransactionType) *EthereumTransactionSignature {
 	r := new(big.Int).SetBytes(signature[1:33])
 	s := new(big.Int).SetBytes(signature[33:signatureLength])
 	v := new(big.Int).SetBytes(signature[0:1])
 
-	if chainID.Int.Sign() != 0 {
+	if txType != LegacyTxType {
+		// typed transactions use the y-parity of the signature (0 or 1) as V value, see https://github.com/ethereum/EIPs/blob/master/EIPS/eip-2930.md#parameters
+		v = big.NewInt(int64(signature[0]) - minSignatureOffsetBitcoin)
+	} else if chainID.Int.Sign() != 0 {
 		ethV := int64(signature[0]) - minSignatureOffsetBitcoin // since we used the bitcoin library, the V value is 27 or 28. However, Ethereum expects either a 0 or a 1, so we substract 27.
 		// calculate the V value based on https://github.com/ethereum/EIPs/blob/master/EIPS/eip-155.md#specification
 		v = big.NewInt(ethV + 35)
@@ -0,0 +1,92 @@
+package hsmhealth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+)
+
+// DefaultMonitorInterval time between the checks of the HSM modules and slots if no interval is configured.
+const DefaultMonitorInterval = 30 * time.Second
+
+// MonitorInterval time between the checks of the HSM modules and slots. The monitor doesn't run if it is 0.
+type MonitorInterval time.Duration
+
+// Monitor checks the health of the HSM modules and slots periodically in the background, so that their state is
+// published in the metrics and the modules whose device is restarted are reset without restarting the signare.
+type Monitor struct {
+	useCase  HSMHealthUseCase
+	interval time.Duration
+
+	mutex sync.Mutex
+	// cancel stops the running monitor. It is nil if the monitor is not running
+	cancel context.CancelFunc
+	// done is closed once the running monitor has stopped
+	done chan struct{}
+}
+
+// Start starts checking the HSM modules and slots in the background until Stop is called or the context is done. The
+// first check is run right away. It does nothing if the monitor is disabled or already running.
+func (m *Monitor) Start(ctx context.Context) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.interval <= 0 || m.cancel != nil {
+		return
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	logger.LogEntry(ctx).Infof("starting hsm health monitor with interval %s", m.interval)
+	go m.run(ctx, m.done)
+}
+
+// Stop stops the monitor and waits for the running check to finish, so that the HSM resources can be closed safely.
+func (m *Monitor) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+	m.cancel = nil
+}
+
+func (m *Monitor) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		_, err := m.useCase.CheckHSMHealth(ctx, CheckHSMHealthInput{})
+		if err != nil && ctx.Err() == nil {
+			logger.LogEntry(ctx).Warnf("error checking the health of the hsm modules: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// MonitorOptions are the set of fields to create a Monitor.
+type MonitorOptions struct {
+	// UseCase checks the health of the HSM modules and slots
+	UseCase HSMHealthUseCase
+	// Interval time between the checks
+	Interval MonitorInterval
+}
+
+// ProvideMonitor creates a Monitor with the given options.
+func ProvideMonitor(options MonitorOptions) (*Monitor, error) {
+	if options.UseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'UseCase' not provided")
+	}
+	return &Monitor{
+		useCase:  options.UseCase,
+		interval: time.Duration(options.Interval),
+	}, nil
+}
@@ -0,0 +1,292 @@
+// Package hsmhealth defines the supervision of the availability of the HSM modules and their slots.
+package hsmhealth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
+	signertime "github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+)
+
+const (
+	resetResultSuccess = "success"
+	resetResultFailure = "failure"
+	// slotNotAliveReason reason reported when the slot answered the probe but is not alive.
+	slotNotAliveReason = "the slot is not alive"
+	// slotNotProbedReason reason reported when the probe failed without a message that can be reported.
+	slotNotProbedReason = "the slot could not be probed"
+)
+
+// probeDurationBuckets buckets of the latency of the probes, in seconds.
+var probeDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// HSMHealthUseCase defines the supervision of the HSM modules and slots.
+type HSMHealthUseCase interface {
+	// CheckHSMHealth probes every slot of every HSM module and returns their health. The modules whose device is not
+	// available are reset, so that they are reachable again once the device is back. It returns an error if the
+	// modules or slots can't be listed.
+	CheckHSMHealth(ctx context.Context, input CheckHSMHealthInput) (*CheckHSMHealthOutput, error)
+	// GetHSMHealth returns the health of the HSM modules and slots as of the last check. It reports whether they have
+	// been checked at all, since they are not if the monitor is disabled.
+	GetHSMHealth(ctx context.Context, input GetHSMHealthInput) (*GetHSMHealthOutput, error)
+}
+
+func (u *DefaultUseCase) CheckHSMHealth(ctx context.Context, _ CheckHSMHealthInput) (*CheckHSMHealthOutput, error) {
+	// checks don't overlap, so that a module is not reset twice for the same failure
+	u.checkMutex.Lock()
+	defer u.checkMutex.Unlock()
+
+	listHSMModulesOutput, err := u.moduleUseCase.ListHSMModules(ctx, hsmmodule.ListHSMModulesInput{})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	modules := make([]ModuleHealth, 0, len(listHSMModulesOutput.Items))
+	for _, module := range listHSMModulesOutput.Items {
+		moduleHealth, checkErr := u.checkModule(ctx, module)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+		modules = append(modules, *moduleHealth)
+	}
+
+	u.mutex.Lock()
+	u.modules = modules
+	u.mutex.Unlock()
+
+	return &CheckHSMHealthOutput{
+		Modules: modules,
+	}, nil
+}
+
+func (u *DefaultUseCase) GetHSMHealth(_ context.Context, _ GetHSMHealthInput) (*GetHSMHealthOutput, error) {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	// the checks replace the slice instead of modifying it, so it can be shared
+	modules := u.modules
+	if modules == nil {
+		modules = make([]ModuleHealth, 0)
+	}
+	return &GetHSMHealthOutput{
+		Modules: modules,
+		Checked: u.modules != nil,
+	}, nil
+}
+
+// checkModule probes the slots of the module and resets it if any of them reports that the device is not available.
+func (u *DefaultUseCase) checkModule(ctx context.Context, module hsmmodule.HSMModule) (*ModuleHealth, error) {
+	listHSMSlotsInput := hsmslot.ListHSMSlotsByHSMModuleInput{
+		HSMModuleID: entities.StandardID{
+			ID: module.ID,
+		},
+	}
+	slots, err := u.slotUseCase.ListHSMSlotsByHSMModule(ctx, listHSMSlotsInput)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	moduleHealth := ModuleHealth{
+		ModuleID: module.ID,
+		Kind:     module.Kind,
+		Slots:    make([]SlotHealth, 0, len(slots.Items)),
+	}
+	deviceUnavailable := false
+	for _, slot := range slots.Items {
+		slotHealth, unavailable := u.probe(ctx, module, slot)
+		moduleHealth.Slots = append(moduleHealth.Slots, slotHealth)
+		deviceUnavailable = deviceUnavailable || unavailable
+	}
+	if deviceUnavailable {
+		u.reset(ctx, module)
+	}
+	moduleHealth.LastReset = u.lastReset(module.ID)
+
+	return &moduleHealth, nil
+}
+
+// probe checks whether the slot is alive and records the result in the metrics. It also returns whether the slot
+// reported that the device of the module is not available.
+func (u *DefaultUseCase) probe(ctx context.Context, module hsmmodule.HSMModule, slot hsmslot.HSMSlot) (SlotHealth, bool) {
+	isAliveInput := hsmconnector.IsAliveInput{
+		Slot:                slot.Slot,
+		Pin:                 slot.Pin,
+		ModuleKind:          hsmconnector.ModuleKind(module.Kind),
+		PKCS11Configuration: mapPKCS11Configuration(module.Configuration.PKCS11Configuration),
+	}
+	start := time.Now()
+	isAliveOutput, err := u.hsmConnector.IsAlive(ctx, isAliveInput)
+	latency := time.Since(start)
+
+	slotHealth := SlotHealth{
+		SlotID:        slot.ID,
+		ApplicationID: slot.ApplicationID,
+		Slot:          slot.Slot,
+		Latency:       latency,
+		LastCheck:     signertime.Now(),
+	}
+	switch {
+	case err != nil:
+		reason := failureReason(err)
+		slotHealth.Reason = &reason
+		logger.LogEntry(ctx).Warnf("hsm slot [%s] of module [%s] is down: %v", slot.ID, module.ID, err)
+	case !isAliveOutput.IsAlive:
+		reason := slotNotAliveReason
+		slotHealth.Reason = &reason
+		logger.LogEntry(ctx).Warnf("hsm slot [%s] of module [%s] is down: %s", slot.ID, module.ID, reason)
+	default:
+		slotHealth.Up = true
+	}
+
+	labels := map[string]string{
+		"module": module.ID,
+		"slot":   slot.ID,
+	}
+	up := 0.0
+	if slotHealth.Up {
+		up = 1
+	}
+	u.slotUp.Set(labels, up)
+	u.probeDuration.Observe(labels, latency.Seconds())
+
+	return slotHealth, err != nil && errors.IsUnavailable(err)
+}
+
+// reset reinitializes the library of the module, so that it connects again with the device once it is back.
+func (u *DefaultUseCase) reset(ctx context.Context, module hsmmodule.HSMModule) {
+	logger.LogEntry(ctx).Warnf("resetting hsm module [%s] because its device is not available", module.ID)
+	resetInput := hsmconnector.ResetInput{
+		ModuleKind:          hsmconnector.ModuleKind(module.Kind),
+		PKCS11Configuration: mapPKCS11Configuration(module.Configuration.PKCS11Configuration),
+	}
+	_, err := u.hsmConnector.Reset(ctx, resetInput)
+	result := resetResultSuccess
+	if err != nil {
+		result = resetResultFailure
+		logger.LogEntry(ctx).Warnf("error resetting hsm module [%s]: %v", module.ID, err)
+	}
+	u.resets.Inc(map[string]string{
+		"module": module.ID,
+		"result": result,
+	})
+	if err != nil {
+		return
+	}
+
+	now := signertime.Now()
+	u.mutex.Lock()
+	u.lastResets[module.ID] = now
+	u.mutex.Unlock()
+}
+
+func (u *DefaultUseCase) lastReset(moduleID string) *signertime.Timestamp {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+	lastReset, ok := u.lastResets[moduleID]
+	if !ok {
+		return nil
+	}
+	return &lastReset
+}
+
+// failureReason returns the reason of a failed probe that can be reported outside the application.
+func failureReason(err error) string {
+	useCaseErr, ok := errors.CastAsUseCaseError(err)
+	if ok && useCaseErr.HumanReadableMessage() != nil {
+		return *useCaseErr.HumanReadableMessage()
+	}
+	return slotNotProbedReason
+}
+
+func mapPKCS11Configuration(configuration *hsmmodule.PKCS11Configuration) *hsmconnector.PKCS11ModuleConfiguration {
+	if configuration == nil {
+		return nil
+	}
+	return &hsmconnector.PKCS11ModuleConfiguration{
+		Library:    hsmconnector.PKCS11Library(configuration.Library),
+		TokenLabel: configuration.TokenLabel,
+		OmitKeyID:  configuration.Quirks.OmitKeyID,
+	}
+}
+
+var _ HSMHealthUseCase = new(DefaultUseCase)
+
+// DefaultUseCase implements the HSMHealthUseCase interface. It keeps the health of the last check in memory.
+type DefaultUseCase struct {
+	moduleUseCase hsmmodule.HSMModuleUseCase
+	slotUseCase   hsmslot.HSMSlotUseCase
+	hsmConnector  hsmconnector.HSMConnector
+	// slotUp is 1 if the slot was up in the last check and 0 otherwise
+	slotUp metricrecorder.GaugeVector
+	// probeDuration latency of the probes of the slots
+	probeDuration metricrecorder.HistogramVector
+	// resets counts the resets of the modules
+	resets metricrecorder.CounterVector
+
+	checkMutex sync.Mutex
+	// mutex protects modules and lastResets
+	mutex sync.RWMutex
+	// modules health of the modules as of the last check
+	modules []ModuleHealth
+	// lastResets instant of the last successful reset of each module, by module ID
+	lastResets map[string]signertime.Timestamp
+}
+
+// DefaultUseCaseOptions are the set of fields to create a DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	// ModuleUseCase lists the HSM modules
+	ModuleUseCase hsmmodule.HSMModuleUseCase
+	// SlotUseCase lists the slots of the HSM modules
+	SlotUseCase hsmslot.HSMSlotUseCase
+	// HSMConnector probes and resets the HSM modules
+	HSMConnector hsmconnector.HSMConnector
+	// MetricRecorder records the health of the slots
+	MetricRecorder metricrecorder.MetricRecorder
+}
+
+// ProvideDefaultUseCase creates a DefaultUseCase with the given options.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.ModuleUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ModuleUseCase' not provided")
+	}
+	if options.SlotUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'SlotUseCase' not provided")
+	}
+	if options.HSMConnector == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'HSMConnector' not provided")
+	}
+	if options.MetricRecorder == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'MetricRecorder' not provided")
+	}
+
+	slotUp, err := options.MetricRecorder.NewGaugeVector("hsm_slot_up", []string{"module", "slot"}, "whether the HSM slot was up in the last health check, 1 if it was and 0 otherwise")
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	probeDuration, err := options.MetricRecorder.NewHistogramVector("hsm_slot_probe_duration_seconds", []string{"module", "slot"}, probeDurationBuckets, "latency of the health checks of the HSM slots")
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	resets, err := options.MetricRecorder.NewCounterVector("hsm_module_reset_count", []string{"module", "result"}, "total number of resets of the HSM modules whose device was not available, by result")
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &DefaultUseCase{
+		moduleUseCase: options.ModuleUseCase,
+		slotUseCase:   options.SlotUseCase,
+		hsmConnector:  options.HSMConnector,
+		slotUp:        slotUp,
+		probeDuration: probeDuration,
+		resets:        resets,
+		lastResets:    make(map[string]signertime.Timestamp),
+	}, nil
+}
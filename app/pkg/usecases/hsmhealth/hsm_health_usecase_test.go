@@ -0,0 +1,227 @@
+package hsmhealth_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/metricrecorder"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmhealth"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmslot"
+
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+func TestDefaultUseCase_CheckHSMHealth(t *testing.T) {
+	t.Run("success: slots up and down", func(t *testing.T) {
+		connector := &hsmConnector{
+			isAlive: map[string]error{
+				"1": nil,
+				"2": errors.PreconditionFailed().SetHumanReadableMessage("the slot '2' is not reachable in the HSM module"),
+			},
+		}
+		useCase := newUseCase(t, connector)
+
+		output, err := useCase.CheckHSMHealth(ctx, hsmhealth.CheckHSMHealthInput{})
+		require.NoError(t, err)
+		require.Len(t, output.Modules, 1)
+		module := output.Modules[0]
+		require.Equal(t, "module", module.ModuleID)
+		require.Equal(t, hsmmodule.SoftHSMModuleKind, module.Kind)
+		require.Nil(t, module.LastReset)
+		require.Len(t, module.Slots, 2)
+		require.True(t, module.Slots[0].Up)
+		require.Nil(t, module.Slots[0].Reason)
+		require.False(t, module.Slots[1].Up)
+		require.Equal(t, "the slot '2' is not reachable in the HSM module", *module.Slots[1].Reason)
+		require.Equal(t, 0, connector.resets)
+
+		getOutput, err := useCase.GetHSMHealth(ctx, hsmhealth.GetHSMHealthInput{})
+		require.NoError(t, err)
+		require.Equal(t, output.Modules, getOutput.Modules)
+		require.True(t, getOutput.Checked)
+	})
+
+	t.Run("success: module reset when its device is not available", func(t *testing.T) {
+		connector := &hsmConnector{
+			isAlive: map[string]error{
+				"1": errors.Unavailable(),
+				"2": errors.Unavailable(),
+			},
+		}
+		useCase := newUseCase(t, connector)
+
+		output, err := useCase.CheckHSMHealth(ctx, hsmhealth.CheckHSMHealthInput{})
+		require.NoError(t, err)
+		require.Equal(t, 1, connector.resets)
+		module := output.Modules[0]
+		require.NotNil(t, module.LastReset)
+		require.False(t, module.Slots[0].Up)
+		require.Equal(t, "the slot could not be probed", *module.Slots[0].Reason)
+
+		connector.setAlive("1", nil)
+		connector.setAlive("2", nil)
+		output, err = useCase.CheckHSMHealth(ctx, hsmhealth.CheckHSMHealthInput{})
+		require.NoError(t, err)
+		require.Equal(t, 1, connector.resets)
+		require.True(t, output.Modules[0].Slots[0].Up)
+		require.Equal(t, module.LastReset, output.Modules[0].LastReset)
+	})
+
+	t.Run("success: no health before the first check", func(t *testing.T) {
+		useCase := newUseCase(t, &hsmConnector{})
+
+		output, err := useCase.GetHSMHealth(ctx, hsmhealth.GetHSMHealthInput{})
+		require.NoError(t, err)
+		require.NotNil(t, output.Modules)
+		require.Empty(t, output.Modules)
+		require.False(t, output.Checked)
+	})
+
+	t.Run("failure: modules can't be listed", func(t *testing.T) {
+		useCase, err := hsmhealth.ProvideDefaultUseCase(hsmhealth.DefaultUseCaseOptions{
+			ModuleUseCase:  &moduleUseCase{err: errors.Internal()},
+			SlotUseCase:    &slotUseCase{},
+			HSMConnector:   &hsmConnector{},
+			MetricRecorder: metricrecorder.NewNoMetricsRecorder(),
+		})
+		require.NoError(t, err)
+
+		_, err = useCase.CheckHSMHealth(ctx, hsmhealth.CheckHSMHealthInput{})
+		require.True(t, errors.IsInternal(err))
+	})
+}
+
+func TestMonitor(t *testing.T) {
+	t.Run("success: checks until stopped", func(t *testing.T) {
+		connector := &hsmConnector{}
+		monitor, err := hsmhealth.ProvideMonitor(hsmhealth.MonitorOptions{
+			UseCase:  newUseCase(t, connector),
+			Interval: hsmhealth.MonitorInterval(10 * time.Millisecond),
+		})
+		require.NoError(t, err)
+
+		monitor.Start(ctx)
+		require.Eventually(t, func() bool {
+			return connector.probes() >= 4
+		}, time.Second, 5*time.Millisecond)
+		monitor.Stop()
+
+		probes := connector.probes()
+		time.Sleep(30 * time.Millisecond)
+		require.Equal(t, probes, connector.probes())
+	})
+
+	t.Run("success: disabled", func(t *testing.T) {
+		connector := &hsmConnector{}
+		monitor, err := hsmhealth.ProvideMonitor(hsmhealth.MonitorOptions{
+			UseCase: newUseCase(t, connector),
+		})
+		require.NoError(t, err)
+
+		monitor.Start(ctx)
+		monitor.Stop()
+		require.Equal(t, 0, connector.probes())
+	})
+}
+
+func newUseCase(t *testing.T, connector *hsmConnector) *hsmhealth.DefaultUseCase {
+	useCase, err := hsmhealth.ProvideDefaultUseCase(hsmhealth.DefaultUseCaseOptions{
+		ModuleUseCase:  &moduleUseCase{},
+		SlotUseCase:    &slotUseCase{},
+		HSMConnector:   connector,
+		MetricRecorder: metricrecorder.NewNoMetricsRecorder(),
+	})
+	require.NoError(t, err)
+	return useCase
+}
+
+type moduleUseCase struct {
+	hsmmodule.HSMModuleUseCase
+	err error
+}
+
+func (u *moduleUseCase) ListHSMModules(_ context.Context, _ hsmmodule.ListHSMModulesInput) (*hsmmodule.ListHSMModulesOutput, error) {
+	if u.err != nil {
+		return nil, u.err
+	}
+	module := hsmmodule.HSMModule{
+		Kind: hsmmodule.SoftHSMModuleKind,
+	}
+	module.ID = "module"
+	return &hsmmodule.ListHSMModulesOutput{
+		HSMModulesCollection: hsmmodule.HSMModulesCollection{
+			Items: []hsmmodule.HSMModule{module},
+		},
+	}, nil
+}
+
+type slotUseCase struct {
+	hsmslot.HSMSlotUseCase
+}
+
+func (u *slotUseCase) ListHSMSlotsByHSMModule(_ context.Context, input hsmslot.ListHSMSlotsByHSMModuleInput) (*hsmslot.ListHSMSlotsByHSMModuleOutput, error) {
+	slots := make([]hsmslot.HSMSlot, 0)
+	for _, slot := range []string{"1", "2"} {
+		hsmSlot := hsmslot.HSMSlot{
+			ApplicationID: "app",
+			HSMModuleID:   input.HSMModuleID.ID,
+			Slot:          slot,
+			Pin:           "pin",
+		}
+		hsmSlot.StandardID = entities.StandardID{ID: "slot-" + slot}
+		slots = append(slots, hsmSlot)
+	}
+	return &hsmslot.ListHSMSlotsByHSMModuleOutput{
+		HSMSlotCollection: hsmslot.HSMSlotCollection{
+			Items: slots,
+		},
+	}, nil
+}
+
+type hsmConnector struct {
+	hsmconnector.HSMConnector
+	mutex sync.Mutex
+	// isAlive error returned when each slot is probed
+	isAlive map[string]error
+	resets  int
+	calls   int
+}
+
+func (c *hsmConnector) IsAlive(_ context.Context, input hsmconnector.IsAliveInput) (*hsmconnector.IsAliveOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.calls++
+	if err := c.isAlive[input.Slot]; err != nil {
+		return nil, err
+	}
+	return &hsmconnector.IsAliveOutput{
+		IsAlive: true,
+	}, nil
+}
+
+func (c *hsmConnector) Reset(_ context.Context, _ hsmconnector.ResetInput) (*hsmconnector.ResetOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.resets++
+	return &hsmconnector.ResetOutput{}, nil
+}
+
+func (c *hsmConnector) setAlive(slot string, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.isAlive[slot] = err
+}
+
+func (c *hsmConnector) probes() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.calls
+}
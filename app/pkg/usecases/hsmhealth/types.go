@@ -0,0 +1,61 @@
+package hsmhealth
+
+import (
+	"time"
+
+	signertime "github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmmodule"
+)
+
+// ModuleHealth health of an HSM module and its slots.
+type ModuleHealth struct {
+	// ModuleID identifier of the HSM module resource.
+	ModuleID string
+	// Kind of the HSM module.
+	Kind hsmmodule.ModuleKind
+	// Slots health of the slots of the module.
+	Slots []SlotHealth
+	// LastReset instant when the module was last reset because its device was not available. It is nil if the module
+	// has not been reset.
+	LastReset *signertime.Timestamp
+}
+
+// SlotHealth health of an HSM slot as seen by the last probe.
+type SlotHealth struct {
+	// SlotID identifier of the HSM slot resource.
+	SlotID string
+	// ApplicationID identifier of the application the slot belongs to.
+	ApplicationID string
+	// Slot the slot in the HSM module.
+	Slot string
+	// Up is true if the slot answered the probe and is alive.
+	Up bool
+	// Latency time the probe took.
+	Latency time.Duration
+	// LastCheck instant when the slot was probed.
+	LastCheck signertime.Timestamp
+	// Reason why the slot is down. It is nil if the slot is up.
+	Reason *string
+}
+
+// CheckHSMHealthInput input to probe the HSM modules and slots.
+type CheckHSMHealthInput struct {
+}
+
+// CheckHSMHealthOutput health of the HSM modules and slots.
+type CheckHSMHealthOutput struct {
+	// Modules health of the HSM modules, in the order they are listed in the storage.
+	Modules []ModuleHealth
+}
+
+// GetHSMHealthInput input to get the health of the HSM modules and slots.
+type GetHSMHealthInput struct {
+}
+
+// GetHSMHealthOutput health of the HSM modules and slots as of the last check.
+type GetHSMHealthOutput struct {
+	// Modules health of the HSM modules. It is empty if they have not been checked yet.
+	Modules []ModuleHealth
+	// Checked is true if the HSM modules have been checked, i.e. if Modules holds the result of a check.
+	Checked bool
+}
@@ -0,0 +1,17 @@
+package hsmmodule
+
+import (
+	"context"
+)
+
+// CacheInvalidationPort invalidates the data cached from the HSMModule resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateHSMModule removes the cached data of the given HSMModule, including the connections to its slots.
+	InvalidateHSMModule(ctx context.Context, hsmModuleID string)
+}
+
+func (u *DefaultUseCase) invalidateCache(ctx context.Context, hsmModuleID string) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateHSMModule(ctx, hsmModuleID)
+	}
+}
@@ -23,7 +23,9 @@ const (
 type ModuleKind string
 
 const (
-	SoftHSMModuleKind ModuleKind = "SoftHSM"
+	SoftHSMModuleKind  ModuleKind = "SoftHSM"
+	CloudKMSModuleKind ModuleKind = "CloudKMS"
+	PKCS11ModuleKind   ModuleKind = "PKCS11"
 )
 
 // HSMModuleUseCase defines the management of HSMModule in storage.
@@ -45,6 +47,10 @@ func (u *DefaultUseCase) CreateHSMModule(ctx context.Context, input CreateHSMMod
 	if err != nil {
 		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
+	err = validateConfiguration(input.ModuleKind, input.Configuration)
+	if err != nil {
+		return nil, err
+	}
 
 	if input.ID == nil {
 		randomID := uuid.New().String()
@@ -133,6 +139,10 @@ func (u *DefaultUseCase) EditHSMModule(ctx context.Context, input EditHSMModuleI
 	if err != nil {
 		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
 	}
+	err = validateConfiguration(input.Kind, input.Configuration)
+	if err != nil {
+		return nil, err
+	}
 
 	input.HSMModule.LastUpdate = time.Now()
 	hsmModule, editHSMModuleErr := u.hsmModuleStorage.Edit(ctx, input.HSMModule)
@@ -143,6 +153,8 @@ func (u *DefaultUseCase) EditHSMModule(ctx context.Context, input EditHSMModuleI
 		return nil, errors.InternalFromErr(editHSMModuleErr)
 	}
 
+	u.invalidateCache(ctx, input.ID)
+
 	return &EditHSMModuleOutput{
 		HSMModule: *hsmModule,
 	}, nil
@@ -167,11 +179,21 @@ func (u *DefaultUseCase) DeleteHSMModule(ctx context.Context, input DeleteHSMMod
 		return nil, errors.InternalFromErr(removeHSMModuleErr)
 	}
 
+	u.invalidateCache(ctx, input.ID)
+
 	return &DeleteHSMModuleOutput{
 		HSMModule: *hsmModule,
 	}, nil
 }
 
+// validateConfiguration checks that the configuration required by the given module kind is provided.
+func validateConfiguration(kind ModuleKind, configuration HSMModuleConfiguration) error {
+	if kind == PKCS11ModuleKind && configuration.PKCS11Configuration == nil {
+		return errors.InvalidArgument().SetHumanReadableMessage("the configuration of the PKCS11 library is required for modules of kind '%s'", kind)
+	}
+	return nil
+}
+
 var _ HSMModuleUseCase = new(DefaultUseCase)
 
 // DefaultUseCaseOptions options to create a DefaultUseCase.
@@ -180,6 +202,8 @@ type DefaultUseCaseOptions struct {
 	HSMModuleStorage HSMModuleStorage
 	// ReferentialIntegrityUseCase to manage dependencies between resources.
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// CacheInvalidation invalidates the cached data of the edited and deleted modules. Optional.
+	CacheInvalidation CacheInvalidationPort
 }
 
 // DefaultUseCase implements the HSMModuleUseCase interface.
@@ -188,6 +212,8 @@ type DefaultUseCase struct {
 	hsmModuleStorage HSMModuleStorage
 	// referentialIntegrityUseCase to manage dependencies between resources.
 	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// cacheInvalidation invalidates the cached data of the edited and deleted modules.
+	cacheInvalidation CacheInvalidationPort
 }
 
 // ProvideDefaultHSMModuleUseCase creates a new DefaultUseCase instance.
@@ -202,5 +228,6 @@ func ProvideDefaultHSMModuleUseCase(options DefaultUseCaseOptions) (*DefaultUseC
 	return &DefaultUseCase{
 		hsmModuleStorage:            options.HSMModuleStorage,
 		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+		cacheInvalidation:           options.CacheInvalidation,
 	}, nil
 }
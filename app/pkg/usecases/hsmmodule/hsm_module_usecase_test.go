@@ -110,6 +110,99 @@ func TestDefaultUseCase_CreateHSM(t *testing.T) {
 		require.True(t, errors.IsAlreadyExists(createHSMErr))
 		require.Nil(t, createHSMOutput)
 	})
+
+	t.Run("success: cloud KMS module", func(t *testing.T) {
+		cloudKMSID := "KMS-01"
+		createHSMInput := hsmmodule.CreateHSMModuleInput{
+			ID:          &cloudKMSID,
+			Description: &description,
+			Configuration: hsmmodule.HSMModuleConfiguration{
+				CloudKMSConfiguration: &hsmmodule.CloudKMSConfiguration{},
+			},
+			ModuleKind: hsmmodule.CloudKMSModuleKind,
+		}
+		_, createHSMErr := app.HSMModuleUseCase.CreateHSMModule(ctx, createHSMInput)
+		require.Nil(t, createHSMErr)
+
+		getHSMOutput, getHSMErr := app.HSMModuleUseCase.GetHSMModule(ctx, hsmmodule.GetHSMModuleInput{
+			StandardID: entities.StandardID{
+				ID: cloudKMSID,
+			},
+		})
+		require.Nil(t, getHSMErr)
+		require.Equal(t, hsmmodule.CloudKMSModuleKind, getHSMOutput.Kind)
+		require.NotNil(t, getHSMOutput.Configuration.CloudKMSConfiguration)
+		require.Nil(t, getHSMOutput.Configuration.SoftHSMConfiguration)
+	})
+
+	t.Run("success: PKCS11 module", func(t *testing.T) {
+		pkcs11ID := "PKCS11-01"
+		tokenLabel := "signare"
+		createHSMInput := hsmmodule.CreateHSMModuleInput{
+			ID:          &pkcs11ID,
+			Description: &description,
+			Configuration: hsmmodule.HSMModuleConfiguration{
+				PKCS11Configuration: &hsmmodule.PKCS11Configuration{
+					Library:    "/usr/lib/pkcs11/vendor_pkcs11.so",
+					TokenLabel: &tokenLabel,
+					Quirks: hsmmodule.PKCS11Quirks{
+						OmitKeyID: true,
+					},
+				},
+			},
+			ModuleKind: hsmmodule.PKCS11ModuleKind,
+		}
+		_, createHSMErr := app.HSMModuleUseCase.CreateHSMModule(ctx, createHSMInput)
+		require.Nil(t, createHSMErr)
+
+		getHSMOutput, getHSMErr := app.HSMModuleUseCase.GetHSMModule(ctx, hsmmodule.GetHSMModuleInput{
+			StandardID: entities.StandardID{
+				ID: pkcs11ID,
+			},
+		})
+		require.Nil(t, getHSMErr)
+		require.Equal(t, hsmmodule.PKCS11ModuleKind, getHSMOutput.Kind)
+		require.Equal(t, createHSMInput.Configuration.PKCS11Configuration, getHSMOutput.Configuration.PKCS11Configuration)
+	})
+
+	t.Run("failure: PKCS11 module without configuration", func(t *testing.T) {
+		pkcs11ID := "PKCS11-02"
+		createHSMInput := hsmmodule.CreateHSMModuleInput{
+			ID:          &pkcs11ID,
+			Description: &description,
+			ModuleKind:  hsmmodule.PKCS11ModuleKind,
+		}
+		createHSMOutput, createHSMErr := app.HSMModuleUseCase.CreateHSMModule(ctx, createHSMInput)
+		require.True(t, errors.IsInvalidArgument(createHSMErr))
+		require.Nil(t, createHSMOutput)
+	})
+
+	t.Run("failure: PKCS11 module without library", func(t *testing.T) {
+		pkcs11ID := "PKCS11-03"
+		createHSMInput := hsmmodule.CreateHSMModuleInput{
+			ID:          &pkcs11ID,
+			Description: &description,
+			Configuration: hsmmodule.HSMModuleConfiguration{
+				PKCS11Configuration: &hsmmodule.PKCS11Configuration{},
+			},
+			ModuleKind: hsmmodule.PKCS11ModuleKind,
+		}
+		createHSMOutput, createHSMErr := app.HSMModuleUseCase.CreateHSMModule(ctx, createHSMInput)
+		require.True(t, errors.IsInvalidArgument(createHSMErr))
+		require.Nil(t, createHSMOutput)
+	})
+
+	t.Run("failure: unsupported module kind", func(t *testing.T) {
+		unsupportedID := "HSM-unsupported"
+		createHSMInput := hsmmodule.CreateHSMModuleInput{
+			ID:          &unsupportedID,
+			Description: &description,
+			ModuleKind:  hsmmodule.ModuleKind("unsupported"),
+		}
+		createHSMOutput, createHSMErr := app.HSMModuleUseCase.CreateHSMModule(ctx, createHSMInput)
+		require.True(t, errors.IsInvalidArgument(createHSMErr))
+		require.Nil(t, createHSMOutput)
+	})
 }
 
 func TestDefaultUseCase_ListHSMs(t *testing.T) {
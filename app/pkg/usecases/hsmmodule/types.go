@@ -20,11 +20,34 @@ type HSMModule struct {
 type HSMModuleConfiguration struct {
 	// SoftHSMConfiguration configuration of a SoftHSM module.
 	SoftHSMConfiguration *SoftHSMConfiguration
+	// CloudKMSConfiguration configuration of a cloud KMS module.
+	CloudKMSConfiguration *CloudKMSConfiguration
+	// PKCS11Configuration configuration of a generic PKCS11 module.
+	PKCS11Configuration *PKCS11Configuration
 }
 
 // SoftHSMConfiguration configuration of a SoftHSM module.
 type SoftHSMConfiguration struct{}
 
+// CloudKMSConfiguration configuration of a cloud KMS module.
+type CloudKMSConfiguration struct{}
+
+// PKCS11Configuration configuration of a generic PKCS11 module.
+type PKCS11Configuration struct {
+	// Library path to the PKCS11 library of the vendor. It must be one of the libraries allowed in the static configuration.
+	Library string `valid:"required"`
+	// TokenLabel of the token that the slots of the module must hold. It is not checked if it is not provided.
+	TokenLabel *string
+	// Quirks of the vendor library.
+	Quirks PKCS11Quirks
+}
+
+// PKCS11Quirks vendor specific behaviours of a PKCS11 library.
+type PKCS11Quirks struct {
+	// OmitKeyID whether the CKA_ID attribute must not be set on generated keys, for libraries that restrict or manage it.
+	OmitKeyID bool
+}
+
 // HSMModulesCollection defines a collection of HSMModule resources.
 type HSMModulesCollection struct {
 	// Items CreateHSMModuleOutput in collection.
@@ -42,7 +65,7 @@ type CreateHSMModuleInput struct {
 	// Configuration defines the configuration of the CreateHSMModuleOutput.
 	Configuration HSMModuleConfiguration
 	// ModuleKind defines the type of the CreateHSMModuleOutput.
-	ModuleKind ModuleKind `valid:"in(SoftHSM)"`
+	ModuleKind ModuleKind `valid:"in(SoftHSM|CloudKMS|PKCS11)"`
 }
 
 // CreateHSMModuleOutput defines the output of the CreateHSMModule method.
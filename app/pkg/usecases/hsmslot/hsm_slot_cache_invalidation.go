@@ -0,0 +1,17 @@
+package hsmslot
+
+import (
+	"context"
+)
+
+// CacheInvalidationPort invalidates the data cached from the HSMSlot resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateApplication removes the cached data of the given Application, including its HSM slots.
+	InvalidateApplication(ctx context.Context, applicationID string)
+}
+
+func (u *DefaultUseCase) invalidateCache(ctx context.Context, applicationID string) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateApplication(ctx, applicationID)
+	}
+}
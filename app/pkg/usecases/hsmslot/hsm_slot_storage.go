@@ -13,7 +13,7 @@ type HSMSlotStorage interface {
 	Add(ctx context.Context, data HSMSlot) (*HSMSlot, error)
 	// Get an HSMSlot from storage.
 	Get(ctx context.Context, id entities.StandardID) (*HSMSlot, error)
-	// GetByApplication an HSMSlot from storage.
+	// GetByApplication gets the HSMSlot with the highest priority of an Application from storage.
 	GetByApplication(ctx context.Context, applicationID entities.StandardID) (*HSMSlot, error)
 	// EditPin of an HSMSlot in storage.
 	EditPin(ctx context.Context, data HSMSlot) (*HSMSlot, error)
@@ -35,6 +35,8 @@ type HSMSlotFilters interface {
 	OrderByCreationDate(orderDirection persistence.OrderDirection) HSMSlotFilters
 	// OrderByLastUpdateDate orders HSMSlot in storage by last update date.
 	OrderByLastUpdateDate(orderDirection persistence.OrderDirection) HSMSlotFilters
+	// OrderByPriority orders HSMSlot in storage by their priority in the Application.
+	OrderByPriority(orderDirection persistence.OrderDirection) HSMSlotFilters
 	// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
 	Paged(limit int, offset int) HSMSlotFilters
 }
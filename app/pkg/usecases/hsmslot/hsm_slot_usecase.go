@@ -20,6 +20,8 @@ import (
 
 const (
 	defaultOrderDirection = entities.OrderDesc
+	// OrderByPriority orders the slots of an application by their priority.
+	OrderByPriority = "priority"
 )
 
 // HSMSlotUseCase defines the management of HSMSlot in storage.
@@ -28,7 +30,7 @@ type HSMSlotUseCase interface {
 	CreateHSMSlot(ctx context.Context, input CreateHSMSlotInput) (*CreateHSMSlotOutput, error)
 	// GetHSMSlot gets an HSMSlot by its ID in storage and returns an error if it fails.
 	GetHSMSlot(ctx context.Context, input GetHSMSlotInput) (*GetHSMSlotOutput, error)
-	// GetHSMSlotByApplication gets the HSMSlot for the specified application in storage and returns an error if it fails.
+	// GetHSMSlotByApplication gets the primary HSMSlot for the specified application in storage and returns an error if it fails.
 	GetHSMSlotByApplication(ctx context.Context, input GetHSMSlotByApplicationInput) (*GetHSMSlotByApplicationOutput, error)
 	// EditPin edits the Pin of an HSMSlot in storage and returns an error if it fails.
 	EditPin(ctx context.Context, input EditPinInput) (*EditPinOutput, error)
@@ -59,7 +61,8 @@ func (u *DefaultUseCase) CreateHSMSlot(ctx context.Context, input CreateHSMSlotI
 	}
 
 	resetInput := hsmconnector.ResetInput{
-		ModuleKind: hsmconnector.ModuleKind(getHSMOutput.Kind),
+		ModuleKind:          hsmconnector.ModuleKind(getHSMOutput.Kind),
+		PKCS11Configuration: mapPKCS11Configuration(getHSMOutput.Configuration.PKCS11Configuration),
 	}
 	_, resetErr := u.hsmConnector.Reset(ctx, resetInput)
 	if resetErr != nil {
@@ -67,9 +70,10 @@ func (u *DefaultUseCase) CreateHSMSlot(ctx context.Context, input CreateHSMSlotI
 	}
 
 	findSlotInput := hsmconnector.IsAliveInput{
-		Slot:       input.Slot,
-		Pin:        input.Pin,
-		ModuleKind: hsmconnector.ModuleKind(getHSMOutput.Kind),
+		Slot:                input.Slot,
+		Pin:                 input.Pin,
+		ModuleKind:          hsmconnector.ModuleKind(getHSMOutput.Kind),
+		PKCS11Configuration: mapPKCS11Configuration(getHSMOutput.Configuration.PKCS11Configuration),
 	}
 	isAliveOutput, isAliveErr := u.hsmConnector.IsAlive(ctx, findSlotInput)
 	if isAliveErr != nil {
@@ -94,6 +98,8 @@ func (u *DefaultUseCase) CreateHSMSlot(ctx context.Context, input CreateHSMSlotI
 		return nil, errors.InternalFromErr(createSlotErr)
 	}
 
+	u.invalidateCache(ctx, hsmSlot.ApplicationID)
+
 	return &CreateHSMSlotOutput{
 		HSMSlot: *hsmSlot,
 	}, nil
@@ -168,9 +174,10 @@ func (u *DefaultUseCase) EditPin(ctx context.Context, input EditPinInput) (*Edit
 	}
 
 	isAliveInput := hsmconnector.IsAliveInput{
-		Slot:       getHSMSlotOutput.Slot,
-		Pin:        input.Pin,
-		ModuleKind: hsmconnector.ModuleKind(getHSMOutput.Kind),
+		Slot:                getHSMSlotOutput.Slot,
+		Pin:                 input.Pin,
+		ModuleKind:          hsmconnector.ModuleKind(getHSMOutput.Kind),
+		PKCS11Configuration: mapPKCS11Configuration(getHSMOutput.Configuration.PKCS11Configuration),
 	}
 
 	isAliveOutput, isAliveErr := u.hsmConnector.IsAlive(ctx, isAliveInput)
@@ -205,6 +212,8 @@ func (u *DefaultUseCase) EditPin(ctx context.Context, input EditPinInput) (*Edit
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, getHSMSlotOutput.ApplicationID)
+
 	return &EditPinOutput{
 		HSMSlot: *editedSlot,
 	}, nil
@@ -228,6 +237,8 @@ func (u *DefaultUseCase) DeleteHSMSlot(ctx context.Context, input DeleteHSMSlotI
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, removedSlot.ApplicationID)
+
 	return &DeleteHSMSlotOutput{
 		HSMSlot: *removedSlot,
 	}, nil
@@ -247,6 +258,9 @@ func (u *DefaultUseCase) ListHSMSlotsByApplication(ctx context.Context, input Li
 	if input.OrderBy == entities.OrderByLastUpdate {
 		filters.OrderByLastUpdateDate(persistence.OrderDirection(direction))
 	}
+	if input.OrderBy == OrderByPriority {
+		filters.OrderByPriority(persistence.OrderDirection(direction))
+	}
 
 	if input.PageLimit > 0 {
 		filters.Paged(input.PageLimit, input.PageOffset)
@@ -302,6 +316,11 @@ func (u *DefaultUseCase) createSlot(ctx context.Context, input CreateHSMSlotInpu
 		input.ID = &randomID
 	}
 
+	priority, err := u.slotPriority(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
 	hsmSlot := HSMSlot{
 		StandardResourceMeta: entities.StandardResourceMeta{
 			StandardResource: entities.StandardResource{
@@ -318,6 +337,7 @@ func (u *DefaultUseCase) createSlot(ctx context.Context, input CreateHSMSlotInpu
 		HSMModuleID:   input.HSMModuleID,
 		Slot:          input.Slot,
 		Pin:           input.Pin,
+		Priority:      *priority,
 	}
 	hsmSlot.InternalResourceID = entities.NewInternalResourceID()
 
@@ -342,6 +362,36 @@ func (u *DefaultUseCase) createSlot(ctx context.Context, input CreateHSMSlotInpu
 	return addedSlot, nil
 }
 
+// slotPriority returns the priority of the slot to be created. Slots without priority are placed after the existing
+// slots of the application.
+func (u *DefaultUseCase) slotPriority(ctx context.Context, input CreateHSMSlotInput) (*int, error) {
+	if input.Priority != nil && *input.Priority < 0 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'priority' cannot be negative")
+	}
+
+	filters := u.hsmSlotStorage.Filter()
+	filters.FilterByApplicationID(entities.StandardID{ID: input.ApplicationID})
+	filters.OrderByPriority(persistence.OrderDirection(entities.OrderAsc))
+	slots, err := u.hsmSlotStorage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	if input.Priority == nil {
+		priority := 0
+		if len(slots.Items) > 0 {
+			priority = slots.Items[len(slots.Items)-1].Priority + 1
+		}
+		return &priority, nil
+	}
+	for _, slot := range slots.Items {
+		if slot.Priority == *input.Priority {
+			return nil, errors.AlreadyExists().SetHumanReadableMessage("application [%s] already has a slot with priority [%d]", input.ApplicationID, *input.Priority)
+		}
+	}
+	return input.Priority, nil
+}
+
 var _ HSMSlotUseCase = new(DefaultUseCase)
 
 // DefaultUseCaseOptions configures a DefaultUseCase.
@@ -357,6 +407,8 @@ type DefaultUseCaseOptions struct {
 	HSMConnector hsmconnector.HSMConnector
 	// ReferentialIntegrityUseCase to manage dependencies between resources.
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// CacheInvalidation invalidates the cached data of the applications whose slots change. Optional.
+	CacheInvalidation CacheInvalidationPort
 }
 
 // DefaultUseCase default management of User in configuration implementation.
@@ -372,6 +424,8 @@ type DefaultUseCase struct {
 	hsmConnector hsmconnector.HSMConnector
 	// referentialIntegrityUseCase to manage dependencies between resources.
 	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+	// cacheInvalidation invalidates the cached data of the applications whose slots change.
+	cacheInvalidation CacheInvalidationPort
 }
 
 // ProvideDefaultUseCase creates a DefaultUseCase with the given options.
@@ -398,5 +452,17 @@ func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, erro
 		hsmSlotStorage:              options.HSMSlotStorage,
 		applicationUseCase:          options.ApplicationUseCase,
 		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+		cacheInvalidation:           options.CacheInvalidation,
 	}, nil
 }
+
+func mapPKCS11Configuration(configuration *hsmmodule.PKCS11Configuration) *hsmconnector.PKCS11ModuleConfiguration {
+	if configuration == nil {
+		return nil
+	}
+	return &hsmconnector.PKCS11ModuleConfiguration{
+		Library:    hsmconnector.PKCS11Library(configuration.Library),
+		TokenLabel: configuration.TokenLabel,
+		OmitKeyID:  configuration.Quirks.OmitKeyID,
+	}
+}
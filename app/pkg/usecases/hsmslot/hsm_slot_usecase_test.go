@@ -225,6 +225,38 @@ func TestDefaultUseCase_CreateHSMSlot(t *testing.T) {
 		require.Nil(t, output)
 	})
 
+	t.Run("failure: invalid priority", func(t *testing.T) {
+		priority := -1
+		input := hsmslot.CreateHSMSlotInput{
+			ApplicationID: createApplicationOutput.ID,
+			HSMModuleID:   addedModule.ID,
+			Slot:          slotIDTwo,
+			Pin:           slotPin,
+			Priority:      &priority,
+		}
+		output, err := app.HSMSlotUseCase.CreateHSMSlot(ctx, input)
+		require.Error(t, err)
+		require.True(t, errors.IsInvalidArgument(err))
+		require.Nil(t, output)
+	})
+
+	t.Run("failure: priority already taken", func(t *testing.T) {
+		// Create Slot with the default priority
+		createdSlot := createOrGetSlot(t, createApplicationOutput.ID, slotIDOne, addedModule.ID)
+
+		input := hsmslot.CreateHSMSlotInput{
+			ApplicationID: createApplicationOutput.ID,
+			HSMModuleID:   addedModule.ID,
+			Slot:          slotIDTwo,
+			Pin:           slotPin,
+			Priority:      &createdSlot.Priority,
+		}
+		output, err := app.HSMSlotUseCase.CreateHSMSlot(ctx, input)
+		require.Error(t, err)
+		require.True(t, errors.IsAlreadyExists(err))
+		require.Nil(t, output)
+	})
+
 	t.Run("success", func(t *testing.T) {
 		// Create Slot
 		createdSlot := createOrGetSlot(t, createApplicationOutput.ID, slotIDOne, addedModule.ID)
@@ -353,6 +385,35 @@ func TestDefaultUseCase_GetHSMSlotByApplication(t *testing.T) {
 		require.Equal(t, slotPin, output.Pin)
 		require.NotEmpty(t, output.InternalResourceID)
 	})
+
+	t.Run("success: primary slot of an application with several slots", func(t *testing.T) {
+		// Create Module
+		addedModule := createOrGetModule(t, "7e61fd30-299a-4282-9cf7-4582505ecbc5")
+
+		otherApplicationID := uuid.NewString()
+		createOtherApplicationInput := application.CreateApplicationInput{
+			ID:      &otherApplicationID,
+			ChainID: *chainID,
+		}
+		_, createOtherApplicationErr := app.ApplicationUseCase.CreateApplication(ctx, createOtherApplicationInput)
+		require.NoError(t, createOtherApplicationErr)
+
+		// Create Slots, the first one is the primary
+		primarySlot := createOrGetSlot(t, otherApplicationID, slotIDTwo, addedModule.ID)
+		secondarySlot := createOrGetSlot(t, otherApplicationID, slotIDOne, addedModule.ID)
+		require.Less(t, primarySlot.Priority, secondarySlot.Priority)
+
+		getSlotInput := hsmslot.GetHSMSlotByApplicationInput{
+			ApplicationID: entities.StandardID{
+				ID: otherApplicationID,
+			},
+		}
+		output, err := app.HSMSlotUseCase.GetHSMSlotByApplication(ctx, getSlotInput)
+		require.NoError(t, err)
+		require.NotNil(t, output)
+		require.Equal(t, primarySlot.ID, output.ID)
+		require.Equal(t, slotIDTwo, output.Slot)
+	})
 }
 
 func TestDefaultUseCase_EditPin(t *testing.T) {
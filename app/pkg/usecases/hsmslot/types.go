@@ -15,6 +15,9 @@ type HSMSlot struct {
 	Slot string `valid:"required"`
 	// Pin defines the alphanumeric code used for authentication in the HSM.
 	Pin string `valid:"required"`
+	// Priority defines the position of the HSMSlot in the ordered set of slots of the Application. The HSMSlot with the
+	// lowest value is the primary slot.
+	Priority int `valid:"natural"`
 }
 
 // CreateHSMSlotInput configures the creation of an HSMSlot.
@@ -29,6 +32,9 @@ type CreateHSMSlotInput struct {
 	Slot string `valid:"required"`
 	// Pin defines the alphanumeric code used for authentication in the HSM.
 	Pin string `valid:"required"`
+	// Priority defines the position of the HSMSlot in the ordered set of slots of the Application. It is placed after
+	// the existing slots of the Application if it is not provided.
+	Priority *int `valid:"optional"`
 }
 
 // CreateHSMSlotOutput defines the output of creating an HSMSlot.
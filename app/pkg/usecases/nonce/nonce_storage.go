@@ -0,0 +1,19 @@
+package nonce
+
+import (
+	"context"
+)
+
+// NonceStorage defines the functionality to interact with the Nonce in storage.
+type NonceStorage interface {
+	// Increment the next nonce of a Nonce in storage. If the Nonce isn't in storage, it is added with the next nonce
+	// after the given one.
+	Increment(ctx context.Context, data Nonce) error
+	// Get a Nonce in storage.
+	Get(ctx context.Context, id NonceID) (*Nonce, error)
+	// Set the next nonce of a Nonce in storage, adding it if it isn't in storage.
+	Set(ctx context.Context, data Nonce) (*Nonce, error)
+	// Advance the next nonce of a Nonce in storage to the given one if it is greater, adding the Nonce if it isn't in
+	// storage.
+	Advance(ctx context.Context, data Nonce) (*Nonce, error)
+}
@@ -0,0 +1,170 @@
+// Package nonce defines the tracking of the nonces assigned to the transactions signed by each account in each chain.
+package nonce
+
+import (
+	"context"
+	"math"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// NonceUseCase defines the management of the Nonce resource.
+type NonceUseCase interface {
+	// AllocateNonce returns the next nonce of an account in a chain and increments it, so that it isn't returned again.
+	// It returns an error if it fails.
+	AllocateNonce(ctx context.Context, input AllocateNonceInput) (*AllocateNonceOutput, error)
+	// GetNonce returns the requested Nonce or an error if it fails.
+	GetNonce(ctx context.Context, input GetNonceInput) (*GetNonceOutput, error)
+	// ResetNonce sets the next nonce of an account in a chain. It returns the reset Nonce or an error if it fails.
+	ResetNonce(ctx context.Context, input ResetNonceInput) (*ResetNonceOutput, error)
+	// AdvanceNonce sets the next nonce of an account in a chain after the used one, unless it is already greater, so
+	// that the used nonce isn't allocated again. It returns the advanced Nonce or an error if it fails.
+	AdvanceNonce(ctx context.Context, input AdvanceNonceInput) (*AdvanceNonceOutput, error)
+}
+
+// AllocateNonce increments the next nonce and reads it back, so it must be executed in a transaction to return the
+// nonce allocated by this call when several calls allocate nonces for the same account concurrently.
+func (u *DefaultUseCase) AllocateNonce(ctx context.Context, input AllocateNonceInput) (*AllocateNonceOutput, error) {
+	err := validateNonceID(input.NonceID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = u.storage.Increment(ctx, Nonce{
+		NonceID: input.NonceID,
+		Timestamps: entities.Timestamps{
+			CreationDate: now,
+			LastUpdate:   now,
+		},
+	})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	nonce, err := u.storage.Get(ctx, input.NonceID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+	if nonce.NextNonce == 0 {
+		return nil, errors.Internal().WithMessage("next nonce of [%s] in chain [%s] was not incremented", input.Address, input.ChainID.String())
+	}
+
+	return &AllocateNonceOutput{
+		Nonce: nonce.NextNonce - 1,
+	}, nil
+}
+
+func (u *DefaultUseCase) GetNonce(ctx context.Context, input GetNonceInput) (*GetNonceOutput, error) {
+	err := validateNonceID(input.NonceID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := u.storage.Get(ctx, input.NonceID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("no nonce has been allocated for [%s] in chain [%s]", input.Address, input.ChainID.String())
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &GetNonceOutput{
+		Nonce: *nonce,
+	}, nil
+}
+
+func (u *DefaultUseCase) ResetNonce(ctx context.Context, input ResetNonceInput) (*ResetNonceOutput, error) {
+	err := validateNonceID(input.NonceID)
+	if err != nil {
+		return nil, err
+	}
+	if input.NextNonce.Uint64() > math.MaxInt64 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'nextNonce' cannot be greater than %d", int64(math.MaxInt64))
+	}
+
+	now := time.Now()
+	nonce, err := u.storage.Set(ctx, Nonce{
+		NonceID:   input.NonceID,
+		NextNonce: input.NextNonce,
+		Timestamps: entities.Timestamps{
+			CreationDate: now,
+			LastUpdate:   now,
+		},
+	})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &ResetNonceOutput{
+		Nonce: *nonce,
+	}, nil
+}
+
+func (u *DefaultUseCase) AdvanceNonce(ctx context.Context, input AdvanceNonceInput) (*AdvanceNonceOutput, error) {
+	err := validateNonceID(input.NonceID)
+	if err != nil {
+		return nil, err
+	}
+	if input.UsedNonce.Uint64() >= math.MaxInt64 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'nonce' must be lower than %d", int64(math.MaxInt64))
+	}
+
+	now := time.Now()
+	nonce, err := u.storage.Advance(ctx, Nonce{
+		NonceID:   input.NonceID,
+		NextNonce: input.UsedNonce + 1,
+		Timestamps: entities.Timestamps{
+			CreationDate: now,
+			LastUpdate:   now,
+		},
+	})
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &AdvanceNonceOutput{
+		Nonce: *nonce,
+	}, nil
+}
+
+func validateNonceID(id NonceID) error {
+	_, err := govalidator.ValidateStruct(id)
+	if err != nil {
+		return errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	if id.Address.IsEmpty() {
+		return errors.InvalidArgument().SetHumanReadableMessage("field 'address' cannot be empty")
+	}
+	if id.ChainID.BigInt().Sign() <= 0 {
+		return errors.InvalidArgument().SetHumanReadableMessage("field 'chainId' must be greater than 0")
+	}
+	return nil
+}
+
+var _ NonceUseCase = new(DefaultUseCase)
+
+// DefaultUseCase default management of Nonce resources.
+type DefaultUseCase struct {
+	storage NonceStorage
+}
+
+// DefaultUseCaseOptions configures a DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	Storage NonceStorage
+}
+
+// ProvideDefaultUseCase provides a DefaultUseCase with the given options.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.Storage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'Storage' not provided")
+	}
+
+	return &DefaultUseCase{
+		storage: options.Storage,
+	}, nil
+}
@@ -0,0 +1,239 @@
+package nonce_test
+
+import (
+	"context"
+	"crypto/rand"
+	"math"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/noncedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/nonce"
+	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
+
+	"github.com/stretchr/testify/require"
+)
+
+var app graph.GraphShared
+
+var (
+	chainID    = *entities.NewInt256FromInt(44844)
+	otherChain = *entities.NewInt256FromInt(1)
+)
+
+func TestMain(m *testing.M) {
+	a, err := dbtesthelper.InitializeApp()
+	if err != nil {
+		panic(err)
+	}
+	app = *a
+	validators.SetValidators()
+	os.Exit(m.Run())
+}
+
+func TestProvideDefaultUseCase(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		useCase, err := nonce.ProvideDefaultUseCase(nonce.DefaultUseCaseOptions{
+			Storage: &noncedbout.Repository{},
+		})
+		require.Nil(t, err)
+		require.NotNil(t, useCase)
+	})
+
+	t.Run("nil storage", func(t *testing.T) {
+		useCase, err := nonce.ProvideDefaultUseCase(nonce.DefaultUseCaseOptions{})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+}
+
+func TestDefaultUseCase_AllocateNonce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success: consecutive nonces starting at zero", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		for expected := entities.UInt64(0); expected < 3; expected++ {
+			out, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+			require.Nil(t, err)
+			require.Equal(t, expected, out.Nonce)
+		}
+
+		getOut, err := app.NonceUseCase.GetNonce(ctx, nonce.GetNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(3), getOut.NextNonce)
+	})
+
+	t.Run("success: nonces tracked per account and chain", func(t *testing.T) {
+		signer := newAddress(t)
+		ids := []nonce.NonceID{
+			{ChainID: chainID, Address: signer},
+			{ChainID: chainID, Address: newAddress(t)},
+			{ChainID: otherChain, Address: signer},
+		}
+		for _, id := range ids {
+			out, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+			require.Nil(t, err)
+			require.Equal(t, entities.UInt64(0), out.Nonce)
+		}
+	})
+
+	t.Run("success: continues from the reset nonce", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		_, err := app.NonceUseCase.ResetNonce(ctx, nonce.ResetNonceInput{NonceID: id, NextNonce: 10})
+		require.Nil(t, err)
+
+		out, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(10), out.Nonce)
+
+		getOut, err := app.NonceUseCase.GetNonce(ctx, nonce.GetNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(11), getOut.NextNonce)
+	})
+
+	t.Run("success: concurrent allocations get different nonces", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		const allocations = 20
+
+		var wg sync.WaitGroup
+		nonces := make(chan entities.UInt64, allocations)
+		errs := make(chan error, allocations)
+		for i := 0; i < allocations; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+				if err != nil {
+					errs <- err
+					return
+				}
+				nonces <- out.Nonce
+			}()
+		}
+		wg.Wait()
+		close(nonces)
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		allocated := make(map[entities.UInt64]bool)
+		for n := range nonces {
+			require.False(t, allocated[n], "nonce %d allocated twice", n)
+			allocated[n] = true
+		}
+		for expected := entities.UInt64(0); expected < allocations; expected++ {
+			require.True(t, allocated[expected], "nonce %d not allocated", expected)
+		}
+
+		getOut, err := app.NonceUseCase.GetNonce(ctx, nonce.GetNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(allocations), getOut.NextNonce)
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		invalidIDs := []nonce.NonceID{
+			{ChainID: chainID},
+			{ChainID: *entities.NewInt256FromInt(0), Address: newAddress(t)},
+		}
+		for _, id := range invalidIDs {
+			out, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+			require.Error(t, err)
+			require.True(t, signererrors.IsInvalidArgument(err))
+			require.Nil(t, out)
+		}
+	})
+}
+
+func TestDefaultUseCase_GetNonce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("failure: not allocated", func(t *testing.T) {
+		out, err := app.NonceUseCase.GetNonce(ctx, nonce.GetNonceInput{
+			NonceID: nonce.NonceID{ChainID: chainID, Address: newAddress(t)},
+		})
+		require.Error(t, err)
+		require.True(t, signererrors.IsNotFound(err))
+		require.Nil(t, out)
+	})
+}
+
+func TestDefaultUseCase_ResetNonce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success: overwrites the next nonce", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		for i := 0; i < 3; i++ {
+			_, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+			require.Nil(t, err)
+		}
+
+		out, err := app.NonceUseCase.ResetNonce(ctx, nonce.ResetNonceInput{NonceID: id, NextNonce: 1})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(1), out.NextNonce)
+
+		allocateOut, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(1), allocateOut.Nonce)
+	})
+
+	t.Run("failure: next nonce out of range", func(t *testing.T) {
+		out, err := app.NonceUseCase.ResetNonce(ctx, nonce.ResetNonceInput{
+			NonceID:   nonce.NonceID{ChainID: chainID, Address: newAddress(t)},
+			NextNonce: entities.UInt64(math.MaxInt64) + 1,
+		})
+		require.Error(t, err)
+		require.True(t, signererrors.IsInvalidArgument(err))
+		require.Nil(t, out)
+	})
+}
+
+func TestDefaultUseCase_AdvanceNonce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success: allocation continues after the used nonce", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		out, err := app.NonceUseCase.AdvanceNonce(ctx, nonce.AdvanceNonceInput{NonceID: id, UsedNonce: 4})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(5), out.NextNonce)
+
+		allocateOut, err := app.NonceUseCase.AllocateNonce(ctx, nonce.AllocateNonceInput{NonceID: id})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(5), allocateOut.Nonce)
+	})
+
+	t.Run("success: the next nonce doesn't move back", func(t *testing.T) {
+		id := nonce.NonceID{ChainID: chainID, Address: newAddress(t)}
+		_, err := app.NonceUseCase.ResetNonce(ctx, nonce.ResetNonceInput{NonceID: id, NextNonce: 10})
+		require.Nil(t, err)
+
+		out, err := app.NonceUseCase.AdvanceNonce(ctx, nonce.AdvanceNonceInput{NonceID: id, UsedNonce: 3})
+		require.Nil(t, err)
+		require.Equal(t, entities.UInt64(10), out.NextNonce)
+	})
+
+	t.Run("failure: used nonce out of range", func(t *testing.T) {
+		out, err := app.NonceUseCase.AdvanceNonce(ctx, nonce.AdvanceNonceInput{
+			NonceID:   nonce.NonceID{ChainID: chainID, Address: newAddress(t)},
+			UsedNonce: entities.UInt64(math.MaxInt64),
+		})
+		require.Error(t, err)
+		require.True(t, signererrors.IsInvalidArgument(err))
+		require.Nil(t, out)
+	})
+}
+
+func newAddress(t *testing.T) address.Address {
+	b := make([]byte, 20)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	addr, err := address.NewFromRawBytes(b)
+	require.NoError(t, err)
+	return *addr
+}
@@ -0,0 +1,106 @@
+package nonce
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
+)
+
+// AllocateNonce implements DefaultUseCase's AllocateNonce to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) AllocateNonce(ctx context.Context, input AllocateNonceInput) (*AllocateNonceOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.allocateNonceInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*AllocateNonceOutput), nil
+}
+
+// GetNonce implements DefaultUseCase's GetNonce to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetNonce(ctx context.Context, input GetNonceInput) (*GetNonceOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getNonceInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetNonceOutput), nil
+}
+
+// ResetNonce implements DefaultUseCase's ResetNonce to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ResetNonce(ctx context.Context, input ResetNonceInput) (*ResetNonceOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.resetNonceInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ResetNonceOutput), nil
+}
+
+// AdvanceNonce implements DefaultUseCase's AdvanceNonce to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) AdvanceNonce(ctx context.Context, input AdvanceNonceInput) (*AdvanceNonceOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.advanceNonceInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*AdvanceNonceOutput), nil
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) allocateNonceInternal(_ context.Context, input AllocateNonceInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.AllocateNonce(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getNonceInternal(_ context.Context, input GetNonceInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.GetNonce(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) resetNonceInternal(_ context.Context, input ResetNonceInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.ResetNonce(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) advanceNonceInternal(_ context.Context, input AdvanceNonceInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.AdvanceNonce(ctx2, input)
+	}
+}
+
+var _ NonceUseCase = new(DefaultUseCaseTransactionalDecorator)
+
+// DefaultUseCaseTransactionalDecorator decorates struct DefaultUseCase wrapped with a transactional manager.
+type DefaultUseCaseTransactionalDecorator struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase
+	// transactionalManager defines the functionality to execute a transaction in a transactional manner.
+	transactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// DefaultUseCaseTransactionalDecoratorOptions is the structure representing the DefaultUseCaseTransactionalDecorator dependencies.
+type DefaultUseCaseTransactionalDecoratorOptions struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase *DefaultUseCase
+	// TransactionalManager defines the functionality to execute a transaction in a transactional manner.
+	TransactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// ProvideDefaultUseCaseTransactionalDecorator creates a new DefaultUseCaseTransactionalDecorator.
+func ProvideDefaultUseCaseTransactionalDecorator(options DefaultUseCaseTransactionalDecoratorOptions) (*DefaultUseCaseTransactionalDecorator, error) {
+	if options.DefaultUseCase == nil {
+		errorMessage := "'DefaultUseCase' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	if options.TransactionalManager == nil {
+		errorMessage := "'TransactionalManager' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	return &DefaultUseCaseTransactionalDecorator{
+		DefaultUseCase:       *options.DefaultUseCase,
+		transactionalManager: options.TransactionalManager,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package nonce
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+)
+
+// NonceID identifies the Nonce of an account in a chain.
+type NonceID struct {
+	// ChainID of the chain the transactions are signed for.
+	ChainID entities.Int256 `valid:"-"`
+	// Address of the account that signs the transactions.
+	Address address.Address `valid:"address"`
+}
+
+// Nonce tracks the nonce to be assigned to the next transaction signed by an account in a chain.
+type Nonce struct {
+	NonceID
+	// NextNonce nonce to be assigned to the next transaction.
+	NextNonce entities.UInt64
+	// Timestamps of the Nonce.
+	entities.Timestamps
+}
+
+// AllocateNonceInput defines the account and the chain to allocate a nonce for.
+type AllocateNonceInput struct {
+	NonceID
+}
+
+// AllocateNonceOutput defines the allocated nonce.
+type AllocateNonceOutput struct {
+	// Nonce allocated to the transaction. It won't be allocated again unless the Nonce is reset.
+	Nonce entities.UInt64
+}
+
+// GetNonceInput defines the input for getting a Nonce.
+type GetNonceInput struct {
+	NonceID
+}
+
+// GetNonceOutput defines the output of getting a Nonce.
+type GetNonceOutput struct {
+	Nonce
+}
+
+// ResetNonceInput configures the reset of a Nonce.
+type ResetNonceInput struct {
+	NonceID
+	// NextNonce nonce to be assigned to the next transaction.
+	NextNonce entities.UInt64 `valid:"-"`
+}
+
+// ResetNonceOutput defines the output of resetting a Nonce.
+type ResetNonceOutput struct {
+	Nonce
+}
+
+// AdvanceNonceInput defines the nonce used by a transaction that was signed without allocating it.
+type AdvanceNonceInput struct {
+	NonceID
+	// UsedNonce nonce of the signed transaction.
+	UsedNonce entities.UInt64 `valid:"-"`
+}
+
+// AdvanceNonceOutput defines the output of advancing a Nonce.
+type AdvanceNonceOutput struct {
+	Nonce
+}
@@ -17,12 +17,14 @@ import (
 type ResourceKind string
 
 const (
-	KindAccount     ResourceKind = "account"
-	KindApplication ResourceKind = "application"
-	KindHSMModule   ResourceKind = "hardware_security_module"
-	KindHSMSlot     ResourceKind = "hardware_security_module_slot"
-	KindUser        ResourceKind = "user"
-	KindAdmin       ResourceKind = "admin"
+	KindAccount       ResourceKind = "account"
+	KindApplication   ResourceKind = "application"
+	KindHSMModule     ResourceKind = "hardware_security_module"
+	KindHSMSlot       ResourceKind = "hardware_security_module_slot"
+	KindUser          ResourceKind = "user"
+	KindAdmin         ResourceKind = "admin"
+	KindSigningPolicy ResourceKind = "signing_policy"
+	KindSigningLimit  ResourceKind = "signing_limit"
 )
 
 // ReferentialIntegrityUseCase defines how to interact with ReferentialIntegrityEntry resources.
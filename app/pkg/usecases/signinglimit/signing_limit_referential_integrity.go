@@ -0,0 +1,51 @@
+package signinglimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+)
+
+func (u *DefaultUseCase) addSigningLimitToApplicationDependency(ctx context.Context, data SigningLimit) error {
+	getApplicationInput := application.GetApplicationInput{
+		StandardID: entities.StandardID{
+			ID: data.ApplicationID,
+		},
+	}
+	getApplicationOutput, getApplicationErr := u.applicationUseCase.GetApplication(ctx, getApplicationInput)
+	if getApplicationErr != nil {
+		if errors.IsNotFound(getApplicationErr) {
+			msg := fmt.Sprintf("signing limit can't be created because the application '%s' does not exist", data.ApplicationID)
+			return errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+		}
+		return getApplicationErr
+	}
+
+	var referentialIntegrityCreateEntryInput referentialintegrity.CreateEntryInput
+	referentialIntegrityCreateEntryInput.ResourceID = string(data.InternalResourceID)
+	referentialIntegrityCreateEntryInput.ResourceKind = referentialintegrity.KindSigningLimit
+	referentialIntegrityCreateEntryInput.ParentResourceID = string(getApplicationOutput.InternalResourceID)
+	referentialIntegrityCreateEntryInput.ParentResourceKind = referentialintegrity.KindApplication
+
+	_, createEntryErr := u.referentialIntegrityUseCase.CreateEntry(ctx, referentialIntegrityCreateEntryInput)
+	if createEntryErr != nil && !errors.IsAlreadyExists(createEntryErr) {
+		return createEntryErr
+	}
+	return nil
+}
+
+func (u *DefaultUseCase) removeAllSigningLimitDependencies(ctx context.Context, signingLimit SigningLimit) error {
+	removeUsageErr := u.usageStorage.RemoveAll(ctx, signingLimit.ApplicationStandardID)
+	if removeUsageErr != nil {
+		return errors.InternalFromErr(removeUsageErr)
+	}
+
+	var deleteInput referentialintegrity.DeleteMyEntriesIfAnyInput
+	deleteInput.ResourceID = string(signingLimit.InternalResourceID)
+	deleteInput.ResourceKind = referentialintegrity.KindSigningLimit
+	return u.referentialIntegrityUseCase.DeleteMyEntriesIfAny(ctx, deleteInput)
+}
@@ -0,0 +1,49 @@
+package signinglimit
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+)
+
+// SigningLimitStorage defines the functionality to interact with the SigningLimit in storage.
+type SigningLimitStorage interface {
+	// Add a SigningLimit in storage.
+	Add(ctx context.Context, data SigningLimit) (*SigningLimit, error)
+	// Get a SigningLimit in storage.
+	Get(ctx context.Context, id entities.ApplicationStandardID) (*SigningLimit, error)
+	// Edit a SigningLimit in storage.
+	Edit(ctx context.Context, data SigningLimit) (*SigningLimit, error)
+	// Remove a SigningLimit in storage.
+	Remove(ctx context.Context, id entities.ApplicationStandardID) (*SigningLimit, error)
+	// All SigningLimit in storage.
+	All(ctx context.Context, filters SigningLimitFilters) (*SigningLimitCollection, error)
+
+	// Filter by applicationID plus other optional filters.
+	Filter(applicationID string) SigningLimitFilters
+}
+
+// SigningLimitFilters defines filter options for retrieving SigningLimit resources from storage.
+type SigningLimitFilters interface {
+	// OrderByCreationDate orders SigningLimit in storage by creation date.
+	OrderByCreationDate(direction persistence.OrderDirection) SigningLimitFilters
+	// OrderByLastUpdateDate orders SigningLimit in storage by last update date.
+	OrderByLastUpdateDate(direction persistence.OrderDirection) SigningLimitFilters
+	// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
+	Paged(limit int, offset int) SigningLimitFilters
+}
+
+// SigningLimitUsageStorage defines the functionality to interact with the SigningLimitUsage in storage.
+type SigningLimitUsageStorage interface {
+	// Add a SigningLimitUsage in storage. It returns an already exists error if the subject already has usage.
+	Add(ctx context.Context, data SigningLimitUsage) (*SigningLimitUsage, error)
+	// Get a SigningLimitUsage in storage.
+	Get(ctx context.Context, id SigningLimitUsageID) (*SigningLimitUsage, error)
+	// Edit a SigningLimitUsage in storage. It returns a not found error if the resource version doesn't match the stored one.
+	Edit(ctx context.Context, data SigningLimitUsage) (*SigningLimitUsage, error)
+	// RemoveAll SigningLimitUsage of a SigningLimit in storage.
+	RemoveAll(ctx context.Context, limitID entities.ApplicationStandardID) error
+	// All SigningLimitUsage of a SigningLimit in storage.
+	All(ctx context.Context, limitID entities.ApplicationStandardID) ([]SigningLimitUsage, error)
+}
@@ -0,0 +1,468 @@
+package signinglimit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/utils"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// SigningLimitUseCase defines the management of the SigningLimit resource.
+type SigningLimitUseCase interface {
+	// CreateSigningLimit creates a SigningLimit. It returns the created SigningLimit or an error if it fails.
+	CreateSigningLimit(ctx context.Context, input CreateSigningLimitInput) (*CreateSigningLimitOutput, error)
+	// ListSigningLimits returns the SigningLimit resources of an Application or an error if it fails.
+	ListSigningLimits(ctx context.Context, input ListSigningLimitsInput) (*ListSigningLimitsOutput, error)
+	// GetSigningLimit returns the requested SigningLimit or an error if it fails.
+	GetSigningLimit(ctx context.Context, input GetSigningLimitInput) (*GetSigningLimitOutput, error)
+	// EditSigningLimit edits a SigningLimit. It returns the edited SigningLimit or an error if it fails.
+	EditSigningLimit(ctx context.Context, input EditSigningLimitInput) (*EditSigningLimitOutput, error)
+	// DeleteSigningLimit deletes a SigningLimit and its usage. It returns the deleted SigningLimit or an error if it fails.
+	DeleteSigningLimit(ctx context.Context, input DeleteSigningLimitInput) (*DeleteSigningLimitOutput, error)
+	// GetSigningLimitUsage returns the usage of the current window of a SigningLimit or an error if it fails.
+	GetSigningLimitUsage(ctx context.Context, input GetSigningLimitUsageInput) (*GetSigningLimitUsageOutput, error)
+	// ConsumeQuota counts a signature against the SigningLimit resources that apply to the account and the user that
+	// sign it. It returns a too many requests error without counting the signature if it exceeds any of them.
+	ConsumeQuota(ctx context.Context, input ConsumeQuotaInput) (*ConsumeQuotaOutput, error)
+}
+
+func (u *DefaultUseCase) CreateSigningLimit(ctx context.Context, input CreateSigningLimitInput) (*CreateSigningLimitOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	err = validateBounds(input.WindowSeconds, input.MaxSignatures, input.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+	subject, err := normalizeSubject(input.Scope, input.Subject)
+	if err != nil {
+		return nil, err
+	}
+	input.Subject = subject
+
+	signingLimit := createToSigningLimit(input)
+	signingLimit.InternalResourceID = entities.NewInternalResourceID()
+	addDependencyErr := u.addSigningLimitToApplicationDependency(ctx, signingLimit)
+	if addDependencyErr != nil {
+		return nil, addDependencyErr
+	}
+
+	addedSigningLimit, err := u.storage.Add(ctx, signingLimit)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, errors.AlreadyExistsFromErr(err).SetHumanReadableMessage("signing limit [%s] already exists", signingLimit.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &CreateSigningLimitOutput{
+		SigningLimit: *addedSigningLimit,
+	}, nil
+}
+
+func (u *DefaultUseCase) ListSigningLimits(ctx context.Context, input ListSigningLimitsInput) (*ListSigningLimitsOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	filters := u.storage.Filter(input.ApplicationID)
+	direction := utils.DefaultString(input.OrderDirection, defaultOrderDirection)
+	filters.OrderByCreationDate(persistence.OrderDirection(direction))
+	if input.OrderBy == entities.OrderByLastUpdate {
+		filters.OrderByLastUpdateDate(persistence.OrderDirection(direction))
+	}
+
+	if input.PageLimit > 0 {
+		filters.Paged(input.PageLimit, input.PageOffset)
+	}
+
+	collection, err := u.storage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &ListSigningLimitsOutput{
+		SigningLimitCollection: *collection,
+	}, nil
+}
+
+func (u *DefaultUseCase) GetSigningLimit(ctx context.Context, input GetSigningLimitInput) (*GetSigningLimitOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	signingLimit, err := u.storage.Get(ctx, input.ApplicationStandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing limit [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &GetSigningLimitOutput{
+		SigningLimit: *signingLimit,
+	}, nil
+}
+
+func (u *DefaultUseCase) EditSigningLimit(ctx context.Context, input EditSigningLimitInput) (*EditSigningLimitOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	err = validateBounds(input.WindowSeconds, input.MaxSignatures, input.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	signingLimit := mapEditedValues(input)
+	editedSigningLimit, err := u.storage.Edit(ctx, signingLimit)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing limit [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &EditSigningLimitOutput{
+		SigningLimit: *editedSigningLimit,
+	}, nil
+}
+
+func (u *DefaultUseCase) DeleteSigningLimit(ctx context.Context, input DeleteSigningLimitInput) (*DeleteSigningLimitOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	getSigningLimitOutput, err := u.GetSigningLimit(ctx, GetSigningLimitInput(input))
+	if err != nil {
+		return nil, err
+	}
+	removeDependenciesErr := u.removeAllSigningLimitDependencies(ctx, getSigningLimitOutput.SigningLimit)
+	if removeDependenciesErr != nil {
+		return nil, removeDependenciesErr
+	}
+
+	signingLimit, err := u.storage.Remove(ctx, input.ApplicationStandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing limit [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &DeleteSigningLimitOutput{
+		SigningLimit: *signingLimit,
+	}, nil
+}
+
+func (u *DefaultUseCase) GetSigningLimitUsage(ctx context.Context, input GetSigningLimitUsageInput) (*GetSigningLimitUsageOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	getSigningLimitOutput, err := u.GetSigningLimit(ctx, GetSigningLimitInput(input))
+	if err != nil {
+		return nil, err
+	}
+	signingLimit := getSigningLimitOutput.SigningLimit
+
+	usages, err := u.usageStorage.All(ctx, signingLimit.ApplicationStandardID)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	now := time.Now()
+	items := make([]SubjectUsage, 0)
+	for _, usage := range usages {
+		signatures, value := sumBuckets(activeBuckets(signingLimit, usage.Buckets, now))
+		if signatures == 0 {
+			continue
+		}
+		items = append(items, SubjectUsage{
+			Subject:    usage.Subject,
+			Signatures: signatures,
+			Value:      *entities.NewInt256(value),
+		})
+	}
+
+	return &GetSigningLimitUsageOutput{
+		SigningLimit: signingLimit,
+		Items:        items,
+	}, nil
+}
+
+func (u *DefaultUseCase) ConsumeQuota(ctx context.Context, input ConsumeQuotaInput) (*ConsumeQuotaOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	filters := u.storage.Filter(input.ApplicationID)
+	filters.OrderByCreationDate(persistence.OrderDirection(entities.OrderAsc))
+	collection, err := u.storage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	quotas := make([]SigningLimitUsageID, 0)
+	signingLimits := make([]SigningLimit, 0)
+	for _, signingLimit := range collection.Items {
+		subject, applies := subjectOf(signingLimit, input)
+		if !applies {
+			continue
+		}
+		quotas = append(quotas, SigningLimitUsageID{
+			LimitID: signingLimit.ApplicationStandardID,
+			Subject: subject,
+		})
+		signingLimits = append(signingLimits, signingLimit)
+	}
+
+	now := time.Now()
+	// all the limits are checked before counting the signature in any of them, so that most rejected signatures don't
+	// update the usage. A signature rejected while it is being counted is only left out of the limits counted before
+	// if ConsumeQuota is executed in a transaction, which is rolled back.
+	for i, quota := range quotas {
+		usage, getErr := u.getUsage(ctx, quota)
+		if getErr != nil {
+			return nil, getErr
+		}
+		checkErr := checkQuota(signingLimits[i], quota.Subject, activeBuckets(signingLimits[i], usage.Buckets, now), input.Value)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+	}
+	for i, quota := range quotas {
+		consumeErr := u.consume(ctx, signingLimits[i], quota, input.Value, now)
+		if consumeErr != nil {
+			return nil, consumeErr
+		}
+	}
+
+	return &ConsumeQuotaOutput{
+		LimitsConsumed: len(quotas),
+	}, nil
+}
+
+// consume counts a signature in the usage of a subject. The usage is updated with optimistic locking, so it is read and
+// checked again if another replica updates it concurrently.
+func (u *DefaultUseCase) consume(ctx context.Context, signingLimit SigningLimit, id SigningLimitUsageID, value *entities.Int256, now time.Timestamp) error {
+	for attempt := 0; attempt < maxConsumeAttempts; attempt++ {
+		usage, err := u.getUsage(ctx, id)
+		if err != nil {
+			return err
+		}
+		buckets := activeBuckets(signingLimit, usage.Buckets, now)
+		err = checkQuota(signingLimit, id.Subject, buckets, value)
+		if err != nil {
+			return err
+		}
+		usage.Buckets = addToBuckets(signingLimit, buckets, value, now)
+		usage.LastUpdate = now
+
+		if len(usage.ResourceVersion) == 0 {
+			_, err = u.usageStorage.Add(ctx, *usage)
+		} else {
+			_, err = u.usageStorage.Edit(ctx, *usage)
+		}
+		if err == nil {
+			return nil
+		}
+		if !errors.IsAlreadyExists(err) && !errors.IsNotFound(err) {
+			return errors.InternalFromErr(err)
+		}
+	}
+	return errors.Unavailable().SetHumanReadableMessage("the usage of signing limit [%s] is being updated concurrently, try again later", signingLimit.ID)
+}
+
+// getUsage returns the stored usage of a subject or an empty usage if the subject has no usage yet.
+func (u *DefaultUseCase) getUsage(ctx context.Context, id SigningLimitUsageID) (*SigningLimitUsage, error) {
+	usage, err := u.usageStorage.Get(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &SigningLimitUsage{
+				SigningLimitUsageID: id,
+			}, nil
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+	return usage, nil
+}
+
+// subjectOf returns the subject the usage of a SigningLimit is counted for and whether the SigningLimit applies to the signature.
+func subjectOf(signingLimit SigningLimit, input ConsumeQuotaInput) (string, bool) {
+	var subject string
+	switch signingLimit.Scope {
+	case AccountScope:
+		subject = input.From.String()
+	case UserScope:
+		if input.UserID == nil {
+			return "", false
+		}
+		subject = *input.UserID
+	default:
+		return "", false
+	}
+	if signingLimit.Subject != nil && *signingLimit.Subject != subject {
+		return "", false
+	}
+	return subject, true
+}
+
+// checkQuota returns a too many requests error if a signature of the given value exceeds the SigningLimit.
+func checkQuota(signingLimit SigningLimit, subject string, buckets []UsageBucket, value *entities.Int256) error {
+	signatures, total := sumBuckets(buckets)
+	var violation string
+	switch {
+	case signingLimit.MaxSignatures != nil && signatures+1 > *signingLimit.MaxSignatures:
+		violation = fmt.Sprintf("at most [%d] signatures every [%d] seconds", *signingLimit.MaxSignatures, signingLimit.WindowSeconds)
+	case signingLimit.MaxValue != nil && value != nil && total.Add(total, value.BigInt()).Cmp(signingLimit.MaxValue.BigInt()) > 0:
+		violation = fmt.Sprintf("at most [%s] wei every [%d] seconds", signingLimit.MaxValue.BigInt(), signingLimit.WindowSeconds)
+	default:
+		return nil
+	}
+	msg := fmt.Sprintf("signing limit [%s] exceeded by [%s]: %s", signingLimit.ID, subject, violation)
+	return errors.TooManyReq().WithMessage(msg).SetHumanReadableMessage(msg)
+}
+
+// bucketSize returns the duration in milliseconds of the buckets of a SigningLimit.
+func bucketSize(signingLimit SigningLimit) int64 {
+	size := signingLimit.WindowSeconds * 1000 / bucketsPerWindow
+	if size < minBucketSize {
+		return minBucketSize
+	}
+	return size
+}
+
+// activeBuckets returns the buckets that overlap with the window ending now.
+func activeBuckets(signingLimit SigningLimit, buckets []UsageBucket, now time.Timestamp) []UsageBucket {
+	windowStart := now.Sub(signingLimit.WindowSeconds * 1000).ToInt64()
+	size := bucketSize(signingLimit)
+	active := make([]UsageBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		if bucket.Start.ToInt64()+size > windowStart {
+			active = append(active, bucket)
+		}
+	}
+	return active
+}
+
+// addToBuckets counts a signature in the bucket of the current period of time.
+func addToBuckets(signingLimit SigningLimit, buckets []UsageBucket, value *entities.Int256, now time.Timestamp) []UsageBucket {
+	start := time.TimestampFromInt64(now.ToInt64() - now.ToInt64()%bucketSize(signingLimit))
+	if len(buckets) == 0 || buckets[len(buckets)-1].Start != start {
+		buckets = append(buckets, UsageBucket{
+			Start: start,
+			Value: *entities.ZeroInt256(),
+		})
+	}
+	current := &buckets[len(buckets)-1]
+	current.Signatures++
+	if value != nil {
+		current.Value = *entities.NewInt256(new(big.Int).Add(current.Value.BigInt(), value.BigInt()))
+	}
+	return buckets
+}
+
+// sumBuckets returns the amount of signatures and the value of the given buckets.
+func sumBuckets(buckets []UsageBucket) (int64, *big.Int) {
+	signatures := int64(0)
+	value := new(big.Int)
+	for _, bucket := range buckets {
+		signatures += bucket.Signatures
+		value.Add(value, bucket.Value.BigInt())
+	}
+	return signatures, value
+}
+
+// validateBounds validates the window and the maximums of a SigningLimit.
+func validateBounds(windowSeconds int64, maxSignatures *int64, maxValue *entities.Int256) error {
+	if windowSeconds <= 0 {
+		return errors.InvalidArgument().SetHumanReadableMessage("field 'windowSeconds' must be greater than 0")
+	}
+	if maxSignatures == nil && maxValue == nil {
+		return errors.InvalidArgument().SetHumanReadableMessage("at least one of the fields 'maxSignatures' and 'maxValue' must be set")
+	}
+	if maxSignatures != nil && *maxSignatures < 0 {
+		return errors.InvalidArgument().SetHumanReadableMessage("field 'maxSignatures' cannot be negative")
+	}
+	if maxValue != nil && maxValue.BigInt().Sign() < 0 {
+		return errors.InvalidArgument().SetHumanReadableMessage("field 'maxValue' cannot be negative")
+	}
+	return nil
+}
+
+// normalizeSubject validates the subject of a SigningLimit and returns the addresses in their checksum format.
+func normalizeSubject(scope Scope, subject *string) (*string, error) {
+	if subject == nil {
+		return nil, nil
+	}
+	if len(*subject) == 0 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'subject' cannot be empty")
+	}
+	if scope != AccountScope {
+		return subject, nil
+	}
+	addr, err := address.NewFromHexString(*subject)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("subject [%s] is not a valid address", *subject)
+	}
+	normalized := addr.String()
+	return &normalized, nil
+}
+
+var _ SigningLimitUseCase = new(DefaultUseCase)
+
+// DefaultUseCase default management of SigningLimit resources.
+type DefaultUseCase struct {
+	storage                     SigningLimitStorage
+	usageStorage                SigningLimitUsageStorage
+	applicationUseCase          application.ApplicationUseCase
+	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+}
+
+// DefaultUseCaseOptions configures a DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	Storage                     SigningLimitStorage
+	UsageStorage                SigningLimitUsageStorage
+	ApplicationUseCase          application.ApplicationUseCase
+	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+}
+
+// ProvideDefaultUseCase provides a DefaultUseCase with the given options.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.Storage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'Storage' not provided")
+	}
+	if options.UsageStorage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'UsageStorage' not provided")
+	}
+	if options.ApplicationUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ApplicationUseCase' not provided")
+	}
+	if options.ReferentialIntegrityUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ReferentialIntegrityUseCase' not provided")
+	}
+
+	return &DefaultUseCase{
+		storage:                     options.Storage,
+		usageStorage:                options.UsageStorage,
+		applicationUseCase:          options.ApplicationUseCase,
+		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+	}, nil
+}
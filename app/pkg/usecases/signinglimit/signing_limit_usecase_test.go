@@ -0,0 +1,521 @@
+package signinglimit_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitdbout"
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signinglimitusagedbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signinglimit"
+	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var app graph.GraphShared
+
+var (
+	chain       = entities.NewInt256FromInt(44844)
+	signer      = address.MustNewFromHexString("0x970e8128ab834e8eac17ab8e3812f010678cf791")
+	otherSigner = address.MustNewFromHexString("0xc0ffee254729296a45a3885639ac7e10f9d54979")
+)
+
+func TestMain(m *testing.M) {
+	a, err := dbtesthelper.InitializeApp()
+	if err != nil {
+		panic(err)
+	}
+	app = *a
+	validators.SetValidators()
+	os.Exit(m.Run())
+}
+
+func TestProvideDefaultUseCase(t *testing.T) {
+	t.Run("nil storage", func(t *testing.T) {
+		useCase, err := signinglimit.ProvideDefaultUseCase(signinglimit.DefaultUseCaseOptions{
+			UsageStorage:                &signinglimitusagedbout.Repository{},
+			ApplicationUseCase:          &application.DefaultUseCase{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil usage storage", func(t *testing.T) {
+		useCase, err := signinglimit.ProvideDefaultUseCase(signinglimit.DefaultUseCaseOptions{
+			Storage:                     &signinglimitdbout.Repository{},
+			ApplicationUseCase:          &application.DefaultUseCase{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil application use case", func(t *testing.T) {
+		useCase, err := signinglimit.ProvideDefaultUseCase(signinglimit.DefaultUseCaseOptions{
+			Storage:                     &signinglimitdbout.Repository{},
+			UsageStorage:                &signinglimitusagedbout.Repository{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil referential integrity use case", func(t *testing.T) {
+		useCase, err := signinglimit.ProvideDefaultUseCase(signinglimit.DefaultUseCaseOptions{
+			Storage:            &signinglimitdbout.Repository{},
+			UsageStorage:       &signinglimitusagedbout.Repository{},
+			ApplicationUseCase: &application.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		useCase, err := signinglimit.ProvideDefaultUseCase(signinglimit.DefaultUseCaseOptions{
+			Storage:                     &signinglimitdbout.Repository{},
+			UsageStorage:                &signinglimitusagedbout.Repository{},
+			ApplicationUseCase:          &application.DefaultUseCase{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, useCase)
+	})
+}
+
+func TestDefaultUseCase_CreateSigningLimit(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	t.Run("success", func(t *testing.T) {
+		id := uuid.NewString()
+		subject := "0x970E8128AB834E8EAC17AB8E3812F010678CF791"
+		output, err := app.SigningLimitUseCase.CreateSigningLimit(ctx, signinglimit.CreateSigningLimitInput{
+			ID:            &id,
+			ApplicationID: applicationID,
+			Scope:         signinglimit.AccountScope,
+			Subject:       &subject,
+			WindowSeconds: 60,
+			MaxSignatures: int64Ptr(10),
+		})
+		require.NoError(t, err)
+		require.Equal(t, id, output.ID)
+		require.NotEmpty(t, output.InternalResourceID)
+		require.NotEmpty(t, output.ResourceVersion)
+		require.Equal(t, signer.String(), *output.Subject)
+
+		stored, err := app.SigningLimitUseCase.GetSigningLimit(ctx, signinglimit.GetSigningLimitInput{
+			ApplicationStandardID: output.ApplicationStandardID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, output.SigningLimit, stored.SigningLimit)
+	})
+
+	t.Run("failure: application does not exist", func(t *testing.T) {
+		output, err := app.SigningLimitUseCase.CreateSigningLimit(ctx, signinglimit.CreateSigningLimitInput{
+			ApplicationID: uuid.NewString(),
+			Scope:         signinglimit.UserScope,
+			WindowSeconds: 60,
+			MaxSignatures: int64Ptr(10),
+		})
+		require.True(t, signererrors.IsPreconditionFailed(err))
+		require.Nil(t, output)
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		emptySubject := ""
+		invalidSubject := "not-an-address"
+		invalidInputs := map[string]signinglimit.CreateSigningLimitInput{
+			"unknown scope": {
+				Scope:         "team",
+				WindowSeconds: 60,
+				MaxSignatures: int64Ptr(10),
+			},
+			"window not positive": {
+				Scope:         signinglimit.UserScope,
+				MaxSignatures: int64Ptr(10),
+			},
+			"no maximum": {
+				Scope:         signinglimit.UserScope,
+				WindowSeconds: 60,
+			},
+			"negative max signatures": {
+				Scope:         signinglimit.UserScope,
+				WindowSeconds: 60,
+				MaxSignatures: int64Ptr(-1),
+			},
+			"negative max value": {
+				Scope:         signinglimit.UserScope,
+				WindowSeconds: 60,
+				MaxValue:      entities.NewInt256FromInt(-1),
+			},
+			"empty subject": {
+				Scope:         signinglimit.UserScope,
+				Subject:       &emptySubject,
+				WindowSeconds: 60,
+				MaxSignatures: int64Ptr(10),
+			},
+			"account subject is not an address": {
+				Scope:         signinglimit.AccountScope,
+				Subject:       &invalidSubject,
+				WindowSeconds: 60,
+				MaxSignatures: int64Ptr(10),
+			},
+		}
+		for name, input := range invalidInputs {
+			input.ApplicationID = applicationID
+			output, err := app.SigningLimitUseCase.CreateSigningLimit(ctx, input)
+			require.True(t, signererrors.IsInvalidArgument(err), name)
+			require.Nil(t, output, name)
+		}
+	})
+}
+
+func TestDefaultUseCase_EditSigningLimit(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+	created := createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+		Scope:         signinglimit.AccountScope,
+		WindowSeconds: 3600,
+		MaxSignatures: int64Ptr(10),
+	})
+
+	edited, err := app.SigningLimitUseCase.EditSigningLimit(ctx, signinglimit.EditSigningLimitInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+		ResourceVersion:       created.ResourceVersion,
+		WindowSeconds:         60,
+		MaxSignatures:         int64Ptr(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.ID, edited.ID)
+	require.Equal(t, created.Scope, edited.Scope)
+	require.NotEqual(t, created.ResourceVersion, edited.ResourceVersion)
+	require.Equal(t, int64(60), edited.WindowSeconds)
+	require.Equal(t, int64(1), *edited.MaxSignatures)
+
+	t.Run("failure: outdated resource version", func(t *testing.T) {
+		output, editErr := app.SigningLimitUseCase.EditSigningLimit(ctx, signinglimit.EditSigningLimitInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+			ResourceVersion:       created.ResourceVersion,
+			WindowSeconds:         60,
+			MaxSignatures:         int64Ptr(5),
+		})
+		require.True(t, signererrors.IsNotFound(editErr))
+		require.Nil(t, output)
+
+		stored, getErr := app.SigningLimitUseCase.GetSigningLimit(ctx, signinglimit.GetSigningLimitInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+		})
+		require.NoError(t, getErr)
+		require.Equal(t, edited.ResourceVersion, stored.ResourceVersion)
+		require.Equal(t, int64(1), *stored.MaxSignatures)
+	})
+
+	t.Run("success: the edited limit is enforced", func(t *testing.T) {
+		input := signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+		}
+		_, consumeErr := app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, consumeErr)
+		_, consumeErr = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.True(t, signererrors.IsTooManyReq(consumeErr))
+	})
+}
+
+func TestDefaultUseCase_ConsumeQuota(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success: signatures within the limit", func(t *testing.T) {
+		applicationID := createApplication(t)
+		createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(2),
+		})
+
+		for i := 0; i < 2; i++ {
+			output, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+				ApplicationID: applicationID,
+				From:          signer,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, output.LimitsConsumed)
+		}
+	})
+
+	t.Run("failure: signatures exceed the limit", func(t *testing.T) {
+		applicationID := createApplication(t)
+		createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(1),
+		})
+
+		input := signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+		}
+		_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, err)
+		output, err := app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.True(t, signererrors.IsTooManyReq(err))
+		require.Nil(t, output)
+
+		// the usage of each account is counted separately
+		input.From = otherSigner
+		_, err = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("failure: value exceeds the limit", func(t *testing.T) {
+		applicationID := createApplication(t)
+		createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxValue:      entities.NewInt256FromInt(100),
+		})
+
+		input := signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			Value:         entities.NewInt256FromInt(60),
+		}
+		_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, err)
+		_, err = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.True(t, signererrors.IsTooManyReq(err))
+
+		// signatures without value are not limited by value
+		input.Value = nil
+		_, err = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("success: user limits only apply to requests with a user", func(t *testing.T) {
+		applicationID := createApplication(t)
+		userID := uuid.NewString()
+		createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.UserScope,
+			Subject:       &userID,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(1),
+		})
+
+		output, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, output.LimitsConsumed)
+
+		input := signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			UserID:        &userID,
+		}
+		_, err = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.NoError(t, err)
+		_, err = app.SigningLimitUseCase.ConsumeQuota(ctx, input)
+		require.True(t, signererrors.IsTooManyReq(err))
+	})
+
+	t.Run("success: rejected signatures are not counted", func(t *testing.T) {
+		applicationID := createApplication(t)
+		created := createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(5),
+		})
+		createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxValue:      entities.NewInt256FromInt(10),
+		})
+
+		_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			Value:         entities.NewInt256FromInt(11),
+		})
+		require.True(t, signererrors.IsTooManyReq(err))
+
+		usage, err := app.SigningLimitUseCase.GetSigningLimitUsage(ctx, signinglimit.GetSigningLimitUsageInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+		})
+		require.NoError(t, err)
+		require.Empty(t, usage.Items)
+	})
+
+	t.Run("success: concurrent signatures don't exceed the limit", func(t *testing.T) {
+		applicationID := createApplication(t)
+		created := createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(5),
+		})
+
+		const signatures = 10
+		var wg sync.WaitGroup
+		errs := make(chan error, signatures)
+		for i := 0; i < signatures; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+					ApplicationID: applicationID,
+					From:          signer,
+				})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		consumed := 0
+		for err := range errs {
+			if err == nil {
+				consumed++
+				continue
+			}
+			require.True(t, signererrors.IsTooManyReq(err), err.Error())
+		}
+		require.Equal(t, 5, consumed)
+
+		usage, err := app.SigningLimitUseCase.GetSigningLimitUsage(ctx, signinglimit.GetSigningLimitUsageInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+		})
+		require.NoError(t, err)
+		require.Len(t, usage.Items, 1)
+		require.Equal(t, int64(5), usage.Items[0].Signatures)
+	})
+
+	t.Run("success: quota released if the transaction of the signature is rolled back", func(t *testing.T) {
+		applicationID := createApplication(t)
+		created := createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+			Scope:         signinglimit.AccountScope,
+			WindowSeconds: 3600,
+			MaxSignatures: int64Ptr(5),
+		})
+
+		_, err := app.TransactionalManagerUseCase.ExecuteInTransaction(ctx, func(txCtx context.Context) (interface{}, error) {
+			_, consumeErr := app.SigningLimitUseCase.ConsumeQuota(txCtx, signinglimit.ConsumeQuotaInput{
+				ApplicationID: applicationID,
+				From:          signer,
+			})
+			require.NoError(t, consumeErr)
+			return nil, signererrors.Timeout().WithMessage("the HSM did not respond in time")
+		})
+		require.True(t, signererrors.IsTimeout(err))
+
+		usage, err := app.SigningLimitUseCase.GetSigningLimitUsage(ctx, signinglimit.GetSigningLimitUsageInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+		})
+		require.NoError(t, err)
+		require.Empty(t, usage.Items)
+	})
+}
+
+func TestDefaultUseCase_GetSigningLimitUsage(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+	created := createSigningLimit(t, applicationID, signinglimit.CreateSigningLimitInput{
+		Scope:         signinglimit.AccountScope,
+		WindowSeconds: 3600,
+		MaxSignatures: int64Ptr(10),
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			Value:         entities.NewInt256FromInt(5),
+		})
+		require.NoError(t, err)
+	}
+
+	output, err := app.SigningLimitUseCase.GetSigningLimitUsage(ctx, signinglimit.GetSigningLimitUsageInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	require.Equal(t, signer.String(), output.Items[0].Subject)
+	require.Equal(t, int64(3), output.Items[0].Signatures)
+	require.Equal(t, int64(15), output.Items[0].Value.BigInt().Int64())
+}
+
+func TestDefaultUseCase_DeleteSigningLimit(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+	id := uuid.NewString()
+	input := signinglimit.CreateSigningLimitInput{
+		ID:            &id,
+		Scope:         signinglimit.AccountScope,
+		WindowSeconds: 3600,
+		MaxSignatures: int64Ptr(10),
+	}
+	created := createSigningLimit(t, applicationID, input)
+	_, err := app.SigningLimitUseCase.ConsumeQuota(ctx, signinglimit.ConsumeQuotaInput{
+		ApplicationID: applicationID,
+		From:          signer,
+	})
+	require.NoError(t, err)
+
+	// the application can't be removed while the signing limit depends on it
+	_, err = app.ApplicationUseCase.DeleteApplication(ctx, application.DeleteApplicationInput{
+		StandardID: entities.StandardID{
+			ID: applicationID,
+		},
+	})
+	require.True(t, signererrors.IsPreconditionFailed(err))
+
+	deleted, err := app.SigningLimitUseCase.DeleteSigningLimit(ctx, signinglimit.DeleteSigningLimitInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.ID, deleted.ID)
+
+	_, err = app.SigningLimitUseCase.GetSigningLimit(ctx, signinglimit.GetSigningLimitInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.True(t, signererrors.IsNotFound(err))
+
+	// the usage is deleted with the signing limit, so a new one with the same identifier starts without usage
+	createSigningLimit(t, applicationID, input)
+	usage, err := app.SigningLimitUseCase.GetSigningLimitUsage(ctx, signinglimit.GetSigningLimitUsageInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, usage.Items)
+}
+
+func createApplication(t *testing.T) string {
+	applicationID := uuid.NewString()
+	_, err := app.ApplicationUseCase.CreateApplication(context.Background(), application.CreateApplicationInput{
+		ID:      &applicationID,
+		ChainID: *chain,
+	})
+	require.NoError(t, err)
+	return applicationID
+}
+
+func createSigningLimit(t *testing.T, applicationID string, input signinglimit.CreateSigningLimitInput) *signinglimit.CreateSigningLimitOutput {
+	input.ApplicationID = applicationID
+	output, err := app.SigningLimitUseCase.CreateSigningLimit(context.Background(), input)
+	require.NoError(t, err)
+	return output
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
@@ -0,0 +1,155 @@
+package signinglimit
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/transactionalmanager"
+)
+
+// CreateSigningLimit implements DefaultUseCase's CreateSigningLimit to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) CreateSigningLimit(ctx context.Context, input CreateSigningLimitInput) (*CreateSigningLimitOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.createSigningLimitInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*CreateSigningLimitOutput), nil
+}
+
+// ListSigningLimits implements DefaultUseCase's ListSigningLimits to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) ListSigningLimits(ctx context.Context, input ListSigningLimitsInput) (*ListSigningLimitsOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.listSigningLimitsInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ListSigningLimitsOutput), nil
+}
+
+// GetSigningLimit implements DefaultUseCase's GetSigningLimit to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetSigningLimit(ctx context.Context, input GetSigningLimitInput) (*GetSigningLimitOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getSigningLimitInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetSigningLimitOutput), nil
+}
+
+// EditSigningLimit implements DefaultUseCase's EditSigningLimit to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) EditSigningLimit(ctx context.Context, input EditSigningLimitInput) (*EditSigningLimitOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.editSigningLimitInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*EditSigningLimitOutput), nil
+}
+
+// DeleteSigningLimit implements DefaultUseCase's DeleteSigningLimit to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) DeleteSigningLimit(ctx context.Context, input DeleteSigningLimitInput) (*DeleteSigningLimitOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.deleteSigningLimitInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*DeleteSigningLimitOutput), nil
+}
+
+// GetSigningLimitUsage implements DefaultUseCase's GetSigningLimitUsage to be a transactional operation.
+func (_d *DefaultUseCaseTransactionalDecorator) GetSigningLimitUsage(ctx context.Context, input GetSigningLimitUsageInput) (*GetSigningLimitUsageOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.getSigningLimitUsageInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*GetSigningLimitUsageOutput), nil
+}
+
+// ConsumeQuota implements DefaultUseCase's ConsumeQuota to be a transactional operation. The signature is counted in all the
+// SigningLimit resources that apply to it or in none of them.
+func (_d *DefaultUseCaseTransactionalDecorator) ConsumeQuota(ctx context.Context, input ConsumeQuotaInput) (*ConsumeQuotaOutput, error) {
+	returnValue, failure := _d.transactionalManager.ExecuteInTransaction(ctx, _d.consumeQuotaInternal(ctx, input))
+	if failure != nil {
+		return nil, failure
+	}
+
+	return returnValue.(*ConsumeQuotaOutput), nil
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) createSigningLimitInternal(_ context.Context, input CreateSigningLimitInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.CreateSigningLimit(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) listSigningLimitsInternal(_ context.Context, input ListSigningLimitsInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.ListSigningLimits(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getSigningLimitInternal(_ context.Context, input GetSigningLimitInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.GetSigningLimit(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) editSigningLimitInternal(_ context.Context, input EditSigningLimitInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.EditSigningLimit(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) deleteSigningLimitInternal(_ context.Context, input DeleteSigningLimitInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.DeleteSigningLimit(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) getSigningLimitUsageInternal(_ context.Context, input GetSigningLimitUsageInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.GetSigningLimitUsage(ctx2, input)
+	}
+}
+
+func (_d *DefaultUseCaseTransactionalDecorator) consumeQuotaInternal(_ context.Context, input ConsumeQuotaInput) func(context.Context) (interface{}, error) {
+	return func(ctx2 context.Context) (interface{}, error) {
+		return _d.DefaultUseCase.ConsumeQuota(ctx2, input)
+	}
+}
+
+var _ SigningLimitUseCase = new(DefaultUseCaseTransactionalDecorator)
+
+// DefaultUseCaseTransactionalDecorator decorates struct DefaultUseCase wrapped with a transactional manager.
+type DefaultUseCaseTransactionalDecorator struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase
+	// transactionalManager defines the functionality to execute a transaction in a transactional manner.
+	transactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// DefaultUseCaseTransactionalDecoratorOptions is the structure representing the DefaultUseCaseTransactionalDecorator dependencies.
+type DefaultUseCaseTransactionalDecoratorOptions struct {
+	// DefaultUseCase is the usecase to be decorated.
+	DefaultUseCase *DefaultUseCase
+	// TransactionalManager defines the functionality to execute a transaction in a transactional manner.
+	TransactionalManager transactionalmanager.TransactionalManagerUseCase
+}
+
+// ProvideDefaultUseCaseTransactionalDecorator creates a new DefaultUseCaseTransactionalDecorator.
+func ProvideDefaultUseCaseTransactionalDecorator(options DefaultUseCaseTransactionalDecoratorOptions) (*DefaultUseCaseTransactionalDecorator, error) {
+	if options.DefaultUseCase == nil {
+		errorMessage := "'DefaultUseCase' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	if options.TransactionalManager == nil {
+		errorMessage := "'TransactionalManager' is mandatory"
+		return nil, errors.InvalidArgument().WithMessage(errorMessage)
+	}
+	return &DefaultUseCaseTransactionalDecorator{
+		DefaultUseCase:       *options.DefaultUseCase,
+		transactionalManager: options.TransactionalManager,
+	}, nil
+}
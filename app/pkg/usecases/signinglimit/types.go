@@ -0,0 +1,263 @@
+package signinglimit
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultOrderDirection = entities.OrderDesc
+	// bucketsPerWindow amount of buckets the usage of a window is tracked in.
+	bucketsPerWindow = 60
+	// minBucketSize minimum duration of a bucket in milliseconds.
+	minBucketSize = int64(1000)
+	// maxConsumeAttempts maximum amount of attempts to update the usage of a subject that is being updated concurrently.
+	maxConsumeAttempts = 5
+)
+
+// Scope defines what the usage of a SigningLimit is counted by.
+type Scope string
+
+const (
+	// AccountScope counts the usage of each account.
+	AccountScope Scope = "account"
+	// UserScope counts the usage of each user.
+	UserScope Scope = "user"
+)
+
+// SigningLimit defines the maximum amount of signatures and value that can be signed in a rolling window of time.
+type SigningLimit struct {
+	entities.ApplicationStandardResourceMeta
+	// InternalResourceID uniquely identifies a SigningLimit by a single ID.
+	entities.InternalResourceID
+	// Scope defines whether the usage is counted by account or by user.
+	Scope Scope
+	// Subject address of the account or identifier of the user the SigningLimit applies to. It applies to every account
+	// or user of the Application individually if it is nil.
+	Subject *string
+	// WindowSeconds duration in seconds of the rolling window.
+	WindowSeconds int64
+	// MaxSignatures maximum amount of signatures in the window. Signatures are not limited if it is nil.
+	MaxSignatures *int64
+	// MaxValue maximum amount of wei transferred by the transactions signed in the window. Value is not limited if it is nil.
+	MaxValue *entities.Int256
+	// Description of the SigningLimit.
+	Description *string
+}
+
+// SigningLimitCollection defines a collection of SigningLimit resources.
+type SigningLimitCollection struct {
+	// Items SigningLimit in collection.
+	Items []SigningLimit
+	// StandardCollectionPage is the page data of the collection.
+	entities.StandardCollectionPage
+}
+
+// SigningLimitUsageID identifies the usage of a SigningLimit by a subject.
+type SigningLimitUsageID struct {
+	// LimitID identifier of the SigningLimit.
+	LimitID entities.ApplicationStandardID
+	// Subject address of the account or identifier of the user the usage is counted for.
+	Subject string
+}
+
+// SigningLimitUsage defines the usage of a SigningLimit by a subject.
+type SigningLimitUsage struct {
+	SigningLimitUsageID
+	// Buckets usage grouped by periods of time.
+	Buckets []UsageBucket
+	// ResourceVersion resource version for resource locking.
+	ResourceVersion string
+	// LastUpdate timestamp of the last update of the usage.
+	LastUpdate time.Timestamp
+}
+
+// UsageBucket defines the usage in a period of time.
+type UsageBucket struct {
+	// Start timestamp of the beginning of the period.
+	Start time.Timestamp
+	// Signatures amount of signatures in the period.
+	Signatures int64
+	// Value amount of wei transferred by the transactions signed in the period.
+	Value entities.Int256
+}
+
+// SubjectUsage defines the usage of a subject in the current window of a SigningLimit.
+type SubjectUsage struct {
+	// Subject address of the account or identifier of the user.
+	Subject string
+	// Signatures amount of signatures in the window.
+	Signatures int64
+	// Value amount of wei transferred by the transactions signed in the window.
+	Value entities.Int256
+}
+
+// CreateSigningLimitInput configures the creation of a SigningLimit.
+type CreateSigningLimitInput struct {
+	// ID defines the identifier of the SigningLimit resource.
+	ID *string `valid:"optional"`
+	// ApplicationID defines the identifier of the Application of the SigningLimit resource.
+	ApplicationID string `valid:"required"`
+	// Scope defines whether the usage is counted by account or by user.
+	Scope Scope `valid:"in(account|user)"`
+	// Subject address of the account or identifier of the user the SigningLimit applies to. It applies to every account
+	// or user of the Application individually if it is nil.
+	Subject *string `valid:"optional"`
+	// WindowSeconds duration in seconds of the rolling window.
+	WindowSeconds int64 `valid:"-"`
+	// MaxSignatures maximum amount of signatures in the window.
+	MaxSignatures *int64 `valid:"optional"`
+	// MaxValue maximum amount of wei transferred by the transactions signed in the window.
+	MaxValue *entities.Int256 `valid:"-"`
+	// Description of the SigningLimit.
+	Description *string `valid:"optional"`
+}
+
+// CreateSigningLimitOutput defines the output of the creation of a SigningLimit.
+type CreateSigningLimitOutput struct {
+	SigningLimit
+}
+
+// EditSigningLimitInput configures the update of a SigningLimit. The scope and the subject of a SigningLimit can't be edited.
+type EditSigningLimitInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+	// ResourceVersion resource version for resource locking.
+	ResourceVersion string `valid:"required"`
+	// WindowSeconds duration in seconds of the rolling window.
+	WindowSeconds int64 `valid:"-"`
+	// MaxSignatures maximum amount of signatures in the window.
+	MaxSignatures *int64 `valid:"optional"`
+	// MaxValue maximum amount of wei transferred by the transactions signed in the window.
+	MaxValue *entities.Int256 `valid:"-"`
+	// Description of the SigningLimit.
+	Description *string `valid:"optional"`
+}
+
+// EditSigningLimitOutput defines the output of editing a SigningLimit.
+type EditSigningLimitOutput struct {
+	SigningLimit
+}
+
+// GetSigningLimitInput defines the input for getting a SigningLimit.
+type GetSigningLimitInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+}
+
+// GetSigningLimitOutput defines the output of getting a SigningLimit.
+type GetSigningLimitOutput struct {
+	SigningLimit
+}
+
+// DeleteSigningLimitInput configures the deletion of a SigningLimit.
+type DeleteSigningLimitInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+}
+
+// DeleteSigningLimitOutput defines the output of deleting a SigningLimit.
+type DeleteSigningLimitOutput struct {
+	SigningLimit
+}
+
+// ListSigningLimitsInput defines all possible options to list SigningLimit resources.
+type ListSigningLimitsInput struct {
+	// ApplicationID defines the identifier of the Application of the SigningLimit resources.
+	ApplicationID string `valid:"required"`
+	// PageLimit maximum amount of SigningLimit in list output.
+	PageLimit int `valid:"natural"`
+	// PageOffset amount of SigningLimit elapsed in list output.
+	PageOffset int `valid:"natural"`
+	// OrderBy whether to order by last update date.
+	OrderBy string
+	// OrderDirection the direction of the OrderBy.
+	OrderDirection string
+}
+
+// ListSigningLimitsOutput defines the output of listing SigningLimit resources.
+type ListSigningLimitsOutput struct {
+	SigningLimitCollection
+}
+
+// GetSigningLimitUsageInput defines the input for getting the current usage of a SigningLimit.
+type GetSigningLimitUsageInput struct {
+	// ApplicationStandardID defines the identifier of the SigningLimit.
+	entities.ApplicationStandardID
+}
+
+// GetSigningLimitUsageOutput defines the current usage of a SigningLimit.
+type GetSigningLimitUsageOutput struct {
+	// SigningLimit the usage belongs to.
+	SigningLimit SigningLimit
+	// Items usage of the subjects with signatures in the current window.
+	Items []SubjectUsage
+}
+
+// ConsumeQuotaInput defines a signature to be counted against the SigningLimit resources of an Application.
+type ConsumeQuotaInput struct {
+	// ApplicationID defines the identifier of the Application the signature is made in.
+	ApplicationID string `valid:"required"`
+	// From address of the account that signs.
+	From address.Address `valid:"address"`
+	// UserID identifier of the user that requests the signature. User scoped limits are not applied if it is nil.
+	UserID *string `valid:"optional"`
+	// Value amount of wei transferred. It is nil for signatures that are not transactions.
+	Value *entities.Int256 `valid:"-"`
+}
+
+// ConsumeQuotaOutput defines the output of consuming quota. The signature is within all the SigningLimit resources if
+// no error is returned.
+type ConsumeQuotaOutput struct {
+	// LimitsConsumed amount of SigningLimit resources the signature has been counted in.
+	LimitsConsumed int
+}
+
+func createToSigningLimit(input CreateSigningLimitInput) SigningLimit {
+	now := time.Now()
+	if input.ID == nil {
+		randomID := uuid.NewString()
+		input.ID = &randomID
+	}
+	return SigningLimit{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ID:            *input.ID,
+					ApplicationID: input.ApplicationID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: now,
+					LastUpdate:   now,
+				},
+			},
+		},
+		Scope:         input.Scope,
+		Subject:       input.Subject,
+		WindowSeconds: input.WindowSeconds,
+		MaxSignatures: input.MaxSignatures,
+		MaxValue:      input.MaxValue,
+		Description:   input.Description,
+	}
+}
+
+func mapEditedValues(input EditSigningLimitInput) SigningLimit {
+	return SigningLimit{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: input.ApplicationStandardID,
+				Timestamps: entities.Timestamps{
+					LastUpdate: time.Now(),
+				},
+			},
+			ResourceVersion: input.ResourceVersion,
+		},
+		WindowSeconds: input.WindowSeconds,
+		MaxSignatures: input.MaxSignatures,
+		MaxValue:      input.MaxValue,
+		Description:   input.Description,
+	}
+}
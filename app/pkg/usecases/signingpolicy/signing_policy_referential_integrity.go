@@ -0,0 +1,54 @@
+package signingpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+)
+
+func (u *DefaultUseCase) addSigningPolicyToApplicationDependency(ctx context.Context, data SigningPolicy) error {
+	getApplicationInput := application.GetApplicationInput{
+		StandardID: entities.StandardID{
+			ID: data.ApplicationID,
+		},
+	}
+	getApplicationOutput, getApplicationErr := u.applicationUseCase.GetApplication(ctx, getApplicationInput)
+	if getApplicationErr != nil {
+		if errors.IsNotFound(getApplicationErr) {
+			msg := fmt.Sprintf("signing policy can't be created because the application '%s' does not exist", data.ApplicationID)
+			return errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+		}
+		return getApplicationErr
+	}
+
+	var referentialIntegrityCreateEntryInput referentialintegrity.CreateEntryInput
+	referentialIntegrityCreateEntryInput.ResourceID = string(data.InternalResourceID)
+	referentialIntegrityCreateEntryInput.ResourceKind = referentialintegrity.KindSigningPolicy
+	referentialIntegrityCreateEntryInput.ParentResourceID = string(getApplicationOutput.InternalResourceID)
+	referentialIntegrityCreateEntryInput.ParentResourceKind = referentialintegrity.KindApplication
+
+	_, createEntryErr := u.referentialIntegrityUseCase.CreateEntry(ctx, referentialIntegrityCreateEntryInput)
+	if createEntryErr != nil && !errors.IsAlreadyExists(createEntryErr) {
+		return createEntryErr
+	}
+	return nil
+}
+
+func (u *DefaultUseCase) removeAllSigningPolicyDependencies(ctx context.Context, applicationStandardID entities.ApplicationStandardID) error {
+	getSigningPolicyInput := GetSigningPolicyInput{
+		ApplicationStandardID: applicationStandardID,
+	}
+	getSigningPolicyOutput, getSigningPolicyErr := u.GetSigningPolicy(ctx, getSigningPolicyInput)
+	if getSigningPolicyErr != nil {
+		return getSigningPolicyErr
+	}
+
+	var deleteInput referentialintegrity.DeleteMyEntriesIfAnyInput
+	deleteInput.ResourceID = string(getSigningPolicyOutput.InternalResourceID)
+	deleteInput.ResourceKind = referentialintegrity.KindSigningPolicy
+	return u.referentialIntegrityUseCase.DeleteMyEntriesIfAny(ctx, deleteInput)
+}
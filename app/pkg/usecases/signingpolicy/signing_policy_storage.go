@@ -0,0 +1,35 @@
+package signingpolicy
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+)
+
+// SigningPolicyStorage defines the functionality to interact with the SigningPolicy in storage.
+type SigningPolicyStorage interface {
+	// Add a SigningPolicy in storage.
+	Add(ctx context.Context, data SigningPolicy) (*SigningPolicy, error)
+	// Get a SigningPolicy in storage.
+	Get(ctx context.Context, id entities.ApplicationStandardID) (*SigningPolicy, error)
+	// Edit a SigningPolicy in storage.
+	Edit(ctx context.Context, data SigningPolicy) (*SigningPolicy, error)
+	// Remove a SigningPolicy in storage.
+	Remove(ctx context.Context, id entities.ApplicationStandardID) (*SigningPolicy, error)
+	// All SigningPolicy in storage.
+	All(ctx context.Context, filters SigningPolicyFilters) (*SigningPolicyCollection, error)
+
+	// Filter by applicationID plus other optional filters.
+	Filter(applicationID string) SigningPolicyFilters
+}
+
+// SigningPolicyFilters defines filter options for retrieving SigningPolicy resources from storage.
+type SigningPolicyFilters interface {
+	// OrderByCreationDate orders SigningPolicy in storage by creation date.
+	OrderByCreationDate(direction persistence.OrderDirection) SigningPolicyFilters
+	// OrderByLastUpdateDate orders SigningPolicy in storage by last update date.
+	OrderByLastUpdateDate(direction persistence.OrderDirection) SigningPolicyFilters
+	// Paged limits the maximum amount of items to limit parameter and starts the list in offset parameter.
+	Paged(limit int, offset int) SigningPolicyFilters
+}
@@ -0,0 +1,379 @@
+package signingpolicy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/utils"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// SigningPolicyUseCase defines the management of the SigningPolicy resource.
+type SigningPolicyUseCase interface {
+	// CreateSigningPolicy creates a SigningPolicy. It returns the created SigningPolicy or an error if it fails.
+	CreateSigningPolicy(ctx context.Context, input CreateSigningPolicyInput) (*CreateSigningPolicyOutput, error)
+	// ListSigningPolicies returns the SigningPolicy resources of an Application or an error if it fails.
+	ListSigningPolicies(ctx context.Context, input ListSigningPoliciesInput) (*ListSigningPoliciesOutput, error)
+	// GetSigningPolicy returns the requested SigningPolicy or an error if it fails.
+	GetSigningPolicy(ctx context.Context, input GetSigningPolicyInput) (*GetSigningPolicyOutput, error)
+	// EditSigningPolicy edits a SigningPolicy. It returns the edited SigningPolicy or an error if it fails.
+	EditSigningPolicy(ctx context.Context, input EditSigningPolicyInput) (*EditSigningPolicyOutput, error)
+	// DeleteSigningPolicy deletes a SigningPolicy. It returns the deleted SigningPolicy or an error if it fails.
+	DeleteSigningPolicy(ctx context.Context, input DeleteSigningPolicyInput) (*DeleteSigningPolicyOutput, error)
+	// EvaluateTransaction checks that a transaction complies with the SigningPolicy resources that apply to the
+	// account that signs it. It returns a precondition failed error if the transaction violates any of them.
+	EvaluateTransaction(ctx context.Context, input EvaluateTransactionInput) (*EvaluateTransactionOutput, error)
+	// EvaluateMessage checks that the SigningPolicy resources that apply to the account that signs a message allow
+	// messages. It returns a precondition failed error if any of them doesn't.
+	EvaluateMessage(ctx context.Context, input EvaluateMessageInput) (*EvaluateMessageOutput, error)
+	// EvaluateTypedData checks that the SigningPolicy resources that apply to the account that signs typed data allow
+	// it. It returns a precondition failed error if any of them doesn't.
+	EvaluateTypedData(ctx context.Context, input EvaluateTypedDataInput) (*EvaluateTypedDataOutput, error)
+}
+
+func (u *DefaultUseCase) CreateSigningPolicy(ctx context.Context, input CreateSigningPolicyInput) (*CreateSigningPolicyOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	rules, err := normalizeRules(input.Rules)
+	if err != nil {
+		return nil, err
+	}
+	input.Rules = *rules
+
+	signingPolicy := createToSigningPolicy(input)
+	signingPolicy.InternalResourceID = entities.NewInternalResourceID()
+	addDependencyErr := u.addSigningPolicyToApplicationDependency(ctx, signingPolicy)
+	if addDependencyErr != nil {
+		return nil, addDependencyErr
+	}
+
+	addedSigningPolicy, err := u.storage.Add(ctx, signingPolicy)
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, errors.AlreadyExistsFromErr(err).SetHumanReadableMessage("signing policy [%s] already exists", signingPolicy.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &CreateSigningPolicyOutput{
+		SigningPolicy: *addedSigningPolicy,
+	}, nil
+}
+
+func (u *DefaultUseCase) ListSigningPolicies(ctx context.Context, input ListSigningPoliciesInput) (*ListSigningPoliciesOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	filters := u.storage.Filter(input.ApplicationID)
+	direction := utils.DefaultString(input.OrderDirection, defaultOrderDirection)
+	filters.OrderByCreationDate(persistence.OrderDirection(direction))
+	if input.OrderBy == entities.OrderByLastUpdate {
+		filters.OrderByLastUpdateDate(persistence.OrderDirection(direction))
+	}
+
+	if input.PageLimit > 0 {
+		filters.Paged(input.PageLimit, input.PageOffset)
+	}
+
+	collection, err := u.storage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &ListSigningPoliciesOutput{
+		SigningPolicyCollection: *collection,
+	}, nil
+}
+
+func (u *DefaultUseCase) GetSigningPolicy(ctx context.Context, input GetSigningPolicyInput) (*GetSigningPolicyOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	signingPolicy, err := u.storage.Get(ctx, input.ApplicationStandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing policy [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &GetSigningPolicyOutput{
+		SigningPolicy: *signingPolicy,
+	}, nil
+}
+
+func (u *DefaultUseCase) EditSigningPolicy(ctx context.Context, input EditSigningPolicyInput) (*EditSigningPolicyOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+	rules, err := normalizeRules(input.Rules)
+	if err != nil {
+		return nil, err
+	}
+	input.Rules = *rules
+
+	signingPolicy := mapEditedValues(input)
+	editedSigningPolicy, err := u.storage.Edit(ctx, signingPolicy)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing policy [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &EditSigningPolicyOutput{
+		SigningPolicy: *editedSigningPolicy,
+	}, nil
+}
+
+func (u *DefaultUseCase) DeleteSigningPolicy(ctx context.Context, input DeleteSigningPolicyInput) (*DeleteSigningPolicyOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	removeDependenciesErr := u.removeAllSigningPolicyDependencies(ctx, input.ApplicationStandardID)
+	if removeDependenciesErr != nil {
+		return nil, removeDependenciesErr
+	}
+
+	signingPolicy, err := u.storage.Remove(ctx, input.ApplicationStandardID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundFromErr(err).SetHumanReadableMessage("signing policy [%s] not found", input.ID)
+		}
+		return nil, errors.InternalFromErr(err)
+	}
+
+	return &DeleteSigningPolicyOutput{
+		SigningPolicy: *signingPolicy,
+	}, nil
+}
+
+func (u *DefaultUseCase) EvaluateTransaction(ctx context.Context, input EvaluateTransactionInput) (*EvaluateTransactionOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	policiesEvaluated, err := u.evaluatePolicies(ctx, input.ApplicationID, input.From, "transaction", func(rules Rules) *string {
+		return evaluateRules(rules, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EvaluateTransactionOutput{
+		PoliciesEvaluated: *policiesEvaluated,
+	}, nil
+}
+
+func (u *DefaultUseCase) EvaluateMessage(ctx context.Context, input EvaluateMessageInput) (*EvaluateMessageOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	policiesEvaluated, err := u.evaluatePolicies(ctx, input.ApplicationID, input.From, "message", func(rules Rules) *string {
+		if rules.AllowMessages {
+			return nil
+		}
+		violation := "signing messages is not allowed"
+		return &violation
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EvaluateMessageOutput{
+		PoliciesEvaluated: *policiesEvaluated,
+	}, nil
+}
+
+func (u *DefaultUseCase) EvaluateTypedData(ctx context.Context, input EvaluateTypedDataInput) (*EvaluateTypedDataOutput, error) {
+	_, err := govalidator.ValidateStruct(input)
+	if err != nil {
+		return nil, errors.InvalidArgumentFromErr(err).SetHumanReadableMessage("couldn't validate input data")
+	}
+
+	policiesEvaluated, err := u.evaluatePolicies(ctx, input.ApplicationID, input.From, "typed data", func(rules Rules) *string {
+		return evaluateTypedDataRules(rules, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EvaluateTypedDataOutput{
+		PoliciesEvaluated: *policiesEvaluated,
+	}, nil
+}
+
+// evaluatePolicies evaluates the SigningPolicy resources of the application that apply to the account with the given
+// function, which returns the description of the rule that is violated. It returns the amount of SigningPolicy
+// resources evaluated or a precondition failed error naming the signature kind if any of them is violated.
+func (u *DefaultUseCase) evaluatePolicies(ctx context.Context, applicationID string, from address.Address, kind string, evaluate func(rules Rules) *string) (*int, error) {
+	filters := u.storage.Filter(applicationID)
+	filters.OrderByCreationDate(persistence.OrderDirection(entities.OrderAsc))
+	collection, err := u.storage.All(ctx, filters)
+	if err != nil {
+		return nil, errors.InternalFromErr(err)
+	}
+
+	policiesEvaluated := 0
+	for _, signingPolicy := range collection.Items {
+		if signingPolicy.Address != nil && *signingPolicy.Address != from {
+			continue
+		}
+		violation := evaluate(signingPolicy.Rules)
+		if violation != nil {
+			msg := fmt.Sprintf("%s rejected by signing policy [%s]: %s", kind, signingPolicy.ID, *violation)
+			return nil, errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+		}
+		policiesEvaluated++
+	}
+
+	return &policiesEvaluated, nil
+}
+
+// evaluateRules returns the description of the first rule that the transaction violates or nil if it complies with all of them.
+func evaluateRules(rules Rules, input EvaluateTransactionInput) *string {
+	var violation string
+	switch {
+	case input.To == nil && rules.DenyContractCreation:
+		violation = "contract creation is not allowed"
+	case input.To != nil && !isAllowedAddress(rules.AllowedRecipients, *input.To):
+		violation = fmt.Sprintf("recipient [%s] is not allowed", input.To.String())
+	case rules.MaxValue != nil && input.Value != nil && input.Value.BigInt().Cmp(rules.MaxValue.BigInt()) > 0:
+		violation = fmt.Sprintf("value [%s] exceeds the maximum of [%s]", input.Value.BigInt(), rules.MaxValue.BigInt())
+	case rules.MaxGas != nil && input.Gas > *rules.MaxGas:
+		violation = fmt.Sprintf("gas [%d] exceeds the maximum of [%d]", input.Gas, *rules.MaxGas)
+	case rules.MaxGasPrice != nil && input.GasPrice != nil && input.GasPrice.BigInt().Cmp(rules.MaxGasPrice.BigInt()) > 0:
+		violation = fmt.Sprintf("gas price [%s] exceeds the maximum of [%s]", input.GasPrice.BigInt(), rules.MaxGasPrice.BigInt())
+	case rules.MaxGasPrice != nil && input.MaxFeePerGas != nil && input.MaxFeePerGas.BigInt().Cmp(rules.MaxGasPrice.BigInt()) > 0:
+		violation = fmt.Sprintf("max fee per gas [%s] exceeds the maximum of [%s]", input.MaxFeePerGas.BigInt(), rules.MaxGasPrice.BigInt())
+	case input.To != nil && len(input.Data) > 0 && !isAllowedMethod(rules.AllowedMethodSelectors, input.Data):
+		violation = "the function called is not allowed"
+	default:
+		return nil
+	}
+	return &violation
+}
+
+// evaluateTypedDataRules returns the description of the first rule that the typed data violates or nil if it complies
+// with all of them.
+func evaluateTypedDataRules(rules Rules, input EvaluateTypedDataInput) *string {
+	var violation string
+	switch {
+	case !rules.AllowTypedData:
+		violation = "signing typed data is not allowed"
+	case len(rules.AllowedVerifyingContracts) > 0 && input.VerifyingContract == nil:
+		violation = "typed data without verifying contract is not allowed"
+	case len(rules.AllowedVerifyingContracts) > 0 && !isAllowedAddress(rules.AllowedVerifyingContracts, *input.VerifyingContract):
+		violation = fmt.Sprintf("verifying contract [%s] is not allowed", input.VerifyingContract.String())
+	default:
+		return nil
+	}
+	return &violation
+}
+
+// isAllowedAddress returns true if there are no allowed addresses or the address is one of them.
+func isAllowedAddress(allowedAddresses []address.Address, addr address.Address) bool {
+	if len(allowedAddresses) == 0 {
+		return true
+	}
+	for _, allowedAddress := range allowedAddresses {
+		if allowedAddress == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllowedMethod returns true if there are no allowed method selectors or the data calls one of them.
+func isAllowedMethod(allowedMethodSelectors []MethodSelector, data []byte) bool {
+	if len(allowedMethodSelectors) == 0 {
+		return true
+	}
+	if len(data) < methodSelectorLength {
+		return false
+	}
+	selector := MethodSelector("0x" + hex.EncodeToString(data[:methodSelectorLength]))
+	for _, allowedMethodSelector := range allowedMethodSelectors {
+		if allowedMethodSelector == selector {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeRules validates the Rules and returns them with the method selectors in lower case.
+func normalizeRules(rules Rules) (*Rules, error) {
+	if rules.MaxValue != nil && rules.MaxValue.BigInt().Sign() < 0 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'maxValue' cannot be negative")
+	}
+	if rules.MaxGasPrice != nil && rules.MaxGasPrice.BigInt().Sign() < 0 {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'maxGasPrice' cannot be negative")
+	}
+	if len(rules.AllowedVerifyingContracts) > 0 && !rules.AllowTypedData {
+		return nil, errors.InvalidArgument().SetHumanReadableMessage("field 'allowedVerifyingContracts' requires 'allowTypedData' to be true")
+	}
+	selectors := make([]MethodSelector, len(rules.AllowedMethodSelectors))
+	for i, selector := range rules.AllowedMethodSelectors {
+		normalized := strings.ToLower(string(selector))
+		selectorBytes, err := hex.DecodeString(strings.TrimPrefix(normalized, "0x"))
+		if !strings.HasPrefix(normalized, "0x") || err != nil || len(selectorBytes) != methodSelectorLength {
+			return nil, errors.InvalidArgument().SetHumanReadableMessage("method selector [%s] must be 4 bytes hex encoded with the 0x prefix", selector)
+		}
+		selectors[i] = MethodSelector(normalized)
+	}
+	rules.AllowedMethodSelectors = selectors
+	return &rules, nil
+}
+
+var _ SigningPolicyUseCase = new(DefaultUseCase)
+
+// DefaultUseCase default management of SigningPolicy resources.
+type DefaultUseCase struct {
+	storage                     SigningPolicyStorage
+	applicationUseCase          application.ApplicationUseCase
+	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+}
+
+// DefaultUseCaseOptions configures a DefaultUseCase.
+type DefaultUseCaseOptions struct {
+	Storage                     SigningPolicyStorage
+	ApplicationUseCase          application.ApplicationUseCase
+	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
+}
+
+// ProvideDefaultUseCase provides a DefaultUseCase with the given options.
+func ProvideDefaultUseCase(options DefaultUseCaseOptions) (*DefaultUseCase, error) {
+	if options.Storage == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'Storage' not provided")
+	}
+	if options.ApplicationUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ApplicationUseCase' not provided")
+	}
+	if options.ReferentialIntegrityUseCase == nil {
+		return nil, errors.Internal().WithMessage("mandatory 'ReferentialIntegrityUseCase' not provided")
+	}
+
+	return &DefaultUseCase{
+		storage:                     options.Storage,
+		applicationUseCase:          options.ApplicationUseCase,
+		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+	}, nil
+}
@@ -0,0 +1,510 @@
+package signingpolicy_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hyperledger-labs/signare/app/pkg/adapters/storage/postgres/signingpolicydbout"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/validators"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	signererrors "github.com/hyperledger-labs/signare/app/pkg/internal/errors"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/application"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/referentialintegrity"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/signingpolicy"
+	"github.com/hyperledger-labs/signare/app/test/dbtesthelper"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var app graph.GraphShared
+
+var (
+	chain     = entities.NewInt256FromInt(44844)
+	signer    = address.MustNewFromHexString("0x970e8128ab834e8eac17ab8e3812f010678cf791")
+	otherUser = address.MustNewFromHexString("0xc0ffee254729296a45a3885639ac7e10f9d54979")
+	recipient = address.MustNewFromHexString("0xa4f666f1860d2acbe49b342c87867754a21de850")
+)
+
+func TestMain(m *testing.M) {
+	a, err := dbtesthelper.InitializeApp()
+	if err != nil {
+		panic(err)
+	}
+	app = *a
+	validators.SetValidators()
+	os.Exit(m.Run())
+}
+
+func TestProvideDefaultUseCase(t *testing.T) {
+	t.Run("nil storage", func(t *testing.T) {
+		useCase, err := signingpolicy.ProvideDefaultUseCase(signingpolicy.DefaultUseCaseOptions{
+			ApplicationUseCase:          &application.DefaultUseCase{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil application use case", func(t *testing.T) {
+		useCase, err := signingpolicy.ProvideDefaultUseCase(signingpolicy.DefaultUseCaseOptions{
+			Storage:                     &signingpolicydbout.Repository{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("nil referential integrity use case", func(t *testing.T) {
+		useCase, err := signingpolicy.ProvideDefaultUseCase(signingpolicy.DefaultUseCaseOptions{
+			Storage:            &signingpolicydbout.Repository{},
+			ApplicationUseCase: &application.DefaultUseCase{},
+		})
+		require.Error(t, err)
+		require.Nil(t, useCase)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		useCase, err := signingpolicy.ProvideDefaultUseCase(signingpolicy.DefaultUseCaseOptions{
+			Storage:                     &signingpolicydbout.Repository{},
+			ApplicationUseCase:          &application.DefaultUseCase{},
+			ReferentialIntegrityUseCase: &referentialintegrity.DefaultUseCase{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, useCase)
+	})
+}
+
+func TestDefaultUseCase_CreateSigningPolicy(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	t.Run("success", func(t *testing.T) {
+		id := uuid.NewString()
+		output, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+			ID:            &id,
+			ApplicationID: applicationID,
+			Address:       &signer,
+			Rules: signingpolicy.Rules{
+				AllowedMethodSelectors: []signingpolicy.MethodSelector{"0xA9059CBB"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, id, output.ID)
+		require.NotEmpty(t, output.InternalResourceID)
+		require.NotEmpty(t, output.ResourceVersion)
+		require.Equal(t, []signingpolicy.MethodSelector{"0xa9059cbb"}, output.Rules.AllowedMethodSelectors)
+
+		stored, err := app.SigningPolicyUseCase.GetSigningPolicy(ctx, signingpolicy.GetSigningPolicyInput{
+			ApplicationStandardID: output.ApplicationStandardID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, output.SigningPolicy, stored.SigningPolicy)
+	})
+
+	t.Run("failure: application does not exist", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+			ApplicationID: uuid.NewString(),
+		})
+		require.True(t, signererrors.IsPreconditionFailed(err))
+		require.Nil(t, output)
+	})
+
+	t.Run("failure: invalid rules", func(t *testing.T) {
+		invalidRules := []signingpolicy.Rules{
+			{MaxValue: entities.NewInt256FromInt(-1)},
+			{MaxGasPrice: entities.NewInt256FromInt(-1)},
+			{AllowedMethodSelectors: []signingpolicy.MethodSelector{"a9059cbb"}},
+			{AllowedMethodSelectors: []signingpolicy.MethodSelector{"0xa9059c"}},
+			{AllowedMethodSelectors: []signingpolicy.MethodSelector{"0xzz059cbb"}},
+			{AllowedVerifyingContracts: []address.Address{recipient}},
+		}
+		for _, rules := range invalidRules {
+			output, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+				ApplicationID: applicationID,
+				Rules:         rules,
+			})
+			require.True(t, signererrors.IsInvalidArgument(err))
+			require.Nil(t, output)
+		}
+	})
+
+	t.Run("failure: signing policy already exists", func(t *testing.T) {
+		id := uuid.NewString()
+		input := signingpolicy.CreateSigningPolicyInput{
+			ID:            &id,
+			ApplicationID: applicationID,
+		}
+		_, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, input)
+		require.NoError(t, err)
+		output, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, input)
+		require.True(t, signererrors.IsAlreadyExists(err))
+		require.Nil(t, output)
+	})
+}
+
+func TestDefaultUseCase_EditSigningPolicy(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	created, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+		ApplicationID: applicationID,
+		Rules: signingpolicy.Rules{
+			MaxValue: entities.NewInt256FromInt(1000),
+		},
+	})
+	require.NoError(t, err)
+
+	edited, err := app.SigningPolicyUseCase.EditSigningPolicy(ctx, signingpolicy.EditSigningPolicyInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+		ResourceVersion:       created.ResourceVersion,
+		Address:               &signer,
+		Rules: signingpolicy.Rules{
+			MaxValue:             entities.NewInt256FromInt(500),
+			DenyContractCreation: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.ID, edited.ID)
+	require.Equal(t, created.InternalResourceID, edited.InternalResourceID)
+	require.NotEqual(t, created.ResourceVersion, edited.ResourceVersion)
+	require.Equal(t, signer, *edited.Address)
+	require.Equal(t, entities.NewInt256FromInt(500), edited.Rules.MaxValue)
+	require.True(t, edited.Rules.DenyContractCreation)
+
+	t.Run("failure: outdated resource version", func(t *testing.T) {
+		output, editErr := app.SigningPolicyUseCase.EditSigningPolicy(ctx, signingpolicy.EditSigningPolicyInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+			ResourceVersion:       created.ResourceVersion,
+		})
+		require.True(t, signererrors.IsNotFound(editErr))
+		require.Nil(t, output)
+
+		stored, getErr := app.SigningPolicyUseCase.GetSigningPolicy(ctx, signingpolicy.GetSigningPolicyInput{
+			ApplicationStandardID: created.ApplicationStandardID,
+		})
+		require.NoError(t, getErr)
+		require.Equal(t, edited.ResourceVersion, stored.ResourceVersion)
+	})
+
+	t.Run("failure: signing policy does not exist", func(t *testing.T) {
+		output, editErr := app.SigningPolicyUseCase.EditSigningPolicy(ctx, signingpolicy.EditSigningPolicyInput{
+			ApplicationStandardID: entities.ApplicationStandardID{
+				ID:            uuid.NewString(),
+				ApplicationID: applicationID,
+			},
+			ResourceVersion: edited.ResourceVersion,
+		})
+		require.True(t, signererrors.IsNotFound(editErr))
+		require.Nil(t, output)
+	})
+}
+
+func TestDefaultUseCase_DeleteSigningPolicy(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	created, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, signingpolicy.CreateSigningPolicyInput{
+		ApplicationID: applicationID,
+	})
+	require.NoError(t, err)
+
+	// the application can't be removed while the signing policy depends on it
+	_, err = app.ApplicationUseCase.DeleteApplication(ctx, application.DeleteApplicationInput{
+		StandardID: entities.StandardID{
+			ID: applicationID,
+		},
+	})
+	require.True(t, signererrors.IsPreconditionFailed(err))
+
+	deleted, err := app.SigningPolicyUseCase.DeleteSigningPolicy(ctx, signingpolicy.DeleteSigningPolicyInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.ID, deleted.ID)
+
+	_, err = app.SigningPolicyUseCase.GetSigningPolicy(ctx, signingpolicy.GetSigningPolicyInput{
+		ApplicationStandardID: created.ApplicationStandardID,
+	})
+	require.True(t, signererrors.IsNotFound(err))
+
+	_, err = app.ApplicationUseCase.DeleteApplication(ctx, application.DeleteApplicationInput{
+		StandardID: entities.StandardID{
+			ID: applicationID,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestDefaultUseCase_EvaluateTransaction(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	maxGas := entities.NewUInt64(100000)
+	policies := []signingpolicy.CreateSigningPolicyInput{
+		{
+			ApplicationID: applicationID,
+			Rules: signingpolicy.Rules{
+				MaxValue:             entities.NewInt256FromInt(1000),
+				MaxGas:               &maxGas,
+				MaxGasPrice:          entities.NewInt256FromInt(50),
+				DenyContractCreation: true,
+			},
+		},
+		{
+			ApplicationID: applicationID,
+			Address:       &signer,
+			Rules: signingpolicy.Rules{
+				AllowedRecipients:      []address.Address{recipient},
+				AllowedMethodSelectors: []signingpolicy.MethodSelector{"0xa9059cbb"},
+			},
+		},
+	}
+	for _, policy := range policies {
+		_, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, policy)
+		require.NoError(t, err)
+	}
+
+	t.Run("success: transaction complies with all the policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTransaction(ctx, signingpolicy.EvaluateTransactionInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			To:            &recipient,
+			Value:         entities.NewInt256FromInt(1000),
+			Gas:           entities.NewUInt64(90000),
+			GasPrice:      entities.NewInt256FromInt(20),
+			Data:          []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, output.PoliciesEvaluated)
+	})
+
+	t.Run("success: policies of other accounts are not evaluated", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTransaction(ctx, signingpolicy.EvaluateTransactionInput{
+			ApplicationID: applicationID,
+			From:          otherUser,
+			To:            &otherUser,
+			Gas:           entities.NewUInt64(90000),
+			Data:          []byte{0x01, 0x02, 0x03, 0x04},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, output.PoliciesEvaluated)
+	})
+
+	t.Run("success: application without policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTransaction(ctx, signingpolicy.EvaluateTransactionInput{
+			ApplicationID: createApplication(t),
+			From:          signer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, output.PoliciesEvaluated)
+	})
+
+	t.Run("failure: transaction violates a policy", func(t *testing.T) {
+		valid := signingpolicy.EvaluateTransactionInput{
+			ApplicationID: applicationID,
+			From:          signer,
+			To:            &recipient,
+			Gas:           entities.NewUInt64(90000),
+		}
+		violations := map[string]func(input *signingpolicy.EvaluateTransactionInput){
+			"contract creation": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.To = nil
+			},
+			"recipient not allowed": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.To = &otherUser
+			},
+			"value exceeds maximum": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.Value = entities.NewInt256FromInt(1001)
+			},
+			"gas exceeds maximum": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.Gas = entities.NewUInt64(100001)
+			},
+			"gas price exceeds maximum": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.GasPrice = entities.NewInt256FromInt(51)
+			},
+			"max fee per gas exceeds maximum": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.MaxFeePerGas = entities.NewInt256FromInt(51)
+			},
+			"method not allowed": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.Data = []byte{0x23, 0xb8, 0x72, 0xdd}
+			},
+			"data shorter than a method selector": func(input *signingpolicy.EvaluateTransactionInput) {
+				input.Data = []byte{0xa9, 0x05}
+			},
+		}
+		for name, violation := range violations {
+			input := valid
+			violation(&input)
+			output, err := app.SigningPolicyUseCase.EvaluateTransaction(ctx, input)
+			require.True(t, signererrors.IsPreconditionFailed(err), name)
+			require.Nil(t, output, name)
+		}
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTransaction(ctx, signingpolicy.EvaluateTransactionInput{
+			From: signer,
+		})
+		require.True(t, signererrors.IsInvalidArgument(err))
+		require.Nil(t, output)
+	})
+}
+
+func TestDefaultUseCase_EvaluateMessage(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+
+	policies := []signingpolicy.CreateSigningPolicyInput{
+		{
+			ApplicationID: applicationID,
+			Rules: signingpolicy.Rules{
+				AllowMessages: true,
+			},
+		},
+		{
+			ApplicationID: applicationID,
+			Address:       &otherUser,
+			Rules: signingpolicy.Rules{
+				MaxValue: entities.NewInt256FromInt(1000),
+			},
+		},
+	}
+	for _, policy := range policies {
+		_, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, policy)
+		require.NoError(t, err)
+	}
+
+	t.Run("success: message allowed by all the policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateMessage(ctx, signingpolicy.EvaluateMessageInput{
+			ApplicationID: applicationID,
+			From:          signer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, output.PoliciesEvaluated)
+	})
+
+	t.Run("success: application without policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateMessage(ctx, signingpolicy.EvaluateMessageInput{
+			ApplicationID: createApplication(t),
+			From:          signer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, output.PoliciesEvaluated)
+	})
+
+	t.Run("failure: message not allowed by a policy of the account", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateMessage(ctx, signingpolicy.EvaluateMessageInput{
+			ApplicationID: applicationID,
+			From:          otherUser,
+		})
+		require.True(t, signererrors.IsPreconditionFailed(err))
+		require.Nil(t, output)
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateMessage(ctx, signingpolicy.EvaluateMessageInput{
+			From: signer,
+		})
+		require.True(t, signererrors.IsInvalidArgument(err))
+		require.Nil(t, output)
+	})
+}
+
+func TestDefaultUseCase_EvaluateTypedData(t *testing.T) {
+	ctx := context.Background()
+	applicationID := createApplication(t)
+	verifyingContract := address.MustNewFromHexString("0xcccccccccccccccccccccccccccccccccccccccc")
+
+	policies := []signingpolicy.CreateSigningPolicyInput{
+		{
+			ApplicationID: applicationID,
+			Rules: signingpolicy.Rules{
+				AllowTypedData: true,
+			},
+		},
+		{
+			ApplicationID: applicationID,
+			Address:       &signer,
+			Rules: signingpolicy.Rules{
+				AllowTypedData:            true,
+				AllowedVerifyingContracts: []address.Address{verifyingContract},
+			},
+		},
+		{
+			ApplicationID: applicationID,
+			Address:       &otherUser,
+			Rules: signingpolicy.Rules{
+				AllowMessages: true,
+			},
+		},
+	}
+	for _, policy := range policies {
+		_, err := app.SigningPolicyUseCase.CreateSigningPolicy(ctx, policy)
+		require.NoError(t, err)
+	}
+
+	t.Run("success: typed data allowed by all the policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTypedData(ctx, signingpolicy.EvaluateTypedDataInput{
+			ApplicationID:     applicationID,
+			From:              signer,
+			VerifyingContract: &verifyingContract,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, output.PoliciesEvaluated)
+	})
+
+	t.Run("success: application without policies", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTypedData(ctx, signingpolicy.EvaluateTypedDataInput{
+			ApplicationID: createApplication(t),
+			From:          signer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, output.PoliciesEvaluated)
+	})
+
+	t.Run("failure: typed data violates a policy", func(t *testing.T) {
+		violations := map[string]signingpolicy.EvaluateTypedDataInput{
+			"typed data not allowed": {
+				ApplicationID:     applicationID,
+				From:              otherUser,
+				VerifyingContract: &verifyingContract,
+			},
+			"verifying contract not allowed": {
+				ApplicationID:     applicationID,
+				From:              signer,
+				VerifyingContract: &recipient,
+			},
+			"verifying contract missing": {
+				ApplicationID: applicationID,
+				From:          signer,
+			},
+		}
+		for name, input := range violations {
+			output, err := app.SigningPolicyUseCase.EvaluateTypedData(ctx, input)
+			require.True(t, signererrors.IsPreconditionFailed(err), name)
+			require.Nil(t, output, name)
+		}
+	})
+
+	t.Run("failure: invalid input", func(t *testing.T) {
+		output, err := app.SigningPolicyUseCase.EvaluateTypedData(ctx, signingpolicy.EvaluateTypedDataInput{
+			From: signer,
+		})
+		require.True(t, signererrors.IsInvalidArgument(err))
+		require.Nil(t, output)
+	})
+}
+
+func createApplication(t *testing.T) string {
+	applicationID := uuid.NewString()
+	_, err := app.ApplicationUseCase.CreateApplication(context.Background(), application.CreateApplicationInput{
+		ID:      &applicationID,
+		ChainID: *chain,
+	})
+	require.NoError(t, err)
+	return applicationID
+}
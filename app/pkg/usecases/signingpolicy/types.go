@@ -0,0 +1,243 @@
+package signingpolicy
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/commons/time"
+	"github.com/hyperledger-labs/signare/app/pkg/entities"
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultOrderDirection = entities.OrderDesc
+	// methodSelectorLength length in bytes of the function selectors of the contract calls.
+	methodSelectorLength = 4
+)
+
+// MethodSelector first 4 bytes of the Keccak-256 hash of the signature of a contract function, hex encoded with the 0x prefix.
+type MethodSelector string
+
+// SigningPolicy defines the restrictions of the transactions signed with the accounts of an Application.
+type SigningPolicy struct {
+	entities.ApplicationStandardResourceMeta
+	// InternalResourceID uniquely identifies a SigningPolicy by a single ID.
+	entities.InternalResourceID
+	// Address of the account the SigningPolicy applies to. It applies to all the accounts of the Application if it is nil.
+	Address *address.Address
+	// Rules the transactions must comply with.
+	Rules Rules
+	// Description of the SigningPolicy.
+	Description *string
+}
+
+// Rules defines the restrictions of a SigningPolicy. Restrictions that are not set don't limit the transactions. Messages
+// and typed data can only be signed if they are explicitly allowed.
+type Rules struct {
+	// AllowedRecipients addresses that the transactions can be sent to. Any recipient is allowed if it is empty.
+	AllowedRecipients []address.Address
+	// MaxValue maximum amount of wei transferred by a transaction.
+	MaxValue *entities.Int256
+	// MaxGas maximum gas of a transaction.
+	MaxGas *entities.UInt64
+	// MaxGasPrice maximum price per gas of a transaction. It limits the gas price of legacy and access list
+	// transactions and the max fee per gas of dynamic fee transactions.
+	MaxGasPrice *entities.Int256
+	// AllowedMethodSelectors function selectors that the transactions with data can call. Any function is allowed if it is empty.
+	AllowedMethodSelectors []MethodSelector
+	// DenyContractCreation whether the transactions without recipient are rejected.
+	DenyContractCreation bool
+	// AllowMessages whether messages can be signed.
+	AllowMessages bool
+	// AllowTypedData whether typed data can be signed.
+	AllowTypedData bool
+	// AllowedVerifyingContracts verifying contracts of the domain of the typed data that can be signed. Typed data of any
+	// verifying contract, or without it, is allowed if it is empty.
+	AllowedVerifyingContracts []address.Address
+}
+
+// SigningPolicyCollection defines a collection of SigningPolicy resources.
+type SigningPolicyCollection struct {
+	// Items SigningPolicy in collection.
+	Items []SigningPolicy
+	// StandardCollectionPage is the page data of the collection.
+	entities.StandardCollectionPage
+}
+
+// CreateSigningPolicyInput configures the creation of a SigningPolicy.
+type CreateSigningPolicyInput struct {
+	// ID defines the identifier of the SigningPolicy resource.
+	ID *string `valid:"optional"`
+	// ApplicationID defines the identifier of the Application of the SigningPolicy resource.
+	ApplicationID string `valid:"required"`
+	// Address of the account the SigningPolicy applies to. It applies to all the accounts of the Application if it is nil.
+	Address *address.Address `valid:"optional"`
+	// Rules the transactions must comply with.
+	Rules Rules `valid:"-"`
+	// Description of the SigningPolicy.
+	Description *string `valid:"optional"`
+}
+
+// CreateSigningPolicyOutput defines the output of the creation of a SigningPolicy.
+type CreateSigningPolicyOutput struct {
+	SigningPolicy
+}
+
+// EditSigningPolicyInput configures the update of a SigningPolicy.
+type EditSigningPolicyInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+	// ResourceVersion resource version for resource locking.
+	ResourceVersion string `valid:"required"`
+	// Address of the account the SigningPolicy applies to. It applies to all the accounts of the Application if it is nil.
+	Address *address.Address `valid:"optional"`
+	// Rules the transactions must comply with.
+	Rules Rules `valid:"-"`
+	// Description of the SigningPolicy.
+	Description *string `valid:"optional"`
+}
+
+// EditSigningPolicyOutput defines the output of editing a SigningPolicy.
+type EditSigningPolicyOutput struct {
+	SigningPolicy
+}
+
+// GetSigningPolicyInput defines the input for getting a SigningPolicy.
+type GetSigningPolicyInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+}
+
+// GetSigningPolicyOutput defines the output of getting a SigningPolicy.
+type GetSigningPolicyOutput struct {
+	SigningPolicy
+}
+
+// DeleteSigningPolicyInput configures the deletion of a SigningPolicy.
+type DeleteSigningPolicyInput struct {
+	// ApplicationStandardID defines the identifier of the resource.
+	entities.ApplicationStandardID
+}
+
+// DeleteSigningPolicyOutput defines the output of deleting a SigningPolicy.
+type DeleteSigningPolicyOutput struct {
+	SigningPolicy
+}
+
+// ListSigningPoliciesInput defines all possible options to list SigningPolicy resources.
+type ListSigningPoliciesInput struct {
+	// ApplicationID defines the identifier of the Application of the SigningPolicy resources.
+	ApplicationID string `valid:"required"`
+	// PageLimit maximum amount of SigningPolicy in list output.
+	PageLimit int `valid:"natural"`
+	// PageOffset amount of SigningPolicy elapsed in list output.
+	PageOffset int `valid:"natural"`
+	// OrderBy whether to order by last update date.
+	OrderBy string
+	// OrderDirection the direction of the OrderBy.
+	OrderDirection string
+}
+
+// ListSigningPoliciesOutput defines the output of listing SigningPolicy resources.
+type ListSigningPoliciesOutput struct {
+	SigningPolicyCollection
+}
+
+// EvaluateTransactionInput defines the transaction to be evaluated against the SigningPolicy resources of an Application.
+type EvaluateTransactionInput struct {
+	// ApplicationID defines the identifier of the Application the transaction is signed in.
+	ApplicationID string `valid:"required"`
+	// From address of the account that signs the transaction.
+	From address.Address `valid:"address"`
+	// To address of the recipient. It is nil for contract creations.
+	To *address.Address `valid:"optional"`
+	// Value amount of wei transferred.
+	Value *entities.Int256 `valid:"optional"`
+	// Gas of the transaction.
+	Gas entities.UInt64
+	// GasPrice of legacy and access list transactions.
+	GasPrice *entities.Int256 `valid:"optional"`
+	// MaxFeePerGas of dynamic fee transactions.
+	MaxFeePerGas *entities.Int256 `valid:"optional"`
+	// Data of the transaction.
+	Data []byte `valid:"-"`
+}
+
+// EvaluateTransactionOutput defines the output of evaluating a transaction. The transaction complies with all the
+// SigningPolicy resources if no error is returned.
+type EvaluateTransactionOutput struct {
+	// PoliciesEvaluated amount of SigningPolicy resources the transaction complies with.
+	PoliciesEvaluated int
+}
+
+// EvaluateMessageInput defines the message to be evaluated against the SigningPolicy resources of an Application.
+type EvaluateMessageInput struct {
+	// ApplicationID defines the identifier of the Application the message is signed in.
+	ApplicationID string `valid:"required"`
+	// From address of the account that signs the message.
+	From address.Address `valid:"address"`
+}
+
+// EvaluateMessageOutput defines the output of evaluating a message. The message can be signed if no error is returned.
+type EvaluateMessageOutput struct {
+	// PoliciesEvaluated amount of SigningPolicy resources that allow the message.
+	PoliciesEvaluated int
+}
+
+// EvaluateTypedDataInput defines the typed data to be evaluated against the SigningPolicy resources of an Application.
+type EvaluateTypedDataInput struct {
+	// ApplicationID defines the identifier of the Application the typed data is signed in.
+	ApplicationID string `valid:"required"`
+	// From address of the account that signs the typed data.
+	From address.Address `valid:"address"`
+	// VerifyingContract of the domain of the typed data. It is nil if the domain doesn't define it.
+	VerifyingContract *address.Address `valid:"optional"`
+}
+
+// EvaluateTypedDataOutput defines the output of evaluating typed data. The typed data can be signed if no error is
+// returned.
+type EvaluateTypedDataOutput struct {
+	// PoliciesEvaluated amount of SigningPolicy resources that allow the typed data.
+	PoliciesEvaluated int
+}
+
+func createToSigningPolicy(input CreateSigningPolicyInput) SigningPolicy {
+	now := time.Now()
+	if input.ID == nil {
+		randomID := uuid.NewString()
+		input.ID = &randomID
+	}
+	return SigningPolicy{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: entities.ApplicationStandardID{
+					ID:            *input.ID,
+					ApplicationID: input.ApplicationID,
+				},
+				Timestamps: entities.Timestamps{
+					CreationDate: now,
+					LastUpdate:   now,
+				},
+			},
+		},
+		Address:     input.Address,
+		Rules:       input.Rules,
+		Description: input.Description,
+	}
+}
+
+func mapEditedValues(input EditSigningPolicyInput) SigningPolicy {
+	return SigningPolicy{
+		ApplicationStandardResourceMeta: entities.ApplicationStandardResourceMeta{
+			ApplicationStandardResource: entities.ApplicationStandardResource{
+				ApplicationStandardID: input.ApplicationStandardID,
+				Timestamps: entities.Timestamps{
+					LastUpdate: time.Now(),
+				},
+			},
+			ResourceVersion: input.ResourceVersion,
+		},
+		Address:     input.Address,
+		Rules:       input.Rules,
+		Description: input.Description,
+	}
+}
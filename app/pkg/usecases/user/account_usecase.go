@@ -125,6 +125,8 @@ func (u *DefaultUserUseCase) DeleteAccount(ctx context.Context, input DeleteAcco
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, input.ApplicationID, input.UserID)
+
 	return &DeleteAccountOutput{
 		Account: *account,
 	}, nil
@@ -138,6 +140,7 @@ func (u *DefaultUserUseCase) DeleteAllAccountsForAddress(ctx context.Context, in
 
 	byApplicationInput := hsmconnection.ByApplicationInput{
 		ApplicationID: input.ApplicationID,
+		Address:       &input.Address,
 	}
 	hsmConnection, byApplicationErr := u.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
 	if byApplicationErr != nil {
@@ -153,10 +156,11 @@ func (u *DefaultUserUseCase) DeleteAllAccountsForAddress(ctx context.Context, in
 	// 1. Remove it from the HSM
 	removeAddressInput := hsmconnector.RemoveAddressInput{
 		SlotConnectionData: hsmconnector.SlotConnectionData{
-			Slot:       hsmConnection.Slot,
-			Pin:        hsmConnection.Pin,
-			ModuleKind: hsmconnector.ModuleKind(hsmConnection.ModuleKind),
-			ChainID:    hsmConnection.ChainID,
+			Slot:                hsmConnection.Slot,
+			Pin:                 hsmConnection.Pin,
+			ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+			PKCS11Configuration: hsmConnection.PKCS11Configuration,
+			ChainID:             hsmConnection.ChainID,
 		},
 		Address: input.Address,
 	}
@@ -169,12 +173,16 @@ func (u *DefaultUserUseCase) DeleteAllAccountsForAddress(ctx context.Context, in
 	}
 
 	tracer.Trace("removed address from HSM")
+	u.invalidateAddressCache(ctx, input.ApplicationID, input.Address)
 
 	// 2. Remove it from storage
 	accounts, err := u.accountStorage.RemoveAllForAddress(ctx, input.ApplicationID, input.Address)
 	if err != nil {
 		return nil, errors.InternalFromErr(err)
 	}
+	for _, account := range accounts.Items {
+		u.invalidateCache(ctx, account.ApplicationID, account.UserID)
+	}
 
 	return &DeleteAllAccountsForAddressOutput{
 		Items: accounts.Items,
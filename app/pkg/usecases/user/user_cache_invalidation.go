@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/signare/app/pkg/entities/address"
+)
+
+// CacheInvalidationPort invalidates the data cached from the User and Account resources when they change.
+type CacheInvalidationPort interface {
+	// InvalidateUser removes the cached data of the given User, such as its roles and accounts.
+	InvalidateUser(ctx context.Context, applicationID string, userID string)
+	// InvalidateAddress removes the cached data of the given address of the given Application, such as the HSM slot
+	// that holds it.
+	InvalidateAddress(ctx context.Context, applicationID string, addr address.Address)
+}
+
+func (u *DefaultUserUseCase) invalidateCache(ctx context.Context, applicationID string, userID string) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateUser(ctx, applicationID, userID)
+	}
+}
+
+func (u *DefaultUserUseCase) invalidateAddressCache(ctx context.Context, applicationID string, addr address.Address) {
+	if u.cacheInvalidation != nil {
+		u.cacheInvalidation.InvalidateAddress(ctx, applicationID, addr)
+	}
+}
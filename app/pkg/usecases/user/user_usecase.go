@@ -237,6 +237,7 @@ func (u *DefaultUserUseCase) EditUser(ctx context.Context, input EditUserInput)
 		}
 		return nil, errors.InternalFromErr(err)
 	}
+	u.invalidateCache(ctx, input.ApplicationID, input.ID)
 
 	listAccountsInput := ListAccountsInput{
 		ApplicationID: user.ApplicationID,
@@ -276,6 +277,8 @@ func (u *DefaultUserUseCase) DeleteUser(ctx context.Context, input DeleteUserInp
 		return nil, errors.InternalFromErr(err)
 	}
 
+	u.invalidateCache(ctx, input.ApplicationID, input.ID)
+
 	return &DeleteUserOutput{
 		User: *user,
 	}, nil
@@ -305,31 +308,38 @@ func (u *DefaultUserUseCase) EnableAccounts(ctx context.Context, input EnableAcc
 		return nil, errors.InternalFromErr(err)
 	}
 
-	byApplicationInput := hsmconnection.ByApplicationInput{
-		ApplicationID: input.ApplicationID,
-	}
+	// Accounts need to be validated with the HSM manager to see if they exist in their slots.
+	for _, addr := range input.Addresses {
+		accountAddress := addr
+		// the slot that holds the address is looked up again, in case the address was moved between slots
+		u.invalidateAddressCache(ctx, input.ApplicationID, accountAddress)
+		byApplicationInput := hsmconnection.ByApplicationInput{
+			ApplicationID: input.ApplicationID,
+			Address:       &accountAddress,
+		}
 
-	hsmConnection, byApplicationErr := u.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
-	if byApplicationErr != nil {
-		return nil, byApplicationErr
-	}
+		hsmConnection, byApplicationErr := u.hsmConnectionResolver.ByApplication(ctx, byApplicationInput)
+		if byApplicationErr != nil {
+			return nil, byApplicationErr
+		}
 
-	// Accounts need to be validated with the HSM manager to see if they exist in their slots.
-	listAddressesInput := hsmconnector.ListAddressesInput{
-		SlotConnectionData: hsmconnector.SlotConnectionData{
-			Slot:       hsmConnection.Slot,
-			Pin:        hsmConnection.Pin,
-			ModuleKind: hsmconnector.ModuleKind(hsmConnection.ModuleKind),
-			ChainID:    hsmConnection.ChainID,
-		},
-	}
-	listAddressesOutput, err := u.hsmConnector.ListAddresses(ctx, listAddressesInput)
-	if err != nil {
-		return nil, errors.InternalFromErr(err)
-	}
-	if !areAddressesValid(listAddressesOutput.Items, input.Addresses) {
-		msg := fmt.Sprintf("one or more accounts '%s' do not exist in the HSM", input.Addresses)
-		return nil, errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+		listAddressesInput := hsmconnector.ListAddressesInput{
+			SlotConnectionData: hsmconnector.SlotConnectionData{
+				Slot:                hsmConnection.Slot,
+				Pin:                 hsmConnection.Pin,
+				ModuleKind:          hsmconnector.ModuleKind(hsmConnection.ModuleKind),
+				PKCS11Configuration: hsmConnection.PKCS11Configuration,
+				ChainID:             hsmConnection.ChainID,
+			},
+		}
+		listAddressesOutput, listAddressesErr := u.hsmConnector.ListAddresses(ctx, listAddressesInput)
+		if listAddressesErr != nil {
+			return nil, errors.InternalFromErr(listAddressesErr)
+		}
+		if !containsAddress(listAddressesOutput.Items, accountAddress) {
+			msg := fmt.Sprintf("one or more accounts '%s' do not exist in the HSM", input.Addresses)
+			return nil, errors.PreconditionFailed().WithMessage(msg).SetHumanReadableMessage(msg)
+		}
 	}
 
 	accountsToCreate := make([]CreateAccountInput, len(input.Addresses))
@@ -399,6 +409,7 @@ func (u *DefaultUserUseCase) DisableAccount(ctx context.Context, input DisableAc
 		}
 		return nil, errors.InternalFromErr(err)
 	}
+	u.invalidateAddressCache(ctx, input.ApplicationID, input.Address)
 
 	getUserInput := entities.ApplicationStandardID{
 		ID:            input.UserID,
@@ -427,15 +438,6 @@ func (u *DefaultUserUseCase) DisableAccount(ctx context.Context, input DisableAc
 	}, nil
 }
 
-func areAddressesValid(items []address.Address, addresses []address.Address) bool {
-	for _, addr := range addresses {
-		if !containsAddress(items, addr) {
-			return false
-		}
-	}
-	return true
-}
-
 func containsAddress(items []address.Address, address address.Address) bool {
 	for _, item := range items {
 		if item == address {
@@ -464,6 +466,8 @@ type DefaultUserUseCase struct {
 	referentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
 	// roleUseCase defines how to interact with Role resources.
 	roleUseCase role.RoleUseCase
+	// cacheInvalidation invalidates the cached data of the users whose roles or accounts change.
+	cacheInvalidation CacheInvalidationPort
 }
 
 // DefaultUserUseCaseOptions configures a DefaultUserUseCase.
@@ -483,6 +487,8 @@ type DefaultUserUseCaseOptions struct {
 	ReferentialIntegrityUseCase referentialintegrity.ReferentialIntegrityUseCase
 	// RoleUseCase defines how to interact with Role resources.
 	RoleUseCase role.RoleUseCase
+	// CacheInvalidation invalidates the cached data of the users whose roles or accounts change. Optional.
+	CacheInvalidation CacheInvalidationPort
 }
 
 // ProvideDefaultUseCase creates a DefaultUserUseCase with the given options.
@@ -516,5 +522,6 @@ func ProvideDefaultUseCase(options DefaultUserUseCaseOptions) (*DefaultUserUseCa
 		hsmConnector:                options.HSMConnector,
 		hsmConnectionResolver:       options.HSMConnectionResolver,
 		referentialIntegrityUseCase: options.ReferentialIntegrityUseCase,
+		cacheInvalidation:           options.CacheInvalidation,
 	}, nil
 }
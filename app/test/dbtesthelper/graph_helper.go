@@ -2,26 +2,51 @@ package dbtesthelper
 
 import (
 	"context"
+	"os"
 
 	embedded "github.com/hyperledger-labs/signare/app"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/dbmigrator"
 	_ "github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/init" // Used to register sql dialects
 	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
 	"github.com/hyperledger-labs/signare/app/test/signaturemanagertesthelper"
 )
 
+// masterKey is the hexadecimal master key that encrypts the HSM slot pins in the tests.
+const masterKey = "6d6d2f9f0c1c3a2f8d7f4e0b9a1c5e3d2b4a6f8e0d1c3b5a79688f7e6d5c4b3a"
+
 func InitializeApp() (*graph.GraphShared, error) {
+	masterKeyFile, err := os.CreateTemp("", "signare-master-key")
+	if err != nil {
+		return nil, err
+	}
+	_, err = masterKeyFile.WriteString(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	err = masterKeyFile.Close()
+	if err != nil {
+		return nil, err
+	}
+
 	graphConfig := graph.Config{
 		BuildConfig: nil,
 		Libraries: graph.LibrariesConfig{
-			PersistenceFw: graph.PersistenceFwConfig{
-				SQLite: &graph.SQLiteConfig{},
+			PersistenceFw: graphconfig.PersistenceFwConfig{
+				SQLite: &graphconfig.SQLiteConfig{},
 			},
 			HSMModules: graph.HSMModules{
 				SoftHSM: &graph.SoftHSMConfig{
 					Library: signaturemanagertesthelper.SoftHSMLib,
 				},
 			},
+			PinEncryption: &graphconfig.PinEncryptionConfig{
+				MasterKey: graphconfig.MasterKeyConfig{
+					File: &graphconfig.FileMasterKeyConfig{
+						Path: masterKeyFile.Name(),
+					},
+				},
+			},
 		},
 	}
 
@@ -0,0 +1,330 @@
+// Package kmstesthelper provides an in-memory emulator of the KMS REST API to test the cloud KMS signature manager.
+package kmstesthelper
+
+import (
+	"encoding/asn1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/google/uuid"
+)
+
+const (
+	targetPrefix = "TrentService."
+	keySpec      = "ECC_SECG_P256K1"
+	digestLength = 32
+)
+
+var (
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// KMSEmulator emulates the subset of the KMS REST API used by the cloud KMS signature manager.
+type KMSEmulator struct {
+	*httptest.Server
+
+	mutex   sync.Mutex
+	keys    map[string]*emulatedKey
+	aliases map[string]emulatedAlias
+}
+
+type emulatedKey struct {
+	privateKey          *btcec.PrivateKey
+	pendingDeletion     bool
+	pendingWindowInDays int
+}
+
+type emulatedAlias struct {
+	targetKeyID  string
+	creationDate float64
+}
+
+type subjectPublicKeyInfo struct {
+	Algorithm        algorithmIdentifier
+	SubjectPublicKey asn1.BitString
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.ObjectIdentifier
+}
+
+// NewKMSEmulator starts a new KMS emulator. It must be closed once it is no longer used.
+func NewKMSEmulator() *KMSEmulator {
+	emulator := &KMSEmulator{
+		keys:    make(map[string]*emulatedKey),
+		aliases: make(map[string]emulatedAlias),
+	}
+	emulator.Server = httptest.NewServer(http.HandlerFunc(emulator.handle))
+	return emulator
+}
+
+// ImportKey stores the given raw secp256k1 private key with the given alias, returning the ID of the key.
+func (e *KMSEmulator) ImportKey(rawPrivateKey []byte, aliasName string) string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	privateKey, _ := btcec.PrivKeyFromBytes(rawPrivateKey)
+	keyID := uuid.New().String()
+	e.keys[keyID] = &emulatedKey{privateKey: privateKey}
+	e.aliases[aliasName] = emulatedAlias{targetKeyID: keyID, creationDate: creationDate()}
+	return keyID
+}
+
+// IsPendingDeletion returns true if the key with the given ID has been scheduled for deletion.
+func (e *KMSEmulator) IsPendingDeletion(keyID string) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	key, ok := e.keys[keyID]
+	return ok && key.pendingDeletion
+}
+
+func (e *KMSEmulator) handle(w http.ResponseWriter, r *http.Request) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var request map[string]any
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		writeError(w, "SerializationException", err.Error())
+		return
+	}
+
+	switch strings.TrimPrefix(r.Header.Get("X-Amz-Target"), targetPrefix) {
+	case "CreateKey":
+		e.createKey(w, request)
+	case "GetPublicKey":
+		e.getPublicKey(w, request)
+	case "DescribeKey":
+		e.describeKey(w, request)
+	case "ScheduleKeyDeletion":
+		e.scheduleKeyDeletion(w, request)
+	case "CreateAlias":
+		e.createAlias(w, request)
+	case "DeleteAlias":
+		e.deleteAlias(w, request)
+	case "ListAliases":
+		e.listAliases(w, request)
+	case "Sign":
+		e.sign(w, request)
+	default:
+		writeError(w, "UnknownOperationException", fmt.Sprintf("unknown operation '%s'", r.Header.Get("X-Amz-Target")))
+	}
+}
+
+func (e *KMSEmulator) createKey(w http.ResponseWriter, request map[string]any) {
+	if request["KeySpec"] != keySpec || request["KeyUsage"] != "SIGN_VERIFY" {
+		writeError(w, "ValidationException", "unsupported key spec or key usage")
+		return
+	}
+	privateKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		writeError(w, "KMSInternalException", err.Error())
+		return
+	}
+	keyID := uuid.New().String()
+	e.keys[keyID] = &emulatedKey{privateKey: privateKey}
+	writeResponse(w, map[string]any{
+		"KeyMetadata": map[string]any{
+			"KeyId":    keyID,
+			"KeySpec":  keySpec,
+			"KeyUsage": "SIGN_VERIFY",
+		},
+	})
+}
+
+func (e *KMSEmulator) getPublicKey(w http.ResponseWriter, request map[string]any) {
+	keyID, key, ok := e.resolveKey(w, request, false)
+	if !ok {
+		return
+	}
+	publicKey, err := asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: algorithmIdentifier{
+			Algorithm:  oidECPublicKey,
+			Parameters: oidSecp256k1,
+		},
+		SubjectPublicKey: asn1.BitString{
+			Bytes:     key.privateKey.PubKey().SerializeUncompressed(),
+			BitLength: 8 * len(key.privateKey.PubKey().SerializeUncompressed()),
+		},
+	})
+	if err != nil {
+		writeError(w, "KMSInternalException", err.Error())
+		return
+	}
+	writeResponse(w, map[string]any{
+		"KeyId":     keyID,
+		"KeySpec":   keySpec,
+		"PublicKey": publicKey,
+	})
+}
+
+func (e *KMSEmulator) describeKey(w http.ResponseWriter, request map[string]any) {
+	keyID, key, ok := e.resolveKey(w, request, true)
+	if !ok {
+		return
+	}
+	keyState := "Enabled"
+	if key.pendingDeletion {
+		keyState = "PendingDeletion"
+	}
+	writeResponse(w, map[string]any{
+		"KeyMetadata": map[string]any{
+			"KeyId":    keyID,
+			"KeyState": keyState,
+		},
+	})
+}
+
+func (e *KMSEmulator) scheduleKeyDeletion(w http.ResponseWriter, request map[string]any) {
+	keyID, key, ok := e.resolveKey(w, request, false)
+	if !ok {
+		return
+	}
+	pendingWindowInDays, _ := request["PendingWindowInDays"].(float64)
+	if pendingWindowInDays < 7 || pendingWindowInDays > 30 {
+		writeError(w, "ValidationException", "PendingWindowInDays must be between 7 and 30")
+		return
+	}
+	key.pendingDeletion = true
+	key.pendingWindowInDays = int(pendingWindowInDays)
+	writeResponse(w, map[string]any{
+		"KeyId":    keyID,
+		"KeyState": "PendingDeletion",
+	})
+}
+
+func (e *KMSEmulator) createAlias(w http.ResponseWriter, request map[string]any) {
+	aliasName, _ := request["AliasName"].(string)
+	if !strings.HasPrefix(aliasName, "alias/") || strings.HasPrefix(aliasName, "alias/aws/") {
+		writeError(w, "InvalidAliasNameException", fmt.Sprintf("invalid alias name '%s'", aliasName))
+		return
+	}
+	if _, exists := e.aliases[aliasName]; exists {
+		writeError(w, "AlreadyExistsException", fmt.Sprintf("alias '%s' already exists", aliasName))
+		return
+	}
+	keyID, _, ok := e.resolveKey(w, map[string]any{"KeyId": request["TargetKeyId"]}, false)
+	if !ok {
+		return
+	}
+	e.aliases[aliasName] = emulatedAlias{targetKeyID: keyID, creationDate: creationDate()}
+	writeResponse(w, map[string]any{})
+}
+
+func (e *KMSEmulator) deleteAlias(w http.ResponseWriter, request map[string]any) {
+	aliasName, _ := request["AliasName"].(string)
+	if _, exists := e.aliases[aliasName]; !exists {
+		writeError(w, "NotFoundException", fmt.Sprintf("alias '%s' not found", aliasName))
+		return
+	}
+	delete(e.aliases, aliasName)
+	writeResponse(w, map[string]any{})
+}
+
+func (e *KMSEmulator) listAliases(w http.ResponseWriter, request map[string]any) {
+	names := make([]string, 0, len(e.aliases))
+	for name := range e.aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start := 0
+	if marker, ok := request["Marker"].(string); ok && len(marker) > 0 {
+		start = sort.SearchStrings(names, marker)
+	}
+	limit := len(names)
+	if l, ok := request["Limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+	end := start + limit
+	if end > len(names) {
+		end = len(names)
+	}
+
+	aliases := make([]map[string]any, 0, end-start)
+	for _, name := range names[start:end] {
+		aliases = append(aliases, map[string]any{
+			"AliasName":    name,
+			"TargetKeyId":  e.aliases[name].targetKeyID,
+			"CreationDate": e.aliases[name].creationDate,
+		})
+	}
+	response := map[string]any{
+		"Aliases":   aliases,
+		"Truncated": end < len(names),
+	}
+	if end < len(names) {
+		response["NextMarker"] = names[end]
+	}
+	writeResponse(w, response)
+}
+
+func (e *KMSEmulator) sign(w http.ResponseWriter, request map[string]any) {
+	keyID, key, ok := e.resolveKey(w, request, false)
+	if !ok {
+		return
+	}
+	if request["MessageType"] != "DIGEST" || request["SigningAlgorithm"] != "ECDSA_SHA_256" {
+		writeError(w, "ValidationException", "unsupported message type or signing algorithm")
+		return
+	}
+	var message []byte
+	encodedMessage, _ := json.Marshal(request["Message"])
+	err := json.Unmarshal(encodedMessage, &message)
+	if err != nil || len(message) != digestLength {
+		writeError(w, "ValidationException", "the digest to sign must be 32 bytes long")
+		return
+	}
+	signature := ecdsa.Sign(key.privateKey, message)
+	writeResponse(w, map[string]any{
+		"KeyId":            keyID,
+		"Signature":        signature.Serialize(),
+		"SigningAlgorithm": "ECDSA_SHA_256",
+	})
+}
+
+// resolveKey returns the key identified by the 'KeyId' attribute of the request, that can be either a key ID or an alias name. Keys pending deletion can only be described.
+func (e *KMSEmulator) resolveKey(w http.ResponseWriter, request map[string]any, allowPendingDeletion bool) (string, *emulatedKey, bool) {
+	keyID, _ := request["KeyId"].(string)
+	if alias, ok := e.aliases[keyID]; ok {
+		keyID = alias.targetKeyID
+	}
+	key, ok := e.keys[keyID]
+	if !ok {
+		writeError(w, "NotFoundException", fmt.Sprintf("key '%s' not found", request["KeyId"]))
+		return "", nil, false
+	}
+	if key.pendingDeletion && !allowPendingDeletion {
+		writeError(w, "KMSInvalidStateException", fmt.Sprintf("key '%s' is pending deletion", keyID))
+		return "", nil, false
+	}
+	return keyID, key, true
+}
+
+// creationDate returns the current date as the fractional seconds since epoch.
+func creationDate() float64 {
+	return float64(time.Now().UnixMicro()) / 1e6
+}
+
+func writeResponse(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func writeError(w http.ResponseWriter, errType, message string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"__type":  errType,
+		"message": message,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/rbacrules"
 	"github.com/hyperledger-labs/tools/rbac-validator/cmd/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -152,7 +153,6 @@ func executeCmd(_ *cobra.Command, _ []string) error {
 
 	// 2. Read permissions
 	fmt.Print("2. Reading permissions")
-	var permissions map[string]types.ActionCollection
 	var permissionCollection types.PermissionCollection
 	{
 		permissionsBytes, err := os.ReadFile(permissionsFile)
@@ -163,16 +163,10 @@ func executeCmd(_ *cobra.Command, _ []string) error {
 		if err != nil {
 			return err
 		}
-
-		permissions = make(map[string]types.ActionCollection)
-		for _, permission := range permissionCollection.Permissions {
-			permissions[permission.ID] = types.ActionCollection{Actions: permission.Actions}
-		}
 	}
 	printSuccessLog()
 
 	fmt.Print("3. Reading roles")
-	// 3.1. Read roles
 	var roles types.RoleCollection
 	{
 		rolesBytes, err := os.ReadFile(rolesFile)
@@ -184,19 +178,6 @@ func executeCmd(_ *cobra.Command, _ []string) error {
 			return err
 		}
 	}
-	// 3.2. Expand roles' actions (create a hashset with all the actions pointed by all the roles)
-	actionsPointedByRoles := make(map[string]string)
-	for _, role := range roles.Roles {
-		for _, permission := range role.Permissions {
-			actionsForPermission, ok := permissions[permission]
-			if !ok {
-				return fmt.Errorf("role '%s' points to a permission '%s' that does not exist", role.ID, permission)
-			}
-			for _, action := range actionsForPermission.Actions {
-				actionsPointedByRoles[action] = ""
-			}
-		}
-	}
 	printSuccessLog()
 
 	// Validations
@@ -207,27 +188,32 @@ func executeCmd(_ *cobra.Command, _ []string) error {
 	}
 	printSuccessLog()
 
+	rulesRoles := make([]rbacrules.Role, len(roles.Roles))
+	for i, role := range roles.Roles {
+		rulesRoles[i] = rbacrules.Role{ID: role.ID, Permissions: role.Permissions}
+	}
+	rulesPermissions := make([]rbacrules.Permission, len(permissionCollection.Permissions))
+	for i, permission := range permissionCollection.Permissions {
+		rulesPermissions[i] = rbacrules.Permission{ID: permission.ID, Actions: permission.Actions}
+	}
+
 	// 2. Validate that permissions point to existing actions
 	fmt.Print("5. Validating that permissions point to existing actions")
-	if err = validatePermissions(permissionCollection, actions); err != nil {
+	if err = rbacrules.ValidatePermissions(rulesPermissions, actions.Actions); err != nil {
 		return err
 	}
 	printSuccessLog()
 
 	// 3. Validate that roles point to existing permissions
 	fmt.Print("6. Validating that roles point to existing permissions")
-	if err = validateRoles(roles, permissionCollection); err != nil {
+	if err = rbacrules.ValidateRoles(rulesRoles, rulesPermissions); err != nil {
 		return err
 	}
 	printSuccessLog()
 
 	// 4. Validate that every action is pointed by at least one permission that is also pointed by a role (in other words: check that every action is assigned to at least one role)
 	fmt.Print("7. Validating that every action is pointed by at least one permission that is also pointed by a role (in other words: check that every action is assigned to at least one role)")
-	actionsMap := make(map[string]string) // create a map to be able to use reflect.DeepEqual to compare the two groups
-	for _, item := range actions.Actions {
-		actionsMap[item] = ""
-	}
-	if err = checkOrphanedActions(actionsMap, actionsPointedByRoles); err != nil {
+	if err = rbacrules.CheckOrphanedActions(rulesRoles, rulesPermissions, actions.Actions); err != nil {
 		return err
 	}
 	printSuccessLog()
@@ -257,38 +243,6 @@ func getOperationIds(paths []string, operationIdExclusions map[string]string) ([
 	return operationIds, nil
 }
 
-// validatePermissions checks that actions pointed by permissions exist and returns an error if any of them doesn't exist
-func validatePermissions(permissions types.PermissionCollection, actions types.ActionCollection) error {
-	actionMap := make(map[string]string)
-	for _, action := range actions.Actions {
-		actionMap[action] = ""
-	}
-	for _, permission := range permissions.Permissions {
-		for _, action := range permission.Actions {
-			if _, actionExists := actionMap[action]; !actionExists {
-				return fmt.Errorf("permission '%s' points to an action '%s' that does not exist", permission.ID, action)
-			}
-		}
-	}
-	return nil
-}
-
-// validateRoles checks that permissions pointed by roles exist and returns an error if any of them doesn't exist
-func validateRoles(roles types.RoleCollection, permissions types.PermissionCollection) error {
-	permissionMap := make(map[string]string)
-	for _, permission := range permissions.Permissions {
-		permissionMap[permission.ID] = ""
-	}
-	for _, role := range roles.Roles {
-		for _, permission := range role.Permissions {
-			if _, permissionExists := permissionMap[permission]; !permissionExists {
-				return fmt.Errorf("role '%s' points to a permission '%s' that does not exist", role.ID, permission)
-			}
-		}
-	}
-	return nil
-}
-
 // checkActionsAndOperationIDs checks if actions and operation IDs map 1 to 1
 func checkActionsAndOperationIDs(actions, operationIDs []string) error {
 	// use maps as they are more efficient for lookups (and use them to check if there is any repeated item)
@@ -345,20 +299,6 @@ func checkActionsAndOperationIDs(actions, operationIDs []string) error {
 	return nil
 }
 
-// checkOrphanedActions checks if the provided maps have the same elements, throwing an error with the ones that doesn't match if it fails
-func checkOrphanedActions(actions, rolesActions map[string]string) error {
-	orphanActions := []string{}
-	for k := range actions {
-		if _, ok := rolesActions[k]; !ok {
-			orphanActions = append(orphanActions, k)
-		}
-	}
-	if len(orphanActions) > 0 {
-		return fmt.Errorf("actions %s are not assigned to any role", orphanActions)
-	}
-	return nil
-}
-
 func printSuccessLog() {
 	fmt.Println(" -> SUCCESS")
 }
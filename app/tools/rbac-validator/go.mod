@@ -1,9 +1,10 @@
 module github.com/hyperledger-labs/tools/rbac-validator
 
-go 1.22
+go 1.22.0
 
 require (
 	github.com/getkin/kin-openapi v0.116.0
+	github.com/hyperledger-labs/signare/app v0.0.0
 	github.com/spf13/cobra v1.7.0
 	github.com/spf13/viper v1.15.0
 	gopkg.in/yaml.v3 v3.0.1
@@ -28,8 +29,10 @@ require (
 	github.com/spf13/jwalterweatherman v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/subosito/gotenv v1.4.2 // indirect
-	golang.org/x/sys v0.3.0 // indirect
-	golang.org/x/text v0.5.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
+
+replace github.com/hyperledger-labs/signare/app v0.0.0 => ../..
@@ -21,10 +21,25 @@ type StaticConfiguration struct {
 	DatabaseInfo DatabaseInfo `mapstructure:"database" valid:"required"`
 	// RequestContext defines the context of a request
 	RequestContext *RequestContext `mapstructure:"requestContext" valid:"optional"`
+	// TLS configures the TLS of the HTTP and JSON-RPC listeners. They listen in plain HTTP if it is not provided.
+	TLS *TLS `mapstructure:"tls" valid:"optional"`
+	// Authentication configures the authentication of the requests. The user and the application are read from the
+	// headers defined in RequestContext if it is not provided.
+	Authentication *Authentication `mapstructure:"authentication" valid:"optional"`
+	// RPC configures the JSON-RPC server.
+	RPC *RPC `mapstructure:"rpc" valid:"optional"`
+	// Cache configures the caching of the data read from the database to process the requests.
+	Cache *Cache `mapstructure:"cache" valid:"optional"`
+	// HSMHealthMonitor configures the periodic health checks of the HSM modules and slots.
+	HSMHealthMonitor *HSMHealthMonitor `mapstructure:"hsmHealthMonitor" valid:"optional"`
+	// Health configures the liveness and readiness endpoints.
+	Health *Health `mapstructure:"health" valid:"optional"`
 	// MetricsConfig provides configuration to expose numeric metrics.
 	MetricsConfig *MetricsConfig `mapstructure:"metrics" valid:"optional"`
 	// HSMModules provides the configuration of the hardware security modules.
 	HSMModules HSMModules `mapstructure:"hsmmodules" valid:"required"`
+	// PinEncryption configures the encryption of the HSM slot pins in the database.
+	PinEncryption *PinEncryptionConfig `mapstructure:"pinEncryption" valid:"optional"`
 }
 
 // Logger specification
@@ -33,10 +48,15 @@ type Logger struct {
 	LogLevel string `mapstructure:"logLevel" valid:"required"`
 }
 
-// DatabaseInfo configures signare database access
+// DatabaseInfo configures signare database access. Only one of PostgreSQL and SQLite can be provided.
 type DatabaseInfo struct {
 	// PostgreSQL database configuration
 	PostgreSQL *PostgreSQLInfo `mapstructure:"postgresql"`
+	// SQLite database configuration
+	SQLite *SQLiteInfo `mapstructure:"sqlite"`
+	// SchemaVersionCheck what to do on startup if the database schema doesn't have the expected version: 'refuse' to
+	// start, 'warn' and start or 'migrate' the schema and start. Default is 'refuse'
+	SchemaVersionCheck string `mapstructure:"schemaVersionCheck" valid:"optional,in(refuse|warn|migrate)"`
 }
 
 // RequestContext
@@ -47,6 +67,94 @@ type RequestContext struct {
 	ApplicationRequestHeader string `mapstructure:"applicationRequestHeader"`
 }
 
+// TLS configures the TLS of the listeners
+type TLS struct {
+	// CertFile path to the PEM encoded certificate chain of the server
+	CertFile string `mapstructure:"certFile" valid:"required"`
+	// KeyFile path to the PEM encoded private key of the server
+	KeyFile string `mapstructure:"keyFile" valid:"required"`
+	// MinVersion minimum TLS version accepted, '1.2' or '1.3'
+	MinVersion string `mapstructure:"minVersion" valid:"optional"`
+	// ClientCAFile path to the PEM encoded certificates of the authorities that issue the client certificates
+	ClientCAFile string `mapstructure:"clientCAFile" valid:"optional"`
+	// ClientAuth 'none', 'optional' or 'required' client certificates
+	ClientAuth string `mapstructure:"clientAuth" valid:"optional"`
+}
+
+// Authentication configures the authentication of the requests. Only one of its fields can be provided.
+type Authentication struct {
+	// JWT authenticates the requests with signed bearer tokens
+	JWT *JWTAuthentication `mapstructure:"jwt" valid:"optional"`
+	// ClientCertificate authenticates the requests with the client certificates verified by the listeners
+	ClientCertificate *ClientCertificateAuthentication `mapstructure:"clientCertificate" valid:"optional"`
+}
+
+// ClientCertificateAuthentication configures the authentication with client certificates
+type ClientCertificateAuthentication struct {
+	// UserField field of the certificate that holds the ID of the user
+	UserField string `mapstructure:"userField" valid:"optional"`
+	// UserPrefix prefix removed from the value of UserField
+	UserPrefix string `mapstructure:"userPrefix" valid:"optional"`
+	// ApplicationField field of the certificate that holds the ID of the application
+	ApplicationField string `mapstructure:"applicationField" valid:"optional"`
+	// ApplicationPrefix prefix removed from the value of ApplicationField
+	ApplicationPrefix string `mapstructure:"applicationPrefix" valid:"optional"`
+}
+
+// RPC configures the JSON-RPC server
+type RPC struct {
+	// Batch configures the processing of batch requests
+	Batch *RPCBatch `mapstructure:"batch" valid:"optional"`
+}
+
+// RPCBatch configures the processing of JSON-RPC batch requests
+type RPCBatch struct {
+	// MaxConcurrentRequests maximum number of requests of a batch processed at the same time
+	MaxConcurrentRequests *int `mapstructure:"maxConcurrentRequests" valid:"optional"`
+	// MaxSize maximum number of requests in a batch
+	MaxSize *int `mapstructure:"maxSize" valid:"optional"`
+}
+
+// Cache configures the caching of the applications, HSM slots, users and admins read from the database
+type Cache struct {
+	// TTLInSeconds time the cached entries are kept, 0 disables caching
+	TTLInSeconds *int `mapstructure:"ttlInSeconds" valid:"optional"`
+	// MaxEntries maximum number of entries of each cache
+	MaxEntries *int `mapstructure:"maxEntries" valid:"optional"`
+}
+
+// HSMHealthMonitor configures the periodic health checks of the HSM slots and the reset of the modules whose device is not available
+type HSMHealthMonitor struct {
+	// IntervalInSeconds time between the checks, 0 disables the checks
+	IntervalInSeconds *int `mapstructure:"intervalInSeconds" valid:"optional"`
+}
+
+// Health configures the liveness and readiness endpoints
+type Health struct {
+	// ShutdownDelayInSeconds time the signare keeps serving requests once it is shutting down and no longer ready, 0 shuts it down right away
+	ShutdownDelayInSeconds *int `mapstructure:"shutdownDelayInSeconds" valid:"optional"`
+}
+
+// JWTAuthentication configures the authentication with JSON Web Tokens. Exactly one of JWKSFile and JWKSURL must be provided.
+type JWTAuthentication struct {
+	// JWKSFile path to a JSON Web Key Set file with the keys that verify the tokens
+	JWKSFile string `mapstructure:"jwksFile" valid:"optional"`
+	// JWKSURL URL of the JSON Web Key Set of the identity provider
+	JWKSURL string `mapstructure:"jwksURL" valid:"optional"`
+	// JWKSRefreshIntervalInSeconds maximum time the keys fetched from JWKSURL are cached
+	JWKSRefreshIntervalInSeconds *int `mapstructure:"jwksRefreshIntervalInSeconds" valid:"optional"`
+	// Issuer expected in the tokens
+	Issuer string `mapstructure:"issuer" valid:"optional"`
+	// Audience expected in the tokens
+	Audience string `mapstructure:"audience" valid:"optional"`
+	// UserClaim claim that holds the ID of the user
+	UserClaim string `mapstructure:"userClaim" valid:"optional"`
+	// ApplicationClaim claim that holds the ID of the application
+	ApplicationClaim string `mapstructure:"applicationClaim" valid:"required~applicationClaim is mandatory in JWT authentication config"`
+	// ClockSkewInSeconds tolerated when checking the expiration of the tokens
+	ClockSkewInSeconds *int `mapstructure:"clockSkewInSeconds" valid:"optional"`
+}
+
 // PostgreSQLInfo defines the access to a SQL-compatible database system
 type PostgreSQLInfo struct {
 	// Host of database system
@@ -77,6 +185,12 @@ type PostgreSQLClient struct {
 	MaxConnectionLifetime *int `mapstructure:"maxConnectionLifetime"`
 }
 
+// SQLiteInfo defines the access to a SQLite database file
+type SQLiteInfo struct {
+	// Path to the database file, which is created if it doesn't exist
+	Path string `mapstructure:"path" valid:"required~path is mandatory in SQLite DB config"`
+}
+
 // MetricsConfig configures signare to export metrics
 type MetricsConfig struct {
 	// Prometheus Metric Record configuration
@@ -102,11 +216,75 @@ type PrometheusMetricsConfig struct {
 type HSMModules struct {
 	// SoftHSM configuration for SoftHSM.
 	SoftHSM *SoftHSMConfig `mapstructure:"softhsm" valid:"optional"`
+	// CloudKMS configuration for a cloud KMS.
+	CloudKMS *CloudKMSConfig `mapstructure:"cloudkms" valid:"optional"`
+	// PKCS11 configuration for generic PKCS11 modules.
+	PKCS11 *PKCS11Config `mapstructure:"pkcs11" valid:"optional"`
 }
 
 // SoftHSMConfig configures a SoftHSM in the signare.
 type SoftHSMConfig struct {
 	Library string `mapstructure:"lib" valid:"required"`
+	// OperationTimeoutInSeconds maximum time an operation can take, 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
+}
+
+// CloudKMSConfig configures a cloud KMS in the signare.
+type CloudKMSConfig struct {
+	// Endpoint URL of the KMS REST API
+	Endpoint string `mapstructure:"endpoint" valid:"required"`
+	// Region where the keys are managed
+	Region string `mapstructure:"region" valid:"required"`
+	// AccessKeyID to sign the requests. Requests are not signed if it is not provided
+	AccessKeyID *string `mapstructure:"accessKeyId" valid:"optional"`
+	// SecretAccessKey to sign the requests
+	SecretAccessKey *string `mapstructure:"secretAccessKey" json:"-" valid:"optional"`
+	// SessionToken to sign the requests with temporary credentials
+	SessionToken *string `mapstructure:"sessionToken" json:"-" valid:"optional"`
+	// OperationTimeoutInSeconds maximum time an operation can take, 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
+}
+
+// PKCS11Config configures the libraries that generic PKCS11 modules are allowed to load in the signare.
+type PKCS11Config struct {
+	// Libraries paths to the PKCS11 libraries of the vendors
+	Libraries []string `mapstructure:"libs" valid:"required"`
+	// OperationTimeoutInSeconds maximum time an operation can take, 0 disables the timeout
+	OperationTimeoutInSeconds *int `mapstructure:"operationTimeoutInSeconds" valid:"optional"`
+}
+
+// PinEncryptionConfig configures the encryption of the HSM slot pins in the database.
+type PinEncryptionConfig struct {
+	// MasterKey encrypts the pins
+	MasterKey MasterKeyConfig `mapstructure:"masterKey" valid:"required"`
+	// PreviousMasterKeys decrypt the pins that have not been re-encrypted with MasterKey yet
+	PreviousMasterKeys []MasterKeyConfig `mapstructure:"previousMasterKeys" valid:"optional"`
+}
+
+// MasterKeyConfig configures a master key. Exactly one of the sources of the key must be provided.
+type MasterKeyConfig struct {
+	// File configures a key read from a file
+	File *FileMasterKeyConfig `mapstructure:"file" valid:"optional"`
+	// PKCS11 configures a key stored in a PKCS11 token
+	PKCS11 *PKCS11MasterKeyConfig `mapstructure:"pkcs11" valid:"optional"`
+}
+
+// FileMasterKeyConfig configures a master key read from a file.
+type FileMasterKeyConfig struct {
+	// Path to the file holding the 32 bytes of the key encoded in hexadecimal or base64
+	Path string `mapstructure:"path" valid:"required"`
+}
+
+// PKCS11MasterKeyConfig configures a master key stored in a PKCS11 token.
+type PKCS11MasterKeyConfig struct {
+	// Library path to the PKCS11 library
+	Library string `mapstructure:"lib" valid:"required"`
+	// Slot where the token holding the key is
+	Slot uint `mapstructure:"slot"`
+	// Pin of the slot
+	Pin string `mapstructure:"pin" json:"-" valid:"required"`
+	// KeyLabel label of the AES secret key
+	KeyLabel string `mapstructure:"keyLabel" valid:"required"`
 }
 
 func GetStaticConfiguration(path string) (*StaticConfiguration, error) {
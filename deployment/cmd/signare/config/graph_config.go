@@ -0,0 +1,65 @@
+package config
+
+import (
+	"github.com/hyperledger-labs/signare/app/pkg/graph/graphconfig"
+)
+
+// ToGraphPersistenceFwConfiguration maps the database information to the persistence framework configuration of the
+// application and upgrade graphs.
+func ToGraphPersistenceFwConfiguration(databaseInfo DatabaseInfo) graphconfig.PersistenceFwConfig {
+	persistenceFw := graphconfig.PersistenceFwConfig{}
+	if databaseInfo.PostgreSQL != nil {
+		persistenceFw.PostgreSQL = &graphconfig.PostgresSQLConfig{
+			Host:     databaseInfo.PostgreSQL.Host,
+			Port:     &databaseInfo.PostgreSQL.Port,
+			Scheme:   &databaseInfo.PostgreSQL.Scheme,
+			Username: databaseInfo.PostgreSQL.Username,
+			Password: databaseInfo.PostgreSQL.Password,
+			SSLMode:  databaseInfo.PostgreSQL.SSLMode,
+			Database: databaseInfo.PostgreSQL.Database,
+		}
+		if databaseInfo.PostgreSQL.SQLClient != nil {
+			persistenceFw.PostgreSQL.SQLClient = &graphconfig.PostgresSQLClientConfig{
+				MaxIdleConnections:    databaseInfo.PostgreSQL.SQLClient.MaxIdleConnections,
+				MaxOpenConnections:    databaseInfo.PostgreSQL.SQLClient.MaxOpenConnections,
+				MaxConnectionLifetime: databaseInfo.PostgreSQL.SQLClient.MaxConnectionLifetime,
+			}
+		}
+	}
+	if databaseInfo.SQLite != nil {
+		persistenceFw.SQLite = &graphconfig.SQLiteConfig{
+			Path: &databaseInfo.SQLite.Path,
+		}
+	}
+	return persistenceFw
+}
+
+// ToGraphPinEncryptionConfiguration maps the configuration of the encryption of the HSM slot pins to the one of the
+// application and upgrade graphs.
+func ToGraphPinEncryptionConfiguration(pinEncryption PinEncryptionConfig) *graphconfig.PinEncryptionConfig {
+	graphPinEncryption := &graphconfig.PinEncryptionConfig{
+		MasterKey: toGraphMasterKeyConfiguration(pinEncryption.MasterKey),
+	}
+	for _, previousMasterKey := range pinEncryption.PreviousMasterKeys {
+		graphPinEncryption.PreviousMasterKeys = append(graphPinEncryption.PreviousMasterKeys, toGraphMasterKeyConfiguration(previousMasterKey))
+	}
+	return graphPinEncryption
+}
+
+func toGraphMasterKeyConfiguration(masterKey MasterKeyConfig) graphconfig.MasterKeyConfig {
+	graphMasterKey := graphconfig.MasterKeyConfig{}
+	if masterKey.File != nil {
+		graphMasterKey.File = &graphconfig.FileMasterKeyConfig{
+			Path: masterKey.File.Path,
+		}
+	}
+	if masterKey.PKCS11 != nil {
+		graphMasterKey.PKCS11 = &graphconfig.PKCS11MasterKeyConfig{
+			Library:  masterKey.PKCS11.Library,
+			Slot:     masterKey.PKCS11.Slot,
+			Pin:      masterKey.PKCS11.Pin,
+			KeyLabel: masterKey.PKCS11.KeyLabel,
+		}
+	}
+	return graphMasterKey
+}
@@ -0,0 +1,68 @@
+// Package pinrotator defines the command to rotate the master key that encrypts the HSM slot pins.
+package pinrotator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	_ "github.com/hyperledger-labs/signare/app/pkg/commons/persistence/sql/init" // Used to register sql dialects
+	upgrade "github.com/hyperledger-labs/signare/app/pkg/graph/ugprade"
+	"github.com/hyperledger-labs/signare/deployment/cmd/signare/config"
+	"github.com/hyperledger-labs/signare/deployment/cmd/signare/flags"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "rotate-master-key",
+		Long: "re-encrypts the HSM slot pins with the master key configured in 'pinEncryption.masterKey'. The master key used " +
+			"before must be listed in 'pinEncryption.previousMasterKeys' until the command finishes. Pins stored in plain text are encrypted too",
+		RunE: executeRotation,
+	}
+	return cmd
+}
+
+func executeRotation(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+	configFilePath := viper.GetString(flags.SignareConfigPathFlag)
+	staticConfig, err := config.GetStaticConfiguration(configFilePath)
+	if err != nil {
+		panic(fmt.Sprintf("error reading static configuration: [%v]", err))
+	}
+	if staticConfig.PinEncryption == nil {
+		return errors.New("'pinEncryption' must be configured to rotate the master key")
+	}
+
+	appConfig := toGraphConfiguration(staticConfig)
+	appGraph, err := upgrade.New(appConfig)
+	if err != nil {
+		panic(fmt.Sprintf("error initializing appGraph: [%v]", err))
+	}
+
+	appGraph.Build()
+	output, err := appGraph.HSMSlotStorage().ReEncryptPins(ctx)
+	if err != nil {
+		return err
+	}
+	logger.LogEntry(ctx).Infof("%d HSM slot pins re-encrypted with the current master key", output.ReEncrypted)
+	if output.Skipped > 0 {
+		return fmt.Errorf("%d HSM slot pins were modified during the rotation and were not re-encrypted, run the command again", output.Skipped)
+	}
+
+	return nil
+}
+
+func toGraphConfiguration(staticConfig *config.StaticConfiguration) upgrade.Config {
+	graphConfig := upgrade.Config{
+		Libraries: upgrade.LibrariesConfig{
+			PersistenceFw: config.ToGraphPersistenceFwConfiguration(staticConfig.DatabaseInfo),
+			PinEncryption: config.ToGraphPinEncryptionConfiguration(*staticConfig.PinEncryption),
+		},
+	}
+
+	return graphConfig
+}
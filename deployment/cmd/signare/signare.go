@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,10 +13,13 @@ import (
 	"time"
 
 	"github.com/hyperledger-labs/signare/app/pkg/commons/logger"
+	"github.com/hyperledger-labs/signare/app/pkg/commons/tlsconfig"
 	"github.com/hyperledger-labs/signare/app/pkg/graph"
+	"github.com/hyperledger-labs/signare/app/pkg/usecases/health"
 	"github.com/hyperledger-labs/signare/app/pkg/usecases/hsmconnector"
 	"github.com/hyperledger-labs/signare/deployment/cmd/signare/config"
 	"github.com/hyperledger-labs/signare/deployment/cmd/signare/flags"
+	"github.com/hyperledger-labs/signare/deployment/cmd/signare/pinrotator"
 	"github.com/hyperledger-labs/signare/deployment/cmd/signare/upgrader"
 
 	"github.com/gorilla/handlers"
@@ -32,6 +36,8 @@ const (
 	defaultPrometheusPort = 9785
 	defaultHTTPPort       = 32325
 	defaultRPCPort        = 4545
+
+	defaultShutdownDelay = 5 * time.Second
 )
 
 var (
@@ -55,6 +61,7 @@ func main() {
 	viper.SetEnvPrefix("GOsignare")
 
 	coreCmd.AddCommand(upgrader.Command())
+	coreCmd.AddCommand(pinrotator.Command())
 
 	if err := coreCmd.Execute(); err != nil {
 		logger.LogEntry(context.Background()).Errorf("not able to bootstrap: error executing %s cmd", name)
@@ -135,6 +142,14 @@ func startServer(_ *cobra.Command, _ []string) {
 	}
 
 	appGraph.Build()
+	schemaVersion, err := appGraph.UseCases().HealthUseCase.CheckSchemaVersion(ctxMainWithCancellation, health.CheckSchemaVersionInput{})
+	if err != nil {
+		panic(fmt.Sprintf("error checking the version of the database schema: [%v]", err))
+	}
+	if schemaVersion.Status == health.StatusUp {
+		logger.LogEntry(ctxMainWithCancellation).Info(schemaVersion.Detail)
+	}
+
 	initialSignerAdministrator := viper.GetString(flags.SignareAdministratorFlag)
 	responseMessage, setInitialASignerAdministratorErr := appGraph.SetInitialSignerAdministrator(initialSignerAdministrator)
 	if setInitialASignerAdministratorErr != nil {
@@ -143,7 +158,12 @@ func startServer(_ *cobra.Command, _ []string) {
 	}
 	logger.LogEntry(ctxMainWithCancellation).Infof(responseMessage)
 
-	httpServer := startMainServer(addr, *appGraph)
+	tlsConfig, err := getTLSConfiguration(staticConfig)
+	if err != nil {
+		panic(fmt.Sprintf("error configuring TLS: [%v]", err))
+	}
+
+	httpServer := startMainServer(addr, *appGraph, tlsConfig)
 	var rpcServerAddress string
 	if viper.GetString(flags.ListenAddressFlag) == defaultAllAddresses {
 		rpcServerAddress = fmt.Sprintf(":%d", viper.GetInt(flags.RPCPortFlag))
@@ -151,7 +171,7 @@ func startServer(_ *cobra.Command, _ []string) {
 		rpcServerAddress = fmt.Sprintf("%s:%d", viper.GetString(flags.ListenAddressFlag), viper.GetInt(flags.RPCPortFlag))
 	}
 
-	rpcServer := startRPCServer(rpcServerAddress, *appGraph)
+	rpcServer := startRPCServer(rpcServerAddress, *appGraph, tlsConfig)
 
 	var metricsServer *http.Server
 	if staticConfig.MetricsConfig != nil {
@@ -161,6 +181,8 @@ func startServer(_ *cobra.Command, _ []string) {
 		}
 	}
 
+	appGraph.UseCases().HSMHealthMonitor.Start(ctxMainWithCancellation)
+
 	// Shutdown server
 	terminationChannel := make(chan os.Signal, 1)
 	signal.Notify(terminationChannel, os.Interrupt, syscall.SIGTERM)
@@ -171,6 +193,7 @@ func startServer(_ *cobra.Command, _ []string) {
 			mainCancel() // the mainCancel is triggered from terminationChannel
 		case <-ctxMainWithCancellation.Done():
 			logger.LogEntry(ctxMainWithCancellation).Info("shutting down signare")
+			drainServers(context.Background(), *appGraph, staticConfig)
 			if err = shutDownServer(ctxMainWithCancellation, httpServer); err != nil {
 				logger.LogEntry(ctxMainWithCancellation).Errorf("error shutting down main HTTP signare server: %v", err)
 			}
@@ -182,6 +205,7 @@ func startServer(_ *cobra.Command, _ []string) {
 					logger.LogEntry(ctxMainWithCancellation).Errorf("error shutting down metrics server: %v", err)
 				}
 			}
+			appGraph.UseCases().HSMHealthMonitor.Stop()
 			_, err = appGraph.UseCases().HSMConnector.CloseAll(context.Background(), hsmconnector.CloseAllInput{})
 			if err != nil {
 				logger.LogEntry(ctxMainWithCancellation).Errorf("error closing HSM resources: %v", err)
@@ -192,27 +216,32 @@ func startServer(_ *cobra.Command, _ []string) {
 	}
 }
 
-func startMainServer(addr string, appGraph graph.ApplicationGraph) *http.Server {
+func startMainServer(addr string, appGraph graph.ApplicationGraph, tlsConfig *tls.Config) *http.Server {
 	router := appGraph.MainServer()
+	// the health endpoints are served without the middlewares of the main router, which serves the rest of requests
+	healthRouter := appGraph.HealthServer()
+	healthRouter.MainRouter().NotFoundHandler = router.MainRouter()
 	srv := &http.Server{
 		Addr:              addr,
 		WriteTimeout:      time.Second * 15,
 		ReadTimeout:       time.Second * 15,
 		IdleTimeout:       time.Second * 60,
 		ReadHeaderTimeout: time.Second * 15,
-		Handler:           handlers.LoggingHandler(os.Stdout, router.MainRouter()),
+		Handler:           handlers.LoggingHandler(os.Stdout, healthRouter.MainRouter()),
+		TLSConfig:         tlsConfig,
 	}
-	logger.LogEntry(context.Background()).Infof("starting HTTP server on %s", addr)
+	logger.LogEntry(context.Background()).Infof("starting HTTP server on %s (TLS: %t)", addr, tlsConfig != nil)
+	printRoutes(context.Background(), healthRouter.MainRouter())
 	printRoutes(context.Background(), router.MainRouter())
 	go func() {
-		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := listenAndServe(srv); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			panic(fmt.Sprintf("error starting HTTP server: %v", err))
 		}
 	}()
 	return srv
 }
 
-func startRPCServer(addr string, appGraph graph.ApplicationGraph) *http.Server {
+func startRPCServer(addr string, appGraph graph.ApplicationGraph, tlsConfig *tls.Config) *http.Server {
 	rpcRouter := appGraph.RPCServer()
 	srv := &http.Server{
 		Addr:              addr,
@@ -221,17 +250,70 @@ func startRPCServer(addr string, appGraph graph.ApplicationGraph) *http.Server {
 		IdleTimeout:       time.Second * 60,
 		ReadHeaderTimeout: time.Second * 15,
 		Handler:           handlers.LoggingHandler(os.Stdout, rpcRouter.Router()),
+		TLSConfig:         tlsConfig,
 	}
-	logger.LogEntry(context.Background()).Infof("starting JSON-RPC server on %s", addr)
+	logger.LogEntry(context.Background()).Infof("starting JSON-RPC server on %s (TLS: %t)", addr, tlsConfig != nil)
 	printRPCMethods(context.Background(), appGraph.RPCMethods())
 	go func() {
-		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := listenAndServe(srv); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			panic(fmt.Sprintf("error starting JSON-RPC server: %v", err))
 		}
 	}()
 	return srv
 }
 
+// drainServers reports the signare as not ready and waits for the load balancers to stop sending it requests, so that
+// the requests in flight finish before the servers are shut down and the HSM resources are closed
+func drainServers(ctx context.Context, appGraph graph.ApplicationGraph, staticConfig *config.StaticConfiguration) {
+	_, err := appGraph.UseCases().HealthUseCase.StartShutdown(ctx, health.StartShutdownInput{})
+	if err != nil {
+		logger.LogEntry(ctx).Errorf("error reporting the shutdown of signare: %v", err)
+	}
+
+	shutdownDelay := defaultShutdownDelay
+	if staticConfig.Health != nil && staticConfig.Health.ShutdownDelayInSeconds != nil {
+		shutdownDelay = time.Duration(*staticConfig.Health.ShutdownDelayInSeconds) * time.Second
+	}
+	if shutdownDelay > 0 {
+		logger.LogEntry(ctx).Infof("waiting %s for the load balancers to stop sending requests", shutdownDelay)
+		time.Sleep(shutdownDelay)
+	}
+}
+
+// listenAndServe serves over TLS if the server has a TLS configuration and over plain HTTP otherwise
+func listenAndServe(srv *http.Server) error {
+	if srv.TLSConfig != nil {
+		// the certificates are already loaded in the TLS configuration
+		return srv.ListenAndServeTLS("", "")
+	}
+	return srv.ListenAndServe()
+}
+
+// getTLSConfiguration returns the TLS configuration of the HTTP and JSON-RPC listeners, or nil if they listen in plain HTTP
+func getTLSConfiguration(staticConfig *config.StaticConfiguration) (*tls.Config, error) {
+	clientCertificateAuthentication := staticConfig.Authentication != nil && staticConfig.Authentication.ClientCertificate != nil
+	if staticConfig.TLS == nil {
+		if clientCertificateAuthentication {
+			return nil, errors.New("'tls' must be configured to authenticate the requests with client certificates")
+		}
+		return nil, nil
+	}
+	tlsConfig, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+		CertFile:     staticConfig.TLS.CertFile,
+		KeyFile:      staticConfig.TLS.KeyFile,
+		MinVersion:   staticConfig.TLS.MinVersion,
+		ClientCAFile: staticConfig.TLS.ClientCAFile,
+		ClientAuth:   tlsconfig.ClientAuthMode(staticConfig.TLS.ClientAuth),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if clientCertificateAuthentication && tlsConfig.ClientAuth == tls.NoClientCert {
+		return nil, errors.New("'tls.clientCAFile' must be configured to authenticate the requests with client certificates")
+	}
+	return tlsConfig, nil
+}
+
 func startMetricsServers(staticConfig *config.StaticConfiguration, appGraph graph.ApplicationGraph) (*http.Server, error) {
 	if staticConfig.MetricsConfig.PrometheusMetricsConfig == nil {
 		return nil, errors.New("unknown metric option to start server listener")
@@ -270,32 +352,43 @@ func toGraphConfiguration(staticConfig *config.StaticConfiguration) graph.Config
 			CommitHash: &commitHash,
 		},
 		Libraries: graph.LibrariesConfig{
-			PersistenceFw: graph.PersistenceFwConfig{
-				PostgreSQL: &graph.PostgresSQLConfig{
-					Host:     staticConfig.DatabaseInfo.PostgreSQL.Host,
-					Port:     &staticConfig.DatabaseInfo.PostgreSQL.Port,
-					Scheme:   &staticConfig.DatabaseInfo.PostgreSQL.Scheme,
-					Username: staticConfig.DatabaseInfo.PostgreSQL.Username,
-					Password: staticConfig.DatabaseInfo.PostgreSQL.Password,
-					SSLMode:  staticConfig.DatabaseInfo.PostgreSQL.SSLMode,
-					Database: staticConfig.DatabaseInfo.PostgreSQL.Database,
-				},
-			},
-			HSMModules: graph.HSMModules{},
+			PersistenceFw: config.ToGraphPersistenceFwConfiguration(staticConfig.DatabaseInfo),
+			HSMModules:    graph.HSMModules{},
 		},
 	}
 
 	if staticConfig.HSMModules.SoftHSM != nil {
 		graphConfig.Libraries.HSMModules.SoftHSM = &graph.SoftHSMConfig{
-			Library: staticConfig.HSMModules.SoftHSM.Library,
+			Library:                   staticConfig.HSMModules.SoftHSM.Library,
+			OperationTimeoutInSeconds: staticConfig.HSMModules.SoftHSM.OperationTimeoutInSeconds,
 		}
 	}
 
-	if staticConfig.DatabaseInfo.PostgreSQL.SQLClient != nil {
-		graphConfig.Libraries.PersistenceFw.PostgreSQL.SQLClient = &graph.PostgresSQLClientConfig{
-			MaxIdleConnections:    staticConfig.DatabaseInfo.PostgreSQL.SQLClient.MaxIdleConnections,
-			MaxOpenConnections:    staticConfig.DatabaseInfo.PostgreSQL.SQLClient.MaxOpenConnections,
-			MaxConnectionLifetime: staticConfig.DatabaseInfo.PostgreSQL.SQLClient.MaxConnectionLifetime,
+	if staticConfig.HSMModules.CloudKMS != nil {
+		graphConfig.Libraries.HSMModules.CloudKMS = &graph.CloudKMSConfig{
+			Endpoint:                  staticConfig.HSMModules.CloudKMS.Endpoint,
+			Region:                    staticConfig.HSMModules.CloudKMS.Region,
+			AccessKeyID:               staticConfig.HSMModules.CloudKMS.AccessKeyID,
+			SecretAccessKey:           staticConfig.HSMModules.CloudKMS.SecretAccessKey,
+			SessionToken:              staticConfig.HSMModules.CloudKMS.SessionToken,
+			OperationTimeoutInSeconds: staticConfig.HSMModules.CloudKMS.OperationTimeoutInSeconds,
+		}
+	}
+
+	if staticConfig.HSMModules.PKCS11 != nil {
+		graphConfig.Libraries.HSMModules.PKCS11 = &graph.PKCS11Config{
+			Libraries:                 staticConfig.HSMModules.PKCS11.Libraries,
+			OperationTimeoutInSeconds: staticConfig.HSMModules.PKCS11.OperationTimeoutInSeconds,
+		}
+	}
+
+	if staticConfig.PinEncryption != nil {
+		graphConfig.Libraries.PinEncryption = config.ToGraphPinEncryptionConfiguration(*staticConfig.PinEncryption)
+	}
+
+	if staticConfig.DatabaseInfo.SchemaVersionCheck != "" {
+		graphConfig.SchemaVersionCheck = &graph.SchemaVersionCheckConfig{
+			Mode: &staticConfig.DatabaseInfo.SchemaVersionCheck,
 		}
 	}
 
@@ -312,6 +405,50 @@ func toGraphConfiguration(staticConfig *config.StaticConfiguration) graph.Config
 		}
 	}
 
+	if staticConfig.Authentication != nil {
+		graphConfig.Authentication = &graph.AuthenticationConfig{}
+		if staticConfig.Authentication.JWT != nil {
+			graphConfig.Authentication.JWT = &graph.JWTAuthenticationConfig{
+				JWKSFile:                     staticConfig.Authentication.JWT.JWKSFile,
+				JWKSURL:                      staticConfig.Authentication.JWT.JWKSURL,
+				JWKSRefreshIntervalInSeconds: staticConfig.Authentication.JWT.JWKSRefreshIntervalInSeconds,
+				Issuer:                       staticConfig.Authentication.JWT.Issuer,
+				Audience:                     staticConfig.Authentication.JWT.Audience,
+				UserClaim:                    staticConfig.Authentication.JWT.UserClaim,
+				ApplicationClaim:             staticConfig.Authentication.JWT.ApplicationClaim,
+				ClockSkewInSeconds:           staticConfig.Authentication.JWT.ClockSkewInSeconds,
+			}
+		}
+		if staticConfig.Authentication.ClientCertificate != nil {
+			graphConfig.Authentication.ClientCertificate = &graph.ClientCertificateAuthenticationConfig{
+				UserField:         staticConfig.Authentication.ClientCertificate.UserField,
+				UserPrefix:        staticConfig.Authentication.ClientCertificate.UserPrefix,
+				ApplicationField:  staticConfig.Authentication.ClientCertificate.ApplicationField,
+				ApplicationPrefix: staticConfig.Authentication.ClientCertificate.ApplicationPrefix,
+			}
+		}
+	}
+
+	if staticConfig.RPC != nil && staticConfig.RPC.Batch != nil {
+		graphConfig.RPCBatch = &graph.RPCBatchConfig{
+			MaxConcurrentRequests: staticConfig.RPC.Batch.MaxConcurrentRequests,
+			MaxSize:               staticConfig.RPC.Batch.MaxSize,
+		}
+	}
+
+	if staticConfig.Cache != nil {
+		graphConfig.Cache = &graph.CacheConfig{
+			TTLInSeconds: staticConfig.Cache.TTLInSeconds,
+			MaxEntries:   staticConfig.Cache.MaxEntries,
+		}
+	}
+
+	if staticConfig.HSMHealthMonitor != nil {
+		graphConfig.HSMHealthMonitor = &graph.HSMHealthMonitorConfig{
+			IntervalInSeconds: staticConfig.HSMHealthMonitor.IntervalInSeconds,
+		}
+	}
+
 	if staticConfig.MetricsConfig != nil && staticConfig.MetricsConfig.PrometheusMetricsConfig != nil {
 		graphConfig.Libraries.Metrics = &graph.MetricsConfig{
 			Prometheus: graph.PrometheusConfig{
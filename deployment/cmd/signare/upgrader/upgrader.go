@@ -3,8 +3,10 @@ package upgrader
 
 import (
 	"context"
-	"embed"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 
 	embedded "github.com/hyperledger-labs/signare/app"
 	"github.com/hyperledger-labs/signare/app/pkg/commons/persistence/dbmigrator"
@@ -17,17 +19,110 @@ import (
 	"github.com/spf13/viper"
 )
 
+const (
+	toFlag     = "to"
+	dryRunFlag = "dry-run"
+)
+
 func Command() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "upgrade",
-		Long: "upgrades to the given version",
+		Use: "upgrade",
+		Long: "upgrades the database schema to the latest version, or to the version given with --to, which downgrades the " +
+			"database schema if it is lower than the current version",
+		Args: cobra.NoArgs,
 		RunE: executeUpgrade,
 	}
+	cmd.Flags().Int(toFlag, 0, "Version of the database schema to migrate to (default latest version)")
+	cmd.Flags().Bool(dryRunFlag, false, "Prints the SQL steps of the migration without executing them")
+
+	cmd.AddCommand(statusCommand())
+	cmd.AddCommand(forceCommand())
 	return cmd
 }
 
-func executeUpgrade(_ *cobra.Command, _ []string) error {
+func statusCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:  "status",
+		Long: "prints the current version of the database schema, the latest version available and whether the last migration didn't finish",
+		Args: cobra.NoArgs,
+		RunE: executeStatus,
+	}
+}
+
+func forceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use: "force <version>",
+		Long: "sets the version of the database schema and clears its dirty flag without executing any migration step. It must only be " +
+			"used once the database has been repaired manually after a migration step that failed, so it fails if the database schema is not dirty",
+		Args: cobra.ExactArgs(1),
+		RunE: executeForce,
+	}
+}
+
+func executeUpgrade(cmd *cobra.Command, _ []string) error {
 	ctx := context.Background()
+	dbMigrator := newDbMigrator()
+
+	input := dbmigrator.MigrateFromFilesInput{FS: embedded.DatabaseMigrations}
+	if cmd.Flags().Changed(toFlag) {
+		targetVersion, err := cmd.Flags().GetInt(toFlag)
+		if err != nil {
+			return err
+		}
+		input.TargetVersion = &targetVersion
+	}
+
+	dryRun, err := cmd.Flags().GetBool(dryRunFlag)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		plan, planErr := dbMigrator.PlanFromFiles(ctx, input)
+		if planErr != nil {
+			return planErr
+		}
+		printPlan(cmd.OutOrStdout(), *plan)
+		return nil
+	}
+
+	return dbMigrator.MigrateFromFiles(ctx, input)
+}
+
+func executeStatus(cmd *cobra.Command, _ []string) error {
+	ctx := context.Background()
+	dbMigrator := newDbMigrator()
+
+	version, err := dbMigrator.GetVersion(ctx, dbmigrator.GetVersionInput{})
+	if err != nil {
+		return err
+	}
+	latestVersion, err := dbMigrator.LatestVersionFromFiles(dbmigrator.LatestVersionFromFilesInput{FS: embedded.DatabaseMigrations})
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "current version: %d\n", version.Version)
+	fmt.Fprintf(out, "latest version: %d\n", latestVersion)
+	fmt.Fprintf(out, "dirty: %t\n", version.Dirty)
+	return nil
+}
+
+func executeForce(_ *cobra.Command, args []string) error {
+	ctx := context.Background()
+	version, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid version [%s]: %w", args[0], err)
+	}
+
+	dbMigrator := newDbMigrator()
+	return dbMigrator.ForceVersion(ctx, dbmigrator.ForceVersionInput{
+		FS:      embedded.DatabaseMigrations,
+		Version: version,
+	})
+}
+
+func newDbMigrator() *dbmigrator.DbMigrator {
 	configFilePath := viper.GetString(flags.SignareConfigPathFlag)
 	staticConfig, err := config.GetStaticConfiguration(configFilePath)
 	if err != nil {
@@ -41,38 +136,30 @@ func executeUpgrade(_ *cobra.Command, _ []string) error {
 	}
 
 	appGraph.Build()
-	upgradeDatabase(ctx, embedded.DatabaseMigrations, *appGraph)
+	dbMigrator, err := dbmigrator.NewDbMigrator(dbmigrator.DbMigratorOptions{Connection: appGraph.PersistenceFwConnection()})
+	if err != nil {
+		panic(err)
+	}
+	return dbMigrator
+}
 
-	return nil
+func printPlan(out io.Writer, plan dbmigrator.MigrationPlan) {
+	if len(plan.Steps) == 0 {
+		fmt.Fprintf(out, "nothing to migrate, the database schema has version %d\n", plan.CurrentVersion)
+		return
+	}
+	fmt.Fprintf(out, "-- migration from version %d to version %d\n", plan.CurrentVersion, plan.TargetVersion)
+	for _, step := range plan.Steps {
+		fmt.Fprintf(out, "\n-- step to version %d [%s]\n%s\n", step.Version, step.Description, strings.TrimSpace(step.Query))
+	}
 }
 
 func toGraphConfiguration(staticConfig *config.StaticConfiguration) upgrade.Config {
 	graphConfig := upgrade.Config{
 		Libraries: upgrade.LibrariesConfig{
-			PersistenceFw: upgrade.PersistenceFwConfig{
-				PostgreSQL: &upgrade.PostgresSQLConfig{
-					Host:     staticConfig.DatabaseInfo.PostgreSQL.Host,
-					Port:     &staticConfig.DatabaseInfo.PostgreSQL.Port,
-					Scheme:   &staticConfig.DatabaseInfo.PostgreSQL.Scheme,
-					Username: staticConfig.DatabaseInfo.PostgreSQL.Username,
-					Password: staticConfig.DatabaseInfo.PostgreSQL.Password,
-					SSLMode:  staticConfig.DatabaseInfo.PostgreSQL.SSLMode,
-					Database: staticConfig.DatabaseInfo.PostgreSQL.Database,
-				},
-			},
+			PersistenceFw: config.ToGraphPersistenceFwConfiguration(staticConfig.DatabaseInfo),
 		},
 	}
 
 	return graphConfig
 }
-
-func upgradeDatabase(ctx context.Context, fs embed.FS, upgradeGraph upgrade.UpgradeGraph) {
-	dbMigrator, err := dbmigrator.NewDbMigrator(dbmigrator.DbMigratorOptions{Connection: upgradeGraph.PersistenceFwConnection()})
-	if err != nil {
-		panic(err)
-	}
-	err = dbMigrator.MigrateFromFiles(ctx, dbmigrator.MigrateFromFilesInput{FS: fs})
-	if err != nil {
-		panic(err)
-	}
-}